@@ -107,12 +107,12 @@ func ingestSLSA(ctx context.Context, client graphql.Client) {
 	}
 
 	artifact := model.ArtifactInputSpec{
-		Digest:    "5a787865sd676dacb0142afa0b83029cd7befd9",
+		Digest:    "5a787865fd676dacb0142afa0b83029cd7befd9",
 		Algorithm: "sha1",
 	}
 	materials := []model.ArtifactInputSpec{
 		model.ArtifactInputSpec{
-			Digest:    "0123456789abcdef0000000fedcba9876543210",
+			Digest:    "0123456789abcdef00000000fedcba9876543210",
 			Algorithm: "sha1",
 		},
 	}
@@ -278,7 +278,7 @@ func ingestOccurrence(ctx context.Context, client graphql.Client) {
 		},
 		src: nil,
 		art: model.ArtifactInputSpec{
-			Digest:    "5a787865sd676dacb0142afa0b83029cd7befd9",
+			Digest:    "5a787865fd676dacb0142afa0b83029cd7befd9",
 			Algorithm: "sha1",
 		},
 		occurrence: model.IsOccurrenceInputSpec{
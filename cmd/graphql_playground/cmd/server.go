@@ -70,6 +70,7 @@ func startServer() {
 
 	config := generated.Config{Resolvers: &topResolver}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv.SetErrorPresenter(resolvers.ErrorPresenter)
 
 	// Ingest additional test data in a go-routine.
 	port := flags.playgroundPort
@@ -25,6 +25,7 @@ import (
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/depsdev"
 	"github.com/guacsec/guac/pkg/certifier/osv"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -57,6 +58,13 @@ var certifierCmd = &cobra.Command{
 			logger.Fatalf("unable to register certifier: %w", err)
 		}
 
+		// this is to satisfy the RegisterCertifier function
+		depsDevCertifier := func() certifier.Certifier { return depsdev.NewDepsDevCertifier() }
+
+		if err := certify.RegisterCertifier(depsDevCertifier, certifier.CertifierDepsDev); err != nil {
+			logger.Fatalf("unable to register certifier: %w", err)
+		}
+
 		authToken := graphdb.CreateAuthTokenWithUsernameAndPassword(opts.user, opts.pass, opts.realm)
 		client, err := graphdb.NewGraphClient(opts.dbAddr, authToken)
 		if err != nil {
@@ -19,7 +19,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -28,9 +27,11 @@ import (
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/guacsec/guac/pkg/blobstore"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
+	"github.com/guacsec/guac/pkg/handler/collector/oci/sigverify"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor/key"
@@ -56,9 +57,14 @@ type options struct {
 	path string
 	// datasource for collectors
 	dataSource datasource.CollectSource
+	// keys and certificates to verify image signatures against
+	trustRoots *sigverify.TrustRoots
 
 	// gql endpoint
 	graphqlEndpoint string
+	// gql namespace and bearer token
+	graphqlNamespace string
+	graphqlToken     string
 }
 
 var exampleCmd = &cobra.Command{
@@ -76,6 +82,8 @@ var exampleCmd = &cobra.Command{
 			viper.GetString("verifier-keyPath"),
 			viper.GetString("verifier-keyID"),
 			viper.GetString("gql-endpoint"),
+			viper.GetString("gql-namespace"),
+			viper.GetString("gql-token"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -185,13 +193,15 @@ var exampleCmd = &cobra.Command{
 	},
 }
 
-func validateFlags(user string, pass string, dbAddr string, realm string, keyPath string, keyID string, graphqlEndpoint string, args []string) (options, error) {
+func validateFlags(user string, pass string, dbAddr string, realm string, keyPath string, keyID string, graphqlEndpoint string, graphqlNamespace string, graphqlToken string, args []string) (options, error) {
 	var opts options
 	opts.user = user
 	opts.pass = pass
 	opts.dbAddr = dbAddr
 	opts.realm = realm
 	opts.graphqlEndpoint = graphqlEndpoint
+	opts.graphqlNamespace = graphqlNamespace
+	opts.graphqlToken = graphqlToken
 
 	if keyPath != "" {
 		if strings.HasSuffix(keyPath, "pem") {
@@ -214,10 +224,35 @@ func validateFlags(user string, pass string, dbAddr string, realm string, keyPat
 }
 
 func getProcessor(ctx context.Context) (func(*processor.Document) (processor.DocumentTree, error), error) {
+	store, err := getBlobStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	process.RegisterBlobStore(store)
+	spdxFiles, err := processor.ParseSPDXFileMode(viper.GetString("spdx-files"))
+	if err != nil {
+		return nil, err
+	}
+	process.RegisterParserOptions(processor.ParserOptions{SPDXFiles: spdxFiles})
 	return func(d *processor.Document) (processor.DocumentTree, error) {
 		return process.Process(ctx, d)
 	}, nil
 }
+
+// getBlobStore opens the store for the original documents, or returns nil
+// if none is configured.
+func getBlobStore(ctx context.Context) (blobstore.Store, error) {
+	location := viper.GetString("blob-store")
+	if location == "" {
+		return nil, nil
+	}
+	store, err := blobstore.Open(ctx, location, blobstore.WithMaxBytes(viper.GetInt64("blob-store-max-bytes")))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open blob store: %w", err)
+	}
+	return store, nil
+}
+
 func getIngestor(ctx context.Context) (func(processor.DocumentTree) ([]assembler.IngestPredicates, error), error) {
 	return func(doc processor.DocumentTree) ([]assembler.IngestPredicates, error) {
 		// for guacone collectors, we do not integrate with the collectsub service
@@ -231,8 +266,8 @@ func getIngestor(ctx context.Context) (func(processor.DocumentTree) ([]assembler
 }
 
 func getAssembler(ctx context.Context, opts options) (func([]assembler.IngestPredicates) error, error) {
-	httpClient := http.Client{}
-	gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+	httpClient := helpers.NewHTTPClient(opts.graphqlNamespace, opts.graphqlToken)
+	gqlclient := helpers.WarnOnTruncation(ctx, graphql.NewClient(opts.graphqlEndpoint, httpClient))
 	f := helpers.GetAssembler(ctx, gqlclient)
 	return f, nil
 }
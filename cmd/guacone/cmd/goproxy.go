@@ -0,0 +1,204 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/goproxy"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type goProxyOptions struct {
+	options
+	proxy    string
+	cacheDir string
+	discover bool
+	modules  []string
+}
+
+var goProxyCmd = &cobra.Command{
+	Use:   "goproxy [flags] module_path1 module_path2...",
+	Short: "takes Go modules to download every version of from a Go module proxy to add to GUAC graph, this command talks directly to the graphQL endpoint",
+	Long: `takes Go modules to download every version of from a Go module proxy to add to GUAC graph, this command
+talks directly to the graphQL endpoint.
+
+For each version, the go.mod file and the hashes of the module zip are ingested as the package, its
+dependencies, the zip it occurs in and, for modules hosted on a known forge, the repository it comes from.
+With --goproxy-discover, the Go packages already in the graph are collected as well.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateGoProxyFlags(
+			viper.GetString("gql-endpoint"),
+			viper.GetString("gql-namespace"),
+			viper.GetString("gql-token"),
+			viper.GetString("goproxy-url"),
+			viper.GetString("goproxy-cache"),
+			viper.GetBool("goproxy-discover"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		// Register collector
+		var collectorOpts []goproxy.Option
+		if opts.cacheDir != "" {
+			cache, err := goproxy.NewDirCache(opts.cacheDir)
+			if err != nil {
+				logger.Fatalf("unable to create go proxy cache: %v", err)
+			}
+			collectorOpts = append(collectorOpts, goproxy.WithCache(cache))
+		}
+		if opts.discover {
+			httpClient := helpers.NewHTTPClient(opts.graphqlNamespace, opts.graphqlToken)
+			gqlclient := helpers.WarnOnTruncation(ctx, graphql.NewClient(opts.graphqlEndpoint, httpClient))
+			collectorOpts = append(collectorOpts, goproxy.WithDiscovery(func(ctx context.Context) ([]string, error) {
+				return discoverGoModules(ctx, gqlclient)
+			}))
+		}
+		goProxyCollector, err := goproxy.NewGoProxyCollector(opts.proxy, opts.modules, false, 10*time.Minute, collectorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create go proxy collector: %v", err)
+		}
+		err = collector.RegisterDocumentCollector(goProxyCollector, goproxy.GoProxyCollector)
+		if err != nil {
+			logger.Errorf("unable to register go proxy collector: %v", err)
+		}
+
+		// Get pipeline of components
+		processorFunc, err := getProcessor(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			os.Exit(1)
+		}
+		ingestorFunc, err := getIngestor(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			os.Exit(1)
+		}
+		assemblerFunc, err := getAssembler(ctx, opts.options)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			os.Exit(1)
+		}
+
+		totalNum := 0
+		gotErr := false
+		// Set emit function to go through the entire pipeline
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			start := time.Now()
+
+			docTree, err := processorFunc(d)
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to process doc: %v, fomat: %v, document: %v", err, d.Format, d.Type)
+			}
+
+			graphs, err := ingestorFunc(docTree)
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to ingest doc tree: %v", err)
+			}
+
+			err = assemblerFunc(graphs)
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to assemble graphs: %v", err)
+			}
+			t := time.Now()
+			elapsed := t.Sub(start)
+			logger.Infof("[%v] completed doc %+v", elapsed, d.SourceInformation)
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Fatal(err)
+		}
+
+		if gotErr {
+			logger.Fatalf("completed ingestion with errors")
+		} else {
+			logger.Infof("completed ingesting %v documents", totalNum)
+		}
+	},
+}
+
+func validateGoProxyFlags(graphqlEndpoint string, graphqlNamespace string, graphqlToken string, proxy string, cacheDir string, discover bool, args []string) (goProxyOptions, error) {
+	var opts goProxyOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.graphqlNamespace = graphqlNamespace
+	opts.graphqlToken = graphqlToken
+	opts.proxy = proxy
+	opts.cacheDir = cacheDir
+	opts.discover = discover
+
+	if len(args) < 1 && !discover {
+		return opts, fmt.Errorf("expected positional argument for module_path or --goproxy-discover")
+	}
+	opts.modules = args
+	return opts, nil
+}
+
+// discoverGoModules returns the paths of the Go packages in the graph, which
+// are the module paths when they were ingested from go.mod files or the
+// module proxy.
+func discoverGoModules(ctx context.Context, gqlclient graphql.Client) ([]string, error) {
+	resp, err := generated.GoModules(ctx, gqlclient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query Go modules: %w", err)
+	}
+	var paths []string
+	for _, pkg := range resp.Packages {
+		for _, namespace := range pkg.Namespaces {
+			for _, name := range namespace.Names {
+				path := name.Name
+				if namespace.Namespace != "" {
+					path = namespace.Namespace + "/" + name.Name
+				}
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths, nil
+}
+
+func init() {
+	rootCmd.AddCommand(goProxyCmd)
+}
@@ -17,19 +17,26 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	neo4j "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
 	"github.com/guacsec/guac/pkg/assembler/backends/testing"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/blobstore"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -43,6 +50,16 @@ type graphqlServerOptions struct {
 	graphqlPort    int
 	graphqlDebug   bool
 
+	// inmem specific
+	resultLimit  int
+	resultLimits map[string]int
+	autoIngest   bool
+	// cap on the namespaces in use, 0 for no cap
+	maxNamespaces int
+	// namespaces each bearer token can access, nil if requests are not
+	// authenticated
+	authTokens map[string][]string
+
 	// neo4j specific
 	dbAddr string
 	user   string
@@ -66,6 +83,11 @@ var graphqlServerCmd = &cobra.Command{
 			viper.GetString("gql-backend"),
 			viper.GetInt("gql-port"),
 			viper.GetBool("gql-debug"),
+			viper.GetInt("gql-result-limit"),
+			viper.GetStringMapString("gql-result-limits"),
+			viper.GetBool("gql-auto-ingest-subjects"),
+			viper.GetString("gql-auth-tokens"),
+			viper.GetInt("gql-max-namespaces"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -73,12 +95,30 @@ var graphqlServerCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		srv, err := getGraphqlServer(opts)
+		srv, backend, err := getGraphqlServer(opts)
 		if err != nil {
 			logger.Errorf("unable to initialize graphql server: %v", err)
 			os.Exit(1)
 		}
 		http.Handle("/query", srv)
+		if opts.authTokens != nil {
+			logger.Infof("graphql requests are authenticated, %d tokens loaded", len(opts.authTokens))
+		}
+
+		store, err := getBlobStore(ctx)
+		if err != nil {
+			logger.Errorf("unable to initialize blob store: %v", err)
+			os.Exit(1)
+		}
+		if store != nil {
+			blobs := blobstore.Handler(ctx, store)
+			if opts.graphqlBackend == gqlBackendInmem {
+				// documents are only served to the namespaces that hold them
+				blobs = helper.NamespaceHandler(helper.DocumentHandler(blobs, backend), opts.authTokens)
+			}
+			http.Handle("/blob/", http.StripPrefix("/blob", blobs))
+			logger.Infof("original documents served at http://localhost:%d/blob/<documentHash>", opts.graphqlPort)
+		}
 
 		logger.Infof("graphql server running with %v backend at http://localhost:%d/query", opts.graphqlBackend, opts.graphqlPort)
 		if opts.graphqlDebug {
@@ -91,7 +131,8 @@ var graphqlServerCmd = &cobra.Command{
 }
 
 func validateGraphqlServerFlags(user string, pass string, dbAddr string, realm string,
-	graphqlBackend string, graphqlPort int, graphqlDebug bool, args []string) (graphqlServerOptions, error) {
+	graphqlBackend string, graphqlPort int, graphqlDebug bool, resultLimit int, resultLimits map[string]string,
+	autoIngest bool, authTokens string, maxNamespaces int, args []string) (graphqlServerOptions, error) {
 
 	var opts graphqlServerOptions
 	opts.user = user
@@ -108,10 +149,56 @@ func validateGraphqlServerFlags(user string, pass string, dbAddr string, realm s
 	opts.graphqlPort = graphqlPort
 	opts.graphqlDebug = graphqlDebug
 
+	if resultLimit < 0 {
+		return opts, fmt.Errorf("invalid graphql result limit specified: %v", resultLimit)
+	}
+	opts.resultLimit = resultLimit
+	opts.resultLimits = map[string]int{}
+	for query, value := range resultLimits {
+		if !slices.Contains(testing.ResultLimitQueries, query) {
+			return opts, fmt.Errorf("invalid graphql result limit specified for %s: not one of %s", query, strings.Join(testing.ResultLimitQueries, ", "))
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return opts, fmt.Errorf("invalid graphql result limit specified for %s: %w", query, err)
+		}
+		opts.resultLimits[query] = limit
+	}
+	opts.autoIngest = autoIngest
+
+	if maxNamespaces < 0 {
+		return opts, fmt.Errorf("invalid graphql namespace cap specified: %v", maxNamespaces)
+	}
+	opts.maxNamespaces = maxNamespaces
+
+	if authTokens != "" {
+		if graphqlBackend != gqlBackendInmem {
+			return opts, fmt.Errorf("namespaces are only supported by the %v backend", gqlBackendInmem)
+		}
+		b, err := os.ReadFile(authTokens)
+		if err != nil {
+			return opts, fmt.Errorf("unable to read graphql auth tokens: %w", err)
+		}
+		if err := json.Unmarshal(b, &opts.authTokens); err != nil {
+			return opts, fmt.Errorf("unable to parse graphql auth tokens: %w", err)
+		}
+		if opts.authTokens == nil {
+			opts.authTokens = map[string][]string{}
+		}
+		for token, scopes := range opts.authTokens {
+			if token == "" {
+				return opts, fmt.Errorf("empty graphql auth token")
+			}
+			if err := helper.ValidateScopes(scopes); err != nil {
+				return opts, fmt.Errorf("invalid scopes for graphql auth token: %w", err)
+			}
+		}
+	}
+
 	return opts, nil
 }
 
-func getGraphqlServer(opts graphqlServerOptions) (*handler.Server, error) {
+func getGraphqlServer(opts graphqlServerOptions) (http.Handler, backends.Backend, error) {
 	var topResolver resolvers.Resolver
 
 	switch opts.graphqlBackend {
@@ -126,26 +213,37 @@ func getGraphqlServer(opts graphqlServerOptions) (*handler.Server, error) {
 
 		backend, err := neo4j.GetBackend(&args)
 		if err != nil {
-			return nil, fmt.Errorf("Error creating neo4j backend: %w", err)
+			return nil, nil, fmt.Errorf("Error creating neo4j backend: %w", err)
 		}
 
 		topResolver = resolvers.Resolver{Backend: backend}
 	case gqlBackendInmem:
-		args := testing.DemoCredentials{}
+		args := testing.DemoCredentials{
+			DefaultResultLimit: opts.resultLimit,
+			ResultLimits:       opts.resultLimits,
+			AutoIngestSubjects: opts.autoIngest,
+			MaxNamespaces:      opts.maxNamespaces,
+		}
 		backend, err := testing.GetEmptyBackend(&args)
 		if err != nil {
-			return nil, fmt.Errorf("Error creating inmem backend: %w", err)
+			return nil, nil, fmt.Errorf("Error creating inmem backend: %w", err)
 		}
 
 		topResolver = resolvers.Resolver{Backend: backend}
 	default:
-		return nil, fmt.Errorf("invalid backend specified: %v", opts.graphqlBackend)
+		return nil, nil, fmt.Errorf("invalid backend specified: %v", opts.graphqlBackend)
 	}
 
 	config := generated.Config{Resolvers: &topResolver}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv.AroundResponses(resolvers.ResultLimits)
+	srv.SetErrorPresenter(resolvers.ErrorPresenter)
 
-	return srv, nil
+	if opts.graphqlBackend == gqlBackendInmem {
+		// only the inmem backend partitions its data per namespace
+		return helper.NamespaceHandler(srv, opts.authTokens), topResolver.Backend, nil
+	}
+	return srv, topResolver.Backend, nil
 }
 
 func init() {
@@ -0,0 +1,90 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type healthcheckOptions struct {
+	graphqlEndpoint string
+	graphqlToken    string
+}
+
+var healthcheckCmd = &cobra.Command{
+	Use:   "healthcheck [flags]",
+	Short: "checks that the graphql server can ingest evidence and query it back",
+	Long: `checks that the graphql server can ingest evidence and query it back unchanged, reporting the latency
+of each step and exiting with a non-zero code on failure.
+
+The evidence is ingested into the reserved "` + helpers.HealthcheckNamespace + `" namespace, which is purged
+before and after the check, so it is safe to run repeatedly against a server in use. --gql-token must give
+access to that namespace if the server authenticates requests.`,
+	Args: cobra.MinimumNArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateHealthcheckFlags(
+			viper.GetString("gql-endpoint"),
+			viper.GetString("gql-token"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := helpers.NewHTTPClient(helpers.HealthcheckNamespace, opts.graphqlToken)
+		gqlclient := helpers.WarnOnTruncation(ctx, graphql.NewClient(opts.graphqlEndpoint, httpClient))
+
+		steps, err := helpers.Healthcheck(ctx, gqlclient)
+		for _, step := range steps {
+			result := "ok"
+			if step.Err != nil {
+				result = fmt.Sprintf("failed: %v", step.Err)
+			}
+			fmt.Printf("%-20s %10v  %s\n", step.Name, step.Duration, result)
+		}
+		if err != nil {
+			logger.Errorf("healthcheck of %s failed: %v", opts.graphqlEndpoint, err)
+			os.Exit(1)
+		}
+		fmt.Println("healthcheck passed")
+	},
+}
+
+func validateHealthcheckFlags(graphqlEndpoint string, graphqlToken string, args []string) (healthcheckOptions, error) {
+	var opts healthcheckOptions
+	if graphqlEndpoint == "" {
+		return opts, fmt.Errorf("no graphql endpoint specified")
+	}
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.graphqlToken = graphqlToken
+	return opts, nil
+}
+
+func init() {
+	rootCmd.AddCommand(healthcheckCmd)
+}
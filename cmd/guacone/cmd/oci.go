@@ -19,12 +19,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/oci"
+	"github.com/guacsec/guac/pkg/handler/collector/oci/sigverify"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/regclient/regclient/types/ref"
@@ -45,6 +48,8 @@ var ociCmd = &cobra.Command{
 			viper.GetString("gdbpass"),
 			viper.GetString("gdbaddr"),
 			viper.GetString("realm"),
+			viper.GetStringSlice("cosign-keys"),
+			viper.GetStringSlice("signing-roots"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -53,7 +58,7 @@ var ociCmd = &cobra.Command{
 		}
 
 		// Register collector
-		ociCollector := oci.NewOCICollector(ctx, opts.dataSource, false, 10*time.Minute)
+		ociCollector := oci.NewOCICollector(ctx, opts.dataSource, false, 10*time.Minute, oci.WithTrustRoots(opts.trustRoots))
 		err = collector.RegisterDocumentCollector(ociCollector, oci.OCICollector)
 		if err != nil {
 			logger.Errorf("unable to register oci collector: %v", err)
@@ -127,13 +132,34 @@ var ociCmd = &cobra.Command{
 	},
 }
 
-func validateOCIFlags(user string, pass string, dbAddr string, realm string, args []string) (options, error) {
+func validateOCIFlags(user string, pass string, dbAddr string, realm string, cosignKeys []string, signingRoots []string, args []string) (options, error) {
 	var opts options
 	opts.user = user
 	opts.pass = pass
 	opts.dbAddr = dbAddr
 	opts.realm = realm
 
+	// keys and roots are named after their file, without the extension
+	opts.trustRoots = sigverify.NewTrustRoots()
+	for _, path := range cosignKeys {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return opts, fmt.Errorf("unable to read cosign key: %w", err)
+		}
+		if err := opts.trustRoots.AddCosignKey(trustRootName(path), b); err != nil {
+			return opts, err
+		}
+	}
+	for _, path := range signingRoots {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return opts, fmt.Errorf("unable to read signing root: %w", err)
+		}
+		if err := opts.trustRoots.AddRoot(trustRootName(path), b); err != nil {
+			return opts, err
+		}
+	}
+
 	if len(args) < 1 {
 		return opts, fmt.Errorf("expected positional argument for image_path")
 	}
@@ -158,6 +184,11 @@ func validateOCIFlags(user string, pass string, dbAddr string, realm string, arg
 	return opts, nil
 }
 
+func trustRootName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
 func init() {
 	rootCmd.AddCommand(ociCmd)
 }
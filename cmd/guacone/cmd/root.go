@@ -19,7 +19,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/guacsec/guac/pkg/handler/collector/goproxy"
+	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 
 	homedir "github.com/mitchellh/go-homedir"
@@ -36,6 +39,17 @@ var flags = struct {
 	keyPath string
 	keyID   string
 
+	// signature verification flags
+	cosignKeys   []string
+	signingRoots []string
+
+	// blob store flags
+	blobStore         string
+	blobStoreMaxBytes int64
+
+	// parser flags
+	spdxFiles string
+
 	// collect-sub flags
 	collectSubAddr       string
 	collectSubListenPort int
@@ -44,9 +58,25 @@ var flags = struct {
 	graphqlBackend string
 	graphqlPort    int
 	graphqlDebug   bool
+	resultLimit    int
+	resultLimits   map[string]string
+	authTokens     string
+	autoIngest     bool
+	maxNamespaces  int
 
 	// graphQL client flags
-	graphqlEndpoint string
+	graphqlEndpoint  string
+	graphqlNamespace string
+	graphqlToken     string
+
+	// stitch flags
+	stitchWindow time.Duration
+	stitchApply  bool
+
+	// go proxy collector flags
+	goProxyURL      string
+	goProxyCache    string
+	goProxyDiscover bool
 }{}
 
 var cfgFile string
@@ -60,6 +90,15 @@ func init() {
 	persistentFlags.StringVar(&flags.realm, "realm", "neo4j", "realm to connect to graph db")
 	persistentFlags.StringVar(&flags.keyPath, "verifier-keyPath", "", "path to pem file to verify dsse")
 	persistentFlags.StringVar(&flags.keyID, "verifier-keyID", "", "ID of the key to be stored")
+	persistentFlags.StringSliceVar(&flags.cosignKeys, "cosign-keys", nil, "paths to pem files of public keys trusted for cosign signatures on images")
+	persistentFlags.StringSliceVar(&flags.signingRoots, "signing-roots", nil, "paths to pem files of root certificates trusted for keyless cosign and notation signatures on images")
+
+	// blob store flags
+	persistentFlags.StringVar(&flags.blobStore, "blob-store", "", "directory or bucket URL (e.g. s3://bucket?region=us-east-1) to keep the original documents in, disabled if empty")
+	persistentFlags.Int64Var(&flags.blobStoreMaxBytes, "blob-store-max-bytes", 0, "size cap of the blob store, least recently used documents are evicted first (0 means no cap)")
+
+	// parser flags
+	persistentFlags.StringVar(&flags.spdxFiles, "spdx-files", string(processor.SPDXFilesFull), "how to ingest the files section of SPDX documents: [full | checksums-only | skip]")
 
 	// collectsub flags
 	persistentFlags.StringVar(&flags.collectSubAddr, "csub-addr", "localhost:2782", "address to connect to collect-sub service")
@@ -69,14 +108,35 @@ func init() {
 	persistentFlags.StringVar(&flags.graphqlBackend, "gql-backend", "neo4j", "backend used for graphql api server: [neo4j | inmem]")
 	persistentFlags.IntVar(&flags.graphqlPort, "gql-port", 8080, "port used for graphql api server")
 	persistentFlags.BoolVar(&flags.graphqlDebug, "gql-debug", false, "debug flag which enables the graphQL playground")
+	persistentFlags.IntVar(&flags.resultLimit, "gql-result-limit", 0, "default cap on the results of a query that is not paginated, for the inmem backend (0 means no cap)")
+	persistentFlags.StringToStringVar(&flags.resultLimits, "gql-result-limits", nil, "per query overrides of --gql-result-limit, e.g. CertifyVuln=100,changes=-1 (negative means no cap), for the queries CertifyVuln, HasSourceAt, IsDependency, IsOccurrence, changes, conflicts, findSoftware and riskyPackages")
+	persistentFlags.BoolVar(&flags.autoIngest, "gql-auto-ingest-subjects", false, "create the packages and sources linked by ingested evidence when they are missing instead of failing, for the inmem backend")
+	persistentFlags.StringVar(&flags.authTokens, "gql-auth-tokens", "", "path to a JSON file mapping bearer tokens to the namespaces they can access (\"*\" for all), for the inmem backend. If empty, requests are not authenticated")
+	persistentFlags.IntVar(&flags.maxNamespaces, "gql-max-namespaces", 100, "cap on the namespaces in use at once, including the default one, for the inmem backend (0 means no cap)")
 
 	// graphql client flags
 	persistentFlags.StringVar(&flags.graphqlEndpoint, "gql-endpoint", "http://localhost:8080/query", "endpoint used to connect to graphQL server")
+	persistentFlags.StringVar(&flags.graphqlNamespace, "gql-namespace", "", "namespace of the graphQL server to ingest into and query, defaults to the one of the token or the server's default namespace")
+	persistentFlags.StringVar(&flags.graphqlToken, "gql-token", "", "bearer token used to authenticate to the graphQL server")
+
+	// stitch flags
+	persistentFlags.DurationVar(&flags.stitchWindow, "stitch-window", time.Hour, "maximum time between the ingestion of the documents of two digests for them to be stitched")
+	persistentFlags.BoolVar(&flags.stitchApply, "stitch-apply", false, "ingest the proposed HashEqual edges instead of only reporting them")
+
+	// go proxy collector flags
+	persistentFlags.StringVar(&flags.goProxyURL, "goproxy-url", goproxy.DefaultProxy, "Go module proxy to collect modules from, an http(s) or file URL like GOPROXY")
+	persistentFlags.StringVar(&flags.goProxyCache, "goproxy-cache", "", "directory to cache the immutable responses of the Go module proxy in across runs, in memory if empty")
+	persistentFlags.BoolVar(&flags.goProxyDiscover, "goproxy-discover", false, "also collect the Go packages already in the graph")
 
 	flagNames := []string{"gdbaddr", "gdbuser", "gdbpass", "realm",
-		"verifier-keyPath", "verifier-keyID",
+		"verifier-keyPath", "verifier-keyID", "cosign-keys", "signing-roots",
+		"blob-store", "blob-store-max-bytes",
+		"spdx-files",
 		"csub-addr", "csub-listen-port",
-		"gql-backend", "gql-port", "gql-debug", "gql-endpoint",
+		"gql-backend", "gql-port", "gql-debug", "gql-result-limit", "gql-result-limits", "gql-auto-ingest-subjects", "gql-auth-tokens", "gql-max-namespaces",
+		"gql-endpoint", "gql-namespace", "gql-token",
+		"stitch-window", "stitch-apply",
+		"goproxy-url", "goproxy-cache", "goproxy-discover",
 	}
 	for _, name := range flagNames {
 		if flag := persistentFlags.Lookup(name); flag != nil {
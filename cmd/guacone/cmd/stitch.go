@@ -0,0 +1,133 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type stitchOptions struct {
+	graphqlEndpoint  string
+	graphqlNamespace string
+	graphqlToken     string
+	artifact         generated.ArtifactSpec
+	window           time.Duration
+	apply            bool
+}
+
+var stitchCmd = &cobra.Command{
+	Use:   "stitch [flags] algorithm:digest",
+	Short: "reports digests that identify the same image as the artifact but are not linked to it by HashEqual",
+	Long: `reports digests that identify the same image as the artifact but are not linked to it by HashEqual,
+e.g. the manifest digest used by an SBOM and the image ID used by a provenance document.
+
+Digests are only proposed if documents referencing both of them name the same image repository and tag
+in their origin and were ingested within --stitch-window of each other. With --stitch-apply the proposed
+HashEqual edges are ingested with the "stitched-by-guac" justification.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateStitchFlags(
+			viper.GetString("gql-endpoint"),
+			viper.GetString("gql-namespace"),
+			viper.GetString("gql-token"),
+			viper.GetDuration("stitch-window"),
+			viper.GetBool("stitch-apply"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := helpers.NewHTTPClient(opts.graphqlNamespace, opts.graphqlToken)
+		gqlclient := helpers.WarnOnTruncation(ctx, graphql.NewClient(opts.graphqlEndpoint, httpClient))
+
+		windowSeconds := int(opts.window.Seconds())
+		resp, err := generated.StitchingProposals(ctx, gqlclient, opts.artifact, &windowSeconds)
+		if err != nil {
+			logger.Errorf("unable to query stitching proposals: %v", err)
+			os.Exit(1)
+		}
+		if len(resp.StitchingProposals) == 0 {
+			fmt.Println("no digests to stitch")
+			return
+		}
+
+		for _, p := range resp.StitchingProposals {
+			fmt.Printf("%s:%s == %s:%s (%s), evidence: %s\n",
+				p.Artifact.Algorithm, p.Artifact.Digest,
+				p.EqualArtifact.Algorithm, p.EqualArtifact.Digest,
+				p.ImageRef, strings.Join(p.Evidence, ", "))
+			if !opts.apply {
+				continue
+			}
+			_, err := generated.HashEqual(ctx, gqlclient,
+				generated.ArtifactInputSpec{Algorithm: p.Artifact.Algorithm, Digest: p.Artifact.Digest},
+				generated.ArtifactInputSpec{Algorithm: p.EqualArtifact.Algorithm, Digest: p.EqualArtifact.Digest},
+				generated.HashEqualInputSpec{
+					Justification: p.Justification,
+					Origin:        fmt.Sprintf("evidence: %s", strings.Join(p.Evidence, ", ")),
+					Collector:     "guacone stitch",
+				})
+			if err != nil {
+				logger.Errorf("unable to ingest HashEqual: %v", err)
+				os.Exit(1)
+			}
+		}
+		if !opts.apply {
+			fmt.Println("run with --stitch-apply to ingest the proposed HashEqual edges")
+		}
+	},
+}
+
+func validateStitchFlags(graphqlEndpoint string, graphqlNamespace string, graphqlToken string, window time.Duration, apply bool, args []string) (stitchOptions, error) {
+	var opts stitchOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.graphqlNamespace = graphqlNamespace
+	opts.graphqlToken = graphqlToken
+	opts.apply = apply
+
+	if window < 0 {
+		return opts, fmt.Errorf("invalid stitch window specified: %v", window)
+	}
+	opts.window = window
+
+	algorithm, digest, ok := strings.Cut(args[0], ":")
+	if !ok || algorithm == "" || digest == "" {
+		return opts, fmt.Errorf("artifact %q is not of the form algorithm:digest", args[0])
+	}
+	opts.artifact = generated.ArtifactSpec{Algorithm: &algorithm, Digest: &digest}
+
+	return opts, nil
+}
+
+func init() {
+	rootCmd.AddCommand(stitchCmd)
+}
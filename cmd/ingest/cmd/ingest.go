@@ -216,7 +216,7 @@ func init() {
 
 func getAssembler(ctx context.Context, opts options) (func([]assembler.IngestPredicates) error, error) {
 	httpClient := http.Client{}
-	gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+	gqlclient := helpers.WarnOnTruncation(ctx, graphql.NewClient(opts.graphqlEndpoint, &httpClient))
 	f := helpers.GetAssembler(ctx, gqlclient)
 	return f, nil
 }
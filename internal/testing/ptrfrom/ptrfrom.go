@@ -26,6 +26,9 @@ package ptrfrom
 // Bool helps get bool pointers for test literals
 func Bool(v bool) *bool { return &v }
 
+// Float64 helps get float64 pointers for test literals
+func Float64(v float64) *float64 { return &v }
+
 // Int helps get int pointers for test literals
 func Int(v int) *int { return &v }
 
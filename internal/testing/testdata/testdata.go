@@ -116,6 +116,30 @@ var (
 	//go:embed exampledata/go-spdx-multi-arch_3.json
 	OCIGoSPDXMulti3 []byte
 
+	// Signed image fixtures. The image manifest is signed with cosign using
+	// CosignPublicKey, keyless with a certificate issued by SigningRootCA, and
+	// an untrusted key, and with notation using a certificate issued by
+	// SigningRootCA.
+
+	//go:embed exampledata/oci-signed-image-manifest.json
+	OCISignedImageManifest []byte
+
+	//go:embed exampledata/oci-cosign-payload.json
+	OCICosignPayload []byte
+
+	// Annotations of the cosign signature layers, one per signature
+	//go:embed exampledata/oci-cosign-signatures.json
+	OCICosignSignatures []byte
+
+	//go:embed exampledata/oci-notation-signature.jws
+	OCINotationSignature []byte
+
+	//go:embed exampledata/cosign.pub
+	CosignPublicKey []byte
+
+	//go:embed exampledata/signing-root.pem
+	SigningRootCA []byte
+
 	// DSSE/SLSA Testdata
 
 	// Taken from: https://slsa.dev/provenance/v0.1#example
@@ -411,9 +435,22 @@ var (
 		},
 	}
 
+	spdxFileModeFull = string(processor.SPDXFilesFull)
+
+	SpdxHasSBOM = []assembler.HasSBOMIngest{
+		{
+			Pkg: topLevelPack,
+			HasSBOM: &model.HasSBOMInputSpec{
+				Uri:      "https://anchore.com/syft/image/alpine-latest-e78eca08-d9f4-49c7-97e0-6d4b9bfa99c2",
+				FileMode: &spdxFileModeFull,
+			},
+		},
+	}
+
 	SpdxIngestionPredicates = assembler.IngestPredicates{
 		IsDependency: SpdxDeps,
 		IsOccurence:  SpdxOccurences,
+		HasSBOM:      SpdxHasSBOM,
 	}
 
 	// CycloneDX Testdata
@@ -151,8 +151,12 @@ type IngestPredicates struct {
 	IsDependency     []IsDependencyIngest
 	IsOccurence      []IsOccurenceIngest
 	HasSlsa          []HasSlsaIngest
+	HasSBOM          []HasSBOMIngest
 	CertifyVuln      []CertifyVulnIngest
 	IsVuln           []IsVulnIngest
+	CertifySigned    []CertifySignedIngest
+	SupersededBy     []SupersededByIngest
+	HasSourceAt      []HasSourceAtIngest
 }
 
 type CertifyScorecardIngest struct {
@@ -190,6 +194,14 @@ type HasSlsaIngest struct {
 	// Src      *generated.SourceInputSpec
 }
 
+type HasSBOMIngest struct {
+	// HasSBOM describes either pkg or src
+	Pkg *generated.PkgInputSpec
+	Src *generated.SourceInputSpec
+
+	HasSBOM *generated.HasSBOMInputSpec
+}
+
 type CertifyVulnIngest struct {
 	Pkg      *generated.PkgInputSpec
 	OSV      *generated.OSVInputSpec
@@ -204,5 +216,24 @@ type IsVulnIngest struct {
 	IsVuln *generated.IsVulnerabilityInputSpec
 }
 
+type CertifySignedIngest struct {
+	Artifact      *generated.ArtifactInputSpec
+	CertifySigned *generated.CertifySignedInputSpec
+}
+
+type SupersededByIngest struct {
+	Pkg          *generated.PkgInputSpec
+	Successor    *generated.PkgInputSpec
+	PkgMatchFlag generated.MatchFlags
+	SupersededBy *generated.SupersededByInputSpec
+}
+
+type HasSourceAtIngest struct {
+	Pkg          *generated.PkgInputSpec
+	PkgMatchFlag generated.MatchFlags
+	Src          *generated.SourceInputSpec
+	HasSourceAt  *generated.HasSourceAtInputSpec
+}
+
 // AssemblerInput represents the inputs to add to the graph
 type AssemblerInput = IngestPredicates
@@ -33,6 +33,7 @@ type Backend interface {
 	Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model.Osv, error)
 	Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error)
 	Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error)
+	FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error)
 
 	// Retrieval read-only queries for evidence trees
 	HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error)
@@ -42,16 +43,53 @@ type Backend interface {
 	CertifyPkg(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec) ([]*model.CertifyPkg, error)
 	HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error)
 	CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error)
+	CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error)
 	Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error)
 	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
 	IsVulnerability(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec) ([]*model.IsVulnerability, error)
 	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
 	HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error)
+	SeverityOverride(ctx context.Context, severityOverrideSpec *model.SeverityOverrideSpec) ([]*model.SeverityOverride, error)
+	EffectiveSeverity(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) (*model.EffectiveSeverity, error)
+	CertifySigned(ctx context.Context, certifySignedSpec *model.CertifySignedSpec) ([]*model.CertifySigned, error)
+	SupersededBy(ctx context.Context, supersededBySpec *model.SupersededBySpec) ([]*model.SupersededBy, error)
+
+	// Paginated read-only queries for evidence trees. A page holds at most
+	// first results following the opaque cursor after, along with the total
+	// number of matches. Results are returned in a stable order, so that a
+	// cursor stays valid while more evidence is ingested.
+	HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, first *int, after *string) (*model.HasSourceAtConnection, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, first *int, after *string) (*model.CertifyVulnConnection, error)
+	IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, first *int, after *string) (*model.IsDependencyConnection, error)
+
+	// Count read-only queries for evidence trees. They apply the filters of
+	// the corresponding queries and return the number of matches only.
+	HasSourceAtCount(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) (int, error)
+	CertifyVulnCount(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) (int, error)
+	IsDependencyCount(ctx context.Context, isDependencySpec *model.IsDependencySpec) (int, error)
+
+	// Retrieval read-only queries combining evidence trees
+	RiskyPackages(ctx context.Context, conditions model.RiskyPackageConditions, first *int, after *string) (*model.RiskyPackageConnection, error)
+	Successors(ctx context.Context, pkg model.PkgSpec) ([]*model.SupersededBy, error)
+	Dependencies(ctx context.Context, pkg model.PkgSpec, maxDepth int) (*model.DependencyTree, error)
+	EqualArtifacts(ctx context.Context, artifact model.ArtifactSpec) ([]*model.Artifact, error)
+	StitchingProposals(ctx context.Context, artifact model.ArtifactSpec, windowSeconds *int) ([]*model.StitchProposal, error)
+	Node(ctx context.Context, node string) (model.Nodes, error)
+	Nodes(ctx context.Context, nodes []string) ([]model.Nodes, error)
+	Neighbors(ctx context.Context, node string) ([]model.Nodes, error)
+	Path(ctx context.Context, subject string, target string, maxPathLength int) ([]model.Nodes, error)
+	Changes(ctx context.Context, after *string, types []model.NodeType, first *int) (*model.ChangeConnection, error)
+	CollectorDiff(ctx context.Context, verb model.Verb, collectorA string, collectorB string, subject model.PkgSpec, sampleSize *int) (*model.CollectorDiff, error)
+	Conflicts(ctx context.Context, patterns []model.ConflictPattern) ([]*model.Conflict, error)
+	Stats(ctx context.Context) (*model.BackendStats, error)
 
 	// Mutations for software trees (read-write queries)
 	IngestPackage(ctx context.Context, pkg model.PkgInputSpec) (*model.Package, error)
 	IngestSource(ctx context.Context, source model.SourceInputSpec) (*model.Source, error)
 	IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error)
+	IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error)
+	IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error)
+	IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error)
 	IngestMaterials(ctx context.Context, materials []*model.ArtifactInputSpec) ([]*model.Artifact, error)
 	IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error)
 	IngestCve(ctx context.Context, cve *model.CVEInputSpec) (*model.Cve, error)
@@ -66,11 +104,22 @@ type Backend interface {
 	IngestVulnerability(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) (*model.CertifyVuln, error)
 	IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) (*model.CertifyPkg, error)
 	IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (*model.CertifyBad, error)
+	IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (*model.CertifyGood, error)
 	IngestHashEqual(ctx context.Context, artifact model.ArtifactInputSpec, equalArtifact model.ArtifactInputSpec, hashEqual model.HashEqualInputSpec) (*model.HashEqual, error)
 	IngestHasSbom(ctx context.Context, subject model.PackageOrSourceInput, hasSbom model.HasSBOMInputSpec) (*model.HasSbom, error)
 	IngestHasSourceAt(ctx context.Context, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error)
+	IngestHasSourceAts(ctx context.Context, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) ([]*model.HasSourceAt, error)
 	IngestIsVulnerability(ctx context.Context, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) (*model.IsVulnerability, error)
 	IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error)
+	IngestSeverityOverride(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) (*model.SeverityOverride, error)
+	IngestCertifySigned(ctx context.Context, artifact model.ArtifactInputSpec, certifySigned model.CertifySignedInputSpec) (*model.CertifySigned, error)
+	IngestSupersededBy(ctx context.Context, pkg model.PkgInputSpec, successor model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) (*model.SupersededBy, error)
+
+	// Mutations retracting evidence
+	RetractEvidence(ctx context.Context, origin string) (int, error)
+
+	// Mutations for namespace lifecycle
+	PurgeNamespace(ctx context.Context) (bool, error)
 }
 
 // BackendArgs interface allows each backend to specify the arguments needed to
@@ -0,0 +1,892 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func testHasSourceAt(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	// knownSince is stored in UTC whatever the time zone it is ingested in
+	eastern := time.FixedZone("UTC-5", -5*60*60)
+	inputs := []struct {
+		Pkg         *model.PkgInputSpec
+		Src         *model.SourceInputSpec
+		HasSourceAt model.HasSourceAtInputSpec
+	}{
+		{
+			Pkg: tensorflow,
+			Src: tensorflowSrc,
+			HasSourceAt: model.HasSourceAtInputSpec{
+				KnownSince:    earlier.In(eastern),
+				Justification: "tensorflow repository",
+				Origin:        "origin-a",
+				Collector:     "collector-a",
+			},
+		},
+		{
+			Pkg: numpy,
+			Src: numpySrc,
+			HasSourceAt: model.HasSourceAtInputSpec{
+				KnownSince:    later,
+				Justification: "numpy repository",
+				Origin:        "origin-b",
+				Collector:     "collector-b",
+			},
+		},
+		{
+			Pkg: tensorflow,
+			Src: numpySrc,
+			HasSourceAt: model.HasSourceAtInputSpec{
+				KnownSince:    middle,
+				Justification: "tensorflow moved",
+				Origin:        "origin-c",
+				Collector:     "collector-c",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestHasSourceAt(ctx, *in.Pkg, specificVersion, *in.Src, in.HasSourceAt)
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+		if !got.KnownSince.Equal(in.HasSourceAt.KnownSince) || got.KnownSince.Location() != time.UTC {
+			t.Errorf("expected knownSince %v in UTC, got %v", in.HasSourceAt.KnownSince, got.KnownSince)
+		}
+		ids = append(ids, got.ID)
+	}
+	again := inputs[0].HasSourceAt
+	again.KnownSince = earlier
+	got, err := b.IngestHasSourceAt(ctx, *tensorflow, specificVersion, *tensorflowSrc, again)
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.HasSourceAtSpec]{
+		{
+			Name: "Query all",
+			Spec: &model.HasSourceAtSpec{},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query by package",
+			Spec: &model.HasSourceAtSpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}},
+			Exp:  []int{0, 2},
+		},
+		{
+			Name: "Query by source",
+			Spec: &model.HasSourceAtSpec{Source: &model.SourceSpec{Name: ptrfrom.String("numpy")}},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by knownSince",
+			Spec: &model.HasSourceAtSpec{KnownSince: &earlier},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by knownSinceAfter",
+			Spec: &model.HasSourceAtSpec{KnownSinceAfter: &middle},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by knownSinceBefore",
+			Spec: &model.HasSourceAtSpec{KnownSinceBefore: &middle},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by justification",
+			Spec: &model.HasSourceAtSpec{Justification: ptrfrom.String("numpy repository")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.HasSourceAtSpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.HasSourceAtSpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by any origin",
+			Spec: &model.HasSourceAtSpec{Origins: []string{"origin-a", "origin-c"}},
+			Exp:  []int{0, 2},
+		},
+		{
+			Name: "Query by any collector",
+			Spec: &model.HasSourceAtSpec{Collectors: []string{"collector-b", "collector-c", "collector-d"}},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by origin and any collector",
+			Spec: &model.HasSourceAtSpec{Origin: ptrfrom.String("origin-a"), Collectors: []string{"collector-a", "collector-b"}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by empty origins",
+			Spec: &model.HasSourceAtSpec{Origins: []string{}},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.HasSourceAtSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("numpy")},
+				Source:  &model.SourceSpec{Name: ptrfrom.String("tensorflow")},
+			},
+			Exp: []int{},
+		},
+		{
+			Name: "Query latest",
+			Spec: &model.HasSourceAtSpec{Latest: ptrfrom.Bool(true)},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query latest by package",
+			Spec: &model.HasSourceAtSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")},
+				Latest:  ptrfrom.Bool(true),
+			},
+			Exp: []int{2},
+		},
+		{
+			Name: "Query latest after filtering",
+			Spec: &model.HasSourceAtSpec{
+				KnownSinceBefore: &middle,
+				Latest:           ptrfrom.Bool(true),
+			},
+			Exp: []int{0},
+		},
+		{
+			Name: "Query not only latest",
+			Spec: &model.HasSourceAtSpec{Latest: ptrfrom.Bool(false)},
+			Exp:  []int{0, 1, 2},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.HasSourceAtSpec {
+		return &model.HasSourceAtSpec{ID: &id}
+	})...)
+	checkQueries(t, b.HasSourceAt, func(h *model.HasSourceAt) string { return h.ID }, ids, tests)
+}
+
+func testCertifyVuln(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Pkg  *model.PkgInputSpec
+		Vuln model.OsvCveOrGhsaInput
+		Meta model.VulnerabilityMetaDataInput
+	}{
+		{
+			Pkg:  tensorflow,
+			Vuln: model.OsvCveOrGhsaInput{Cve: cve},
+			Meta: model.VulnerabilityMetaDataInput{
+				TimeScanned:    earlier,
+				DbURI:          "db-a",
+				DbVersion:      "1.0.0",
+				ScannerURI:     "scanner-a",
+				ScannerVersion: "1.0.0",
+				Origin:         "origin-a",
+				Collector:      "collector-a",
+			},
+		},
+		{
+			Pkg:  numpy,
+			Vuln: model.OsvCveOrGhsaInput{Ghsa: ghsa},
+			Meta: model.VulnerabilityMetaDataInput{
+				TimeScanned:    later,
+				DbURI:          "db-b",
+				DbVersion:      "2.0.0",
+				ScannerURI:     "scanner-b",
+				ScannerVersion: "2.0.0",
+				Origin:         "origin-b",
+				Collector:      "collector-b",
+			},
+		},
+		{
+			Pkg:  openssl,
+			Vuln: model.OsvCveOrGhsaInput{Osv: osvGhsa},
+			Meta: model.VulnerabilityMetaDataInput{
+				TimeScanned:    later,
+				DbURI:          "db-b",
+				DbVersion:      "2.0.0",
+				ScannerURI:     "scanner-b",
+				ScannerVersion: "2.0.0",
+				Origin:         "origin-b",
+				Collector:      "collector-b",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestVulnerability(ctx, *in.Pkg, in.Vuln, in.Meta)
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyVuln: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.IngestVulnerability(ctx, *inputs[0].Pkg, inputs[0].Vuln, inputs[0].Meta)
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.CertifyVulnSpec]{
+		{
+			Name: "Query all",
+			Spec: &model.CertifyVulnSpec{},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query by package",
+			Spec: &model.CertifyVulnSpec{Package: &model.PkgSpec{Name: ptrfrom.String("numpy")}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by CVE",
+			Spec: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Cve: &model.CVESpec{CveID: ptrfrom.String(cve.CveID)}}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by GHSA",
+			Spec: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Ghsa: &model.GHSASpec{GhsaID: ptrfrom.String(ghsa.GhsaID)}}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by OSV",
+			Spec: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Osv: &model.OSVSpec{OsvID: ptrfrom.String(osvGhsa.OsvID)}}},
+			Exp:  []int{2},
+		},
+		{
+			Name: "Query by timeScanned",
+			Spec: &model.CertifyVulnSpec{TimeScanned: &earlier},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by timeScannedAfter",
+			Spec: &model.CertifyVulnSpec{TimeScannedAfter: &later},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by timeScannedBefore",
+			Spec: &model.CertifyVulnSpec{TimeScannedBefore: &later},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query by timeScanned range",
+			Spec: &model.CertifyVulnSpec{TimeScannedAfter: &earlier, TimeScannedBefore: &middle},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by dbUri",
+			Spec: &model.CertifyVulnSpec{DbURI: ptrfrom.String("db-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by dbVersion",
+			Spec: &model.CertifyVulnSpec{DbVersion: ptrfrom.String("2.0.0")},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by scannerUri",
+			Spec: &model.CertifyVulnSpec{ScannerURI: ptrfrom.String("scanner-b")},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by scannerVersion",
+			Spec: &model.CertifyVulnSpec{ScannerVersion: ptrfrom.String("1.0.0")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.CertifyVulnSpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.CertifyVulnSpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1, 2},
+		},
+		{
+			Name: "Query by any origin",
+			Spec: &model.CertifyVulnSpec{Origins: []string{"origin-a", "origin-b"}},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query by any collector",
+			Spec: &model.CertifyVulnSpec{Collectors: []string{"collector-a", "collector-c"}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by empty collectors",
+			Spec: &model.CertifyVulnSpec{Collectors: []string{}},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.CertifyVulnSpec{
+				Package:       &model.PkgSpec{Name: ptrfrom.String("tensorflow")},
+				Vulnerability: &model.OsvCveOrGhsaSpec{Ghsa: &model.GHSASpec{GhsaID: ptrfrom.String(ghsa.GhsaID)}},
+			},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.CertifyVulnSpec {
+		return &model.CertifyVulnSpec{ID: &id}
+	})...)
+	checkQueries(t, b.CertifyVuln, func(c *model.CertifyVuln) string { return c.ID }, ids, tests)
+}
+
+func testIsDependency(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Pkg        *model.PkgInputSpec
+		DepPkg     *model.PkgInputSpec
+		Dependency model.IsDependencyInputSpec
+	}{
+		{
+			Pkg:    tensorflow,
+			DepPkg: numpy,
+			Dependency: model.IsDependencyInputSpec{
+				VersionRange:  "<2.0.0",
+				Justification: "direct dependency",
+				Origin:        "origin-a",
+				Collector:     "collector-a",
+			},
+		},
+		{
+			Pkg:    openssl,
+			DepPkg: tensorflow,
+			Dependency: model.IsDependencyInputSpec{
+				VersionRange:  ">=2.0.0",
+				Justification: "indirect dependency",
+				Origin:        "origin-b",
+				Collector:     "collector-b",
+			},
+		},
+		{
+			Pkg:    numpy,
+			DepPkg: openssl,
+			Dependency: model.IsDependencyInputSpec{
+				// not semver, as the versions of deb packages
+				VersionRange:  "1:2.30-1",
+				Justification: "system dependency",
+				Origin:        "origin-c",
+				Collector:     "collector-c",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestDependency(ctx, *in.Pkg, *in.DepPkg, in.Dependency)
+		if err != nil {
+			t.Fatalf("Could not ingest IsDependency: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.IngestDependency(ctx, *inputs[0].Pkg, *inputs[0].DepPkg, inputs[0].Dependency)
+	if err != nil {
+		t.Fatalf("Could not ingest IsDependency again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.IsDependencySpec]{
+		{
+			Name: "Query all",
+			Spec: &model.IsDependencySpec{},
+			Exp:  []int{0, 1, 2},
+		},
+		{
+			Name: "Query by package",
+			Spec: &model.IsDependencySpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by dependent package",
+			Spec: &model.IsDependencySpec{DependentPackage: &model.PkgNameSpec{Name: ptrfrom.String("tensorflow")}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by versionRange",
+			Spec: &model.IsDependencySpec{VersionRange: ptrfrom.String("<2.0.0")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by dependentVersion",
+			Spec: &model.IsDependencySpec{DependentVersion: ptrfrom.String("1.4.7")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by dependentVersion at a range bound",
+			Spec: &model.IsDependencySpec{DependentVersion: ptrfrom.String("2.0.0")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by non semver dependentVersion",
+			Spec: &model.IsDependencySpec{DependentVersion: ptrfrom.String("1:2.30-1")},
+			Exp:  []int{2},
+		},
+		{
+			Name: "Query by justification",
+			Spec: &model.IsDependencySpec{Justification: ptrfrom.String("indirect dependency")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.IsDependencySpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.IsDependencySpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.IsDependencySpec{
+				Package:          &model.PkgSpec{Name: ptrfrom.String("tensorflow")},
+				DependentPackage: &model.PkgNameSpec{Name: ptrfrom.String("tensorflow")},
+			},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.IsDependencySpec {
+		return &model.IsDependencySpec{ID: &id}
+	})...)
+	checkQueries(t, b.IsDependency, func(d *model.IsDependency) string { return d.ID }, ids, tests)
+}
+
+func testIsOccurrence(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Subject    model.PackageOrSourceInput
+		Artifact   *model.ArtifactInputSpec
+		Occurrence model.IsOccurrenceInputSpec
+	}{
+		{
+			Subject:  model.PackageOrSourceInput{Package: tensorflow},
+			Artifact: sha256Artifact,
+			Occurrence: model.IsOccurrenceInputSpec{
+				Justification: "package artifact",
+				Origin:        "origin-a",
+				Collector:     "collector-a",
+			},
+		},
+		{
+			Subject:  model.PackageOrSourceInput{Source: numpySrc},
+			Artifact: sha1Artifact,
+			Occurrence: model.IsOccurrenceInputSpec{
+				Justification: "source artifact",
+				Origin:        "origin-b",
+				Collector:     "collector-b",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestOccurrence(ctx, in.Subject, *in.Artifact, in.Occurrence)
+		if err != nil {
+			t.Fatalf("Could not ingest IsOccurrence: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.IngestOccurrence(ctx, inputs[0].Subject, *inputs[0].Artifact, inputs[0].Occurrence)
+	if err != nil {
+		t.Fatalf("Could not ingest IsOccurrence again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.IsOccurrenceSpec]{
+		{
+			Name: "Query all",
+			Spec: &model.IsOccurrenceSpec{},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by package",
+			Spec: &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by source",
+			Spec: &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Source: &model.SourceSpec{Name: ptrfrom.String("numpy")}}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by artifact algorithm",
+			Spec: &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{Algorithm: ptrfrom.String("sha256")}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by artifact digest",
+			Spec: &model.IsOccurrenceSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom.String(sha1Artifact.Digest)}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by justification",
+			Spec: &model.IsOccurrenceSpec{Justification: ptrfrom.String("source artifact")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.IsOccurrenceSpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.IsOccurrenceSpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by any origin",
+			Spec: &model.IsOccurrenceSpec{Origins: []string{"origin-a", "origin-b"}},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by any collector",
+			Spec: &model.IsOccurrenceSpec{Collectors: []string{"collector-c", "collector-d"}},
+			Exp:  []int{},
+		},
+		{
+			Name: "Query by empty origins",
+			Spec: &model.IsOccurrenceSpec{Origins: []string{}},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.IsOccurrenceSpec{
+				Subject:  &model.PackageOrSourceSpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}},
+				Artifact: &model.ArtifactSpec{Algorithm: ptrfrom.String("sha1")},
+			},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.IsOccurrenceSpec {
+		return &model.IsOccurrenceSpec{ID: &id}
+	})...)
+	checkQueries(t, b.IsOccurrence, func(o *model.IsOccurrence) string { return o.ID }, ids, tests)
+}
+
+func testHashEqual(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Artifact      *model.ArtifactInputSpec
+		EqualArtifact *model.ArtifactInputSpec
+		HashEqual     model.HashEqualInputSpec
+	}{
+		{
+			Artifact:      sha256Artifact,
+			EqualArtifact: sha1Artifact,
+			HashEqual: model.HashEqualInputSpec{
+				Justification: "same file",
+				Origin:        "origin-a",
+				Collector:     "collector-a",
+			},
+		},
+		{
+			Artifact:      sha1Artifact,
+			EqualArtifact: sha512Artifact,
+			HashEqual: model.HashEqualInputSpec{
+				Justification: "same content",
+				Origin:        "origin-b",
+				Collector:     "collector-b",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestHashEqual(ctx, *in.Artifact, *in.EqualArtifact, in.HashEqual)
+		if err != nil {
+			t.Fatalf("Could not ingest HashEqual: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.IngestHashEqual(ctx, *inputs[0].Artifact, *inputs[0].EqualArtifact, inputs[0].HashEqual)
+	if err != nil {
+		t.Fatalf("Could not ingest HashEqual again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.HashEqualSpec]{
+		{
+			Name: "Query all",
+			Spec: &model.HashEqualSpec{},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by one artifact",
+			Spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{{Algorithm: ptrfrom.String("sha1")}}},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by both artifacts",
+			Spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{
+				{Algorithm: ptrfrom.String("sha512")},
+				{Algorithm: ptrfrom.String("sha1")},
+			}},
+			Exp: []int{1},
+		},
+		{
+			Name: "Query by justification",
+			Spec: &model.HashEqualSpec{Justification: ptrfrom.String("same file")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.HashEqualSpec{Origin: ptrfrom.String("origin-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.HashEqualSpec{Collector: ptrfrom.String("collector-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{
+				{Algorithm: ptrfrom.String("sha256")},
+				{Algorithm: ptrfrom.String("sha512")},
+			}},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.HashEqualSpec {
+		return &model.HashEqualSpec{ID: &id}
+	})...)
+	checkQueries(t, b.HashEqual, func(h *model.HashEqual) string { return h.ID }, ids, tests)
+}
+
+func testIsVulnerability(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Osv             *model.OSVInputSpec
+		Vuln            model.CveOrGhsaInput
+		IsVulnerability model.IsVulnerabilityInputSpec
+	}{
+		{
+			Osv:  osvCve,
+			Vuln: model.CveOrGhsaInput{Cve: cve},
+			IsVulnerability: model.IsVulnerabilityInputSpec{
+				Justification: "same identifier",
+				Origin:        "origin-a",
+				Collector:     "collector-a",
+			},
+		},
+		{
+			Osv:  osvGhsa,
+			Vuln: model.CveOrGhsaInput{Ghsa: ghsa},
+			IsVulnerability: model.IsVulnerabilityInputSpec{
+				Justification: "alias",
+				Origin:        "origin-b",
+				Collector:     "collector-b",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.IngestIsVulnerability(ctx, *in.Osv, in.Vuln, in.IsVulnerability)
+		if err != nil {
+			t.Fatalf("Could not ingest IsVulnerability: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.IngestIsVulnerability(ctx, *inputs[0].Osv, inputs[0].Vuln, inputs[0].IsVulnerability)
+	if err != nil {
+		t.Fatalf("Could not ingest IsVulnerability again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.IsVulnerabilitySpec]{
+		{
+			Name: "Query all",
+			Spec: &model.IsVulnerabilitySpec{},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by OSV",
+			Spec: &model.IsVulnerabilitySpec{Osv: &model.OSVSpec{OsvID: ptrfrom.String(osvGhsa.OsvID)}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by CVE",
+			Spec: &model.IsVulnerabilitySpec{Vulnerability: &model.CveOrGhsaSpec{Cve: &model.CVESpec{Year: ptrfrom.Int(cve.Year)}}},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by GHSA",
+			Spec: &model.IsVulnerabilitySpec{Vulnerability: &model.CveOrGhsaSpec{Ghsa: &model.GHSASpec{GhsaID: ptrfrom.String(ghsa.GhsaID)}}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by justification",
+			Spec: &model.IsVulnerabilitySpec{Justification: ptrfrom.String("alias")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.IsVulnerabilitySpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.IsVulnerabilitySpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.IsVulnerabilitySpec{
+				Osv:           &model.OSVSpec{OsvID: ptrfrom.String(osvCve.OsvID)},
+				Vulnerability: &model.CveOrGhsaSpec{Ghsa: &model.GHSASpec{GhsaID: ptrfrom.String(ghsa.GhsaID)}},
+			},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.IsVulnerabilitySpec {
+		return &model.IsVulnerabilitySpec{ID: &id}
+	})...)
+	checkQueries(t, b.IsVulnerability, func(v *model.IsVulnerability) string { return v.ID }, ids, tests)
+}
+
+func testCertifyScorecard(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+	ingestTrees(t, b)
+	inputs := []struct {
+		Src       *model.SourceInputSpec
+		Scorecard model.ScorecardInputSpec
+	}{
+		{
+			Src: tensorflowSrc,
+			Scorecard: model.ScorecardInputSpec{
+				Checks:           []*model.ScorecardCheckInputSpec{{Check: "Binary-Artifacts", Score: 4}},
+				AggregateScore:   4.5,
+				TimeScanned:      earlier,
+				ScorecardVersion: "v4.10.2",
+				ScorecardCommit:  "5e6a521",
+				Origin:           "origin-a",
+				Collector:        "collector-a",
+			},
+		},
+		{
+			Src: numpySrc,
+			Scorecard: model.ScorecardInputSpec{
+				Checks:           []*model.ScorecardCheckInputSpec{{Check: "Code-Review", Score: 7}},
+				AggregateScore:   7.5,
+				TimeScanned:      later,
+				ScorecardVersion: "v4.11.0",
+				ScorecardCommit:  "8c4d1a3",
+				Origin:           "origin-b",
+				Collector:        "collector-b",
+			},
+		},
+	}
+	var ids []string
+	for _, in := range inputs {
+		got, err := b.CertifyScorecard(ctx, *in.Src, in.Scorecard)
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyScorecard: %v", err)
+		}
+		ids = append(ids, got.ID)
+	}
+	got, err := b.CertifyScorecard(ctx, *inputs[0].Src, inputs[0].Scorecard)
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyScorecard again: %v", err)
+	}
+	checkDedup(t, ids[0], got.ID)
+
+	tests := []queryTest[model.CertifyScorecardSpec]{
+		{
+			Name: "Query all",
+			Spec: &model.CertifyScorecardSpec{},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by source",
+			Spec: &model.CertifyScorecardSpec{Source: &model.SourceSpec{Name: ptrfrom.String("numpy")}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by aggregateScore",
+			Spec: &model.CertifyScorecardSpec{AggregateScore: ptrfrom.Float64(4.5)},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by checks",
+			Spec: &model.CertifyScorecardSpec{Checks: []*model.ScorecardCheckSpec{{Check: "Code-Review", Score: 7}}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by scorecardVersion",
+			Spec: &model.CertifyScorecardSpec{ScorecardVersion: ptrfrom.String("v4.10.2")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by scorecardCommit",
+			Spec: &model.CertifyScorecardSpec{ScorecardCommit: ptrfrom.String("8c4d1a3")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by origin",
+			Spec: &model.CertifyScorecardSpec{Origin: ptrfrom.String("origin-a")},
+			Exp:  []int{0},
+		},
+		{
+			Name: "Query by collector",
+			Spec: &model.CertifyScorecardSpec{Collector: ptrfrom.String("collector-b")},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by any origin",
+			Spec: &model.CertifyScorecardSpec{Origins: []string{"origin-b", "origin-c"}},
+			Exp:  []int{1},
+		},
+		{
+			Name: "Query by any collector",
+			Spec: &model.CertifyScorecardSpec{Collectors: []string{"collector-a", "collector-b"}},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query by empty collectors",
+			Spec: &model.CertifyScorecardSpec{Collectors: []string{}},
+			Exp:  []int{0, 1},
+		},
+		{
+			Name: "Query without match",
+			Spec: &model.CertifyScorecardSpec{
+				Source:         &model.SourceSpec{Name: ptrfrom.String("tensorflow")},
+				AggregateScore: ptrfrom.Float64(7.5),
+			},
+			Exp: []int{},
+		},
+	}
+	tests = append(tests, idTests(ids, func(id string) *model.CertifyScorecardSpec {
+		return &model.CertifyScorecardSpec{ID: &id}
+	})...)
+	checkQueries(t, b.Scorecards, func(s *model.CertifyScorecard) string { return s.ID }, ids, tests)
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Software trees shared by all tests of the suite.
+var (
+	tensorflow = &model.PkgInputSpec{
+		Type:    "pypi",
+		Name:    "tensorflow",
+		Version: ptrfrom.String("2.11.1"),
+	}
+	openssl = &model.PkgInputSpec{
+		Type:      "conan",
+		Namespace: ptrfrom.String("openssl.org"),
+		Name:      "openssl",
+		Version:   ptrfrom.String("3.0.3"),
+	}
+	numpy = &model.PkgInputSpec{
+		Type:    "pypi",
+		Name:    "numpy",
+		Version: ptrfrom.String("1.24.0"),
+	}
+
+	tensorflowSrc = &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/tensorflow",
+		Name:      "tensorflow",
+	}
+	numpySrc = &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/numpy",
+		Name:      "numpy",
+	}
+
+	sha256Artifact = &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+	}
+	sha1Artifact = &model.ArtifactInputSpec{
+		Algorithm: "sha1",
+		Digest:    "7a8f47318e4676dacb0142afa0b83029cd7befd9",
+	}
+	sha512Artifact = &model.ArtifactInputSpec{
+		Algorithm: "sha512",
+		Digest:    "374ab8f711235830769aa5f0b31ce9b72c5670074b34cb302cdafe3b606233ee92ee01e298e5701f15cc7087714cd9abd7ddb838a6e1206b3642de16d9fc9dd7",
+	}
+
+	cve = &model.CVEInputSpec{
+		Year:  2019,
+		CveID: "cve-2019-13110",
+	}
+	ghsa = &model.GHSAInputSpec{
+		GhsaID: "ghsa-h45f-rjvw-2rv2",
+	}
+	osvCve = &model.OSVInputSpec{
+		OsvID: "cve-2019-13110",
+	}
+	osvGhsa = &model.OSVInputSpec{
+		OsvID: "ghsa-h45f-rjvw-2rv2",
+	}
+)
+
+// Times of evidence, far enough apart that the bounds of time range filters
+// are not ambiguous.
+var (
+	earlier = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	middle  = time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	later   = time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var specificVersion = model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+
+// unknownID is well formed, but does not match any node ingested by the suite.
+const unknownID = "4294967295"
+
+// invalidID is not a well formed ID for any backend.
+const invalidID = "not-an-id"
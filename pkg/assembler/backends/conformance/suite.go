@@ -0,0 +1,179 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance is a test suite shared by all backends. It ingests the
+// same evidence in every backend and checks the results of the queries, so
+// that backends agree on the semantics of the API.
+//
+// A backend runs the suite from one of its tests:
+//
+//	func TestConformance(t *testing.T) {
+//	  conformance.RunSuite(t, func() backends.Backend {
+//	    return newEmptyBackend(t)
+//	  })
+//	}
+package conformance
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// RunSuite runs the conformance tests against the backends returned by
+// factory. Every test calls factory once and expects an empty backend. When
+// names are given, only the tests with these names run, for the backends
+// implementing part of the API.
+func RunSuite(t *testing.T, factory func() backends.Backend, names ...string) {
+	tests := []struct {
+		Name string
+		Run  func(t *testing.T, b backends.Backend)
+	}{
+		{Name: "HasSourceAt", Run: testHasSourceAt},
+		{Name: "CertifyVuln", Run: testCertifyVuln},
+		{Name: "IsDependency", Run: testIsDependency},
+		{Name: "IsOccurrence", Run: testIsOccurrence},
+		{Name: "HashEqual", Run: testHashEqual},
+		{Name: "IsVulnerability", Run: testIsVulnerability},
+		{Name: "CertifyScorecard", Run: testCertifyScorecard},
+		{Name: "VulnerabilityIDs", Run: testVulnerabilityIDs},
+	}
+	selected := map[string]bool{}
+	for _, name := range names {
+		selected[name] = true
+	}
+	for _, test := range tests {
+		if len(names) > 0 && !selected[test.Name] {
+			continue
+		}
+		t.Run(test.Name, func(t *testing.T) {
+			test.Run(t, factory())
+		})
+	}
+}
+
+// queryTest is a query of the evidence ingested by a test. Exp holds the
+// positions of the expected results in the ingested evidence. When MayErr is
+// set, the query may also fail instead of returning the expected results.
+type queryTest[S any] struct {
+	Name   string
+	Spec   *S
+	Exp    []int
+	ExpErr bool
+	MayErr bool
+}
+
+// idTests are the query tests by ID, which all evidence supports: a query by
+// the ID of the second ingested evidence, by a malformed ID, and by a well
+// formed ID not matching any node.
+func idTests[S any](ids []string, spec func(id string) *S) []queryTest[S] {
+	return []queryTest[S]{
+		{
+			Name: "Query by ID",
+			Spec: spec(ids[1]),
+			Exp:  []int{1},
+		},
+		{
+			Name:   "Query by invalid ID",
+			Spec:   spec(invalidID),
+			ExpErr: true,
+		},
+		{
+			Name:   "Query by unknown ID",
+			Spec:   spec(unknownID),
+			Exp:    []int{},
+			MayErr: true,
+		},
+	}
+}
+
+// checkQueries runs the query tests, comparing the IDs of the results with
+// the IDs of the ingested evidence.
+func checkQueries[S, R any](t *testing.T, query func(context.Context, *S) ([]*R, error), idOf func(*R) string, ids []string, tests []queryTest[S]) {
+	t.Helper()
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := query(ctx, test.Spec)
+			if err != nil && test.MayErr {
+				return
+			}
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			gotIDs := []string{}
+			for _, r := range got {
+				gotIDs = append(gotIDs, idOf(r))
+			}
+			expIDs := []string{}
+			for _, i := range test.Exp {
+				expIDs = append(expIDs, ids[i])
+			}
+			sort.Strings(gotIDs)
+			sort.Strings(expIDs)
+			if diff := cmp.Diff(expIDs, gotIDs); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// checkDedup checks that ingesting evidence again returns the evidence
+// already ingested.
+func checkDedup(t *testing.T, ingested string, again string) {
+	t.Helper()
+	if again != ingested {
+		t.Errorf("expected ingesting again to return ID %s, got %s", ingested, again)
+	}
+}
+
+// ingestTrees ingests the software trees linked by the evidence of the suite.
+func ingestTrees(t *testing.T, b backends.Backend) {
+	t.Helper()
+	ctx := context.Background()
+	for _, p := range []*model.PkgInputSpec{tensorflow, openssl, numpy} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{tensorflowSrc, numpySrc} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{sha256Artifact, sha1Artifact, sha512Artifact} {
+		if _, err := b.IngestArtifact(ctx, a); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+	}
+	if _, err := b.IngestCve(ctx, cve); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	if _, err := b.IngestGhsa(ctx, ghsa); err != nil {
+		t.Fatalf("Could not ingest GHSA: %v", err)
+	}
+	for _, o := range []*model.OSVInputSpec{osvCve, osvGhsa} {
+		if _, err := b.IngestOsv(ctx, o); err != nil {
+			t.Fatalf("Could not ingest OSV: %v", err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// testVulnerabilityIDs checks that CVE and GHSA IDs are canonicalized, so
+// that the same vulnerability in different casing is a single node.
+func testVulnerabilityIDs(t *testing.T, b backends.Backend) {
+	ctx := context.Background()
+
+	cveIDs := map[string]bool{}
+	for _, id := range []string{"CVE-2021-44228", "cve-2021-44228", " Cve-2021-44228 "} {
+		got, err := b.IngestCve(ctx, &model.CVEInputSpec{Year: 2021, CveID: id})
+		if err != nil {
+			t.Fatalf("Could not ingest CVE %q: %v", id, err)
+		}
+		for _, cveID := range got.CveIds {
+			if cveID.CveID != "cve-2021-44228" {
+				t.Errorf("expected canonical CVE ID cve-2021-44228, got %q", cveID.CveID)
+			}
+			cveIDs[cveID.ID] = true
+		}
+	}
+	if len(cveIDs) != 1 {
+		t.Errorf("expected a single CVE node, got %d", len(cveIDs))
+	}
+
+	ghsaIDs := map[string]bool{}
+	for _, id := range []string{"GHSA-JFH8-C2JP-5V3Q", "ghsa-jfh8-c2jp-5v3q"} {
+		got, err := b.IngestGhsa(ctx, &model.GHSAInputSpec{GhsaID: id})
+		if err != nil {
+			t.Fatalf("Could not ingest GHSA %q: %v", id, err)
+		}
+		for _, ghsaID := range got.GhsaIds {
+			if ghsaID.GhsaID != "ghsa-jfh8-c2jp-5v3q" {
+				t.Errorf("expected canonical GHSA ID ghsa-jfh8-c2jp-5v3q, got %q", ghsaID.GhsaID)
+			}
+			ghsaIDs[ghsaID.ID] = true
+		}
+	}
+	if len(ghsaIDs) != 1 {
+		t.Errorf("expected a single GHSA node, got %d", len(ghsaIDs))
+	}
+
+	t.Run("Query in any casing", func(t *testing.T) {
+		cves, err := b.Cve(ctx, &model.CVESpec{CveID: ptrfrom.String("CVE-2021-44228")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(cves) != 1 || len(cves[0].CveIds) != 1 {
+			t.Errorf("expected to find the CVE, got %+v", cves)
+		}
+		ghsas, err := b.Ghsa(ctx, &model.GHSASpec{GhsaID: ptrfrom.String("Ghsa-Jfh8-C2jp-5v3Q")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(ghsas) != 1 || len(ghsas[0].GhsaIds) != 1 {
+			t.Errorf("expected to find the GHSA, got %+v", ghsas)
+		}
+	})
+
+	invalid := []struct {
+		Name string
+		Run  func() error
+	}{
+		{
+			Name: "CVE without number",
+			Run: func() error {
+				_, err := b.IngestCve(ctx, &model.CVEInputSpec{Year: 2021, CveID: "CVE-2021"})
+				return err
+			},
+		},
+		{
+			Name: "CVE with short number",
+			Run: func() error {
+				_, err := b.IngestCve(ctx, &model.CVEInputSpec{Year: 2021, CveID: "CVE-2021-442"})
+				return err
+			},
+		},
+		{
+			Name: "CVE with another year",
+			Run: func() error {
+				_, err := b.IngestCve(ctx, &model.CVEInputSpec{Year: 2022, CveID: "CVE-2021-44228"})
+				return err
+			},
+		},
+		{
+			Name: "GHSA ID as CVE",
+			Run: func() error {
+				_, err := b.IngestCve(ctx, &model.CVEInputSpec{Year: 2021, CveID: "GHSA-jfh8-c2jp-5v3q"})
+				return err
+			},
+		},
+		{
+			Name: "GHSA with short segment",
+			Run: func() error {
+				_, err := b.IngestGhsa(ctx, &model.GHSAInputSpec{GhsaID: "GHSA-jfh8-c2jp-5v3"})
+				return err
+			},
+		},
+		{
+			Name: "Query by garbage CVE",
+			Run: func() error {
+				_, err := b.Cve(ctx, &model.CVESpec{CveID: ptrfrom.String("log4shell")})
+				return err
+			},
+		},
+	}
+	for _, test := range invalid {
+		t.Run(test.Name, func(t *testing.T) {
+			if err := test.Run(); !errors.Is(err, backends.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of the errors returned by backends, to be matched with errors.Is.
+var (
+	// ErrNotFound is returned when the nodes selected by an ingestion or a
+	// query, such as by ID, do not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput is returned when the arguments of an ingestion or a
+	// query are malformed, ambiguous or of the wrong node type.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrInternal is returned when the data stored by the backend is
+	// inconsistent.
+	ErrInternal = errors.New("internal error")
+	// ErrCanceled is returned when the context of a query is canceled or
+	// times out before the query completes.
+	ErrCanceled = errors.New("canceled")
+)
+
+// ErrorCodeExtension is the key of the GraphQL error extension holding the
+// code of the kind of the error.
+const ErrorCodeExtension = "code"
+
+// Codes of the kinds of errors, in the ErrorCodeExtension of GraphQL errors.
+const (
+	CodeNotFound     = "NOT_FOUND"
+	CodeInvalidInput = "INVALID_INPUT"
+	CodeInternal     = "INTERNAL"
+	CodeCanceled     = "CANCELED"
+)
+
+var errorCodes = []struct {
+	kind error
+	code string
+}{
+	{kind: ErrNotFound, code: CodeNotFound},
+	{kind: ErrInvalidInput, code: CodeInvalidInput},
+	{kind: ErrInternal, code: CodeInternal},
+	{kind: ErrCanceled, code: CodeCanceled},
+}
+
+// Error is an error of a backend. Its message is left unchanged, while
+// errors.Is matches it with its kind.
+type Error struct {
+	Kind    error
+	Message string
+	// cause is an error from outside the backend that errors.Is also
+	// matches, such as context.Canceled.
+	cause error
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Kind }
+
+func (e *Error) Is(target error) bool {
+	return e.cause != nil && errors.Is(e.cause, target)
+}
+
+// NotFoundf formats an error of kind ErrNotFound.
+func NotFoundf(format string, args ...interface{}) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidInputf formats an error of kind ErrInvalidInput.
+func InvalidInputf(format string, args ...interface{}) error {
+	return &Error{Kind: ErrInvalidInput, Message: fmt.Sprintf(format, args...)}
+}
+
+// Internalf formats an error of kind ErrInternal.
+func Internalf(format string, args ...interface{}) error {
+	return &Error{Kind: ErrInternal, Message: fmt.Sprintf(format, args...)}
+}
+
+// Canceled wraps the error of a context that is done in an error of kind
+// ErrCanceled. errors.Is matches it with the error of the context as well,
+// context.Canceled or context.DeadlineExceeded.
+func Canceled(err error) error {
+	return &Error{Kind: ErrCanceled, Message: fmt.Sprintf("query canceled: %v", err), cause: err}
+}
+
+// ErrorCode returns the code of the kind of err, or an empty string if err
+// is of none of the kinds.
+func ErrorCode(err error) string {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.kind) {
+			return c.code
+		}
+	}
+	return ""
+}
+
+// ErrorKind returns the kind of the errors with the given code, so that
+// clients can match the errors of a GraphQL response with errors.Is. It
+// returns nil for unknown codes.
+func ErrorKind(code string) error {
+	for _, c := range errorCodes {
+		if c.code == code {
+			return c.kind
+		}
+	}
+	return nil
+}
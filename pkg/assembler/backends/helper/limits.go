@@ -0,0 +1,87 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"sync"
+)
+
+// ResultLimitsExtension is the key of the GraphQL response extension listing
+// the queries whose results were truncated by the server's default result cap.
+const ResultLimitsExtension = "resultLimits"
+
+// ResultLimit reports how many results a truncated query returned and how
+// many matched in total.
+type ResultLimit struct {
+	Returned       int  `json:"returned"`
+	TotalAvailable int  `json:"totalAvailable"`
+	Truncated      bool `json:"truncated"`
+}
+
+// ResultLimits collects the truncated queries of a single GraphQL operation.
+type ResultLimits struct {
+	mu      sync.Mutex
+	queries map[string]ResultLimit
+}
+
+type resultLimitsKey struct{}
+
+// WithResultLimits returns a context on which backends can record truncated
+// queries, together with the collector that receives them.
+func WithResultLimits(ctx context.Context) (context.Context, *ResultLimits) {
+	limits := &ResultLimits{}
+	return context.WithValue(ctx, resultLimitsKey{}, limits), limits
+}
+
+// RecordResultLimit records that query returned only returned of the
+// totalAvailable matching results. It is a no-op if ctx was not created by
+// WithResultLimits. If the same query is truncated more than once in an
+// operation, e.g. through aliases, the largest total is kept.
+func RecordResultLimit(ctx context.Context, query string, returned, totalAvailable int) {
+	limits, ok := ctx.Value(resultLimitsKey{}).(*ResultLimits)
+	if !ok {
+		return
+	}
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	if limits.queries == nil {
+		limits.queries = map[string]ResultLimit{}
+	}
+	if previous, ok := limits.queries[query]; ok && previous.TotalAvailable > totalAvailable {
+		return
+	}
+	limits.queries[query] = ResultLimit{
+		Returned:       returned,
+		TotalAvailable: totalAvailable,
+		Truncated:      true,
+	}
+}
+
+// Truncated returns the truncated queries keyed by query name, or nil if no
+// query was truncated.
+func (r *ResultLimits) Truncated() map[string]ResultLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.queries) == 0 {
+		return nil
+	}
+	out := make(map[string]ResultLimit, len(r.queries))
+	for query, limit := range r.queries {
+		out[query] = limit
+	}
+	return out
+}
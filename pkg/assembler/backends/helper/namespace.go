@@ -0,0 +1,170 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultNamespace is the namespace of requests that don't select one.
+	// A deployment that doesn't use namespaces keeps all its data there.
+	DefaultNamespace = "default"
+	// WildcardScope grants access to all the namespaces.
+	WildcardScope = "*"
+	// NamespaceHeader is the HTTP header selecting the namespace of a
+	// GraphQL request.
+	NamespaceHeader = "X-Guac-Namespace"
+)
+
+var validNamespace = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,62}$`)
+
+type namespaceKey struct{}
+
+type scopesKey struct{}
+
+// WithNamespace returns a context selecting namespace for the ingestions and
+// queries made with it.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// NamespaceFromContext returns the namespace selected on ctx, or
+// DefaultNamespace if none was.
+func NamespaceFromContext(ctx context.Context) string {
+	if namespace, ok := ctx.Value(namespaceKey{}).(string); ok && namespace != "" {
+		return namespace
+	}
+	return DefaultNamespace
+}
+
+// WithScopes returns a context for an authenticated identity that can only
+// access the given namespaces, or all of them if scopes has WildcardScope.
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// AuthorizeNamespace returns the namespace selected on ctx, after checking
+// that the identity on ctx, if any, has it in its scopes. Contexts without an
+// identity, as when the server doesn't authenticate requests, can access any
+// namespace.
+func AuthorizeNamespace(ctx context.Context) (string, error) {
+	namespace := NamespaceFromContext(ctx)
+	if !validNamespace.MatchString(namespace) {
+		return "", backends.InvalidInputf("invalid namespace %q", namespace)
+	}
+	scopes, ok := ctx.Value(scopesKey{}).([]string)
+	if !ok {
+		return namespace, nil
+	}
+	for _, scope := range scopes {
+		if scope == namespace || scope == WildcardScope {
+			return namespace, nil
+		}
+	}
+	return "", gqlerror.Errorf("namespace %q is not in the scope of the caller", namespace)
+}
+
+// NamespaceHandler selects the namespace of the requests to next, from the
+// NamespaceHeader or else from the scope of the caller.
+//
+// If tokens is not nil, requests must carry one of its keys as a bearer token,
+// and can only access the namespaces listed for it. A token with a single
+// namespace selects it without the header.
+func NamespaceHandler(next http.Handler, tokens map[string][]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		namespace := r.Header.Get(NamespaceHeader)
+		if tokens != nil {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			scopes, ok := tokens[token]
+			if token == "" || !ok {
+				http.Error(w, "missing or unknown bearer token", http.StatusUnauthorized)
+				return
+			}
+			ctx = WithScopes(ctx, scopes)
+			if namespace == "" && len(scopes) == 1 && scopes[0] != WildcardScope {
+				namespace = scopes[0]
+			}
+		}
+		if namespace != "" {
+			ctx = WithNamespace(ctx, namespace)
+		}
+		if _, err := AuthorizeNamespace(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// DocumentHandler serves the original documents of next, a handler mounted
+// like blobstore.Handler, to the namespaces that hold evidence recorded from
+// them: a HasSBOM or HasSLSA with the document hash. Other namespaces get a
+// 404, as if the document wasn't stored. It is meant to be wrapped in
+// NamespaceHandler, which selects the namespace of the request.
+func DocumentHandler(next http.Handler, b backends.Backend) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		hash := strings.TrimPrefix(r.URL.Path, "/")
+		found, err := hasDocument(ctx, b, hash)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, backends.ErrInvalidInput) {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		if !found {
+			http.Error(w, fmt.Sprintf("document %s not found", hash), http.StatusNotFound)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func hasDocument(ctx context.Context, b backends.Backend, hash string) (bool, error) {
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{DocumentHash: &hash})
+	if err != nil || len(sboms) > 0 {
+		return len(sboms) > 0, err
+	}
+	slsas, err := b.HasSlsa(ctx, &model.HasSLSASpec{DocumentHash: &hash})
+	return len(slsas) > 0, err
+}
+
+// ValidateScopes checks the namespaces that can be listed in the scopes of a
+// token.
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("no namespaces in scope")
+	}
+	for _, scope := range scopes {
+		if scope != WildcardScope && !validNamespace.MatchString(scope) {
+			return fmt.Errorf("invalid namespace %q", scope)
+		}
+	}
+	return nil
+}
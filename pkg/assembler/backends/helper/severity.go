@@ -0,0 +1,35 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import "github.com/guacsec/guac/pkg/assembler/graphql/model"
+
+// SeverityBucketForScore maps a numeric score to its qualitative rating using
+// the CVSS v3 severity rating scale.
+func SeverityBucketForScore(score float64) model.SeverityBucket {
+	switch {
+	case score <= 0:
+		return model.SeverityBucketNone
+	case score < 4.0:
+		return model.SeverityBucketLow
+	case score < 7.0:
+		return model.SeverityBucketMedium
+	case score < 9.0:
+		return model.SeverityBucketHigh
+	default:
+		return model.SeverityBucketCritical
+	}
+}
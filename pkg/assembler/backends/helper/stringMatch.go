@@ -0,0 +1,113 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ValidateStringMatch checks that mode, when set, is one of the modes of
+// model.StringMatchMode. The GraphQL layer rejects unknown modes already, this
+// is for the other clients of the backends.
+func ValidateStringMatch(mode *model.StringMatchMode) error {
+	if mode != nil && !mode.IsValid() {
+		return backends.InvalidInputf("invalid string match mode %s", *mode)
+	}
+	return nil
+}
+
+// MatchString reports whether value matches filter in mode, EXACT if nil. See
+// model.StringMatchMode for the semantics of the modes.
+func MatchString(mode *model.StringMatchMode, filter string, value string) bool {
+	if mode == nil {
+		return value == filter
+	}
+	switch *mode {
+	case model.StringMatchModePrefix:
+		return strings.HasPrefix(value, filter)
+	case model.StringMatchModeGlob:
+		return matchGlob([]rune(filter), []rune(value))
+	}
+	return value == filter
+}
+
+// matchGlob matches value against pattern, backtracking to the last * when a
+// character doesn't match.
+func matchGlob(pattern, value []rune) bool {
+	p, v := 0, 0
+	star, starValue := -1, 0
+	for v < len(value) {
+		if p < len(pattern) {
+			switch c := pattern[p]; {
+			case c == '*':
+				star, starValue = p, v
+				p++
+				continue
+			case c == '?':
+				p++
+				v++
+				continue
+			case c == '\\' && p+1 < len(pattern):
+				if pattern[p+1] == value[v] {
+					p += 2
+					v++
+					continue
+				}
+			case c == value[v]:
+				p++
+				v++
+				continue
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		// let the last * match one more character
+		starValue++
+		p, v = star+1, starValue
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// GlobToRegex translates a GLOB filter to a regular expression with the same
+// semantics, for backends matching in their query language. Like the =~
+// operator of Cypher, the expression must match the whole value.
+func GlobToRegex(pattern string) string {
+	var sb strings.Builder
+	sb.WriteString("(?s)")
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '*':
+			sb.WriteString(".*")
+		case c == '?':
+			sb.WriteString(".")
+		case c == '\\' && i+1 < len(runes):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(runes[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
@@ -16,12 +16,20 @@
 package helper
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// ValidateOsvCveOrGhsaIngestionInput checks that exactly one of osv, cve, ghsa
+// or noVuln is set. A false noVuln counts as not set.
 func ValidateOsvCveOrGhsaIngestionInput(vulnerability model.OsvCveOrGhsaInput) error {
 	vulnDefined := 0
+	if vulnerability.NoVuln != nil && *vulnerability.NoVuln {
+		vulnDefined = vulnDefined + 1
+	}
 	if vulnerability.Osv != nil {
 		vulnDefined = vulnDefined + 1
 	}
@@ -32,16 +40,21 @@ func ValidateOsvCveOrGhsaIngestionInput(vulnerability model.OsvCveOrGhsaInput) e
 		vulnDefined = vulnDefined + 1
 	}
 	if vulnDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, or ghsa)")
+		return backends.InvalidInputf("Must specify at most one vulnerability (cve, osv, ghsa, or noVuln)")
 	}
 	return nil
 }
 
+// ValidateOsvCveOrGhsaQueryInput checks that at most one of osv, cve, ghsa or
+// noVuln is set, whether noVuln is true or false.
 func ValidateOsvCveOrGhsaQueryInput(vulnerability *model.OsvCveOrGhsaSpec) (bool, error) {
 	if vulnerability == nil {
 		return true, nil
 	} else {
 		vulnDefined := 0
+		if vulnerability.NoVuln != nil {
+			vulnDefined = vulnDefined + 1
+		}
 		if vulnerability.Osv != nil {
 			vulnDefined = vulnDefined + 1
 		}
@@ -52,7 +65,7 @@ func ValidateOsvCveOrGhsaQueryInput(vulnerability *model.OsvCveOrGhsaSpec) (bool
 			vulnDefined = vulnDefined + 1
 		}
 		if vulnDefined != 1 {
-			return false, gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, or ghsa)")
+			return false, backends.InvalidInputf("Must specify at most one vulnerability (cve, osv, ghsa, or noVuln)")
 		}
 	}
 	return false, nil
@@ -67,7 +80,7 @@ func ValidateCveOrGhsaIngestionInput(cveOrGhsa model.CveOrGhsaInput, path string
 		vulnDefined = vulnDefined + 1
 	}
 	if vulnDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one vulnerability (cve, or ghsa) for %v", path)
+		return backends.InvalidInputf("Must specify at most one vulnerability (cve, or ghsa) for %v", path)
 	}
 	return nil
 }
@@ -84,7 +97,7 @@ func ValidateCveOrGhsaQueryInput(cveOrGhsa *model.CveOrGhsaSpec) (bool, error) {
 			vulnDefined = vulnDefined + 1
 		}
 		if vulnDefined != 1 {
-			return false, gqlerror.Errorf("Must specify at most one vulnerability (cve, or ghsa)")
+			return false, backends.InvalidInputf("Must specify at most one vulnerability (cve, or ghsa)")
 		}
 	}
 	return false, nil
@@ -105,7 +118,7 @@ func ValidatePackageSourceOrArtifactQueryInput(subject *model.PackageSourceOrArt
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return false, gqlerror.Errorf("must specify at most one subject (package, source, or artifact)")
+			return false, backends.InvalidInputf("must specify at most one subject (package, source, or artifact)")
 		}
 	}
 	return false, nil
@@ -123,7 +136,7 @@ func ValidatePackageSourceOrArtifactInput(item *model.PackageSourceOrArtifactInp
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package, source, or artifact for %v", path)
+		return backends.InvalidInputf("Must specify at most one package, source, or artifact for %v", path)
 	}
 
 	return nil
@@ -138,7 +151,7 @@ func ValidatePackageOrSourceInput(item *model.PackageOrSourceInput, path string)
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package or source for %v", path)
+		return backends.InvalidInputf("Must specify at most one package or source for %v", path)
 	}
 
 	return nil
@@ -156,7 +169,7 @@ func ValidatePackageOrSourceQueryInput(subject *model.PackageOrSourceSpec) (bool
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return false, gqlerror.Errorf("must specify at most one subject (package or source)")
+			return false, backends.InvalidInputf("must specify at most one subject (package or source)")
 		}
 	}
 	return false, nil
@@ -171,7 +184,7 @@ func ValidatePackageOrArtifactInput(item *model.PackageOrArtifactInput, path str
 		valuesDefined = valuesDefined + 1
 	}
 	if valuesDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one package or artifact for %v", path)
+		return backends.InvalidInputf("Must specify at most one package or artifact for %v", path)
 	}
 
 	return nil
@@ -189,8 +202,134 @@ func ValidatePackageOrArtifactQueryInput(subject *model.PackageOrArtifactSpec) (
 			subjectDefined = subjectDefined + 1
 		}
 		if subjectDefined != 1 {
-			return false, gqlerror.Errorf("must specify at most one subject (package or artifact)")
+			return false, backends.InvalidInputf("must specify at most one subject (package or artifact)")
 		}
 	}
 	return false, nil
 }
+
+// InputList is a list argument of a bulk mutation, named as in the error
+// messages of ValidateInputLengths.
+type InputList struct {
+	Name string
+	Len  int
+}
+
+// ValidateInputLengths checks that the parallel lists of a bulk mutation have
+// the same length, as their elements are matched by index.
+func ValidateInputLengths(path string, lists ...InputList) error {
+	uneven := false
+	for _, list := range lists {
+		if list.Len != lists[0].Len {
+			uneven = true
+		}
+	}
+	if !uneven {
+		return nil
+	}
+	counts := make([]string, 0, len(lists))
+	for _, list := range lists {
+		counts = append(counts, fmt.Sprintf("%s (%d)", list.Name, list.Len))
+	}
+	last := len(counts) - 1
+	return backends.InvalidInputf("%v :: uneven number of %s and %s", path, strings.Join(counts[:last], ", "), counts[last])
+}
+
+// ValidatePackageSourceOrArtifactInputs validates every element of a bulk
+// mutation with ValidatePackageSourceOrArtifactInput. The errors name the
+// index of the element, as in path[3], and are all reported at once.
+func ValidatePackageSourceOrArtifactInputs(items []*model.PackageSourceOrArtifactInput, path string) error {
+	return validateInputs(items, path, ValidatePackageSourceOrArtifactInput)
+}
+
+// ValidatePackageOrSourceInputs validates every element of a bulk mutation
+// with ValidatePackageOrSourceInput.
+func ValidatePackageOrSourceInputs(items []*model.PackageOrSourceInput, path string) error {
+	return validateInputs(items, path, ValidatePackageOrSourceInput)
+}
+
+// ValidatePackageOrArtifactInputs validates every element of a bulk mutation
+// with ValidatePackageOrArtifactInput.
+func ValidatePackageOrArtifactInputs(items []*model.PackageOrArtifactInput, path string) error {
+	return validateInputs(items, path, ValidatePackageOrArtifactInput)
+}
+
+// ValidateCveOrGhsaIngestionInputs validates every element of a bulk mutation
+// with ValidateCveOrGhsaIngestionInput.
+func ValidateCveOrGhsaIngestionInputs(items []*model.CveOrGhsaInput, path string) error {
+	return validateInputs(items, path, func(item *model.CveOrGhsaInput, path string) error {
+		return ValidateCveOrGhsaIngestionInput(*item, path)
+	})
+}
+
+// ValidateOsvCveOrGhsaIngestionInputs validates every element of a bulk
+// mutation with ValidateOsvCveOrGhsaIngestionInput.
+func ValidateOsvCveOrGhsaIngestionInputs(items []*model.OsvCveOrGhsaInput, path string) error {
+	return validateInputs(items, path, func(item *model.OsvCveOrGhsaInput, path string) error {
+		if err := ValidateOsvCveOrGhsaIngestionInput(*item); err != nil {
+			return backends.InvalidInputf("%v for %v", err.Error(), path)
+		}
+		return nil
+	})
+}
+
+// validateInputs runs validate on every element of items, collecting the
+// failures in a single invalid input error. Missing elements are failures
+// too.
+func validateInputs[T any](items []*T, path string, validate func(*T, string) error) error {
+	var failures []string
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if item == nil {
+			failures = append(failures, fmt.Sprintf("Missing %v", itemPath))
+			continue
+		}
+		if err := validate(item, itemPath); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		return backends.InvalidInputf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// VexInputStatus returns the status of a VEX statement to ingest, which is
+// NOT_AFFECTED if not set.
+func VexInputStatus(vexStatement model.VexStatementInputSpec) model.VexStatus {
+	if vexStatement.Status == nil {
+		return model.VexStatusNotAffected
+	}
+	return *vexStatement.Status
+}
+
+// ValidateVexInput checks that the status of a VEX statement to ingest is
+// valid and comes with the properties the VEX specification requires: a
+// justification if and only if the subject is not affected, and an action
+// statement if it is affected. All the failures are reported at once.
+func ValidateVexInput(vexStatement model.VexStatementInputSpec) error {
+	var failures []string
+	status := VexInputStatus(vexStatement)
+	switch {
+	case !status.IsValid():
+		failures = append(failures, fmt.Sprintf("invalid vexStatement.status %q, must be one of %v", status, model.AllVexStatus))
+	case status == model.VexStatusNotAffected:
+		if strings.TrimSpace(vexStatement.Justification) == "" {
+			failures = append(failures, fmt.Sprintf("vexStatement.justification is required when vexStatement.status is %v", status))
+		}
+	default:
+		if vexStatement.Justification != "" {
+			failures = append(failures, fmt.Sprintf("vexStatement.justification must not be set when vexStatement.status is %v", status))
+		}
+		if status == model.VexStatusAffected && (vexStatement.Statement == nil || strings.TrimSpace(*vexStatement.Statement) == "") {
+			failures = append(failures, fmt.Sprintf("vexStatement.statement is required when vexStatement.status is %v", status))
+		}
+	}
+	if vexStatement.KnownSince.IsZero() {
+		failures = append(failures, "vexStatement.knownSince is required")
+	}
+	if len(failures) > 0 {
+		return backends.InvalidInputf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
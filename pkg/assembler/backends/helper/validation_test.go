@@ -0,0 +1,123 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestValidateInputs(t *testing.T) {
+	pkg := &model.PkgInputSpec{Type: "pypi", Name: "tensorflow"}
+	src := &model.SourceInputSpec{Type: "git", Namespace: "github.com/tensorflow", Name: "tensorflow"}
+	cve := &model.CVEInputSpec{Year: 2019, CveID: "CVE-2019-13110"}
+	tests := []struct {
+		Name   string
+		Err    error
+		ExpMsg string
+	}{
+		{
+			Name: "Valid subjects",
+			Err: helper.ValidatePackageSourceOrArtifactInputs([]*model.PackageSourceOrArtifactInput{
+				{Package: pkg},
+				{Source: src},
+			}, "certifyBad"),
+		},
+		{
+			Name: "No subjects",
+			Err:  helper.ValidatePackageSourceOrArtifactInputs(nil, "certifyBad"),
+		},
+		{
+			Name: "All failures reported",
+			Err: helper.ValidatePackageSourceOrArtifactInputs([]*model.PackageSourceOrArtifactInput{
+				{Package: pkg},
+				{Package: pkg, Source: src},
+				nil,
+				{},
+			}, "certifyBad"),
+			ExpMsg: "Must specify at most one package, source, or artifact for certifyBad[1]; " +
+				"Missing certifyBad[2]; " +
+				"Must specify at most one package, source, or artifact for certifyBad[3]",
+		},
+		{
+			Name: "Package or source",
+			Err: helper.ValidatePackageOrSourceInputs([]*model.PackageOrSourceInput{
+				{Source: src},
+				{},
+			}, "certifyGood"),
+			ExpMsg: "Must specify at most one package or source for certifyGood[1]",
+		},
+		{
+			Name: "Package or artifact",
+			Err: helper.ValidatePackageOrArtifactInputs([]*model.PackageOrArtifactInput{
+				{},
+			}, "isOccurrence"),
+			ExpMsg: "Must specify at most one package or artifact for isOccurrence[0]",
+		},
+		{
+			Name: "CVE or GHSA",
+			Err: helper.ValidateCveOrGhsaIngestionInputs([]*model.CveOrGhsaInput{
+				{Cve: cve},
+				{},
+			}, "isVulnerability"),
+			ExpMsg: "Must specify at most one vulnerability (cve, or ghsa) for isVulnerability[1]",
+		},
+		{
+			Name: "OSV, CVE or GHSA",
+			Err: helper.ValidateOsvCveOrGhsaIngestionInputs([]*model.OsvCveOrGhsaInput{
+				{NoVuln: ptrfrom.Bool(false)},
+				{NoVuln: ptrfrom.Bool(true)},
+			}, "certifyVuln"),
+			ExpMsg: "Must specify at most one vulnerability (cve, osv, ghsa, or noVuln) for certifyVuln[0]",
+		},
+		{
+			Name: "Even lengths",
+			Err: helper.ValidateInputLengths("IngestHasSourceAts",
+				helper.InputList{Name: "packages", Len: 2},
+				helper.InputList{Name: "sources", Len: 2},
+				helper.InputList{Name: "hasSourceAts", Len: 2}),
+		},
+		{
+			Name: "Uneven lengths",
+			Err: helper.ValidateInputLengths("IngestHasSourceAts",
+				helper.InputList{Name: "packages", Len: 2},
+				helper.InputList{Name: "sources", Len: 1},
+				helper.InputList{Name: "hasSourceAts", Len: 2}),
+			ExpMsg: "IngestHasSourceAts :: uneven number of packages (2), sources (1) and hasSourceAts (2)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if test.ExpMsg == "" {
+				if test.Err != nil {
+					t.Fatalf("unexpected error: %v", test.Err)
+				}
+				return
+			}
+			if !errors.Is(test.Err, backends.ErrInvalidInput) {
+				t.Fatalf("expected an invalid input error, got: %v", test.Err)
+			}
+			if test.Err.Error() != test.ExpMsg {
+				t.Errorf("unexpected error message, want: %q, got: %q", test.ExpMsg, test.Err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version ranges follow the npm syntax, the most common one for semver:
+//
+//   - a range is a list of alternatives separated by ||, matching a version if
+//     any of them does
+//   - an alternative is a list of comparators separated by spaces or commas,
+//     matching a version if all of them do
+//   - a comparator is a version, possibly partial, preceded by one of =, ==,
+//     !=, <, <=, >, >=, ^ (same major version, or same minor version below
+//     1.0.0) or ~ (same minor version)
+//   - a partial version, as 1.2, 1.2.x or 1.*, stands for all its versions,
+//     and * or x for any version
+//
+// Pre-release versions are ordered by semver precedence, so that 2.0.0-rc.1
+// is below 2.0.0. As with npm, a pre-release is only in an alternative that
+// has a comparator with a pre-release of the same major.minor.patch: ^1.2.0
+// doesn't include 1.3.0-beta, while >=1.3.0-alpha <2.0.0 includes 1.3.0-beta
+// but not 1.4.0-beta. Build metadata is ignored.
+
+// MatchVersionRange reports whether version is in versionRange. It returns
+// an error if either is not semver, as for the versions of ecosystems such
+// as deb.
+func MatchVersionRange(versionRange, version string) (bool, error) {
+	v, parts, err := parsePartialVersion(version)
+	if err != nil {
+		return false, err
+	}
+	if parts != 3 {
+		return false, fmt.Errorf("invalid version %q: not a full version", version)
+	}
+	alternatives, err := parseVersionRange(versionRange)
+	if err != nil {
+		return false, err
+	}
+	for _, comparators := range alternatives {
+		if v.pre != "" && !namesPrerelease(comparators, v) {
+			continue
+		}
+		match := true
+		for _, c := range comparators {
+			if !c.match(v) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// namesPrerelease reports whether a comparator has a pre-release of the same
+// major.minor.patch as v.
+func namesPrerelease(comparators []versionComparator, v semver) bool {
+	for _, c := range comparators {
+		if c.v.pre != "" && !c.bump && c.v.major == v.major && c.v.minor == v.minor && c.v.patch == v.patch {
+			return true
+		}
+	}
+	return false
+}
+
+// semver is a version; pre is its pre-release, empty for a release.
+type semver struct {
+	major, minor, patch uint64
+	pre                 string
+}
+
+// versionComparator compares versions with v, op is one of =, !=, <, <=, >
+// and >=. bump is set if v is the bound after a partial version or a caret
+// or tilde range, rather than a version of the range.
+type versionComparator struct {
+	op   string
+	v    semver
+	bump bool
+}
+
+func (c versionComparator) match(v semver) bool {
+	cmp := compareSemver(v, c.v)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// rangeOperators are the comparator operators, longest first.
+var rangeOperators = []string{">=", "<=", "!=", "==", ">", "<", "=", "^", "~"}
+
+func parseVersionRange(versionRange string) ([][]versionComparator, error) {
+	var alternatives [][]versionComparator
+	for _, alternative := range strings.Split(versionRange, "||") {
+		fields := strings.Fields(strings.ReplaceAll(alternative, ",", " "))
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid version range %q: empty range", versionRange)
+		}
+		comparators := []versionComparator{}
+		for i := 0; i < len(fields); i++ {
+			op, version := splitOperator(fields[i])
+			if version == "" && op != "" && i+1 < len(fields) {
+				// the operator is separated from its version, as in >= 1.2.0
+				i++
+				version = fields[i]
+			}
+			c, err := desugarComparator(op, version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version range %q: %w", versionRange, err)
+			}
+			comparators = append(comparators, c...)
+		}
+		alternatives = append(alternatives, comparators)
+	}
+	return alternatives, nil
+}
+
+func splitOperator(field string) (string, string) {
+	for _, op := range rangeOperators {
+		if strings.HasPrefix(field, op) {
+			return op, field[len(op):]
+		}
+	}
+	return "", field
+}
+
+// desugarComparator returns the comparators matching the same versions as a
+// comparator of the range, which may have a partial version.
+func desugarComparator(op, version string) ([]versionComparator, error) {
+	v, parts, err := parsePartialVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	// next is the first version after the partial version
+	next := bumpVersion(v, parts)
+	switch op {
+	case "", "=", "==":
+		switch parts {
+		case 0:
+			return nil, nil
+		case 3:
+			return []versionComparator{{"=", v, false}}, nil
+		}
+		return []versionComparator{{">=", v, false}, {"<", next, true}}, nil
+	case "!=":
+		if parts != 3 {
+			return nil, fmt.Errorf("%s%s: != requires a full version", op, version)
+		}
+		return []versionComparator{{"!=", v, false}}, nil
+	case "<", ">":
+		if parts == 0 {
+			return nil, fmt.Errorf("%s%s: no version is %s any version", op, version, op)
+		}
+		if op == "<" {
+			return []versionComparator{{"<", v, false}}, nil
+		}
+		if parts == 3 {
+			return []versionComparator{{">", v, false}}, nil
+		}
+		return []versionComparator{{">=", next, true}}, nil
+	case "<=":
+		switch parts {
+		case 0:
+			return nil, nil
+		case 3:
+			return []versionComparator{{"<=", v, false}}, nil
+		}
+		return []versionComparator{{"<", next, true}}, nil
+	case ">=":
+		if parts == 0 {
+			return nil, nil
+		}
+		return []versionComparator{{">=", v, false}}, nil
+	case "~":
+		if parts == 0 {
+			return nil, nil
+		}
+		if parts == 3 {
+			next = bumpVersion(v, 2)
+		}
+		return []versionComparator{{">=", v, false}, {"<", next, true}}, nil
+	}
+	// ^ keeps the first non-zero part of the version, or the last given one
+	switch {
+	case parts == 0:
+		return nil, nil
+	case v.major > 0 || parts == 1:
+		next = bumpVersion(v, 1)
+	case v.minor > 0 || parts == 2:
+		next = bumpVersion(v, 2)
+	default:
+		next = bumpVersion(v, 3)
+	}
+	return []versionComparator{{">=", v, false}, {"<", next, true}}, nil
+}
+
+// parsePartialVersion parses a version, with an optional v prefix, returning
+// the number of parts given before the first wildcard. The missing parts are
+// zero.
+func parsePartialVersion(version string) (semver, int, error) {
+	var v semver
+	s := strings.TrimPrefix(version, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		v.pre = s[i+1:]
+		s = s[:i]
+		if !validPrerelease(v.pre) {
+			return v, 0, fmt.Errorf("invalid version %q: bad pre-release", version)
+		}
+	}
+	numbers := []*uint64{&v.major, &v.minor, &v.patch}
+	fields := strings.Split(s, ".")
+	if len(fields) > len(numbers) {
+		return v, 0, fmt.Errorf("invalid version %q: too many parts", version)
+	}
+	parts := 0
+	for i, field := range fields {
+		if field == "*" || field == "x" || field == "X" {
+			// the parts after a wildcard must be wildcards too
+			for _, rest := range fields[i:] {
+				if rest != "*" && rest != "x" && rest != "X" {
+					return v, 0, fmt.Errorf("invalid version %q: number after a wildcard", version)
+				}
+			}
+			break
+		}
+		n, err := strconv.ParseUint(field, 10, 64)
+		if err != nil || (len(field) > 1 && field[0] == '0') {
+			return v, 0, fmt.Errorf("invalid version %q: bad number %q", version, field)
+		}
+		*numbers[i] = n
+		parts++
+	}
+	if v.pre != "" && parts != 3 {
+		return v, 0, fmt.Errorf("invalid version %q: pre-release of a partial version", version)
+	}
+	return v, parts, nil
+}
+
+func validPrerelease(pre string) bool {
+	for _, id := range strings.Split(pre, ".") {
+		if id == "" {
+			return false
+		}
+		for _, r := range id {
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// bumpVersion returns the first version after all the versions starting
+// with the first parts of v: the first pre-release of the next release.
+func bumpVersion(v semver, parts int) semver {
+	switch parts {
+	case 1:
+		return semver{major: v.major + 1, pre: "0"}
+	case 2:
+		return semver{major: v.major, minor: v.minor + 1, pre: "0"}
+	case 3:
+		return semver{major: v.major, minor: v.minor, patch: v.patch + 1, pre: "0"}
+	}
+	return semver{}
+}
+
+// compareSemver compares versions by semver precedence.
+func compareSemver(a, b semver) int {
+	for _, c := range [][2]uint64{{a.major, b.major}, {a.minor, b.minor}, {a.patch, b.patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case a.pre == b.pre:
+		return 0
+	case a.pre == "":
+		return 1
+	case b.pre == "":
+		return -1
+	}
+	aIDs, bIDs := strings.Split(a.pre, "."), strings.Split(b.pre, ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		if cmp := comparePrereleaseID(aIDs[i], bIDs[i]); cmp != 0 {
+			return cmp
+		}
+	}
+	switch {
+	case len(aIDs) < len(bIDs):
+		return -1
+	case len(aIDs) > len(bIDs):
+		return 1
+	}
+	return 0
+}
+
+// comparePrereleaseID compares identifiers of pre-releases: numeric ones
+// numerically, and before the alphanumeric ones.
+func comparePrereleaseID(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// VersionInRange reports whether a dependency on versionRange accepts
+// version: whether version is in the range if both are semver, or equal to it
+// otherwise.
+func VersionInRange(versionRange, version string) bool {
+	match, err := MatchVersionRange(versionRange, version)
+	if err != nil {
+		return versionRange == version
+	}
+	return match
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+)
+
+func TestMatchVersionRange(t *testing.T) {
+	tests := []struct {
+		Range   string
+		Match   []string
+		NoMatch []string
+	}{
+		// caret
+		{Range: "^1.2.3", Match: []string{"1.2.3", "1.9.0", "v1.2.4"}, NoMatch: []string{"1.2.2", "2.0.0", "2.0.0-alpha", "1.2.3-beta"}},
+		{Range: "^0.2.3", Match: []string{"0.2.3", "0.2.9"}, NoMatch: []string{"0.3.0", "0.2.2"}},
+		{Range: "^0.0.3", Match: []string{"0.0.3"}, NoMatch: []string{"0.0.4", "0.1.0"}},
+		{Range: "^1.2", Match: []string{"1.2.0", "1.5.1"}, NoMatch: []string{"1.1.9", "2.0.0"}},
+		{Range: "^0.0", Match: []string{"0.0.0", "0.0.9"}, NoMatch: []string{"0.1.0"}},
+		{Range: "^0.x", Match: []string{"0.0.1", "0.9.0"}, NoMatch: []string{"1.0.0"}},
+		{Range: "^1.2.3-beta.2", Match: []string{"1.2.3-beta.2", "1.2.3-beta.11", "1.2.3"}, NoMatch: []string{"1.2.3-beta.1", "1.2.3-alpha"}},
+		{Range: "^1.2.0", Match: []string{"1.3.0"}, NoMatch: []string{"1.3.0-beta", "1.2.1-rc.1"}},
+		{Range: "^1.2.3-beta.2", Match: []string{"1.3.0"}, NoMatch: []string{"1.3.0-beta"}},
+		// tilde
+		{Range: "~1.2.3", Match: []string{"1.2.3", "1.2.9"}, NoMatch: []string{"1.3.0", "1.2.2"}},
+		{Range: "~1.2", Match: []string{"1.2.0", "1.2.9"}, NoMatch: []string{"1.3.0"}},
+		{Range: "~1", Match: []string{"1.0.0", "1.9.9"}, NoMatch: []string{"2.0.0", "0.9.9"}},
+		{Range: "~0.2.3", Match: []string{"0.2.5"}, NoMatch: []string{"0.3.0"}},
+		// comparisons
+		{Range: ">=1.2.0 <2.0.0", Match: []string{"1.2.0", "1.99.0"}, NoMatch: []string{"1.1.9", "2.0.0"}},
+		{Range: ">= 1.2.0, < 2.0.0", Match: []string{"1.2.0"}, NoMatch: []string{"2.0.0"}},
+		{Range: ">1.2.3", Match: []string{"1.2.4"}, NoMatch: []string{"1.2.3", "1.2.3-rc.1", "1.2.4-rc.1"}},
+		{Range: ">=1.3.0-alpha <2.0.0", Match: []string{"1.3.0-beta", "1.4.0"}, NoMatch: []string{"1.4.0-beta", "2.0.0-rc.1"}},
+		{Range: "<2.0.0-rc.2", Match: []string{"2.0.0-rc.1", "1.9.0"}, NoMatch: []string{"1.9.0-beta", "2.0.0-rc.2"}},
+		{Range: ">=1.0.0 || >=2.0.0-rc.1", Match: []string{"2.0.0-rc.2"}, NoMatch: []string{"1.5.0-rc.1"}},
+		{Range: ">1.2", Match: []string{"1.3.0"}, NoMatch: []string{"1.2.9"}},
+		{Range: "<=1.2", Match: []string{"1.2.9"}, NoMatch: []string{"1.3.0"}},
+		{Range: "<1.2", Match: []string{"1.1.9"}, NoMatch: []string{"1.2.0"}},
+		{Range: "=1.2.3", Match: []string{"1.2.3", "1.2.3+build.7"}, NoMatch: []string{"1.2.4"}},
+		{Range: "==1.2.3", Match: []string{"1.2.3"}, NoMatch: []string{"1.2.4"}},
+		{Range: "1.2.3", Match: []string{"1.2.3"}, NoMatch: []string{"1.2.4"}},
+		{Range: ">=1.0.0 !=1.5.0", Match: []string{"1.4.0", "1.6.0"}, NoMatch: []string{"1.5.0"}},
+		{Range: "<1.0.0 || >=2.0.0", Match: []string{"0.9.0", "2.1.0"}, NoMatch: []string{"1.0.0", "1.9.9"}},
+		// wildcards
+		{Range: "*", Match: []string{"0.0.0", "3.2.1"}},
+		{Range: "x", Match: []string{"1.0.0"}},
+		{Range: "1.x", Match: []string{"1.0.0", "1.9.9"}, NoMatch: []string{"2.0.0", "0.9.0"}},
+		{Range: "1.2.*", Match: []string{"1.2.0", "1.2.9"}, NoMatch: []string{"1.3.0"}},
+		{Range: "1.2", Match: []string{"1.2.5"}, NoMatch: []string{"1.3.0"}},
+		{Range: "1.X.x", Match: []string{"1.5.0"}, NoMatch: []string{"2.0.0"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Range, func(t *testing.T) {
+			for _, v := range test.Match {
+				got, err := helper.MatchVersionRange(test.Range, v)
+				if err != nil {
+					t.Fatalf("Unexpected error for %s: %v", v, err)
+				}
+				if !got {
+					t.Errorf("expected %s to be in %s", v, test.Range)
+				}
+			}
+			for _, v := range test.NoMatch {
+				got, err := helper.MatchVersionRange(test.Range, v)
+				if err != nil {
+					t.Fatalf("Unexpected error for %s: %v", v, err)
+				}
+				if got {
+					t.Errorf("expected %s not to be in %s", v, test.Range)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchVersionRangeErrors(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Range   string
+		Version string
+	}{
+		{Name: "Empty range", Range: "", Version: "1.0.0"},
+		{Name: "Empty alternative", Range: "1.0.0 ||", Version: "1.0.0"},
+		{Name: "Deb range", Range: "1:2.30-1ubuntu1", Version: "1.0.0"},
+		{Name: "Hyphen range", Range: "1.0.0 - 2.0.0", Version: "1.0.0"},
+		{Name: "Too many parts", Range: "1.2.3.4", Version: "1.0.0"},
+		{Name: "Leading zero", Range: "01.2.3", Version: "1.0.0"},
+		{Name: "Number after wildcard", Range: "1.x.3", Version: "1.0.0"},
+		{Name: "Partial not equal", Range: "!=1.2", Version: "1.0.0"},
+		{Name: "Below any", Range: "<*", Version: "1.0.0"},
+		{Name: "Partial version", Range: "^1.0.0", Version: "1.2"},
+		{Name: "Deb version", Range: "^1.0.0", Version: "1:2.30-1ubuntu1"},
+		{Name: "Bad pre-release", Range: "^1.0.0", Version: "1.0.0-beta..1"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := helper.MatchVersionRange(test.Range, test.Version); err == nil {
+				t.Errorf("expected an error for %s in %s", test.Version, test.Range)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+var (
+	cveIDPattern  = regexp.MustCompile(`^cve-\d{4}-\d{4,}$`)
+	ghsaIDPattern = regexp.MustCompile(`^ghsa(-[0-9a-z]{4}){3}$`)
+)
+
+// NormalizeVulnerabilityID returns a CVE or GHSA ID in the form the backends
+// store it: without surrounding spaces and lowercase. Queries go through it
+// so that they find the IDs whatever their casing, including the IDs stored
+// before they were validated, which were already lowercase.
+func NormalizeVulnerabilityID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// CanonicalCveID validates a CVE ID, such as CVE-2021-44228, and returns its
+// normalized form.
+func CanonicalCveID(id string) (string, error) {
+	canonical := NormalizeVulnerabilityID(id)
+	if !cveIDPattern.MatchString(canonical) {
+		return "", backends.InvalidInputf("invalid CVE ID %q, expected CVE-YYYY-NNNN", id)
+	}
+	return canonical, nil
+}
+
+// CanonicalCveInput validates the ID and year of a CVE input and returns its
+// normalized ID. The year must be the one of the ID, so that a CVE is always
+// found under the same year.
+func CanonicalCveInput(year int, id string) (string, error) {
+	canonical, err := CanonicalCveID(id)
+	if err != nil {
+		return "", err
+	}
+	if idYear := canonical[len("cve-") : len("cve-")+4]; idYear != strconv.Itoa(year) {
+		return "", backends.InvalidInputf("CVE ID %q is from %s, not %d", id, idYear, year)
+	}
+	return canonical, nil
+}
+
+// CanonicalGhsaID validates a GHSA ID, such as GHSA-jfh8-c2jp-5v3q, and
+// returns its normalized form.
+func CanonicalGhsaID(id string) (string, error) {
+	canonical := NormalizeVulnerabilityID(id)
+	if !ghsaIDPattern.MatchString(canonical) {
+		return "", backends.InvalidInputf("invalid GHSA ID %q, expected GHSA-xxxx-xxxx-xxxx", id)
+	}
+	return canonical, nil
+}
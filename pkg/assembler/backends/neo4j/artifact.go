@@ -17,6 +17,7 @@ package neo4jBackend
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -128,6 +129,21 @@ RETURN a.algorithm, a.digest`
 	return result.(*model.Artifact), nil
 }
 
+func (c *neo4jClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	out := make([]*model.Artifact, 0, len(artifacts))
+	for i, artifact := range artifacts {
+		if artifact == nil {
+			return nil, fmt.Errorf("index %d: missing artifact", i)
+		}
+		ingested, err := c.IngestArtifact(ctx, artifact)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
 func setArtifactMatchValues(sb *strings.Builder, art *model.ArtifactSpec, objectArt bool, firstMatch *bool, queryValues map[string]any) {
 	if art != nil {
 		if art.Algorithm != nil {
@@ -17,10 +17,15 @@ package neo4jBackend
 
 import (
 	"context"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
@@ -105,6 +110,140 @@ func matchProperties(sb *strings.Builder, firstMatch bool, label, property strin
 	sb.WriteString(resolver)
 }
 
+// compareProperty is matchProperties with another comparison operator, such as
+// >= for the lower bound of a range.
+func compareProperty(sb *strings.Builder, firstMatch bool, label, property, operator, resolver string) {
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	sb.WriteString(label)
+	sb.WriteString(".")
+	sb.WriteString(property)
+	sb.WriteString(" ")
+	sb.WriteString(operator)
+	sb.WriteString(" ")
+	sb.WriteString(resolver)
+}
+
+// matchStringProperty is matchProperties for the justification, origin and
+// collector filters, which are compared in mode, see model.StringMatchMode.
+// The value of resolver must be set with stringMatchValue.
+func matchStringProperty(sb *strings.Builder, firstMatch bool, label, property string, resolver string, mode *model.StringMatchMode) {
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	writeStringComparison(sb, label, property, resolver, mode)
+}
+
+// writeStringComparison writes the condition of matchStringProperty.
+func writeStringComparison(sb *strings.Builder, label, property string, resolver string, mode *model.StringMatchMode) {
+	sb.WriteString(label)
+	sb.WriteString(".")
+	sb.WriteString(property)
+	switch {
+	case mode == nil || *mode == model.StringMatchModeExact:
+		sb.WriteString(" = ")
+	case *mode == model.StringMatchModeGlob:
+		sb.WriteString(" =~ ")
+	default:
+		sb.WriteString(" STARTS WITH ")
+	}
+	sb.WriteString(resolver)
+}
+
+// stringMatchValue returns the query value of a filter compared in mode by
+// matchStringProperty.
+func stringMatchValue(mode *model.StringMatchMode, filter *string) any {
+	if mode != nil && *mode == model.StringMatchModeGlob {
+		return helper.GlobToRegex(*filter)
+	}
+	return filter
+}
+
+// matchAnyStringProperty matches the nodes of label whose property matches any
+// of filters in mode, setting the query values resolver0, resolver1 and so on.
+// It must only be called with some filters.
+func matchAnyStringProperty(sb *strings.Builder, firstMatch bool, label, property, resolver string, mode *model.StringMatchMode, filters []string, queryValues map[string]any) {
+	if firstMatch {
+		sb.WriteString(" WHERE (")
+	} else {
+		sb.WriteString(" AND (")
+	}
+	for i := range filters {
+		if i > 0 {
+			sb.WriteString(" OR ")
+		}
+		value := resolver + strconv.Itoa(i)
+		writeStringComparison(sb, label, property, "$"+value, mode)
+		queryValues[value] = stringMatchValue(mode, &filters[i])
+	}
+	sb.WriteString(")")
+}
+
+// matchNodeID matches the node of label by its internal ID, the ID of the
+// evidence returned to clients.
+func matchNodeID(sb *strings.Builder, firstMatch bool, label string, resolver string) {
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	sb.WriteString("id(")
+	sb.WriteString(label)
+	sb.WriteString(") = ")
+	sb.WriteString(resolver)
+}
+
+// parseNodeID parses an ID returned by formatNodeID.
+func parseNodeID(id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n < 0 {
+		return 0, backends.InvalidInputf("invalid ID %s", id)
+	}
+	return n, nil
+}
+
+// formatNodeID returns the ID of a node for clients, its internal ID.
+func formatNodeID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// orderBy sorts the results by the property of label in the direction dir,
+// ties broken by the internal ID of the node.
+func orderBy(sb *strings.Builder, label, property string, dir model.SortDirection) {
+	sb.WriteString(" ORDER BY ")
+	sb.WriteString(label)
+	sb.WriteString(".")
+	sb.WriteString(property)
+	if dir == model.SortDirectionDesc {
+		sb.WriteString(" DESC")
+	}
+	sb.WriteString(", id(")
+	sb.WriteString(label)
+	sb.WriteString(")")
+}
+
+// sortByTime sorts results gathered from several queries, which Cypher cannot
+// order as a whole. As with orderBy, ties are broken by the ID of the node.
+func sortByTime[R any](results []R, dir model.SortDirection, value func(R) time.Time, id func(R) string) {
+	sort.Slice(results, func(i, j int) bool {
+		a, b := value(results[i]), value(results[j])
+		if !a.Equal(b) {
+			if dir == model.SortDirectionDesc {
+				return a.After(b)
+			}
+			return a.Before(b)
+		}
+		aID, _ := parseNodeID(id(results[i]))
+		bID, _ := parseNodeID(id(results[j]))
+		return aID < bID
+	})
+}
+
 // getPreloads get the specific graphQL query fields that are requested.
 // graphql.CollectAllFields only provides the top level fields and none of the nested fields below it.
 // getPreloads recursively goes through the fields and retrieves each nested field below it.
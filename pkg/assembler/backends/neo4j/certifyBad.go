@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -105,7 +106,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 						return nil, gqlerror.Errorf("certifyBad Node not found in neo4j")
 					}
 
-					certifyBad := generateModelCertifyBad(pkg, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad := generateModelCertifyBad(pkg, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string), knownSinceProperty(certifyBadNode.Props))
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -162,7 +163,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 						return nil, gqlerror.Errorf("certifyBad Node not found in neo4j")
 					}
 
-					certifyBad := generateModelCertifyBad(src, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad := generateModelCertifyBad(src, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string), knownSinceProperty(certifyBadNode.Props))
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -213,7 +214,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 						return nil, gqlerror.Errorf("certifyBad Node not found in neo4j")
 					}
 
-					certifyBad := generateModelCertifyBad(artifact, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad := generateModelCertifyBad(artifact, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string), knownSinceProperty(certifyBadNode.Props))
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
 				if err = result.Err(); err != nil {
@@ -233,33 +234,58 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 }
 
 func setCertifyBadValues(sb *strings.Builder, certifyBadSpec *model.CertifyBadSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyBadSpec.KnownSince != nil {
+		matchProperties(sb, *firstMatch, "certifyBad", knownSince, "$"+knownSince)
+		*firstMatch = false
+		queryValues[knownSince] = certifyBadSpec.KnownSince.UTC()
+	}
+	if certifyBadSpec.KnownSinceAfter != nil {
+		compareProperty(sb, *firstMatch, "certifyBad", knownSince, ">=", "$knownSinceAfter")
+		*firstMatch = false
+		queryValues["knownSinceAfter"] = certifyBadSpec.KnownSinceAfter.UTC()
+	}
+	if certifyBadSpec.KnownSinceBefore != nil {
+		compareProperty(sb, *firstMatch, "certifyBad", knownSince, "<", "$knownSinceBefore")
+		*firstMatch = false
+		queryValues["knownSinceBefore"] = certifyBadSpec.KnownSinceBefore.UTC()
+	}
 	if certifyBadSpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "certifyBad", "justification", "$justification")
+		matchStringProperty(sb, *firstMatch, "certifyBad", "justification", "$justification", certifyBadSpec.StringMatch)
 		*firstMatch = false
-		queryValues["justification"] = certifyBadSpec.Justification
+		queryValues["justification"] = stringMatchValue(certifyBadSpec.StringMatch, certifyBadSpec.Justification)
 	}
 	if certifyBadSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "certifyBad", "origin", "$origin")
+		matchStringProperty(sb, *firstMatch, "certifyBad", "origin", "$origin", certifyBadSpec.StringMatch)
 		*firstMatch = false
-		queryValues["origin"] = certifyBadSpec.Origin
+		queryValues["origin"] = stringMatchValue(certifyBadSpec.StringMatch, certifyBadSpec.Origin)
 	}
 	if certifyBadSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "certifyBad", "collector", "$collector")
+		matchStringProperty(sb, *firstMatch, "certifyBad", "collector", "$collector", certifyBadSpec.StringMatch)
 		*firstMatch = false
-		queryValues["collector"] = certifyBadSpec.Collector
+		queryValues["collector"] = stringMatchValue(certifyBadSpec.StringMatch, certifyBadSpec.Collector)
 	}
 }
 
-func generateModelCertifyBad(subject model.PackageSourceOrArtifact, justification, origin, collector string) *model.CertifyBad {
+func generateModelCertifyBad(subject model.PackageSourceOrArtifact, justification, origin, collector string, knownSince time.Time) *model.CertifyBad {
 	certifyBad := model.CertifyBad{
 		Subject:       subject,
 		Justification: justification,
 		Origin:        origin,
 		Collector:     collector,
+		KnownSince:    knownSince,
 	}
 	return &certifyBad
 }
 
+// knownSinceProperty returns the knownSince of a certification node, the zero
+// time for the nodes created before certifications carried one.
+func knownSinceProperty(props map[string]any) time.Time {
+	if t, ok := props[knownSince].(time.Time); ok {
+		return t.UTC()
+	}
+	return time.Time{}
+}
+
 // ingest certifyBad
 
 func (c *neo4jClient) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (*model.CertifyBad, error) {
@@ -148,21 +148,21 @@ func queryCertifyPkg(sb *strings.Builder, selectedPkg *model.PkgSpec, dependentP
 func setCertifyPkgValues(sb *strings.Builder, certifyPkgSpec *model.CertifyPkgSpec, firstMatch *bool, queryValues map[string]any) {
 	if certifyPkgSpec.Justification != nil {
 
-		matchProperties(sb, *firstMatch, "certifyPkg", justification, "$"+justification)
+		matchStringProperty(sb, *firstMatch, "certifyPkg", justification, "$"+justification, certifyPkgSpec.StringMatch)
 		*firstMatch = false
-		queryValues[justification] = certifyPkgSpec.Justification
+		queryValues[justification] = stringMatchValue(certifyPkgSpec.StringMatch, certifyPkgSpec.Justification)
 	}
 	if certifyPkgSpec.Origin != nil {
 
-		matchProperties(sb, *firstMatch, "certifyPkg", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "certifyPkg", origin, "$"+origin, certifyPkgSpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = certifyPkgSpec.Origin
+		queryValues[origin] = stringMatchValue(certifyPkgSpec.StringMatch, certifyPkgSpec.Origin)
 	}
 	if certifyPkgSpec.Collector != nil {
 
-		matchProperties(sb, *firstMatch, "certifyPkg", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "certifyPkg", collector, "$"+collector, certifyPkgSpec.StringMatch)
 		*firstMatch = false
-		queryValues[collector] = certifyPkgSpec.Collector
+		queryValues[collector] = stringMatchValue(certifyPkgSpec.StringMatch, certifyPkgSpec.Collector)
 	}
 }
 
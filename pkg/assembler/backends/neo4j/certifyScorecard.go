@@ -53,6 +53,9 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 	setSrcMatchValues(&sb, certifyScorecardSpec.Source, false, &firstMatch, queryValues)
 	setCertifyScorecardValues(&sb, certifyScorecardSpec, &firstMatch, queryValues)
 	sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyScorecard")
+	if certifyScorecardSpec.OrderByScore != nil {
+		orderBy(&sb, "certifyScorecard", aggregateScore, *certifyScorecardSpec.OrderByScore)
+	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
@@ -176,14 +179,22 @@ func setCertifyScorecardValues(sb *strings.Builder, certifyScorecardSpec *model.
 		queryValues[scorecardCommit] = certifyScorecardSpec.ScorecardCommit
 	}
 	if certifyScorecardSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "certifyScorecard", "origin", "$origin")
+		matchStringProperty(sb, *firstMatch, "certifyScorecard", "origin", "$origin", certifyScorecardSpec.StringMatch)
 		*firstMatch = false
-		queryValues["origin"] = certifyScorecardSpec.Origin
+		queryValues["origin"] = stringMatchValue(certifyScorecardSpec.StringMatch, certifyScorecardSpec.Origin)
 	}
 	if certifyScorecardSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "certifyScorecard", "collector", "$collector")
+		matchStringProperty(sb, *firstMatch, "certifyScorecard", "collector", "$collector", certifyScorecardSpec.StringMatch)
+		*firstMatch = false
+		queryValues["collector"] = stringMatchValue(certifyScorecardSpec.StringMatch, certifyScorecardSpec.Collector)
+	}
+	if len(certifyScorecardSpec.Origins) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "certifyScorecard", origin, "origins", certifyScorecardSpec.StringMatch, certifyScorecardSpec.Origins, queryValues)
+		*firstMatch = false
+	}
+	if len(certifyScorecardSpec.Collectors) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "certifyScorecard", collector, "collectors", certifyScorecardSpec.StringMatch, certifyScorecardSpec.Collectors, queryValues)
 		*firstMatch = false
-		queryValues["collector"] = certifyScorecardSpec.Collector
 	}
 }
 
@@ -351,19 +351,19 @@ func setCertifyVEXStatementValues(sb *strings.Builder, certifyVEXStatementSpec *
 		queryValues[knownSince] = certifyVEXStatementSpec.KnownSince.UTC()
 	}
 	if certifyVEXStatementSpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "certifyVEXStatement", justification, "$"+justification)
+		matchStringProperty(sb, *firstMatch, "certifyVEXStatement", justification, "$"+justification, certifyVEXStatementSpec.StringMatch)
 		*firstMatch = false
-		queryValues["justification"] = certifyVEXStatementSpec.Justification
+		queryValues["justification"] = stringMatchValue(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Justification)
 	}
 	if certifyVEXStatementSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "certifyVEXStatement", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "certifyVEXStatement", origin, "$"+origin, certifyVEXStatementSpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = certifyVEXStatementSpec.Origin
+		queryValues[origin] = stringMatchValue(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Origin)
 	}
 	if certifyVEXStatementSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "certifyVEXStatement", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "certifyVEXStatement", collector, "$"+collector, certifyVEXStatementSpec.StringMatch)
 		*firstMatch = false
-		queryValues[collector] = certifyVEXStatementSpec.Collector
+		queryValues[collector] = stringMatchValue(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Collector)
 	}
 }
 
@@ -389,5 +389,9 @@ func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject model.Pack
 	if err != nil {
 		return nil, err
 	}
+	err = helper.ValidateVexInput(vexStatement)
+	if err != nil {
+		return nil, fmt.Errorf("IngestVEXStatement :: %w", err)
+	}
 	panic(fmt.Errorf("not implemented: IngestVEXStatement - IngestVEXStatement"))
 }
@@ -17,6 +17,7 @@ package neo4jBackend
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -45,6 +46,13 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 	if err != nil {
 		return nil, err
 	}
+	if certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.NoVuln != nil {
+		// noVuln is not stored, a false noVuln matches all the vulnerabilities
+		if *certifyVulnSpec.Vulnerability.NoVuln {
+			return []*model.CertifyVuln{}, nil
+		}
+		queryAll = true
+	}
 
 	aggregateCertifyVuln := []*model.CertifyVuln{}
 
@@ -103,7 +111,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 						return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 					}
 
-					certifyVuln := generateModelCertifyVuln(pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+					certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -175,7 +183,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 						return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 					}
 
-					certifyVuln := generateModelCertifyVuln(pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+					certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -248,7 +256,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 						return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 					}
 
-					certifyVuln := generateModelCertifyVuln(pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+					certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -265,15 +273,36 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 		}
 		aggregateCertifyVuln = append(aggregateCertifyVuln, result.([]*model.CertifyVuln)...)
 	}
+	if certifyVulnSpec.OrderByTimeScanned != nil {
+		sortByTime(aggregateCertifyVuln, *certifyVulnSpec.OrderByTimeScanned, func(v *model.CertifyVuln) time.Time { return v.Metadata.TimeScanned }, func(v *model.CertifyVuln) string { return v.ID })
+	}
 	return aggregateCertifyVuln, nil
 }
 
+func (c *neo4jClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, first *int, after *string) (*model.CertifyVulnConnection, error) {
+	panic(fmt.Errorf("not implemented: CertifyVulnList - CertifyVulnList"))
+}
+
+func (c *neo4jClient) CertifyVulnCount(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) (int, error) {
+	panic(fmt.Errorf("not implemented: CertifyVulnCount - CertifyVulnCount"))
+}
+
 func setCertifyVulnValues(sb *strings.Builder, certifyVulnSpec *model.CertifyVulnSpec, firstMatch *bool, queryValues map[string]any) {
 	if certifyVulnSpec.TimeScanned != nil {
 		matchProperties(sb, *firstMatch, "certifyVuln", timeScanned, "$"+timeScanned)
 		*firstMatch = false
 		queryValues[timeScanned] = certifyVulnSpec.TimeScanned.UTC()
 	}
+	if certifyVulnSpec.TimeScannedAfter != nil {
+		compareProperty(sb, *firstMatch, "certifyVuln", timeScanned, ">=", "$timeScannedAfter")
+		*firstMatch = false
+		queryValues["timeScannedAfter"] = certifyVulnSpec.TimeScannedAfter.UTC()
+	}
+	if certifyVulnSpec.TimeScannedBefore != nil {
+		compareProperty(sb, *firstMatch, "certifyVuln", timeScanned, "<=", "$timeScannedBefore")
+		*firstMatch = false
+		queryValues["timeScannedBefore"] = certifyVulnSpec.TimeScannedBefore.UTC()
+	}
 	if certifyVulnSpec.DbURI != nil {
 		matchProperties(sb, *firstMatch, "certifyVuln", dbUri, "$"+dbUri)
 		*firstMatch = false
@@ -295,18 +324,26 @@ func setCertifyVulnValues(sb *strings.Builder, certifyVulnSpec *model.CertifyVul
 		queryValues[scannerVersion] = certifyVulnSpec.ScannerVersion
 	}
 	if certifyVulnSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "certifyVuln", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "certifyVuln", origin, "$"+origin, certifyVulnSpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = certifyVulnSpec.Origin
+		queryValues[origin] = stringMatchValue(certifyVulnSpec.StringMatch, certifyVulnSpec.Origin)
 	}
 	if certifyVulnSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "certifyVuln", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "certifyVuln", collector, "$"+collector, certifyVulnSpec.StringMatch)
+		*firstMatch = false
+		queryValues[collector] = stringMatchValue(certifyVulnSpec.StringMatch, certifyVulnSpec.Collector)
+	}
+	if len(certifyVulnSpec.Origins) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "certifyVuln", origin, "origins", certifyVulnSpec.StringMatch, certifyVulnSpec.Origins, queryValues)
+		*firstMatch = false
+	}
+	if len(certifyVulnSpec.Collectors) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "certifyVuln", collector, "collectors", certifyVulnSpec.StringMatch, certifyVulnSpec.Collectors, queryValues)
 		*firstMatch = false
-		queryValues[collector] = certifyVulnSpec.Collector
 	}
 }
 
-func generateModelCertifyVuln(pkg *model.Package, vuln model.OsvCveOrGhsa, timeScanned time.Time, dbUri, dbVersion, scannerUri,
+func generateModelCertifyVuln(id string, pkg *model.Package, vuln model.OsvCveOrGhsa, timeScanned time.Time, dbUri, dbVersion, scannerUri,
 	scannerVersion, origin, collector string) *model.CertifyVuln {
 
 	metadata := &model.VulnerabilityMetaData{
@@ -320,6 +357,7 @@ func generateModelCertifyVuln(pkg *model.Package, vuln model.OsvCveOrGhsa, timeS
 	}
 
 	certifyVuln := model.CertifyVuln{
+		ID:            id,
 		Package:       pkg,
 		Vulnerability: vuln,
 		Metadata:      metadata,
@@ -335,6 +373,9 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 	if err != nil {
 		return nil, err
 	}
+	if vulnerability.NoVuln != nil && *vulnerability.NoVuln {
+		panic(fmt.Errorf("not implemented: IngestVulnerability - noVuln"))
+	}
 
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close()
@@ -409,7 +450,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 					return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 				}
 
-				certifyVuln := generateModelCertifyVuln(pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+				certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -476,7 +517,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 					return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 				}
 
-				certifyVuln := generateModelCertifyVuln(pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+				certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -542,7 +583,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 					return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
 				}
 
-				certifyVuln := generateModelCertifyVuln(pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+				certifyVuln := generateModelCertifyVuln(formatNodeID(certifyVulnNode.Id), pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
 
@@ -0,0 +1,73 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/conformance"
+	neo4jBackend "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// TestConformance runs the conformance suite against the neo4j server at
+// GUAC_NEO4J_ADDR, which it empties before every test. It is skipped if the
+// variable is not set. For instance, with a test container:
+//
+//	docker run --rm -p 7687:7687 -e NEO4J_AUTH=neo4j/s3cr3t neo4j:4.4
+//	GUAC_NEO4J_ADDR=neo4j://localhost:7687 GUAC_NEO4J_PASS=s3cr3t go test ./pkg/assembler/backends/neo4j
+//
+// Only the tests of the verbs the backend implements run.
+func TestConformance(t *testing.T) {
+	addr := os.Getenv("GUAC_NEO4J_ADDR")
+	if addr == "" {
+		t.Skip("GUAC_NEO4J_ADDR is not set")
+	}
+	user := os.Getenv("GUAC_NEO4J_USER")
+	if user == "" {
+		user = "neo4j"
+	}
+	config := &neo4jBackend.Neo4jConfig{
+		User:   user,
+		Pass:   os.Getenv("GUAC_NEO4J_PASS"),
+		DBAddr: addr,
+	}
+
+	driver, err := neo4j.NewDriver(config.DBAddr, neo4j.BasicAuth(config.User, config.Pass, config.Realm))
+	if err != nil {
+		t.Fatalf("Could not connect to neo4j: %v", err)
+	}
+	defer driver.Close()
+
+	conformance.RunSuite(t, func() backends.Backend {
+		session := driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+		defer session.Close()
+		result, err := session.Run("MATCH (n) DETACH DELETE n", nil)
+		if err == nil {
+			_, err = result.Consume()
+		}
+		if err != nil {
+			t.Fatalf("Could not empty neo4j: %v", err)
+		}
+		b, err := neo4jBackend.GetBackend(config)
+		if err != nil {
+			t.Fatalf("Could not instantiate neo4j backend: %v", err)
+		}
+		return b
+	}, "HasSourceAt")
+}
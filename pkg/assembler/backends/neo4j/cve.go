@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -144,6 +145,12 @@ func (e *cveYearToCveID) IdentifiablePropertyNames() []string {
 }
 
 func (c *neo4jClient) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model.Cve, error) {
+	if cveSpec.CveID != nil {
+		if _, err := helper.CanonicalCveID(*cveSpec.CveID); err != nil {
+			return nil, err
+		}
+	}
+
 	// fields: [year cveId cveId.id]
 	fields := getPreloads(ctx)
 	cveIDImplRequired := false
@@ -261,19 +268,24 @@ func setCveMatchValues(sb *strings.Builder, cve *model.CVESpec, firstMatch *bool
 
 		if cve.CveID != nil {
 			matchProperties(sb, *firstMatch, "cveID", "id", "$cveID")
-			queryValues["cveID"] = strings.ToLower(*cve.CveID)
+			queryValues["cveID"] = helper.NormalizeVulnerabilityID(*cve.CveID)
 			*firstMatch = false
 		}
 	}
 }
 
 func (c *neo4jClient) IngestCve(ctx context.Context, cve *model.CVEInputSpec) (*model.Cve, error) {
+	cveID, err := helper.CanonicalCveInput(cve.Year, cve.CveID)
+	if err != nil {
+		return nil, err
+	}
+
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close()
 
 	values := map[string]any{}
 	values["year"] = cve.Year
-	values["id"] = strings.ToLower(cve.CveID)
+	values["id"] = cveID
 
 	result, err := session.WriteTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -96,6 +97,12 @@ func (e *ghsaToID) IdentifiablePropertyNames() []string {
 }
 
 func (c *neo4jClient) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*model.Ghsa, error) {
+	if ghsaSpec.GhsaID != nil {
+		if _, err := helper.CanonicalGhsaID(*ghsaSpec.GhsaID); err != nil {
+			return nil, err
+		}
+	}
+
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
@@ -144,18 +151,23 @@ func setGhsaMatchValues(sb *strings.Builder, ghsa *model.GHSASpec, firstMatch *b
 	if ghsa != nil {
 		if ghsa.GhsaID != nil {
 			matchProperties(sb, *firstMatch, "ghsaID", "id", "$ghsaID")
-			queryValues["ghsaID"] = strings.ToLower(*ghsa.GhsaID)
+			queryValues["ghsaID"] = helper.NormalizeVulnerabilityID(*ghsa.GhsaID)
 			*firstMatch = false
 		}
 	}
 }
 
 func (c *neo4jClient) IngestGhsa(ctx context.Context, ghsa *model.GHSAInputSpec) (*model.Ghsa, error) {
+	ghsaID, err := helper.CanonicalGhsaID(ghsa.GhsaID)
+	if err != nil {
+		return nil, err
+	}
+
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close()
 
 	values := map[string]any{}
-	values["id"] = strings.ToLower(ghsa.GhsaID)
+	values["id"] = ghsaID
 
 	result, err := session.WriteTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
@@ -28,7 +28,9 @@ import (
 )
 
 const (
-	uri string = "uri"
+	uri          string = "uri"
+	documentHash string = "documentHash"
+	fileMode     string = "fileMode"
 )
 
 func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
@@ -89,7 +91,7 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 						return nil, gqlerror.Errorf("hasSBOM Node not found in neo4j")
 					}
 
-					hasSBOM := generateModelHasSBOM(pkg, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM := generateModelHasSBOM(pkg, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string), hasSBOMNode.Props[documentHash], hasSBOMNode.Props[fileMode])
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -147,7 +149,7 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 						return nil, gqlerror.Errorf("hasSBOM Node not found in neo4j")
 					}
 
-					hasSBOM := generateModelHasSBOM(src, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM := generateModelHasSBOM(src, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string), hasSBOMNode.Props[documentHash], hasSBOMNode.Props[fileMode])
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -173,24 +175,42 @@ func setHasSBOMValues(sb *strings.Builder, hasSBOMSpec *model.HasSBOMSpec, first
 		queryValues["uri"] = hasSBOMSpec.URI
 	}
 	if hasSBOMSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "hasSBOM", "origin", "$origin")
+		matchStringProperty(sb, *firstMatch, "hasSBOM", "origin", "$origin", hasSBOMSpec.StringMatch)
 		*firstMatch = false
-		queryValues["origin"] = hasSBOMSpec.Origin
+		queryValues["origin"] = stringMatchValue(hasSBOMSpec.StringMatch, hasSBOMSpec.Origin)
 	}
 	if hasSBOMSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "hasSBOM", "collector", "$collector")
+		matchStringProperty(sb, *firstMatch, "hasSBOM", "collector", "$collector", hasSBOMSpec.StringMatch)
 		*firstMatch = false
-		queryValues["collector"] = hasSBOMSpec.Collector
+		queryValues["collector"] = stringMatchValue(hasSBOMSpec.StringMatch, hasSBOMSpec.Collector)
+	}
+	if hasSBOMSpec.DocumentHash != nil {
+		matchProperties(sb, *firstMatch, "hasSBOM", documentHash, "$documentHash")
+		*firstMatch = false
+		queryValues[documentHash] = hasSBOMSpec.DocumentHash
+	}
+	if hasSBOMSpec.FileMode != nil {
+		matchProperties(sb, *firstMatch, "hasSBOM", fileMode, "$fileMode")
+		*firstMatch = false
+		queryValues[fileMode] = hasSBOMSpec.FileMode
 	}
 }
 
-func generateModelHasSBOM(subject model.PackageOrSource, uri, origin, collector string) *model.HasSbom {
+func generateModelHasSBOM(subject model.PackageOrSource, uri, origin, collector string, documentHash, fileMode interface{}) *model.HasSbom {
 	hasSBOM := model.HasSbom{
 		Subject:   subject,
 		URI:       uri,
 		Origin:    origin,
 		Collector: collector,
 	}
+	// documentHash is only set when the original document was stored
+	if hash, ok := documentHash.(string); ok {
+		hasSBOM.DocumentHash = &hash
+	}
+	// fileMode is only set by parsers that can leave out file level entries
+	if mode, ok := fileMode.(string); ok {
+		hasSBOM.FileMode = &mode
+	}
 	return &hasSBOM
 }
 
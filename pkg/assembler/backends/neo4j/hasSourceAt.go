@@ -21,6 +21,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -32,16 +34,73 @@ const (
 )
 
 func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	if err := helper.ValidateStringMatch(hasSourceAtSpec.StringMatch); err != nil {
+		return nil, err
+	}
+
+	query, queryValues, err := hasSourceAtQuery(hasSourceAtSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			collectedHasSourceAt := []*model.HasSourceAt{}
+			pkgIDs := []int64{}
+
+			for result.Next() {
+				hasSourceAt, err := generateModelHasSourceAt(result.Record())
+				if err != nil {
+					return nil, err
+				}
+				collectedHasSourceAt = append(collectedHasSourceAt, hasSourceAt)
+				pkgIDs = append(pkgIDs, result.Record().Values[12].(int64))
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			if hasSourceAtSpec.Latest != nil && *hasSourceAtSpec.Latest {
+				return latestHasSourceAts(collectedHasSourceAt, pkgIDs), nil
+			}
+			return collectedHasSourceAt, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	collectedHasSourceAt := result.([]*model.HasSourceAt)
+	if hasSourceAtSpec.OrderByKnownSince != nil {
+		sortByTime(collectedHasSourceAt, *hasSourceAtSpec.OrderByKnownSince, func(h *model.HasSourceAt) time.Time { return h.KnownSince }, func(h *model.HasSourceAt) string { return h.ID })
+	}
+	return collectedHasSourceAt, nil
+}
+
+// hasSourceAtQuery returns the Cypher query of HasSourceAt and its
+// parameters. HasSourceAt attached to package names are matched by a second
+// query, joined with UNION, unless the filter selects package versions.
+func hasSourceAtQuery(hasSourceAtSpec *model.HasSourceAtSpec) (string, map[string]any, error) {
 	var sb strings.Builder
 	var firstMatch bool = true
 
-	returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
-		"version.qualifier_list, hasSourceAt, objSrcType.type, objSrcNamespace.namespace, objSrcName.name, objSrcName.tag, objSrcName.commit"
-
 	queryValues := map[string]any{}
+	if hasSourceAtSpec.ID != nil {
+		id, err := parseNodeID(*hasSourceAtSpec.ID)
+		if err != nil {
+			return "", nil, err
+		}
+		queryValues["id"] = id
+	}
+
 	// query with pkgVersion
 	query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
@@ -52,11 +111,9 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 	setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 	setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 	setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-	sb.WriteString(returnValue)
-
-	if hasSourceAtSpec.Package == nil || hasSourceAtSpec.Package != nil && hasSourceAtSpec.Package.Version == nil && hasSourceAtSpec.Package.Subpath == nil &&
-		len(hasSourceAtSpec.Package.Qualifiers) == 0 && !*hasSourceAtSpec.Package.MatchOnlyEmptyQualifiers {
+	sb.WriteString(hasSourceAtReturnValue)
 
+	if matchesPkgName(hasSourceAtSpec.Package) {
 		sb.WriteString("\nUNION")
 		// query without pkgVersion
 		query = "\nMATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
@@ -70,94 +127,271 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 		setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 		setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 		setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		sb.WriteString(hasSourceAtReturnValue)
 	}
 
-	result, err := session.ReadTransaction(
-		func(tx neo4j.Transaction) (interface{}, error) {
+	return sb.String(), queryValues, nil
+}
 
-			result, err := tx.Run(sb.String(), queryValues)
-			if err != nil {
-				return nil, err
-			}
+// matchesPkgName reports whether HasSourceAt attached to package names, for
+// all their versions, can match the package filter: it must not select
+// anything at the version level.
+func matchesPkgName(pkg *model.PkgSpec) bool {
+	if pkg == nil {
+		return true
+	}
+	matchOnlyEmptyQualifiers := pkg.MatchOnlyEmptyQualifiers != nil && *pkg.MatchOnlyEmptyQualifiers
+	return pkg.Version == nil && pkg.Subpath == nil && len(pkg.Qualifiers) == 0 && !matchOnlyEmptyQualifiers
+}
 
-			collectedHasSourceAt := []*model.HasSourceAt{}
+// latestHasSourceAts keeps the latest HasSourceAt of each package node, name
+// or version, and the highest ID on a tie. pkgIDs are the IDs of the package
+// nodes of the HasSourceAt.
+func latestHasSourceAts(hasSourceAts []*model.HasSourceAt, pkgIDs []int64) []*model.HasSourceAt {
+	out := []*model.HasSourceAt{}
+	latest := map[int64]int{}
+	for i, hasSourceAt := range hasSourceAts {
+		j, ok := latest[pkgIDs[i]]
+		if !ok {
+			latest[pkgIDs[i]] = len(out)
+			out = append(out, hasSourceAt)
+			continue
+		}
+		if laterHasSourceAt(hasSourceAt, out[j]) {
+			out[j] = hasSourceAt
+		}
+	}
+	return out
+}
 
-			for result.Next() {
-				pkgQualifiers := result.Record().Values[5]
-				subPath := result.Record().Values[4]
-				version := result.Record().Values[3]
-				nameString := result.Record().Values[2].(string)
-				namespaceString := result.Record().Values[1].(string)
-				typeString := result.Record().Values[0].(string)
-
-				pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
-
-				tag := result.Record().Values[10]
-				commit := result.Record().Values[11]
-				nameStr := result.Record().Values[9].(string)
-				namespaceStr := result.Record().Values[8].(string)
-				srcType := result.Record().Values[7].(string)
-
-				src := generateModelSource(srcType, namespaceStr, nameStr, commit, tag)
-
-				hasSourceAtNode := dbtype.Node{}
-				if result.Record().Values[6] != nil {
-					hasSourceAtNode = result.Record().Values[6].(dbtype.Node)
-				} else {
-					return nil, gqlerror.Errorf("hasSourceAt Node not found in neo4j")
-				}
+// laterHasSourceAt reports whether a has a later knownSince than b, or the
+// same knownSince and a higher ID.
+func laterHasSourceAt(a, b *model.HasSourceAt) bool {
+	if !a.KnownSince.Equal(b.KnownSince) {
+		return a.KnownSince.After(b.KnownSince)
+	}
+	aID, _ := parseNodeID(a.ID)
+	bID, _ := parseNodeID(b.ID)
+	return aID > bID
+}
 
-				hasSourceAt := &model.HasSourceAt{
-					Package:       pkg,
-					Source:        src,
-					KnownSince:    hasSourceAtNode.Props[knownSince].(time.Time),
-					Justification: hasSourceAtNode.Props[justification].(string),
-					Origin:        hasSourceAtNode.Props[origin].(string),
-					Collector:     hasSourceAtNode.Props[collector].(string),
-				}
-				collectedHasSourceAt = append(collectedHasSourceAt, hasSourceAt)
-			}
-			if err = result.Err(); err != nil {
-				return nil, err
-			}
+// hasSourceAtReturnValue are the columns read by generateModelHasSourceAt,
+// followed by the ID of the package node the HasSourceAt is attached to.
+// version is null for HasSourceAt attached to a package name.
+const hasSourceAtReturnValue = " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
+	"version.qualifier_list, hasSourceAt, objSrcType.type, objSrcNamespace.namespace, objSrcName.name, objSrcName.tag, objSrcName.commit, " +
+	"id(coalesce(version, name))"
 
-			return collectedHasSourceAt, nil
-		})
-	if err != nil {
-		return nil, err
+func generateModelHasSourceAt(record *neo4j.Record) (*model.HasSourceAt, error) {
+	pkgQualifiers := record.Values[5]
+	subPath := record.Values[4]
+	version := record.Values[3]
+	nameString := record.Values[2].(string)
+	namespaceString := record.Values[1].(string)
+	typeString := record.Values[0].(string)
+
+	pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
+
+	tag := record.Values[10]
+	commit := record.Values[11]
+	nameStr := record.Values[9].(string)
+	namespaceStr := record.Values[8].(string)
+	srcType := record.Values[7].(string)
+
+	src := generateModelSource(srcType, namespaceStr, nameStr, commit, tag)
+
+	hasSourceAtNode := dbtype.Node{}
+	if record.Values[6] != nil {
+		hasSourceAtNode = record.Values[6].(dbtype.Node)
+	} else {
+		return nil, gqlerror.Errorf("hasSourceAt Node not found in neo4j")
 	}
 
-	return result.([]*model.HasSourceAt), nil
+	return &model.HasSourceAt{
+		ID:            formatNodeID(hasSourceAtNode.Id),
+		Package:       pkg,
+		Source:        src,
+		KnownSince:    hasSourceAtNode.Props[knownSince].(time.Time).UTC(),
+		Justification: hasSourceAtNode.Props[justification].(string),
+		Origin:        hasSourceAtNode.Props[origin].(string),
+		Collector:     hasSourceAtNode.Props[collector].(string),
+	}, nil
+}
+
+func (c *neo4jClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, first *int, after *string) (*model.HasSourceAtConnection, error) {
+	return nil, fmt.Errorf("HasSourceAtList :: pagination is not supported by the neo4j backend")
+}
+
+func (c *neo4jClient) HasSourceAtCount(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) (int, error) {
+	return 0, fmt.Errorf("HasSourceAtCount :: counting is not supported by the neo4j backend")
 }
 
+// setHasSourceAtValues filters on the properties of the HasSourceAt node. The
+// ID filter, when set, must be parsed into queryValues["id"] first.
 func setHasSourceAtValues(sb *strings.Builder, hasSourceAtSpec *model.HasSourceAtSpec, firstMatch *bool, queryValues map[string]any) {
+	if hasSourceAtSpec.ID != nil {
+		matchNodeID(sb, *firstMatch, "hasSourceAt", "$id")
+		*firstMatch = false
+	}
 	if hasSourceAtSpec.KnownSince != nil {
 
 		matchProperties(sb, *firstMatch, "hasSourceAt", "knownSince", "$knownSince")
 		*firstMatch = false
 		queryValues["knownSince"] = hasSourceAtSpec.KnownSince.UTC()
 	}
+	if hasSourceAtSpec.KnownSinceAfter != nil {
+		compareProperty(sb, *firstMatch, "hasSourceAt", "knownSince", ">=", "$knownSinceAfter")
+		*firstMatch = false
+		queryValues["knownSinceAfter"] = hasSourceAtSpec.KnownSinceAfter.UTC()
+	}
+	if hasSourceAtSpec.KnownSinceBefore != nil {
+		compareProperty(sb, *firstMatch, "hasSourceAt", "knownSince", "<", "$knownSinceBefore")
+		*firstMatch = false
+		queryValues["knownSinceBefore"] = hasSourceAtSpec.KnownSinceBefore.UTC()
+	}
 	if hasSourceAtSpec.Justification != nil {
 
-		matchProperties(sb, *firstMatch, "hasSourceAt", "justification", "$justification")
+		matchStringProperty(sb, *firstMatch, "hasSourceAt", "justification", "$justification", hasSourceAtSpec.StringMatch)
 		*firstMatch = false
-		queryValues["justification"] = hasSourceAtSpec.Justification
+		queryValues["justification"] = stringMatchValue(hasSourceAtSpec.StringMatch, hasSourceAtSpec.Justification)
 	}
 	if hasSourceAtSpec.Origin != nil {
 
-		matchProperties(sb, *firstMatch, "hasSourceAt", "origin", "$origin")
+		matchStringProperty(sb, *firstMatch, "hasSourceAt", "origin", "$origin", hasSourceAtSpec.StringMatch)
 		*firstMatch = false
-		queryValues["origin"] = hasSourceAtSpec.Origin
+		queryValues["origin"] = stringMatchValue(hasSourceAtSpec.StringMatch, hasSourceAtSpec.Origin)
 	}
 	if hasSourceAtSpec.Collector != nil {
 
-		matchProperties(sb, *firstMatch, "hasSourceAt", "collector", "$collector")
+		matchStringProperty(sb, *firstMatch, "hasSourceAt", "collector", "$collector", hasSourceAtSpec.StringMatch)
+		*firstMatch = false
+		queryValues["collector"] = stringMatchValue(hasSourceAtSpec.StringMatch, hasSourceAtSpec.Collector)
+	}
+	if len(hasSourceAtSpec.Origins) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "hasSourceAt", origin, "origins", hasSourceAtSpec.StringMatch, hasSourceAtSpec.Origins, queryValues)
+		*firstMatch = false
+	}
+	if len(hasSourceAtSpec.Collectors) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "hasSourceAt", collector, "collectors", hasSourceAtSpec.StringMatch, hasSourceAtSpec.Collectors, queryValues)
 		*firstMatch = false
-		queryValues["collector"] = hasSourceAtSpec.Collector
 	}
 }
 
 func (c *neo4jClient) IngestHasSourceAt(ctx context.Context, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
-	panic(fmt.Errorf("not implemented: IngestHasSourceAt - IngestHasSourceAt"))
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			return ingestHasSourceAt(tx, pkg, pkgMatchType, source, hasSourceAt)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.HasSourceAt), nil
+}
+
+// IngestHasSourceAts ingests the HasSourceAt at every index of the lists in a
+// single transaction, so that either all or none of them are ingested.
+func (c *neo4jClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) ([]*model.HasSourceAt, error) {
+	if err := helper.ValidateInputLengths("IngestHasSourceAts",
+		helper.InputList{Name: "packages", Len: len(pkgs)},
+		helper.InputList{Name: "sources", Len: len(sources)},
+		helper.InputList{Name: "hasSourceAts", Len: len(hasSourceAts)}); err != nil {
+		return nil, err
+	}
+	for i := range hasSourceAts {
+		if pkgs[i] == nil || sources[i] == nil || hasSourceAts[i] == nil {
+			return nil, backends.InvalidInputf("IngestHasSourceAts :: index %d: missing package, source or hasSourceAt", i)
+		}
+	}
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			out := make([]*model.HasSourceAt, 0, len(hasSourceAts))
+			for i := range hasSourceAts {
+				ingested, err := ingestHasSourceAt(tx, *pkgs[i], pkgMatchType, *sources[i], *hasSourceAts[i])
+				if err != nil {
+					return nil, fmt.Errorf("IngestHasSourceAts :: index %d: %w", i, err)
+				}
+				out = append(out, ingested)
+			}
+			return out, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.HasSourceAt), nil
+}
+
+// ingestHasSourceAt merges a HasSourceAt between the source and the package
+// version or, to match all the versions, the package name. Ingesting the same
+// HasSourceAt again returns the existing node.
+func ingestHasSourceAt(tx neo4j.Transaction, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	queryValues[knownSince] = hasSourceAt.KnownSince.UTC()
+	queryValues[justification] = hasSourceAt.Justification
+	queryValues[origin] = hasSourceAt.Origin
+	queryValues[collector] = hasSourceAt.Collector
+
+	// TODO: use generics here between PkgInputSpec and PkgSpec?
+	var selectedPkgSpec *model.PkgSpec
+	var subject string
+	if pkgMatchType.Pkg == model.PkgMatchTypeAllVersions {
+		// Note: only match up to the pkgName, the HasSourceAt holding for
+		// all of its versions.
+		matchEmpty := false
+		selectedPkgSpec = &model.PkgSpec{
+			Type:                     &pkg.Type,
+			Namespace:                pkg.Namespace,
+			Name:                     &pkg.Name,
+			MatchOnlyEmptyQualifiers: &matchEmpty,
+		}
+		sb.WriteString("MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(name:PkgName)")
+		subject = "name"
+	} else {
+		selectedPkgSpec = helper.ConvertPkgInputSpecToPkgSpec(&pkg)
+		sb.WriteString("MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)")
+		subject = "version"
+	}
+	setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+
+	sb.WriteString("\nMATCH (objSrcRoot:Src)-[:SrcHasType]->(objSrcType:SrcType)-[:SrcHasNamespace]->(objSrcNamespace:SrcNamespace)" +
+		"-[:SrcHasName]->(objSrcName:SrcName)")
+	firstMatch = true
+	setSrcMatchValues(&sb, helper.ConvertSrcInputSpecToSrcSpec(&source), true, &firstMatch, queryValues)
+
+	sb.WriteString("\nMERGE (" + subject + ")<-[:subject]-(hasSourceAt:HasSourceAt{knownSince:$knownSince,justification:$justification,origin:$origin,collector:$collector})" +
+		"-[:has_source]->(objSrcName)")
+	if subject == "name" {
+		sb.WriteString("\nWITH *, null AS version")
+	}
+	sb.WriteString(hasSourceAtReturnValue)
+
+	result, err := tx.Run(sb.String(), queryValues)
+	if err != nil {
+		return nil, err
+	}
+	records, err := result.Collect()
+	if err != nil {
+		return nil, err
+	}
+	switch len(records) {
+	case 0:
+		return nil, backends.NotFoundf("IngestHasSourceAt :: package or source not found")
+	case 1:
+		return generateModelHasSourceAt(records[0])
+	default:
+		return nil, backends.InvalidInputf("IngestHasSourceAt :: more than one package or source matches input")
+	}
 }
@@ -0,0 +1,168 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// These tests only check the Cypher generated for HasSourceAt, the queries
+// themselves run in the conformance suite, see TestConformance.
+
+const (
+	matchVersionHasSourceAt = "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
+		"-[:subject]-(hasSourceAt:HasSourceAt)-[:has_source]-(objSrcName:SrcName)<-[:SrcHasName]-(objSrcNamespace:SrcNamespace)<-[:SrcHasNamespace]" +
+		"-(objSrcType:SrcType)<-[:SrcHasType]-(objSrcRoot:Src)"
+	matchNameHasSourceAt = "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)" +
+		"-[:subject]-(hasSourceAt:HasSourceAt)-[:has_source]-(objSrcName:SrcName)<-[:SrcHasName]-(objSrcNamespace:SrcNamespace)<-[:SrcHasNamespace]" +
+		"-(objSrcType:SrcType)<-[:SrcHasType]-(objSrcRoot:Src)" +
+		"\nWITH *, null AS version"
+)
+
+func TestHasSourceAtQuery(t *testing.T) {
+	after := time.Date(2023, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+	before := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		Name      string
+		Spec      *model.HasSourceAtSpec
+		ExpQuery  string
+		ExpValues map[string]any
+		ExpErr    bool
+	}{
+		{
+			Name:      "Package names and versions",
+			Spec:      &model.HasSourceAtSpec{},
+			ExpQuery:  matchVersionHasSourceAt + hasSourceAtReturnValue + "\nUNION\n" + matchNameHasSourceAt + hasSourceAtReturnValue,
+			ExpValues: map[string]any{},
+		},
+		{
+			Name: "Package name",
+			Spec: &model.HasSourceAtSpec{Package: &model.PkgSpec{Name: ptrfrom.String("numpy")}},
+			ExpQuery: matchVersionHasSourceAt + " WHERE name.name = $pkgName" + hasSourceAtReturnValue +
+				"\nUNION\n" + matchNameHasSourceAt + " WHERE name.name = $pkgName" + hasSourceAtReturnValue,
+			ExpValues: map[string]any{"pkgName": ptrfrom.String("numpy")},
+		},
+		{
+			Name:      "Package version",
+			Spec:      &model.HasSourceAtSpec{Package: &model.PkgSpec{Name: ptrfrom.String("numpy"), Version: ptrfrom.String("1.24.2")}},
+			ExpQuery:  matchVersionHasSourceAt + " WHERE name.name = $pkgName AND version.version = $pkgVersion" + hasSourceAtReturnValue,
+			ExpValues: map[string]any{"pkgName": ptrfrom.String("numpy"), "pkgVersion": ptrfrom.String("1.24.2")},
+		},
+		{
+			Name: "Known since range",
+			Spec: &model.HasSourceAtSpec{KnownSinceAfter: &after, KnownSinceBefore: &before},
+			ExpQuery: matchVersionHasSourceAt + " WHERE hasSourceAt.knownSince >= $knownSinceAfter AND hasSourceAt.knownSince < $knownSinceBefore" + hasSourceAtReturnValue +
+				"\nUNION\n" + matchNameHasSourceAt + " WHERE hasSourceAt.knownSince >= $knownSinceAfter AND hasSourceAt.knownSince < $knownSinceBefore" + hasSourceAtReturnValue,
+			ExpValues: map[string]any{"knownSinceAfter": after.UTC(), "knownSinceBefore": before},
+		},
+		{
+			Name:   "Invalid ID",
+			Spec:   &model.HasSourceAtSpec{ID: ptrfrom.String("hasSourceAt")},
+			ExpErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			query, values, err := hasSourceAtQuery(test.Spec)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpQuery, query); diff != "" {
+				t.Errorf("Unexpected query (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpValues, values); diff != "" {
+				t.Errorf("Unexpected query values (-want +got):\n%s", diff)
+			}
+			// both sides of the UNION return the same columns, with the
+			// package node the latest filter groups by
+			if !strings.HasSuffix(query, "id(coalesce(version, name))") {
+				t.Errorf("query doesn't return the ID of the package node: %s", query)
+			}
+		})
+	}
+}
+
+func TestLatestHasSourceAts(t *testing.T) {
+	noon := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	hasSourceAt := func(id string, knownSince time.Time) *model.HasSourceAt {
+		return &model.HasSourceAt{ID: id, KnownSince: knownSince}
+	}
+	hasSourceAts := []*model.HasSourceAt{
+		hasSourceAt("1", noon),
+		hasSourceAt("2", noon.Add(time.Hour)),
+		hasSourceAt("3", noon),
+		// the name of the package is a different package node
+		hasSourceAt("4", noon.Add(-time.Hour)),
+		// ties are broken by the highest ID
+		hasSourceAt("5", noon),
+		hasSourceAt("6", noon),
+	}
+	pkgIDs := []int64{10, 10, 10, 20, 30, 30}
+
+	got := []string{}
+	for _, h := range latestHasSourceAts(hasSourceAts, pkgIDs) {
+		got = append(got, h.ID)
+	}
+	if diff := cmp.Diff([]string{"2", "4", "6"}, got); diff != "" {
+		t.Errorf("Unexpected latest HasSourceAt (-want +got):\n%s", diff)
+	}
+}
+
+func TestSortByTime(t *testing.T) {
+	noon := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	hasSourceAt := func(id string, knownSince time.Time) *model.HasSourceAt {
+		return &model.HasSourceAt{ID: id, KnownSince: knownSince}
+	}
+	tests := []struct {
+		Name string
+		Dir  model.SortDirection
+		Exp  []string
+	}{
+		{Name: "Ascending", Dir: model.SortDirectionAsc, Exp: []string{"4", "9", "10", "30", "2"}},
+		// ties are still broken by the lowest ID, as in orderBy
+		{Name: "Descending", Dir: model.SortDirectionDesc, Exp: []string{"2", "9", "10", "30", "4"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			hasSourceAts := []*model.HasSourceAt{
+				hasSourceAt("30", noon),
+				hasSourceAt("2", noon.Add(time.Hour)),
+				hasSourceAt("10", noon),
+				hasSourceAt("4", noon.Add(-time.Hour)),
+				hasSourceAt("9", noon),
+			}
+			sortByTime(hasSourceAts, test.Dir, func(h *model.HasSourceAt) time.Time { return h.KnownSince }, func(h *model.HasSourceAt) string { return h.ID })
+			got := []string{}
+			for _, h := range hasSourceAts {
+				got = append(got, h.ID)
+			}
+			if diff := cmp.Diff(test.Exp, got); diff != "" {
+				t.Errorf("Unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
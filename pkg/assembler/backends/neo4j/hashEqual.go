@@ -124,21 +124,25 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 	return result.([]*model.HashEqual), nil
 }
 
+func (c *neo4jClient) EqualArtifacts(ctx context.Context, artifact model.ArtifactSpec) ([]*model.Artifact, error) {
+	panic(fmt.Errorf("not implemented: EqualArtifacts - equalArtifacts"))
+}
+
 func setHashEqualValues(sb *strings.Builder, hashEqualSpec *model.HashEqualSpec, firstMatch *bool, queryValues map[string]any) {
 	if hashEqualSpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "hashEqual", "justification", "$justification")
+		matchStringProperty(sb, *firstMatch, "hashEqual", "justification", "$justification", hashEqualSpec.StringMatch)
 		*firstMatch = false
-		queryValues["justification"] = hashEqualSpec.Justification
+		queryValues["justification"] = stringMatchValue(hashEqualSpec.StringMatch, hashEqualSpec.Justification)
 	}
 	if hashEqualSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "hashEqual", "origin", "$origin")
+		matchStringProperty(sb, *firstMatch, "hashEqual", "origin", "$origin", hashEqualSpec.StringMatch)
 		*firstMatch = false
-		queryValues["origin"] = hashEqualSpec.Origin
+		queryValues["origin"] = stringMatchValue(hashEqualSpec.StringMatch, hashEqualSpec.Origin)
 	}
 	if hashEqualSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "hashEqual", "collector", "$collector")
+		matchStringProperty(sb, *firstMatch, "hashEqual", "collector", "$collector", hashEqualSpec.StringMatch)
 		*firstMatch = false
-		queryValues["collector"] = hashEqualSpec.Collector
+		queryValues["collector"] = stringMatchValue(hashEqualSpec.StringMatch, hashEqualSpec.Collector)
 	}
 }
 
@@ -17,6 +17,7 @@ package neo4jBackend
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
@@ -111,6 +112,10 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 					Origin:           isDependencyNode.Props[origin].(string),
 					Collector:        isDependencyNode.Props[collector].(string),
 				}
+				// semver ranges can't be evaluated in cypher
+				if isDependencySpec.DependentVersion != nil && !helper.VersionInRange(isDependency.VersionRange, *isDependencySpec.DependentVersion) {
+					continue
+				}
 				collectedIsDependency = append(collectedIsDependency, isDependency)
 			}
 			if err = result.Err(); err != nil {
@@ -126,6 +131,18 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 	return result.([]*model.IsDependency), nil
 }
 
+func (c *neo4jClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, first *int, after *string) (*model.IsDependencyConnection, error) {
+	panic(fmt.Errorf("not implemented: IsDependencyList - IsDependencyList"))
+}
+
+func (c *neo4jClient) IsDependencyCount(ctx context.Context, isDependencySpec *model.IsDependencySpec) (int, error) {
+	panic(fmt.Errorf("not implemented: IsDependencyCount - IsDependencyCount"))
+}
+
+func (c *neo4jClient) Dependencies(ctx context.Context, pkg model.PkgSpec, maxDepth int) (*model.DependencyTree, error) {
+	panic(fmt.Errorf("not implemented: Dependencies - dependencies"))
+}
+
 func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDependencySpec, firstMatch *bool, queryValues map[string]any) {
 	if isDependencySpec.VersionRange != nil {
 
@@ -135,15 +152,15 @@ func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDepend
 	}
 	if isDependencySpec.Origin != nil {
 
-		matchProperties(sb, *firstMatch, "isDependency", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "isDependency", origin, "$"+origin, isDependencySpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = isDependencySpec.Origin
+		queryValues[origin] = stringMatchValue(isDependencySpec.StringMatch, isDependencySpec.Origin)
 	}
 	if isDependencySpec.Collector != nil {
 
-		matchProperties(sb, *firstMatch, "isDependency", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "isDependency", collector, "$"+collector, isDependencySpec.StringMatch)
 		*firstMatch = false
-		queryValues[collector] = isDependencySpec.Collector
+		queryValues[collector] = stringMatchValue(isDependencySpec.StringMatch, isDependencySpec.Collector)
 	}
 }
 
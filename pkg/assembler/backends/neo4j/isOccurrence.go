@@ -175,19 +175,27 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 
 func setIsOccurrenceValues(sb *strings.Builder, isOccurrenceSpec *model.IsOccurrenceSpec, firstMatch *bool, queryValues map[string]any) {
 	if isOccurrenceSpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "isOccurrence", justification, "$"+justification)
+		matchStringProperty(sb, *firstMatch, "isOccurrence", justification, "$"+justification, isOccurrenceSpec.StringMatch)
 		*firstMatch = false
-		queryValues[justification] = isOccurrenceSpec.Justification
+		queryValues[justification] = stringMatchValue(isOccurrenceSpec.StringMatch, isOccurrenceSpec.Justification)
 	}
 	if isOccurrenceSpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "isOccurrence", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "isOccurrence", origin, "$"+origin, isOccurrenceSpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = isOccurrenceSpec.Origin
+		queryValues[origin] = stringMatchValue(isOccurrenceSpec.StringMatch, isOccurrenceSpec.Origin)
 	}
 	if isOccurrenceSpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "isOccurrence", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "isOccurrence", collector, "$"+collector, isOccurrenceSpec.StringMatch)
+		*firstMatch = false
+		queryValues[collector] = stringMatchValue(isOccurrenceSpec.StringMatch, isOccurrenceSpec.Collector)
+	}
+	if len(isOccurrenceSpec.Origins) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "isOccurrence", origin, "origins", isOccurrenceSpec.StringMatch, isOccurrenceSpec.Origins, queryValues)
+		*firstMatch = false
+	}
+	if len(isOccurrenceSpec.Collectors) > 0 {
+		matchAnyStringProperty(sb, *firstMatch, "isOccurrence", collector, "collectors", isOccurrenceSpec.StringMatch, isOccurrenceSpec.Collectors, queryValues)
 		*firstMatch = false
-		queryValues[collector] = isOccurrenceSpec.Collector
 	}
 }
 
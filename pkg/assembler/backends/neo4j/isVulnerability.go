@@ -166,19 +166,19 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 
 func setIsVulnerabilityValues(sb *strings.Builder, isVulnerabilitySpec *model.IsVulnerabilitySpec, firstMatch *bool, queryValues map[string]any) {
 	if isVulnerabilitySpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "isVulnerability", justification, "$"+justification)
+		matchStringProperty(sb, *firstMatch, "isVulnerability", justification, "$"+justification, isVulnerabilitySpec.StringMatch)
 		*firstMatch = false
-		queryValues["justification"] = isVulnerabilitySpec.Justification
+		queryValues["justification"] = stringMatchValue(isVulnerabilitySpec.StringMatch, isVulnerabilitySpec.Justification)
 	}
 	if isVulnerabilitySpec.Origin != nil {
-		matchProperties(sb, *firstMatch, "isVulnerability", origin, "$"+origin)
+		matchStringProperty(sb, *firstMatch, "isVulnerability", origin, "$"+origin, isVulnerabilitySpec.StringMatch)
 		*firstMatch = false
-		queryValues[origin] = isVulnerabilitySpec.Origin
+		queryValues[origin] = stringMatchValue(isVulnerabilitySpec.StringMatch, isVulnerabilitySpec.Origin)
 	}
 	if isVulnerabilitySpec.Collector != nil {
-		matchProperties(sb, *firstMatch, "isVulnerability", collector, "$"+collector)
+		matchStringProperty(sb, *firstMatch, "isVulnerability", collector, "$"+collector, isVulnerabilitySpec.StringMatch)
 		*firstMatch = false
-		queryValues[collector] = isVulnerabilitySpec.Collector
+		queryValues[collector] = stringMatchValue(isVulnerabilitySpec.StringMatch, isVulnerabilitySpec.Collector)
 	}
 }
 
@@ -17,6 +17,7 @@ package neo4jBackend
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -648,6 +649,21 @@ RETURN type.type, ns.namespace, name.name, version.version, version.subpath, ver
 	return result.(*model.Package), nil
 }
 
+func (c *neo4jClient) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	out := make([]*model.Package, 0, len(pkgs))
+	for i, pkg := range pkgs {
+		if pkg == nil {
+			return nil, fmt.Errorf("index %d: missing pkg", i)
+		}
+		ingested, err := c.IngestPackage(ctx, *pkg)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
 func getCollectedPackageQualifiers(qualifierList []interface{}) []*model.PackageQualifier {
 	qualifiers := []*model.PackageQualifier{}
 	for i := range qualifierList {
@@ -732,7 +748,7 @@ func setPkgMatchValues(sb *strings.Builder, pkg *model.PkgSpec, objectPkg bool,
 			*firstMatch = false
 		}
 
-		if !*pkg.MatchOnlyEmptyQualifiers {
+		if pkg.MatchOnlyEmptyQualifiers == nil || !*pkg.MatchOnlyEmptyQualifiers {
 			if len(pkg.Qualifiers) > 0 {
 				if !objectPkg {
 					qualifiers := getQualifiers(pkg.Qualifiers)
@@ -0,0 +1,35 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) SeverityOverride(ctx context.Context, severityOverrideSpec *model.SeverityOverrideSpec) ([]*model.SeverityOverride, error) {
+	panic(fmt.Errorf("not implemented: SeverityOverride - SeverityOverride"))
+}
+
+func (c *neo4jClient) EffectiveSeverity(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) (*model.EffectiveSeverity, error) {
+	panic(fmt.Errorf("not implemented: EffectiveSeverity - EffectiveSeverity"))
+}
+
+func (c *neo4jClient) IngestSeverityOverride(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) (*model.SeverityOverride, error) {
+	panic(fmt.Errorf("not implemented: IngestSeverityOverride - IngestSeverityOverride"))
+}
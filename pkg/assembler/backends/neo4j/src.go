@@ -17,6 +17,7 @@ package neo4jBackend
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
@@ -473,6 +474,21 @@ RETURN type.type, ns.namespace, name.name, name.commit, name.tag`
 	return result.(*model.Source), nil
 }
 
+func (c *neo4jClient) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	out := make([]*model.Source, 0, len(sources))
+	for i, source := range sources {
+		if source == nil {
+			return nil, fmt.Errorf("index %d: missing source", i)
+		}
+		ingested, err := c.IngestSource(ctx, *source)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
 func setSrcMatchValues(sb *strings.Builder, src *model.SourceSpec, objectSrc bool, firstMatch *bool, queryValues map[string]any) {
 	if src != nil {
 		if src.Type != nil {
@@ -0,0 +1,35 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) SupersededBy(ctx context.Context, supersededBySpec *model.SupersededBySpec) ([]*model.SupersededBy, error) {
+	panic(fmt.Errorf("not implemented: SupersededBy - SupersededBy"))
+}
+
+func (c *neo4jClient) Successors(ctx context.Context, pkg model.PkgSpec) ([]*model.SupersededBy, error) {
+	panic(fmt.Errorf("not implemented: Successors - successors"))
+}
+
+func (c *neo4jClient) IngestSupersededBy(ctx context.Context, pkg model.PkgInputSpec, successor model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) (*model.SupersededBy, error) {
+	panic(fmt.Errorf("not implemented: IngestSupersededBy - ingestSupersededBy"))
+}
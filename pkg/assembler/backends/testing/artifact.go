@@ -17,37 +17,47 @@ package testing
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
 	"fmt"
-	"strconv"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 // Internal data: Artifacts
 type artMap map[string]*artStruct
 type artStruct struct {
-	id          uint32
+	id          string
 	algorithm   string
 	digest      string
-	hashEquals  []uint32
-	occurrences []uint32
-	hasSLSAs    []uint32
+	hashEquals  []string
+	occurrences []string
+	hasSLSAs    []string
+	overrides   []string
+	signatures  []string
+}
+
+func (n *artStruct) getID() string { return n.id }
+
+func (n *artStruct) neighbors() []string {
+	return appendIDs(nil, n.hashEquals, n.occurrences, n.hasSLSAs, n.overrides, n.signatures)
 }
 
-func (n *artStruct) getID() uint32 { return n.id }
+func (n *artStruct) getHashEquals() []string { return n.hashEquals }
+func (n *artStruct) setHashEquals(id string) { n.hashEquals = append(n.hashEquals, id) }
 
-func (n *artStruct) getHashEquals() []uint32 { return n.hashEquals }
-func (n *artStruct) setHashEquals(id uint32) { n.hashEquals = append(n.hashEquals, id) }
+func (n *artStruct) getOccurrences() []string { return n.occurrences }
+func (n *artStruct) setOccurrences(id string) { n.occurrences = append(n.occurrences, id) }
 
-func (n *artStruct) getOccurrences() []uint32 { return n.occurrences }
-func (n *artStruct) setOccurrences(id uint32) { n.occurrences = append(n.occurrences, id) }
+func (n *artStruct) getHasSLSAs() []string { return n.hasSLSAs }
+func (n *artStruct) setHasSLSAs(id string) { n.hasSLSAs = append(n.hasSLSAs, id) }
 
-func (n *artStruct) getHasSLSAs() []uint32 { return n.hasSLSAs }
-func (n *artStruct) setHasSLSAs(id uint32) { n.hasSLSAs = append(n.hasSLSAs, id) }
+func (n *artStruct) getOverrides() []string { return n.overrides }
+func (n *artStruct) setOverrides(id string) { n.overrides = append(n.overrides, id) }
+
+func (n *artStruct) getCertifySigneds() []string { return n.signatures }
+func (n *artStruct) setCertifySigneds(id string) { n.signatures = append(n.signatures, id) }
 
 // TODO convert to unit tests
 // func registerAllArtifacts(c *demoClient) {
@@ -68,8 +78,10 @@ func (n *artStruct) setHasSLSAs(id uint32) { n.hasSLSAs = append(n.hasSLSAs, id)
 // Ingest Artifacts
 
 func (c *demoClient) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
-	algorithm := strings.ToLower(artifact.Algorithm)
-	digest := strings.ToLower(artifact.Digest)
+	algorithm, digest, err := normalizeDigest(artifact.Algorithm, artifact.Digest)
+	if err != nil {
+		return nil, err
+	}
 	a, err := c.artifactByKey(algorithm, digest)
 
 	if err != nil {
@@ -79,20 +91,67 @@ func (c *demoClient) IngestArtifact(ctx context.Context, artifact *model.Artifac
 			digest:    digest,
 		}
 		c.index[a.id] = a
+		c.recordChange(a.id, model.NodeTypeArtifact)
 		c.artifacts[strings.Join([]string{algorithm, digest}, ":")] = a
+	} else {
+		c.countIngestion(model.NodeTypeArtifact, false)
 	}
 
 	return convArtifact(a), nil
 }
 
-func (c *demoClient) artifactByID(id uint32) (*artStruct, error) {
+// IngestArtifacts ingests the artifacts at every index of artifacts. The result is in input
+// order, with duplicates within the batch returning the same node.
+func (c *demoClient) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	out := make([]*model.Artifact, 0, len(artifacts))
+	for i, artifact := range artifacts {
+		if artifact == nil {
+			return nil, backends.InvalidInputf("IngestArtifacts :: index %d: missing artifact", i)
+		}
+		ingested, err := c.IngestArtifact(ctx, artifact)
+		if err != nil {
+			return nil, fmt.Errorf("IngestArtifacts :: index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
+// digestLengths are the lengths of the hex digests of the known algorithms.
+var digestLengths = map[string]int{
+	"sha1":   40,
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// normalizeDigest lowercases the algorithm and digest of an artifact, as they
+// are stored and queried, and checks that the digest of a known algorithm is
+// a hex string of the right length. Digests of other algorithms are only
+// lowercased.
+func normalizeDigest(alg, dig string) (string, string, error) {
+	algorithm := strings.ToLower(alg)
+	digest := strings.ToLower(dig)
+	length, known := digestLengths[algorithm]
+	if !known {
+		return algorithm, digest, nil
+	}
+	if len(digest) != length {
+		return "", "", backends.InvalidInputf("invalid %s digest %q: expected %d hex characters, got %d", algorithm, dig, length, len(digest))
+	}
+	if _, err := hex.DecodeString(digest); err != nil {
+		return "", "", backends.InvalidInputf("invalid %s digest %q: not a hex string", algorithm, dig)
+	}
+	return algorithm, digest, nil
+}
+
+func (c *demoClient) artifactByID(id string) (*artStruct, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find artifact")
+		return nil, backends.NotFoundf("could not find artifact")
 	}
 	a, ok := o.(*artStruct)
 	if !ok {
-		return nil, errors.New("not an artifact")
+		return nil, backends.InvalidInputf("not an artifact")
 	}
 	return a, nil
 }
@@ -103,7 +162,23 @@ func (c *demoClient) artifactByKey(alg, dig string) (*artStruct, error) {
 	if a, ok := c.artifacts[strings.Join([]string{algorithm, digest}, ":")]; ok {
 		return a, nil
 	}
-	return nil, errors.New("artifact not found")
+	return nil, backends.NotFoundf("artifact not found")
+}
+
+// artifactBySpec returns the single artifact specified by ID or by algorithm
+// and digest, for the queries starting from one artifact.
+func (c *demoClient) artifactBySpec(artifactSpec model.ArtifactSpec) (*artStruct, error) {
+	if artifactSpec.ID != nil {
+		id, err := parseID(*artifactSpec.ID)
+		if err != nil {
+			return nil, err
+		}
+		return c.artifactByID(id)
+	}
+	if artifactSpec.Algorithm == nil || artifactSpec.Digest == nil {
+		return nil, backends.InvalidInputf("artifact must be specified by ID or by algorithm and digest")
+	}
+	return c.artifactByKey(*artifactSpec.Algorithm, *artifactSpec.Digest)
 }
 
 func (c *demoClient) artifactExact(artifactSpec *model.ArtifactSpec) (*artStruct, error) {
@@ -112,11 +187,10 @@ func (c *demoClient) artifactExact(artifactSpec *model.ArtifactSpec) (*artStruct
 
 	// If ID is provided, try to look up, then check if algo and digest match.
 	if artifactSpec.ID != nil {
-		id64, err := strconv.ParseUint(*artifactSpec.ID, 10, 32)
+		id, err := parseID(*artifactSpec.ID)
 		if err != nil {
-			return nil, fmt.Errorf("couldn't parse id %w", err)
+			return nil, err
 		}
-		id := uint32(id64)
 		a, err := c.artifactByID(id)
 		if err != nil {
 			// Not found
@@ -140,7 +214,7 @@ func (c *demoClient) artifactExact(artifactSpec *model.ArtifactSpec) (*artStruct
 func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
 	a, err := c.artifactExact(artifactSpec)
 	if err != nil {
-		return nil, gqlerror.Errorf("Artifacts :: invalid spec %s", err)
+		return nil, fmt.Errorf("Artifacts :: invalid spec %w", err)
 	}
 	if a != nil {
 		return []*model.Artifact{convArtifact(a)}, nil
@@ -169,7 +243,7 @@ func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 
 func convArtifact(a *artStruct) *model.Artifact {
 	return &model.Artifact{
-		ID:        nodeID(a.id),
+		ID:        a.id,
 		Digest:    a.digest,
 		Algorithm: a.algorithm,
 	}
@@ -0,0 +1,148 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestArtifactDigestNormalization(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	tests := []struct {
+		Name     string
+		Artifact *model.ArtifactInputSpec
+	}{
+		{
+			Name:     "sha1",
+			Artifact: a2,
+		},
+		{
+			Name:     "sha256",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "SHA256", Digest: strings.ToUpper(a1.Digest)},
+		},
+		{
+			Name:     "sha512",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "Sha512", Digest: a3.Digest},
+		},
+		{
+			Name:     "Unknown algorithm",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "GitOID", Digest: "ABC"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ingested, err := b.IngestArtifact(ctx, test.Artifact)
+			if err != nil {
+				t.Fatalf("Could not ingest artifact: %v", err)
+			}
+			algorithm := strings.ToLower(test.Artifact.Algorithm)
+			digest := strings.ToLower(test.Artifact.Digest)
+			if ingested.Algorithm != algorithm || ingested.Digest != digest {
+				t.Errorf("expected artifact %s:%s, got %s:%s", algorithm, digest, ingested.Algorithm, ingested.Digest)
+			}
+			lower, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: algorithm, Digest: digest})
+			if err != nil {
+				t.Fatalf("Could not ingest artifact: %v", err)
+			}
+			if lower.ID != ingested.ID {
+				t.Errorf("expected ingesting the lowercase form to return ID %s, got %s", ingested.ID, lower.ID)
+			}
+			found, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom.String(algorithm), Digest: ptrfrom.String(digest)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(found) != 1 || found[0].ID != ingested.ID {
+				t.Errorf("expected querying the lowercase form to return ID %s, got %+v", ingested.ID, found)
+			}
+		})
+	}
+}
+
+func TestArtifactDigestValidation(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	tests := []struct {
+		Name     string
+		Artifact *model.ArtifactInputSpec
+	}{
+		{
+			Name:     "Short sha1",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "sha1", Digest: a2.Digest[1:]},
+		},
+		{
+			Name:     "sha1 digest as sha256",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: a2.Digest},
+		},
+		{
+			Name:     "Long sha512",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "SHA512", Digest: a3.Digest + "00"},
+		},
+		{
+			Name:     "Not hex",
+			Artifact: &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "5a787865sd676dacb0142afa0b83029cd7befd9"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := b.IngestArtifact(ctx, test.Artifact); !errors.Is(err, backends.ErrInvalidInput) {
+				t.Errorf("expected an invalid input error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestIngestArtifacts(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+
+	got, err := b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{a1, a2, a1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifacts: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 artifacts, got %d", len(got))
+	}
+	if got[0].Algorithm != "sha256" || got[1].Algorithm != "sha1" {
+		t.Errorf("expected artifacts in input order, got %s and %s", got[0].Algorithm, got[1].Algorithm)
+	}
+	if got[0].ID != got[2].ID {
+		t.Errorf("expected duplicates within the batch to be the same node, got %s and %s", got[0].ID, got[2].ID)
+	}
+
+	invalid := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "not-hex"}
+	_, err = b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{a1, invalid})
+	if !errors.Is(err, backends.ErrInvalidInput) || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("expected an invalid input error naming index 1, got: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ingestSubjects ingests the packages and sources that a piece of evidence
+// links, as IngestPackage and IngestSource would, when the backend is set up
+// with AutoIngestSubjects. Otherwise it does nothing, and the ingestion of the
+// evidence fails if they are missing. Nil inputs are skipped.
+//
+// It is called by the mutations once their input is validated, under the same
+// lock as the ingestion of the evidence, so that concurrent clients never see
+// the subjects without the evidence.
+func (c *demoClient) ingestSubjects(ctx context.Context, pkgs []*model.PkgInputSpec, sources []*model.SourceInputSpec) error {
+	if !c.autoIngestSubjects {
+		return nil
+	}
+	for _, pkg := range pkgs {
+		if pkg == nil {
+			continue
+		}
+		if _, err := c.IngestPackage(ctx, *pkg); err != nil {
+			return err
+		}
+	}
+	for _, source := range sources {
+		if source == nil {
+			continue
+		}
+		if _, err := c.IngestSource(ctx, *source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestAutoIngestSubjectsStrict(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	_, err = b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{})
+	if !errors.Is(err, backends.ErrNotFound) {
+		t.Errorf("expected a not found error ingesting HasSourceAt without its subjects, got: %v", err)
+	}
+	pkgs, err := b.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkgs) != 0 {
+		t.Errorf("expected no package to be created, got %d", len(pkgs))
+	}
+}
+
+func TestAutoIngestSubjects(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{AutoIngestSubjects: true})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+
+	hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{Origin: "test"})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	version := hasSourceAt.Package.Namespaces[0].Names[0].Versions[0]
+	if hasSourceAt.Package.Type != p2.Type || hasSourceAt.Package.Namespaces[0].Names[0].Name != p2.Name || version.Version != *p2.Version {
+		t.Errorf("unexpected package in HasSourceAt: %+v", hasSourceAt.Package)
+	}
+	if hasSourceAt.Source.Namespaces[0].Namespace != s1.Namespace || hasSourceAt.Source.Namespaces[0].Names[0].Name != s1.Name {
+		t.Errorf("unexpected source in HasSourceAt: %+v", hasSourceAt.Source)
+	}
+
+	// the subjects are the nodes IngestPackage and IngestSource return
+	pkg, err := b.IngestPackage(ctx, *p2)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if got := pkg.Namespaces[0].Names[0].Versions[0].ID; got != version.ID {
+		t.Errorf("expected IngestPackage to return version %s, got %s", version.ID, got)
+	}
+	src, err := b.IngestSource(ctx, *s1)
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if got, want := src.Namespaces[0].Names[0].ID, hasSourceAt.Source.Namespaces[0].Names[0].ID; got != want {
+		t.Errorf("expected IngestSource to return source %s, got %s", want, got)
+	}
+
+	// the other mutations attaching evidence to packages and sources
+	if _, err := b.IngestDependency(ctx, *p4, *p5, model.IsDependencyInputSpec{VersionRange: "1.24.0"}); err != nil {
+		t.Errorf("Could not ingest IsDependency: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Source: s2}, nil, model.CertifyBadInputSpec{Justification: "test"}); err != nil {
+		t.Errorf("Could not ingest CertifyBad: %v", err)
+	}
+	if _, err := b.CertifyScorecard(ctx, *s2, model.ScorecardInputSpec{AggregateScore: 5}); err != nil {
+		t.Errorf("Could not ingest CertifyScorecard: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p4, p5} {
+		got, err := b.Packages(ctx, &model.PkgSpec{Type: &p.Type, Name: &p.Name, Version: p.Version})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 1 {
+			t.Errorf("expected package %s to be created, got %d packages", p.Name, len(got))
+		}
+	}
+
+	// evidence failing validation doesn't create its subjects
+	_, err = b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Package: p1, Source: s2}, &model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, model.CertifyGoodInputSpec{})
+	if !errors.Is(err, backends.ErrInvalidInput) {
+		t.Errorf("expected an invalid input error ingesting CertifyGood on two subjects, got: %v", err)
+	}
+	versions, err := b.Packages(ctx, &model.PkgSpec{Type: &p1.Type, Name: &p1.Name})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if n := len(versions[0].Namespaces[0].Names[0].Versions); n != 1 {
+		t.Errorf("expected invalid evidence not to create a package version, got %d versions", n)
+	}
+}
+
+func TestAutoIngestSubjectsConcurrent(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{AutoIngestSubjects: true})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	const workers = 8
+	var wg sync.WaitGroup
+	ids := make([]string, workers)
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{Origin: "test"})
+			errs[i] = err
+			if err == nil {
+				ids[i] = hasSourceAt.ID
+			}
+		}(i)
+	}
+	wg.Wait()
+	for i := range ids {
+		if errs[i] != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", errs[i])
+		}
+		if ids[i] != ids[0] {
+			t.Errorf("expected every client to get HasSourceAt %s, got %s", ids[0], ids[i])
+		}
+	}
+	pkgs, err := b.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if n := len(pkgs[0].Namespaces[0].Names[0].Versions); len(pkgs) != 1 || n != 1 {
+		t.Errorf("expected a single package version, got %d packages and %d versions", len(pkgs), n)
+	}
+}
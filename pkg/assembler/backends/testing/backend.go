@@ -16,48 +16,92 @@
 package testing
 
 import (
-	"fmt"
+	"context"
+	"io"
 	"strings"
-	"sync/atomic"
+	"sync"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"golang.org/x/exp/slices"
 )
 
-type DemoCredentials struct{}
+// DemoCredentials configures the testing backend.
+type DemoCredentials struct {
+	// DefaultResultLimit caps the number of results returned by a query that
+	// the client did not paginate. Zero means no cap.
+	DefaultResultLimit int
+	// ResultLimits overrides DefaultResultLimit for individual queries, keyed
+	// by their GraphQL field name: CertifyVuln, HasSourceAt, IsDependency,
+	// IsOccurrence, changes, conflicts, findSoftware and riskyPackages (see
+	// ResultLimitQueries). A negative value disables the cap for that query.
+	ResultLimits map[string]int
+	// Clock returns the time at which evidence is ingested. Defaults to
+	// time.Now.
+	Clock func() time.Time
+	// ChangeRetention is the number of ingested nodes kept in the log
+	// returned by the changes query. Defaults to 100000.
+	ChangeRetention int
+	// ConflictPatterns are the contradictions between evidence detected at
+	// ingestion and returned by the conflicts query. Defaults to all of
+	// model.AllConflictPattern.
+	ConflictPatterns []model.ConflictPattern
+	// AutoIngestSubjects makes the ingestion of evidence create the packages
+	// and sources it links that weren't ingested yet, as IngestPackage and
+	// IngestSource would. By default the ingestion fails instead.
+	AutoIngestSubjects bool
+	// MaxNamespaces caps the number of namespaces in use, including the
+	// default one. Requests to a new namespace past the cap fail until one is
+	// purged. Zero means no cap.
+	MaxNamespaces int
+	// Metrics receives the node counts and operation latencies of every
+	// namespace. By default nothing is measured.
+	Metrics *Metrics
+}
 
-// IDs: We have a global ID for all nodes that have references to/from.
-// Since we always ingest data and never remove, we can keep this global and
-// increment it as needed.
+// IDs: We have a global ID for all nodes that have references to/from,
+// handed out by an idGenerator (see ids.go).
 // For fast retrieval, we also keep a map from ID from nodes that have it.
-// IDs are stored as string in graphql even though we ask for integers
-// See https://github.com/99designs/gqlgen/issues/2561
+//
+// Every node in the index also lists the IDs of its neighbors: its parent and
+// children in a software tree, the evidence linked to it through backedges,
+// or the nodes a piece of evidence links together.
 type hasID interface {
-	getID() uint32
+	getID() string
+	neighbors() []string
 }
 
-type indexType map[uint32]hasID
+type indexType map[string]hasID
 
-// atomic add to ensure ID is not duplicated
-func (c *demoClient) getNextID() uint32 {
-	return atomic.AddUint32(&c.id, 1)
+// The generator is shared by all namespaces, so that an ID never refers to
+// nodes in two of them.
+func (c *demoClient) getNextID() string {
+	return c.ids.next()
 }
 
 type demoClient struct {
+	// mu guards all the state below. It is taken by namespaces, for writing
+	// by mutations and for reading by queries, so the demoClient methods
+	// call each other without locking.
+	mu                   sync.RWMutex
 	hasSBOM              []*model.HasSbom
 	certifyPkg           []*model.CertifyPkg
 	certifyVuln          []*model.CertifyVuln
 	certifyScorecard     []*model.CertifyScorecard
 	certifyBad           []*model.CertifyBad
+	certifyGood          []*model.CertifyGood
 	isVulnerability      []*model.IsVulnerability
 	certifyVEXStatement  []*model.CertifyVEXStatement
-	id                   uint32
+	ids                  *idGenerator
 	index                indexType
 	packages             pkgTypeMap
 	sources              srcTypeMap
 	osvs                 osvMap
 	ghsas                ghsaMap
 	cves                 cveMap
+	noVuln               *noVulnNode
 	hasSources           hasSrcList
 	isDependencies       isDependencyList
 	scorecards           scorecardList
@@ -68,74 +112,146 @@ type demoClient struct {
 	equalVulnerabilities equalVulnerabilityList
 	builders             builderMap
 	hasSLSAs             hasSLSAList
+	severityOverrides    severityOverrideList
+	certifySigneds       certifySignedList
+	supersededBys        supersededByList
+	// The evidence by identity, to find the duplicates of the evidence
+	// being ingested without scanning the backedges of its nodes.
+	hasSourceKeys        map[srcMapLinkKey]*srcMapLink
+	isDependencyKeys     map[isDependencyLinkKey]*isDependencyLink
+	scorecardKeys        map[scorecardLinkKey]*scorecardLink
+	hashEqualKeys        map[hashEqualKey]*hashEqualStruct
+	occurrenceKeys       map[isOccurrenceKey]*isOccurrenceStruct
+	certifyVulnKeys      map[vulnerabilityLinkKey]*vulnerabilityLink
+	isVulnerabilityKeys  map[equalVulnerabilityLinkKey]*equalVulnerabilityLink
+	severityOverrideKeys map[severityOverrideKey]*severityOverrideLink
+	certifySignedKeys    map[certifySignedKey]*certifySignedStruct
+	supersededByKeys     map[supersededByLinkKey]*supersededByLink
+	counts               map[model.NodeType]nodeCount
+	changes              changeLog
+	conflicts            conflictState
+	defaultResultLimit   int
+	resultLimits         map[string]int
+	now                  func() time.Time
+	autoIngestSubjects   bool
+	namespace            string
+	metrics              Metrics
+}
+
+// Snapshotter saves the state of a namespace and restores it, for instance
+// across restarts. The backends returned by GetBackend and GetEmptyBackend
+// implement it.
+type Snapshotter interface {
+	Export(ctx context.Context, w io.Writer) error
+	Import(ctx context.Context, r io.Reader) error
+}
+
+// Visualizer draws the graph of a namespace, for debugging ingestion. The
+// backends returned by GetBackend and GetEmptyBackend implement it.
+type Visualizer interface {
+	Visualize(ctx context.Context, w io.Writer, format string, options *VisualizeOptions) error
 }
 
 func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
-	client := &demoClient{
-		hasSBOM:              []*model.HasSbom{},
-		certifyPkg:           []*model.CertifyPkg{},
-		certifyVuln:          []*model.CertifyVuln{},
-		certifyScorecard:     []*model.CertifyScorecard{},
-		certifyBad:           []*model.CertifyBad{},
-		isVulnerability:      []*model.IsVulnerability{},
-		certifyVEXStatement:  []*model.CertifyVEXStatement{},
-		index:                indexType{},
-		packages:             pkgTypeMap{},
-		sources:              srcTypeMap{},
-		osvs:                 osvMap{},
-		ghsas:                ghsaMap{},
-		cves:                 cveMap{},
-		hasSources:           hasSrcList{},
-		isDependencies:       isDependencyList{},
-		scorecards:           scorecardList{},
-		artifacts:            artMap{},
-		hashEquals:           hashEqualList{},
-		occurrences:          isOccurrenceList{},
-		vulnerabilities:      vulnerabilityList{},
-		equalVulnerabilities: equalVulnerabilityList{},
-		builders:             builderMap{},
-		hasSLSAs:             hasSLSAList{},
-	}
+	return newNamespaces(args, newDemoClient), nil
+}
+
+func GetEmptyBackend(args backends.BackendArgs) (backends.Backend, error) {
+	return newNamespaces(args, newEmptyDemoClient), nil
+}
+
+// newDemoClient returns the state of a namespace, filled with demo data.
+func newDemoClient(args backends.BackendArgs, ids *idGenerator, namespace string) *demoClient {
+	client := newEmptyDemoClient(args, ids, namespace)
 	registerAllPackages(client)
 	registerAllSources(client)
 	registerAllCVE(client)
 	registerAllGHSA(client)
 	registerAllOSV(client)
+	return client
+}
 
-	return client, nil
+// newEmptyDemoClient returns the state of an empty namespace.
+func newEmptyDemoClient(args backends.BackendArgs, ids *idGenerator, namespace string) *demoClient {
+	client := &demoClient{ids: ids, namespace: namespace}
+	client.reset()
+	client.configure(args)
+	return client
 }
 
-func GetEmptyBackend(args backends.BackendArgs) (backends.Backend, error) {
-	client := &demoClient{
-		hasSBOM:              []*model.HasSbom{},
-		certifyPkg:           []*model.CertifyPkg{},
-		certifyVuln:          []*model.CertifyVuln{},
-		certifyScorecard:     []*model.CertifyScorecard{},
-		certifyBad:           []*model.CertifyBad{},
-		isVulnerability:      []*model.IsVulnerability{},
-		certifyVEXStatement:  []*model.CertifyVEXStatement{},
-		index:                indexType{},
-		packages:             pkgTypeMap{},
-		sources:              srcTypeMap{},
-		osvs:                 osvMap{},
-		ghsas:                ghsaMap{},
-		cves:                 cveMap{},
-		hasSources:           hasSrcList{},
-		isDependencies:       isDependencyList{},
-		scorecards:           scorecardList{},
-		artifacts:            artMap{},
-		hashEquals:           hashEqualList{},
-		occurrences:          isOccurrenceList{},
-		vulnerabilities:      vulnerabilityList{},
-		equalVulnerabilities: equalVulnerabilityList{},
-		builders:             builderMap{},
-		hasSLSAs:             hasSLSAList{},
-	}
-	return client, nil
-}
-
-func nodeID(id uint32) string {
-	return fmt.Sprintf("%d", id)
+// reset empties the state of the client, keeping its configuration.
+func (c *demoClient) reset() {
+	c.hasSBOM = []*model.HasSbom{}
+	c.certifyPkg = []*model.CertifyPkg{}
+	c.certifyVuln = []*model.CertifyVuln{}
+	c.certifyScorecard = []*model.CertifyScorecard{}
+	c.certifyBad = []*model.CertifyBad{}
+	c.certifyGood = []*model.CertifyGood{}
+	c.isVulnerability = []*model.IsVulnerability{}
+	c.certifyVEXStatement = []*model.CertifyVEXStatement{}
+	c.index = indexType{}
+	c.counts = map[model.NodeType]nodeCount{}
+	c.packages = pkgTypeMap{}
+	c.sources = srcTypeMap{}
+	c.osvs = osvMap{}
+	c.ghsas = ghsaMap{}
+	c.cves = cveMap{}
+	c.noVuln = nil
+	c.hasSources = hasSrcList{}
+	c.isDependencies = isDependencyList{}
+	c.scorecards = scorecardList{}
+	c.artifacts = artMap{}
+	c.hashEquals = hashEqualList{}
+	c.occurrences = isOccurrenceList{}
+	c.vulnerabilities = vulnerabilityList{}
+	c.equalVulnerabilities = equalVulnerabilityList{}
+	c.builders = builderMap{}
+	c.hasSLSAs = hasSLSAList{}
+	c.severityOverrides = severityOverrideList{}
+	c.certifySigneds = certifySignedList{}
+	c.supersededBys = supersededByList{}
+	c.hasSourceKeys = map[srcMapLinkKey]*srcMapLink{}
+	c.isDependencyKeys = map[isDependencyLinkKey]*isDependencyLink{}
+	c.scorecardKeys = map[scorecardLinkKey]*scorecardLink{}
+	c.hashEqualKeys = map[hashEqualKey]*hashEqualStruct{}
+	c.occurrenceKeys = map[isOccurrenceKey]*isOccurrenceStruct{}
+	c.certifyVulnKeys = map[vulnerabilityLinkKey]*vulnerabilityLink{}
+	c.isVulnerabilityKeys = map[equalVulnerabilityLinkKey]*equalVulnerabilityLink{}
+	c.severityOverrideKeys = map[severityOverrideKey]*severityOverrideLink{}
+	c.certifySignedKeys = map[certifySignedKey]*certifySignedStruct{}
+	c.supersededByKeys = map[supersededByLinkKey]*supersededByLink{}
+	c.changes = changeLog{retention: c.changes.retention}
+	c.conflicts = conflictState{
+		patterns:       c.conflicts.patterns,
+		certifications: map[string][]model.ConflictEvidence{},
+		vexStatements:  map[vexKey][]*model.CertifyVEXStatement{},
+		repositories:   map[string][]*sourceRepository{},
+	}
+}
+
+func (c *demoClient) configure(args backends.BackendArgs) {
+	c.now = time.Now
+	c.changes.retention = defaultChangeRetention
+	c.conflicts = newConflictState(model.AllConflictPattern)
+	creds, ok := args.(*DemoCredentials)
+	if !ok || creds == nil {
+		return
+	}
+	c.defaultResultLimit = creds.DefaultResultLimit
+	c.resultLimits = creds.ResultLimits
+	c.autoIngestSubjects = creds.AutoIngestSubjects
+	if creds.Clock != nil {
+		c.now = creds.Clock
+	}
+	if creds.ChangeRetention > 0 {
+		c.changes.retention = creds.ChangeRetention
+	}
+	if len(creds.ConflictPatterns) > 0 {
+		c.conflicts = newConflictState(creds.ConflictPatterns)
+	}
+	if creds.Metrics != nil {
+		c.metrics = *creds.Metrics
+	}
 }
 
 func noMatch(filter *string, value string) bool {
@@ -145,6 +261,54 @@ func noMatch(filter *string, value string) bool {
 	return false
 }
 
+// noMatchString reports whether value doesn't match filter, when set, in mode.
+// It is used for the justification, origin and collector filters, whose mode
+// is checked by helper.ValidateStringMatch before the search.
+func noMatchString(mode *model.StringMatchMode, filter *string, value string) bool {
+	if filter != nil {
+		return !helper.MatchString(mode, *filter, value)
+	}
+	return false
+}
+
+// noMatchAnyString reports whether value matches none of filters in mode. An
+// empty list matches every value, as an unset filter does.
+func noMatchAnyString(mode *model.StringMatchMode, filters []string, value string) bool {
+	for _, filter := range filters {
+		if helper.MatchString(mode, filter, value) {
+			return false
+		}
+	}
+	return len(filters) > 0
+}
+
+// noMatchTime reports whether value doesn't match the exact timestamp or
+// isn't in the [after, before) range, when they are set.
+func noMatchTime(exact, after, before *time.Time, value time.Time) bool {
+	if exact != nil && !exact.Equal(value) {
+		return true
+	}
+	if after != nil && value.Before(*after) {
+		return true
+	}
+	if before != nil && !value.Before(*before) {
+		return true
+	}
+	return false
+}
+
+// noMatchTimeBetween reports whether value isn't in the [after, before] range,
+// when its bounds are set.
+func noMatchTimeBetween(after, before *time.Time, value time.Time) bool {
+	if after != nil && value.Before(*after) {
+		return true
+	}
+	if before != nil && value.After(*before) {
+		return true
+	}
+	return false
+}
+
 func noMatchInput(filter *string, value string) bool {
 	if filter != nil {
 		return value != *filter
@@ -152,6 +316,55 @@ func noMatchInput(filter *string, value string) bool {
 	return value != ""
 }
 
+// appendIDs appends the ID lists to ids.
+func appendIDs(ids []string, lists ...[]string) []string {
+	for _, list := range lists {
+		ids = append(ids, list...)
+	}
+	return ids
+}
+
+// appendSetIDs appends the optional references that are set, the others
+// being zero, to ids.
+func appendSetIDs(ids []string, refs ...string) []string {
+	for _, ref := range refs {
+		if ref != "" {
+			ids = append(ids, ref)
+		}
+	}
+	return ids
+}
+
+// sortedIDs sorts the IDs of the children of a node, which are kept in maps.
+func sortedIDs(ids []string) []string {
+	slices.Sort(ids)
+	return ids
+}
+
+// sortedBackedges sorts link IDs collected from the backedges of several
+// nodes. IDs grow with every ingestion, so this restores the order in which
+// the links were ingested, and in which a full search returns them. A link
+// found through several of the nodes, such as an IsDependency between two
+// of them, is only returned once.
+func sortedBackedges(ids []string) []string {
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// shortestBackedges returns the shortest of the lists of candidate links found
+// by following the backedges of the nodes in a query filter. A nil list means
+// that part of the filter didn't narrow down the search; if all of them are
+// nil, so is the result.
+func shortestBackedges(candidates ...[]string) []string {
+	var shortest []string
+	for _, ids := range candidates {
+		if ids != nil && (shortest == nil || len(ids) < len(shortest)) {
+			shortest = ids
+		}
+	}
+	return shortest
+}
+
 func nilToEmpty(input *string) string {
 	if input == nil {
 		return ""
@@ -159,6 +372,22 @@ func nilToEmpty(input *string) string {
 	return *input
 }
 
+func emptyToNil(input string) *string {
+	if input == "" {
+		return nil
+	}
+	return &input
+}
+
+// normalizeVulnID normalizes a CVE or GHSA ID filter as the IDs are stored.
+func normalizeVulnID(filter *string) *string {
+	if filter != nil {
+		normalized := helper.NormalizeVulnerabilityID(*filter)
+		return &normalized
+	}
+	return nil
+}
+
 func toLower(filter *string) *string {
 	if filter != nil {
 		lower := strings.ToLower(*filter)
@@ -17,24 +17,25 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type builderMap map[string]*builderStruct
 type builderStruct struct {
-	id       uint32
+	id       string
 	uri      string
-	hasSLSAs []uint32
+	hasSLSAs []string
 }
 
-func (b *builderStruct) getID() uint32 { return b.id }
+func (b *builderStruct) getID() string { return b.id }
 
-func (n *builderStruct) getHasSLSAs() []uint32 { return n.hasSLSAs }
-func (n *builderStruct) setHasSLSAs(id uint32) { n.hasSLSAs = append(n.hasSLSAs, id) }
+func (b *builderStruct) neighbors() []string { return b.hasSLSAs }
+
+func (n *builderStruct) getHasSLSAs() []string { return n.hasSLSAs }
+func (n *builderStruct) setHasSLSAs(id string) { n.hasSLSAs = append(n.hasSLSAs, id) }
 
 // TODO make these into test cases
 // func registerAllBuilders(client *demoClient) {
@@ -46,7 +47,7 @@ func (c *demoClient) builderByKey(uri string) (*builderStruct, error) {
 	if b, ok := c.builders[uri]; ok {
 		return b, nil
 	}
-	return nil, errors.New("builder not found")
+	return nil, backends.NotFoundf("builder not found")
 }
 
 // Ingest Builder
@@ -58,7 +59,10 @@ func (c *demoClient) IngestBuilder(ctx context.Context, builder *model.BuilderIn
 			uri: builder.URI,
 		}
 		c.index[b.id] = b
+		c.recordChange(b.id, model.NodeTypeBuilder)
 		c.builders[builder.URI] = b
+	} else {
+		c.countIngestion(model.NodeTypeBuilder, false)
 	}
 	return convBuilder(b), nil
 }
@@ -73,14 +77,14 @@ func (c *demoClient) IngestBuilder(ctx context.Context, builder *model.BuilderIn
 // 	return newBuilder
 // }
 
-func (c *demoClient) builderByID(id uint32) (*builderStruct, error) {
+func (c *demoClient) builderByID(id string) (*builderStruct, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find builder")
+		return nil, backends.NotFoundf("could not find builder")
 	}
 	b, ok := o.(*builderStruct)
 	if !ok {
-		return nil, errors.New("not a builder")
+		return nil, backends.InvalidInputf("not a builder")
 	}
 	return b, nil
 }
@@ -88,11 +92,10 @@ func (c *demoClient) builderByID(id uint32) (*builderStruct, error) {
 // Query Builder
 func (c *demoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
 	if builderSpec.ID != nil {
-		id64, err := strconv.ParseUint(*builderSpec.ID, 10, 32)
+		id, err := parseID(*builderSpec.ID)
 		if err != nil {
-			return nil, gqlerror.Errorf("Builders :: couldn't parse id %v", err)
+			return nil, fmt.Errorf("Builders :: %w", err)
 		}
-		id := uint32(id64)
 		b, err := c.builderByID(id)
 		if err != nil {
 			return nil, nil
@@ -115,7 +118,7 @@ func (c *demoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpe
 
 func convBuilder(b *builderStruct) *model.Builder {
 	return &model.Builder{
-		ID:  nodeID(b.id),
+		ID:  b.id,
 		URI: b.uri,
 	}
 }
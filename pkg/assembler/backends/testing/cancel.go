@@ -0,0 +1,40 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// cancelCheckInterval is the number of iterations of a query loop between
+// two checks of the context of the query.
+const cancelCheckInterval = 1024
+
+// checkCanceled returns an error of kind backends.ErrCanceled if ctx is done,
+// so that the queries stop scanning the graph once their client is gone. It
+// is called on every iteration i of the query loops, but only looks at ctx
+// every cancelCheckInterval iterations.
+func checkCanceled(ctx context.Context, i int) error {
+	if i%cancelCheckInterval != 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return backends.Canceled(err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// cancelAfterContext is a context canceled by the n-th call to its Err
+// method, so that a query is canceled at a known point of its loops.
+type cancelAfterContext struct {
+	context.Context
+	mu    sync.Mutex
+	n     int
+	calls int
+}
+
+func (c *cancelAfterContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.calls >= c.n {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestQueryCancellation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("ingests a large graph")
+	}
+	const links = 300000
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	pkgs := make([]*model.PkgInputSpec, links)
+	srcs := make([]*model.SourceInputSpec, links)
+	hasSourceAts := make([]*model.HasSourceAtInputSpec, links)
+	for i := range hasSourceAts {
+		pkgs[i], srcs[i] = p2, s1
+		hasSourceAts[i] = &model.HasSourceAtInputSpec{Justification: fmt.Sprintf("link %d", i)}
+	}
+	if _, err := b.IngestHasSourceAts(ctx, pkgs, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, srcs, hasSourceAts); err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+
+	tests := []struct {
+		Name  string
+		Query func(ctx context.Context) error
+	}{
+		{
+			Name: "HasSourceAt",
+			Query: func(ctx context.Context) error {
+				_, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+				return err
+			},
+		},
+		{
+			Name: "HasSourceAt filtered",
+			Query: func(ctx context.Context) error {
+				_, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Justification: new(string)})
+				return err
+			},
+		},
+		{
+			Name: "HasSourceAtCount",
+			Query: func(ctx context.Context) error {
+				_, err := b.HasSourceAtCount(ctx, &model.HasSourceAtSpec{})
+				return err
+			},
+		},
+		{
+			Name: "Neighbors",
+			Query: func(ctx context.Context) error {
+				pkg, err := b.Packages(context.Background(), &model.PkgSpec{Version: p2.Version})
+				if err != nil {
+					return err
+				}
+				_, err = b.Neighbors(ctx, pkg[0].Namespaces[0].Names[0].Versions[0].ID)
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// a few checks into the scan of the links
+			cancelAt := 5
+			ctx := &cancelAfterContext{Context: context.Background(), n: cancelAt}
+			start := time.Now()
+			err := test.Query(ctx)
+			if !errors.Is(err, backends.ErrCanceled) || !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected a cancellation error, got: %v", err)
+			}
+			if got := backends.ErrorCode(err); got != backends.CodeCanceled {
+				t.Errorf("expected error code %s, got %q", backends.CodeCanceled, got)
+			}
+			if ctx.calls != cancelAt {
+				t.Errorf("expected the query to return at the check finding the context canceled, it checked %d times", ctx.calls)
+			}
+			t.Logf("canceled after %v", time.Since(start))
+		})
+	}
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+		if !errors.Is(err, backends.ErrCanceled) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected a cancellation error, got: %v", err)
+		}
+	})
+
+	// a canceled query leaves the backend usable
+	got, err := b.HasSourceAtCount(ctx, &model.HasSourceAtSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != links {
+		t.Errorf("expected %d HasSourceAt, got %d", links, got)
+	}
+}
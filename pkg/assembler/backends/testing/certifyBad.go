@@ -17,13 +17,13 @@ package testing
 
 import (
 	"context"
-	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func registerAllCertifyBad(client *demoClient) error {
@@ -41,7 +41,7 @@ func registerAllCertifyBad(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyBad(selectedPackage[0], nil, nil, "this openssl package is a typosquatting", "testing backend", "testing backend")
+	_, err = client.registerCertifyBad(selectedPackage[0], nil, nil, "this openssl package is a typosquatting", "testing backend", "testing backend", time.Time{})
 	if err != nil {
 		return err
 	}
@@ -55,12 +55,12 @@ func registerAllCertifyBad(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyBad(nil, selectedSource[0], nil, "this source is associated with a bad author", "testing backend", "testing backend")
+	_, err = client.registerCertifyBad(nil, selectedSource[0], nil, "this source is associated with a bad author", "testing backend", "testing backend", time.Time{})
 	if err != nil {
 		return err
 	}
 
-	_, err = client.registerCertifyBad(nil, nil, &model.Artifact{Digest: "5a787865sd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, "this artifact is associated with a bad package", "testing backend", "testing backend")
+	_, err = client.registerCertifyBad(nil, nil, &model.Artifact{Digest: "5a787865fd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, "this artifact is associated with a bad package", "testing backend", "testing backend", time.Time{})
 	if err != nil {
 		return err
 	}
@@ -70,24 +70,27 @@ func registerAllCertifyBad(client *demoClient) error {
 
 // Ingest CertifyBad
 
-func (c *demoClient) registerCertifyBad(selectedPackage *model.Package, selectedSource *model.Source, selectedArtifact *model.Artifact, justification, origin, collector string) (*model.CertifyBad, error) {
+func (c *demoClient) registerCertifyBad(selectedPackage *model.Package, selectedSource *model.Source, selectedArtifact *model.Artifact, justification, origin, collector string, knownSince time.Time) (*model.CertifyBad, error) {
 
 	if selectedPackage != nil && selectedSource != nil && selectedArtifact != nil {
-		return nil, fmt.Errorf("cannot specify package, source or artifact together for CertifyBad")
+		return nil, backends.InvalidInputf("cannot specify package, source or artifact together for CertifyBad")
 	}
 
 	for _, bad := range c.certifyBad {
-		if bad.Justification == justification {
+		if bad.Justification == justification && bad.KnownSince.Equal(knownSince) {
 			if val, ok := bad.Subject.(model.Package); ok {
 				if reflect.DeepEqual(val, *selectedPackage) {
+					c.countIngestion(model.NodeTypeCertifyBad, false)
 					return bad, nil
 				}
 			} else if val, ok := bad.Subject.(model.Source); ok {
 				if reflect.DeepEqual(val, *selectedSource) {
+					c.countIngestion(model.NodeTypeCertifyBad, false)
 					return bad, nil
 				}
 			} else if val, ok := bad.Subject.(model.Artifact); ok {
 				if reflect.DeepEqual(val, *selectedArtifact) {
+					c.countIngestion(model.NodeTypeCertifyBad, false)
 					return bad, nil
 				}
 			}
@@ -98,6 +101,7 @@ func (c *demoClient) registerCertifyBad(selectedPackage *model.Package, selected
 		Justification: justification,
 		Origin:        origin,
 		Collector:     collector,
+		KnownSince:    knownSince.UTC(),
 	}
 	if selectedPackage != nil {
 		newCertifyBad.Subject = selectedPackage
@@ -108,15 +112,34 @@ func (c *demoClient) registerCertifyBad(selectedPackage *model.Package, selected
 	}
 
 	c.certifyBad = append(c.certifyBad, newCertifyBad)
+	c.recordChangedNode(newCertifyBad, model.NodeTypeCertifyBad)
 	return newCertifyBad, nil
 }
 
 func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	newCertifyBad, err := c.ingestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.detectCertifyConflicts(subject, pkgMatchType, newCertifyBad); err != nil {
+		return nil, err
+	}
+	return newCertifyBad, nil
+}
+
+func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (*model.CertifyBad, error) {
 
 	err := helper.ValidatePackageSourceOrArtifactInput(&subject, "bad subject")
 	if err != nil {
 		return nil, err
 	}
+	var knownSince time.Time
+	if certifyBad.KnownSince != nil {
+		knownSince = *certifyBad.KnownSince
+	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, []*model.SourceInputSpec{subject.Source}); err != nil {
+		return nil, err
+	}
 
 	if subject.Package != nil {
 		var selectedPkgSpec *model.PkgSpec
@@ -135,7 +158,7 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			return nil, err
 		}
 		if len(collectedPkg) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestCertifyBad :: multiple packages found")
 		}
 		return c.registerCertifyBad(
@@ -144,7 +167,8 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			nil,
 			certifyBad.Justification,
 			certifyBad.Origin,
-			certifyBad.Collector)
+			certifyBad.Collector,
+			knownSince)
 	}
 
 	if subject.Source != nil {
@@ -155,7 +179,7 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			return nil, err
 		}
 		if len(sources) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestCertifyBad :: source argument must match one"+
 					" single source repository, found %d",
 				len(sources))
@@ -166,7 +190,8 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			nil,
 			certifyBad.Justification,
 			certifyBad.Origin,
-			certifyBad.Collector)
+			certifyBad.Collector,
+			knownSince)
 	}
 
 	if subject.Artifact != nil {
@@ -175,7 +200,7 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			return nil, err
 		}
 		if len(collectedArt) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestCertifyBad :: multiple artifacts found")
 		}
 		return c.registerCertifyBad(
@@ -184,15 +209,19 @@ func (c *demoClient) IngestCertifyBad(ctx context.Context, subject model.Package
 			collectedArt[0],
 			certifyBad.Justification,
 			certifyBad.Origin,
-			certifyBad.Collector)
+			certifyBad.Collector,
+			knownSince)
 	}
 	// it should never reach here else it failed
-	return nil, gqlerror.Errorf("IngestCertifyBad failed")
+	return nil, backends.Internalf("IngestCertifyBad failed")
 }
 
 // Query CertifyBad
 
 func (c *demoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	if err := helper.ValidateStringMatch(certifyBadSpec.StringMatch); err != nil {
+		return nil, err
+	}
 
 	queryAll, err := helper.ValidatePackageSourceOrArtifactQueryInput(certifyBadSpec.Subject)
 	if err != nil {
@@ -201,16 +230,22 @@ func (c *demoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Certi
 
 	var foundCertifyBad []*model.CertifyBad
 
-	for _, h := range c.certifyBad {
+	for i, h := range c.certifyBad {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		matchOrSkip := true
 
-		if certifyBadSpec.Justification != nil && h.Justification != *certifyBadSpec.Justification {
+		if noMatchString(certifyBadSpec.StringMatch, certifyBadSpec.Justification, h.Justification) {
+			matchOrSkip = false
+		}
+		if noMatchString(certifyBadSpec.StringMatch, certifyBadSpec.Collector, h.Collector) {
 			matchOrSkip = false
 		}
-		if certifyBadSpec.Collector != nil && h.Collector != *certifyBadSpec.Collector {
+		if noMatchString(certifyBadSpec.StringMatch, certifyBadSpec.Origin, h.Origin) {
 			matchOrSkip = false
 		}
-		if certifyBadSpec.Origin != nil && h.Origin != *certifyBadSpec.Origin {
+		if noMatchTime(certifyBadSpec.KnownSince, certifyBadSpec.KnownSinceAfter, certifyBadSpec.KnownSinceBefore, h.KnownSince) {
 			matchOrSkip = false
 		}
 
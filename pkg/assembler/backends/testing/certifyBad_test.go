@@ -0,0 +1,139 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestCertifyBadGoodKnownSince(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	newYear := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	// ingested in CET, half an hour before newYear
+	lastYear := time.Date(2023, 1, 1, 0, 30, 0, 0, cet)
+	later := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	ptr := func(t time.Time) *time.Time { return &t }
+	// zero is ingested without knownSince
+	certifications := map[string]*time.Time{
+		"zero":     nil,
+		"lastYear": ptr(lastYear),
+		"newYear":  ptr(newYear),
+		"later":    ptr(later),
+	}
+
+	tests := []struct {
+		Name   string
+		Exact  *time.Time
+		After  *time.Time
+		Before *time.Time
+		Exp    []string
+	}{
+		{
+			Name: "No filter",
+			Exp:  []string{"lastYear", "later", "newYear", "zero"},
+		},
+		{
+			Name:  "Exact in another time zone",
+			Exact: ptr(lastYear.UTC()),
+			Exp:   []string{"lastYear"},
+		},
+		{
+			Name:  "Exact zero",
+			Exact: ptr(time.Time{}),
+			Exp:   []string{"zero"},
+		},
+		{
+			Name:  "After is inclusive",
+			After: ptr(newYear),
+			Exp:   []string{"later", "newYear"},
+		},
+		{
+			Name:   "Before is exclusive",
+			Before: ptr(newYear),
+			Exp:    []string{"lastYear", "zero"},
+		},
+		{
+			Name:   "Range",
+			After:  ptr(lastYear),
+			Before: ptr(later),
+			Exp:    []string{"lastYear", "newYear"},
+		},
+	}
+
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	subject := model.PackageSourceOrArtifactInput{Source: s1}
+	for justification, knownSince := range certifications {
+		if _, err := b.IngestCertifyBad(ctx, subject, nil,
+			model.CertifyBadInputSpec{KnownSince: knownSince, Justification: justification, Origin: "test", Collector: "test"}); err != nil {
+			t.Fatalf("Could not ingest CertifyBad: %v", err)
+		}
+		if _, err := b.IngestCertifyGood(ctx, subject, nil,
+			model.CertifyGoodInputSpec{KnownSince: knownSince, Justification: justification, Origin: "test", Collector: "test"}); err != nil {
+			t.Fatalf("Could not ingest CertifyGood: %v", err)
+		}
+	}
+
+	check := func(t *testing.T, exp []string, justification string, knownSince time.Time) []string {
+		t.Helper()
+		if knownSince.Location() != time.UTC {
+			t.Errorf("knownSince of %s is not in UTC: %v", justification, knownSince)
+		}
+		return append(exp, justification)
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			bad, err := b.CertifyBad(ctx, &model.CertifyBadSpec{KnownSince: test.Exact, KnownSinceAfter: test.After, KnownSinceBefore: test.Before})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			badJustifications := []string{}
+			for _, certifyBad := range bad {
+				badJustifications = check(t, badJustifications, certifyBad.Justification, certifyBad.KnownSince)
+			}
+			sort.Strings(badJustifications)
+			if diff := cmp.Diff(test.Exp, badJustifications); diff != "" {
+				t.Errorf("Unexpected CertifyBad (-want +got):\n%s", diff)
+			}
+
+			good, err := b.CertifyGood(ctx, &model.CertifyGoodSpec{KnownSince: test.Exact, KnownSinceAfter: test.After, KnownSinceBefore: test.Before})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			goodJustifications := []string{}
+			for _, certifyGood := range good {
+				goodJustifications = check(t, goodJustifications, certifyGood.Justification, certifyGood.KnownSince)
+			}
+			sort.Strings(goodJustifications)
+			if diff := cmp.Diff(test.Exp, goodJustifications); diff != "" {
+				t.Errorf("Unexpected CertifyGood (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Ingest CertifyGood
+
+func (c *demoClient) registerCertifyGood(selectedPackage *model.Package, selectedSource *model.Source, selectedArtifact *model.Artifact, justification, origin, collector string, knownSince time.Time) (*model.CertifyGood, error) {
+
+	if selectedPackage != nil && selectedSource != nil && selectedArtifact != nil {
+		return nil, backends.InvalidInputf("cannot specify package, source or artifact together for CertifyGood")
+	}
+
+	for _, good := range c.certifyGood {
+		if good.Justification == justification && good.KnownSince.Equal(knownSince) {
+			if val, ok := good.Subject.(model.Package); ok {
+				if reflect.DeepEqual(val, *selectedPackage) {
+					c.countIngestion(model.NodeTypeCertifyGood, false)
+					return good, nil
+				}
+			} else if val, ok := good.Subject.(model.Source); ok {
+				if reflect.DeepEqual(val, *selectedSource) {
+					c.countIngestion(model.NodeTypeCertifyGood, false)
+					return good, nil
+				}
+			} else if val, ok := good.Subject.(model.Artifact); ok {
+				if reflect.DeepEqual(val, *selectedArtifact) {
+					c.countIngestion(model.NodeTypeCertifyGood, false)
+					return good, nil
+				}
+			}
+		}
+	}
+
+	newCertifyGood := &model.CertifyGood{
+		Justification: justification,
+		Origin:        origin,
+		Collector:     collector,
+		KnownSince:    knownSince.UTC(),
+	}
+	if selectedPackage != nil {
+		newCertifyGood.Subject = selectedPackage
+	} else if selectedSource != nil {
+		newCertifyGood.Subject = selectedSource
+	} else {
+		newCertifyGood.Subject = selectedArtifact
+	}
+
+	c.certifyGood = append(c.certifyGood, newCertifyGood)
+	c.recordChangedNode(newCertifyGood, model.NodeTypeCertifyGood)
+	return newCertifyGood, nil
+}
+
+func (c *demoClient) IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	newCertifyGood, err := c.ingestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.detectCertifyConflicts(subject, pkgMatchType, newCertifyGood); err != nil {
+		return nil, err
+	}
+	return newCertifyGood, nil
+}
+
+func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+
+	err := helper.ValidatePackageSourceOrArtifactInput(&subject, "good subject")
+	if err != nil {
+		return nil, err
+	}
+	var knownSince time.Time
+	if certifyGood.KnownSince != nil {
+		knownSince = *certifyGood.KnownSince
+	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, []*model.SourceInputSpec{subject.Source}); err != nil {
+		return nil, err
+	}
+
+	if subject.Package != nil {
+		var selectedPkgSpec *model.PkgSpec
+		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
+			selectedPkgSpec = helper.ConvertPkgInputSpecToPkgSpec(subject.Package)
+
+		} else {
+			selectedPkgSpec = &model.PkgSpec{
+				Type:      &subject.Package.Type,
+				Namespace: subject.Package.Namespace,
+				Name:      &subject.Package.Name,
+			}
+		}
+		collectedPkg, err := c.Packages(ctx, selectedPkgSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedPkg) != 1 {
+			return nil, backends.InvalidInputf(
+				"IngestCertifyGood :: multiple packages found")
+		}
+		return c.registerCertifyGood(
+			collectedPkg[0],
+			nil,
+			nil,
+			certifyGood.Justification,
+			certifyGood.Origin,
+			certifyGood.Collector,
+			knownSince)
+	}
+
+	if subject.Source != nil {
+		sourceSpec := helper.ConvertSrcInputSpecToSrcSpec(subject.Source)
+
+		sources, err := c.Sources(ctx, sourceSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(sources) != 1 {
+			return nil, backends.InvalidInputf(
+				"IngestCertifyGood :: source argument must match one"+
+					" single source repository, found %d",
+				len(sources))
+		}
+		return c.registerCertifyGood(
+			nil,
+			sources[0],
+			nil,
+			certifyGood.Justification,
+			certifyGood.Origin,
+			certifyGood.Collector,
+			knownSince)
+	}
+
+	if subject.Artifact != nil {
+		collectedArt, err := c.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &subject.Artifact.Algorithm, Digest: &subject.Artifact.Digest})
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedArt) != 1 {
+			return nil, backends.InvalidInputf(
+				"IngestCertifyGood :: multiple artifacts found")
+		}
+		return c.registerCertifyGood(
+			nil,
+			nil,
+			collectedArt[0],
+			certifyGood.Justification,
+			certifyGood.Origin,
+			certifyGood.Collector,
+			knownSince)
+	}
+	// it should never reach here else it failed
+	return nil, backends.Internalf("IngestCertifyGood failed")
+}
+
+// Query CertifyGood
+
+func (c *demoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	if err := helper.ValidateStringMatch(certifyGoodSpec.StringMatch); err != nil {
+		return nil, err
+	}
+
+	queryAll, err := helper.ValidatePackageSourceOrArtifactQueryInput(certifyGoodSpec.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var foundCertifyGood []*model.CertifyGood
+
+	for i, h := range c.certifyGood {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		matchOrSkip := true
+
+		if noMatchString(certifyGoodSpec.StringMatch, certifyGoodSpec.Justification, h.Justification) {
+			matchOrSkip = false
+		}
+		if noMatchString(certifyGoodSpec.StringMatch, certifyGoodSpec.Collector, h.Collector) {
+			matchOrSkip = false
+		}
+		if noMatchString(certifyGoodSpec.StringMatch, certifyGoodSpec.Origin, h.Origin) {
+			matchOrSkip = false
+		}
+		if noMatchTime(certifyGoodSpec.KnownSince, certifyGoodSpec.KnownSinceAfter, certifyGoodSpec.KnownSinceBefore, h.KnownSince) {
+			matchOrSkip = false
+		}
+
+		if !queryAll {
+			if certifyGoodSpec.Subject != nil && certifyGoodSpec.Subject.Package != nil && h.Subject != nil {
+				if val, ok := h.Subject.(*model.Package); ok {
+					if certifyGoodSpec.Subject.Package.Type == nil || val.Type == *certifyGoodSpec.Subject.Package.Type {
+						newPkg := filterPackageNamespace(val, certifyGoodSpec.Subject.Package)
+						if newPkg == nil {
+							matchOrSkip = false
+						}
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if certifyGoodSpec.Subject != nil && certifyGoodSpec.Subject.Source != nil && h.Subject != nil {
+				if val, ok := h.Subject.(*model.Source); ok {
+					if certifyGoodSpec.Subject.Source.Type == nil || val.Type == *certifyGoodSpec.Subject.Source.Type {
+						newSource, err := filterSourceNamespace(val, certifyGoodSpec.Subject.Source)
+						if err != nil {
+							return nil, err
+						}
+						if newSource == nil {
+							matchOrSkip = false
+						}
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if certifyGoodSpec.Subject != nil && certifyGoodSpec.Subject.Artifact != nil && h.Subject != nil {
+				if val, ok := h.Subject.(*model.Artifact); ok {
+					queryArt := &model.Artifact{
+						Algorithm: strings.ToLower(*certifyGoodSpec.Subject.Artifact.Algorithm),
+						Digest:    strings.ToLower(*certifyGoodSpec.Subject.Artifact.Digest),
+					}
+					if *queryArt != *val {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+		}
+
+		if matchOrSkip {
+			foundCertifyGood = append(foundCertifyGood, h)
+		}
+	}
+
+	return foundCertifyGood, nil
+}
@@ -19,9 +19,9 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func registerAllCertifyPkg(client *demoClient) error {
@@ -95,6 +95,7 @@ func (c *demoClient) registerCertifyPkg(selectedPackages []*model.Package, justi
 
 	for _, certPkg := range c.certifyPkg {
 		if reflect.DeepEqual(certPkg.Packages, selectedPackages) && certPkg.Justification == justification {
+			c.countIngestion(model.NodeTypeCertifyPkg, false)
 			return certPkg, nil
 		}
 	}
@@ -106,10 +107,14 @@ func (c *demoClient) registerCertifyPkg(selectedPackages []*model.Package, justi
 		Collector:     collector,
 	}
 	c.certifyPkg = append(c.certifyPkg, newCertifyPkg)
+	c.recordChangedNode(newCertifyPkg, model.NodeTypeCertifyPkg)
 	return newCertifyPkg, nil
 }
 
 func (c *demoClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) (*model.CertifyPkg, error) {
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{&pkg, &depPkg}, nil); err != nil {
+		return nil, err
+	}
 
 	selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(&pkg)
 	collectedPkg, err := c.Packages(ctx, selectedPkgSpec)
@@ -117,7 +122,7 @@ func (c *demoClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpe
 		return nil, err
 	}
 	if len(collectedPkg) != 1 {
-		return nil, gqlerror.Errorf(
+		return nil, backends.InvalidInputf(
 			"IngestCertifyPkg :: multiple package found")
 	}
 
@@ -128,7 +133,7 @@ func (c *demoClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpe
 		return nil, err
 	}
 	if len(collectedDepPkg) != 1 {
-		return nil, gqlerror.Errorf(
+		return nil, backends.InvalidInputf(
 			"IngestCertifyPkg :: multiple secondary package found")
 	}
 
@@ -142,6 +147,9 @@ func (c *demoClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpe
 // Query CertifyPkg
 
 func (c *demoClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec) ([]*model.CertifyPkg, error) {
+	if err := helper.ValidateStringMatch(certifyPkgSpec.StringMatch); err != nil {
+		return nil, err
+	}
 	var certifyPkgs []*model.CertifyPkg
 
 	queryPkgs, err := getPackagesFromInput(c, ctx, certifyPkgSpec.Packages)
@@ -149,16 +157,19 @@ func (c *demoClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Certi
 		return nil, err
 	}
 
-	for _, h := range c.certifyPkg {
+	for i, h := range c.certifyPkg {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		matchOrSkip := true
 
-		if certifyPkgSpec.Justification != nil && h.Justification != *certifyPkgSpec.Justification {
+		if noMatchString(certifyPkgSpec.StringMatch, certifyPkgSpec.Justification, h.Justification) {
 			matchOrSkip = false
 		}
-		if certifyPkgSpec.Collector != nil && h.Collector != *certifyPkgSpec.Collector {
+		if noMatchString(certifyPkgSpec.StringMatch, certifyPkgSpec.Collector, h.Collector) {
 			matchOrSkip = false
 		}
-		if certifyPkgSpec.Origin != nil && h.Origin != *certifyPkgSpec.Origin {
+		if noMatchString(certifyPkgSpec.StringMatch, certifyPkgSpec.Origin, h.Origin) {
 			matchOrSkip = false
 		}
 		if len(queryPkgs) > 0 {
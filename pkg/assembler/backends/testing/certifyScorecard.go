@@ -17,20 +17,22 @@ package testing
 
 import (
 	"context"
-	"errors"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between source and scorecard (certifyScorecard)
 type scorecardList []*scorecardLink
 type scorecardLink struct {
-	id               uint32
-	sourceID         uint32
+	id               string
+	sourceID         string
 	timeScanned      time.Time
 	aggregateScore   float64
 	checks           map[string]int
@@ -40,58 +42,80 @@ type scorecardLink struct {
 	collector        string
 }
 
-func (n *scorecardLink) getID() uint32 { return n.id }
+func (n *scorecardLink) getID() string { return n.id }
+
+func (n *scorecardLink) neighbors() []string { return []string{n.sourceID} }
+
+// scorecardLinkKey is the identity of a CertifyScorecard: ingesting a link
+// with the same key returns the existing one. The checks are canonicalized
+// into a string, as maps can't be part of a key.
+type scorecardLinkKey struct {
+	sourceID         string
+	timeScanned      time.Time
+	aggregateScore   float64
+	checks           string
+	scorecardVersion string
+	scorecardCommit  string
+	origin           string
+	collector        string
+}
+
+func (n *scorecardLink) key() scorecardLinkKey {
+	checks := make([]string, 0, len(n.checks))
+	for check, score := range n.checks {
+		checks = append(checks, strconv.Quote(check)+"="+strconv.Itoa(score))
+	}
+	sort.Strings(checks)
+	return scorecardLinkKey{
+		sourceID:         n.sourceID,
+		timeScanned:      n.timeScanned.UTC(),
+		aggregateScore:   n.aggregateScore,
+		checks:           strings.Join(checks, ","),
+		scorecardVersion: n.scorecardVersion,
+		scorecardCommit:  n.scorecardCommit,
+		origin:           n.origin,
+		collector:        n.collector,
+	}
+}
 
 // Ingest CertifyScorecard
 func (c *demoClient) CertifyScorecard(ctx context.Context, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	if err := c.ingestSubjects(ctx, nil, []*model.SourceInputSpec{&source}); err != nil {
+		return nil, err
+	}
 	sourceID, err := getSourceIDFromInput(c, source)
 	if err != nil {
 		return nil, err
 	}
 
-	searchIDs := []uint32{}
-	srcName, ok := c.index[sourceID].(*srcNameNode)
-	if ok {
-		searchIDs = append(searchIDs, srcName.scorecardLink...)
+	collectedScorecardLink := &scorecardLink{
+		sourceID:         sourceID,
+		timeScanned:      scorecard.TimeScanned.UTC(),
+		aggregateScore:   scorecard.AggregateScore,
+		checks:           getChecksFromInput(scorecard.Checks),
+		scorecardVersion: scorecard.ScorecardVersion,
+		scorecardCommit:  scorecard.ScorecardCommit,
+		origin:           scorecard.Origin,
+		collector:        scorecard.Collector,
 	}
 
-	checksMap := getChecksFromInput(scorecard.Checks)
-
 	// Don't insert duplicates
-	duplicate := false
-	collectedScorecardLink := scorecardLink{}
-	for _, id := range searchIDs {
-		v, _ := c.certifyScorecardByID(id)
-		if sourceID == v.sourceID && scorecard.TimeScanned.UTC() == v.timeScanned && scorecard.AggregateScore == v.aggregateScore &&
-			scorecard.ScorecardVersion == v.scorecardVersion && scorecard.ScorecardCommit == v.scorecardCommit && scorecard.Origin == v.origin &&
-			scorecard.Collector == v.collector && reflect.DeepEqual(checksMap, v.checks) {
-
-			collectedScorecardLink = *v
-			duplicate = true
-			break
-		}
-	}
-	if !duplicate {
+	if existing, ok := c.scorecardKeys[collectedScorecardLink.key()]; ok {
+		collectedScorecardLink = existing
+		c.countIngestion(model.NodeTypeCertifyScorecard, false)
+	} else {
 		// store the link
-		collectedScorecardLink = scorecardLink{
-			id:               c.getNextID(),
-			sourceID:         sourceID,
-			timeScanned:      scorecard.TimeScanned.UTC(),
-			aggregateScore:   scorecard.AggregateScore,
-			checks:           checksMap,
-			scorecardVersion: scorecard.ScorecardVersion,
-			scorecardCommit:  scorecard.ScorecardCommit,
-			origin:           scorecard.Origin,
-			collector:        scorecard.Collector,
-		}
-		c.index[collectedScorecardLink.id] = &collectedScorecardLink
-		c.scorecards = append(c.scorecards, &collectedScorecardLink)
+		collectedScorecardLink.id = c.getNextID()
+		c.index[collectedScorecardLink.id] = collectedScorecardLink
+		c.scorecardKeys[collectedScorecardLink.key()] = collectedScorecardLink
+		c.recordChange(collectedScorecardLink.id, model.NodeTypeCertifyScorecard)
+		c.scorecards = append(c.scorecards, collectedScorecardLink)
 		// set the backlinks
 		c.index[sourceID].(*srcNameNode).setScorecardLink(collectedScorecardLink.id)
 	}
 
 	// build return GraphQL type
-	builtCertifyScorecard, err := buildScorecard(c, &collectedScorecardLink, nil, true)
+	builtCertifyScorecard, err := buildScorecard(c, collectedScorecardLink, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -102,15 +126,23 @@ func (c *demoClient) CertifyScorecard(ctx context.Context, source model.SourceIn
 // Query CertifyScorecard
 func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	out := []*model.CertifyScorecard{}
+	if filter != nil {
+		if err := checkSortDirection("Scorecards", filter.OrderByScore); err != nil {
+			return nil, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
 
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		node, ok := c.index[uint32(id)]
+		node, ok := c.index[id]
 		if !ok {
-			return nil, gqlerror.Errorf("ID does not match existing node")
+			return nil, backends.NotFoundf("ID does not match existing node")
 		}
 		if link, ok := node.(*scorecardLink); ok {
 			foundCertifyScorecard, err := buildScorecard(c, link, filter, true)
@@ -119,12 +151,15 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 			}
 			return []*model.CertifyScorecard{foundCertifyScorecard}, nil
 		} else {
-			return nil, gqlerror.Errorf("ID does not match expected node type for CertifyScorecard")
+			return nil, backends.InvalidInputf("ID does not match expected node type for CertifyScorecard")
 		}
 	}
 
 	// TODO if any of the source is specified, ony search those backedges
-	for _, link := range c.scorecards {
+	for i, link := range c.scorecards {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		if filter != nil && filter.TimeScanned != nil && filter.TimeScanned.UTC() == link.timeScanned {
 			continue
 		}
@@ -140,10 +175,16 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		if filter != nil && noMatch(filter.ScorecardCommit, link.scorecardCommit) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Origin, link.origin) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
+			continue
+		}
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Origins, link.origin) {
+			continue
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Collector, link.collector) {
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Collectors, link.collector) {
 			continue
 		}
 
@@ -156,6 +197,13 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		}
 		out = append(out, foundCertifyScorecard)
 	}
+	if filter != nil && filter.OrderByScore != nil {
+		dir := *filter.OrderByScore
+		sort.Slice(out, func(i, j int) bool {
+			a, b := out[i], out[j]
+			return sortedBefore(dir, compareFloats(a.Scorecard.AggregateScore, b.Scorecard.AggregateScore), a.ID, b.ID)
+		})
+	}
 
 	return out, nil
 }
@@ -177,13 +225,13 @@ func buildScorecard(c *demoClient, link *scorecardLink, filter *model.CertifySco
 
 	// if source not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if s == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+		return nil, backends.Internalf("failed to retrieve source via sourceID")
 	} else if s == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	newScorecard := model.CertifyScorecard{
-		ID:     nodeID(link.id),
+		ID:     link.id,
 		Source: s,
 		Scorecard: &model.Scorecard{
 			TimeScanned:      link.timeScanned,
@@ -241,14 +289,14 @@ func noMatchChecks(checksFilter []*model.ScorecardCheckSpec, v map[string]int) b
 	return false
 }
 
-func (c *demoClient) certifyScorecardByID(id uint32) (*scorecardLink, error) {
+func (c *demoClient) certifyScorecardByID(id string) (*scorecardLink, error) {
 	node, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find scorecardLink")
+		return nil, backends.NotFoundf("could not find scorecardLink")
 	}
 	link, ok := node.(*scorecardLink)
 	if !ok {
-		return nil, errors.New("not an scorecardLink")
+		return nil, backends.InvalidInputf("not an scorecardLink")
 	}
 	return link, nil
 }
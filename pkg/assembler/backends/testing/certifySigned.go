@@ -0,0 +1,221 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal certifySigned
+
+type certifySignedList []*certifySignedStruct
+type certifySignedStruct struct {
+	id            string
+	artifact      string
+	signer        string
+	signatureType model.SignatureType
+	status        model.SignatureStatus
+	verifiedAt    time.Time
+	trustRoot     string
+	origin        string
+	collector     string
+}
+
+func (n *certifySignedStruct) getID() string { return n.id }
+
+func (n *certifySignedStruct) neighbors() []string { return []string{n.artifact} }
+
+// certifySignedKey is the identity of a CertifySigned: ingesting a signature
+// with the same key updates the existing one. The verification time is not
+// part of it, as signatures are verified again over time.
+type certifySignedKey struct {
+	artifact      string
+	signer        string
+	signatureType model.SignatureType
+	status        model.SignatureStatus
+	trustRoot     string
+	origin        string
+	collector     string
+}
+
+func (n *certifySignedStruct) key() certifySignedKey {
+	return certifySignedKey{
+		artifact:      n.artifact,
+		signer:        n.signer,
+		signatureType: n.signatureType,
+		status:        n.status,
+		trustRoot:     n.trustRoot,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+}
+
+func (c *demoClient) certifySignedByID(id string) (*certifySignedStruct, error) {
+	o, ok := c.index[id]
+	if !ok {
+		return nil, backends.NotFoundf("could not find certifySigned")
+	}
+	s, ok := o.(*certifySignedStruct)
+	if !ok {
+		return nil, backends.InvalidInputf("not a certifySigned")
+	}
+	return s, nil
+}
+
+// Ingest CertifySigned
+
+// IngestCertifySigned records a signature found on an artifact. The same
+// signature being checked again (same signer, type, status, trust root, origin
+// and collector) does not create a new node, it only moves verifiedAt forward.
+func (c *demoClient) IngestCertifySigned(ctx context.Context, artifact model.ArtifactInputSpec, certifySigned model.CertifySignedInputSpec) (*model.CertifySigned, error) {
+	if !certifySigned.SignatureType.IsValid() {
+		return nil, backends.InvalidInputf("IngestCertifySigned :: invalid signature type %s", certifySigned.SignatureType)
+	}
+	if !certifySigned.Status.IsValid() {
+		return nil, backends.InvalidInputf("IngestCertifySigned :: invalid signature status %s", certifySigned.Status)
+	}
+	a, err := c.artifactByKey(artifact.Algorithm, artifact.Digest)
+	if err != nil {
+		return nil, backends.NotFoundf("IngestCertifySigned :: Artifact not found")
+	}
+	verifiedAt := certifySigned.VerifiedAt.UTC()
+
+	s := &certifySignedStruct{
+		artifact:      a.id,
+		signer:        certifySigned.Signer,
+		signatureType: certifySigned.SignatureType,
+		status:        certifySigned.Status,
+		verifiedAt:    verifiedAt,
+		trustRoot:     certifySigned.TrustRoot,
+		origin:        certifySigned.Origin,
+		collector:     certifySigned.Collector,
+	}
+	if existing, ok := c.certifySignedKeys[s.key()]; ok {
+		c.countIngestion(model.NodeTypeCertifySigned, false)
+		if verifiedAt.After(existing.verifiedAt) {
+			existing.verifiedAt = verifiedAt
+		}
+		return c.convCertifySigned(existing)
+	}
+	s.id = c.getNextID()
+	c.index[s.id] = s
+	c.certifySignedKeys[s.key()] = s
+	c.recordChange(s.id, model.NodeTypeCertifySigned)
+	c.certifySigneds = append(c.certifySigneds, s)
+	a.setCertifySigneds(s.id)
+
+	return c.convCertifySigned(s)
+}
+
+// Query CertifySigned
+
+func (c *demoClient) CertifySigned(ctx context.Context, certifySignedSpec *model.CertifySignedSpec) ([]*model.CertifySigned, error) {
+	if err := helper.ValidateStringMatch(certifySignedSpec.StringMatch); err != nil {
+		return nil, err
+	}
+	// If ID is provided, try to look up, then check if rest matches
+	if certifySignedSpec.ID != nil {
+		id, err := parseID(*certifySignedSpec.ID)
+		if err != nil {
+			return nil, fmt.Errorf("CertifySigned :: %w", err)
+		}
+		s, err := c.certifySignedByID(id)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		cs, err := c.convCertifySigned(s)
+		if err != nil {
+			return nil, err
+		}
+		return []*model.CertifySigned{cs}, nil
+	}
+
+	// If the artifact is known exactly, only search its backedges
+	search := c.certifySigneds
+	if certifySignedSpec.Artifact != nil &&
+		certifySignedSpec.Artifact.Algorithm != nil && certifySignedSpec.Artifact.Digest != nil {
+		a, err := c.artifactByKey(*certifySignedSpec.Artifact.Algorithm, *certifySignedSpec.Artifact.Digest)
+		if err != nil {
+			return nil, nil
+		}
+		search = nil
+		for _, id := range a.getCertifySigneds() {
+			s, err := c.certifySignedByID(id)
+			if err != nil {
+				return nil, backends.Internalf("CertifySigned :: Bad certifySigned id stored on existing artifact: %s", err)
+			}
+			search = append(search, s)
+		}
+	}
+
+	var out []*model.CertifySigned
+	for i, s := range search {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if noMatch(certifySignedSpec.Signer, s.signer) ||
+			noMatch(certifySignedSpec.TrustRoot, s.trustRoot) ||
+			noMatchString(certifySignedSpec.StringMatch, certifySignedSpec.Origin, s.origin) ||
+			noMatchString(certifySignedSpec.StringMatch, certifySignedSpec.Collector, s.collector) {
+			continue
+		}
+		if certifySignedSpec.SignatureType != nil && *certifySignedSpec.SignatureType != s.signatureType {
+			continue
+		}
+		if certifySignedSpec.Status != nil && *certifySignedSpec.Status != s.status {
+			continue
+		}
+		if certifySignedSpec.VerifiedAt != nil && !certifySignedSpec.VerifiedAt.UTC().Equal(s.verifiedAt) {
+			continue
+		}
+		if certifySignedSpec.Artifact != nil && !c.artifactMatch(s.artifact, certifySignedSpec.Artifact) {
+			continue
+		}
+		cs, err := c.convCertifySigned(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, cs)
+	}
+
+	return out, nil
+}
+
+func (c *demoClient) convCertifySigned(s *certifySignedStruct) (*model.CertifySigned, error) {
+	a, err := c.artifactByID(s.artifact)
+	if err != nil {
+		return nil, backends.Internalf("CertifySigned :: Bad artifact id stored on certifySigned: %s", err)
+	}
+	return &model.CertifySigned{
+		ID:            s.id,
+		Artifact:      convArtifact(a),
+		Signer:        s.signer,
+		SignatureType: s.signatureType,
+		Status:        s.status,
+		VerifiedAt:    s.verifiedAt,
+		TrustRoot:     s.trustRoot,
+		Origin:        s.origin,
+		Collector:     s.collector,
+	}, nil
+}
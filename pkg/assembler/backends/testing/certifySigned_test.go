@@ -0,0 +1,178 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestCertifySigned(t *testing.T) {
+	type call struct {
+		Artifact *model.ArtifactInputSpec
+		Signed   model.CertifySignedInputSpec
+	}
+	first := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(24 * time.Hour)
+	cosign := model.SignatureTypeCosign
+	failed := model.SignatureStatusFailed
+	cosignVerified := model.CertifySignedInputSpec{
+		Signer:        "release-eng@example.com",
+		SignatureType: model.SignatureTypeCosign,
+		Status:        model.SignatureStatusVerified,
+		VerifiedAt:    first,
+		TrustRoot:     "release-eng",
+	}
+	notationFailed := model.CertifySignedInputSpec{
+		Signer:        "CN=unknown",
+		SignatureType: model.SignatureTypeNotation,
+		Status:        model.SignatureStatusFailed,
+		VerifiedAt:    first,
+	}
+	tests := []struct {
+		Name         string
+		Calls        []call
+		Query        *model.CertifySignedSpec
+		ExpSigned    []*model.CertifySigned
+		ExpIngestErr bool
+	}{
+		{
+			Name:  "HappyPath",
+			Calls: []call{{Artifact: a1, Signed: cosignVerified}},
+			Query: &model.CertifySignedSpec{Signer: ptrfrom.String("release-eng@example.com")},
+			ExpSigned: []*model.CertifySigned{{
+				Artifact:      &model.Artifact{Algorithm: "sha256", Digest: a1.Digest},
+				Signer:        "release-eng@example.com",
+				SignatureType: model.SignatureTypeCosign,
+				Status:        model.SignatureStatusVerified,
+				VerifiedAt:    first,
+				TrustRoot:     "release-eng",
+			}},
+		},
+		{
+			Name: "Re-verification updates verifiedAt",
+			Calls: []call{
+				{Artifact: a1, Signed: cosignVerified},
+				{Artifact: a1, Signed: func() model.CertifySignedInputSpec { s := cosignVerified; s.VerifiedAt = second; return s }()},
+				{Artifact: a1, Signed: cosignVerified},
+			},
+			Query: &model.CertifySignedSpec{},
+			ExpSigned: []*model.CertifySigned{{
+				Artifact:      &model.Artifact{Algorithm: "sha256", Digest: a1.Digest},
+				Signer:        "release-eng@example.com",
+				SignatureType: model.SignatureTypeCosign,
+				Status:        model.SignatureStatusVerified,
+				VerifiedAt:    second,
+				TrustRoot:     "release-eng",
+			}},
+		},
+		{
+			Name:  "Failed signatures are kept",
+			Calls: []call{{Artifact: a1, Signed: cosignVerified}, {Artifact: a1, Signed: notationFailed}},
+			Query: &model.CertifySignedSpec{Status: &failed},
+			ExpSigned: []*model.CertifySigned{{
+				Artifact:      &model.Artifact{Algorithm: "sha256", Digest: a1.Digest},
+				Signer:        "CN=unknown",
+				SignatureType: model.SignatureTypeNotation,
+				Status:        model.SignatureStatusFailed,
+				VerifiedAt:    first,
+			}},
+		},
+		{
+			Name:  "Query by signature type",
+			Calls: []call{{Artifact: a1, Signed: cosignVerified}, {Artifact: a2, Signed: notationFailed}},
+			Query: &model.CertifySignedSpec{SignatureType: &cosign},
+			ExpSigned: []*model.CertifySigned{{
+				Artifact:      &model.Artifact{Algorithm: "sha256", Digest: a1.Digest},
+				Signer:        "release-eng@example.com",
+				SignatureType: model.SignatureTypeCosign,
+				Status:        model.SignatureStatusVerified,
+				VerifiedAt:    first,
+				TrustRoot:     "release-eng",
+			}},
+		},
+		{
+			Name:  "Query by artifact",
+			Calls: []call{{Artifact: a1, Signed: cosignVerified}, {Artifact: a2, Signed: notationFailed}},
+			Query: &model.CertifySignedSpec{Artifact: &model.ArtifactSpec{Algorithm: ptrfrom.String("sha1"), Digest: ptrfrom.String(a2.Digest)}},
+			ExpSigned: []*model.CertifySigned{{
+				Artifact:      &model.Artifact{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+				Signer:        "CN=unknown",
+				SignatureType: model.SignatureTypeNotation,
+				Status:        model.SignatureStatusFailed,
+				VerifiedAt:    first,
+			}},
+		},
+		{
+			Name:  "Query by unknown artifact",
+			Calls: []call{{Artifact: a1, Signed: cosignVerified}},
+			Query: &model.CertifySignedSpec{Artifact: &model.ArtifactSpec{Algorithm: ptrfrom.String("sha512"), Digest: ptrfrom.String(a3.Digest)}},
+		},
+		{
+			Name:         "Ingest without artifact",
+			Calls:        []call{{Artifact: a3, Signed: cosignVerified}},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Ingest with invalid signature type",
+			Calls: []call{{Artifact: a1, Signed: model.CertifySignedInputSpec{
+				SignatureType: "pgp",
+				Status:        model.SignatureStatusVerified,
+			}}},
+			ExpIngestErr: true,
+		},
+	}
+	ignoreID := cmp.FilterPath(func(p cmp.Path) bool {
+		return p.Last().String() == ".ID"
+	}, cmp.Ignore())
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			for _, a := range []*model.ArtifactInputSpec{a1, a2} {
+				if _, err := b.IngestArtifact(ctx, a); err != nil {
+					t.Fatalf("Could not ingest artifact: %v", err)
+				}
+			}
+			for _, o := range test.Calls {
+				_, err := b.IngestCertifySigned(ctx, *o.Artifact, o.Signed)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+				if err != nil {
+					return
+				}
+			}
+			got, err := b.CertifySigned(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.ExpSigned, got, ignoreID, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,9 +22,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func registerAllCertifyVEXStatement(client *demoClient) error {
@@ -50,7 +50,7 @@ func registerAllCertifyVEXStatement(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyVEXStatement(selectedPackage[0], nil, selectedCve[0], nil, "this package is not vulnerable to this CVE", "testing backend", "testing backend", time.Now())
+	_, err = client.registerCertifyVEXStatement(selectedPackage[0], nil, selectedCve[0], nil, model.VexStatusNotAffected, "", "this package is not vulnerable to this CVE", "testing backend", "testing backend", time.Now())
 	if err != nil {
 		return err
 	}
@@ -61,7 +61,7 @@ func registerAllCertifyVEXStatement(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyVEXStatement(nil, &model.Artifact{Digest: "5a787865sd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, nil, selectedGhsa[0], "this artifact is not vulnerable to this GHSA", "testing backend", "testing backend", time.Now())
+	_, err = client.registerCertifyVEXStatement(nil, &model.Artifact{Digest: "5a787865fd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, nil, selectedGhsa[0], model.VexStatusNotAffected, "", "this artifact is not vulnerable to this GHSA", "testing backend", "testing backend", time.Now())
 	if err != nil {
 		return err
 	}
@@ -70,29 +70,33 @@ func registerAllCertifyVEXStatement(client *demoClient) error {
 
 // Ingest CertifyPkg
 
-func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package, selectedArtifact *model.Artifact, selectedCve *model.Cve, selectedGhsa *model.Ghsa, justification, origin, collector string, timestamp time.Time) (*model.CertifyVEXStatement, error) {
+func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package, selectedArtifact *model.Artifact, selectedCve *model.Cve, selectedGhsa *model.Ghsa, status model.VexStatus, statement, justification, origin, collector string, timestamp time.Time) (*model.CertifyVEXStatement, error) {
 
 	if selectedPackage != nil && selectedArtifact != nil {
-		return nil, fmt.Errorf("cannot specify both package and artifact for CertifyVEXStatement")
+		return nil, backends.InvalidInputf("cannot specify both package and artifact for CertifyVEXStatement")
 	}
 
 	for _, vex := range c.certifyVEXStatement {
-		if vex.Justification == justification {
+		if vex.Status == status && vex.Statement == statement && vex.Justification == justification {
 			if val, ok := vex.Subject.(model.Package); ok {
 				if reflect.DeepEqual(val, *selectedPackage) {
+					c.countIngestion(model.NodeTypeCertifyVexStatement, false)
 					return vex, nil
 				}
 			} else if val, ok := vex.Subject.(model.Artifact); ok {
 				if reflect.DeepEqual(val, *selectedArtifact) {
+					c.countIngestion(model.NodeTypeCertifyVexStatement, false)
 					return vex, nil
 				}
 			}
 			if val, ok := vex.Vulnerability.(model.Cve); ok {
 				if reflect.DeepEqual(val, *selectedCve) {
+					c.countIngestion(model.NodeTypeCertifyVexStatement, false)
 					return vex, nil
 				}
 			} else if val, ok := vex.Vulnerability.(model.Ghsa); ok {
 				if reflect.DeepEqual(val, *selectedGhsa) {
+					c.countIngestion(model.NodeTypeCertifyVexStatement, false)
 					return vex, nil
 				}
 			}
@@ -100,6 +104,8 @@ func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package,
 	}
 
 	newCertifyVEXStatement := &model.CertifyVEXStatement{
+		Status:        status,
+		Statement:     statement,
 		KnownSince:    timestamp,
 		Justification: justification,
 		Origin:        origin,
@@ -117,18 +123,42 @@ func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package,
 	}
 
 	c.certifyVEXStatement = append(c.certifyVEXStatement, newCertifyVEXStatement)
+	c.recordChangedNode(newCertifyVEXStatement, model.NodeTypeCertifyVexStatement)
 	return newCertifyVEXStatement, nil
 }
 
 func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	newVEXStatement, err := c.ingestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.detectVEXConflicts(subject, vulnerability, newVEXStatement); err != nil {
+		return nil, err
+	}
+	return newVEXStatement, nil
+}
+
+func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
 	err := helper.ValidatePackageOrArtifactInput(&subject, "IngestVEXStatement")
 	if err != nil {
 		return nil, err
 	}
+	err = helper.ValidateVexInput(vexStatement)
+	if err != nil {
+		return nil, fmt.Errorf("IngestVEXStatement :: %w", err)
+	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, nil); err != nil {
+		return nil, err
+	}
 	err = helper.ValidateCveOrGhsaIngestionInput(vulnerability, "IngestVEXStatement")
 	if err != nil {
 		return nil, err
 	}
+	status := helper.VexInputStatus(vexStatement)
+	var statement string
+	if vexStatement.Statement != nil {
+		statement = *vexStatement.Statement
+	}
 
 	if subject.Package != nil {
 		selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(subject.Package)
@@ -138,7 +168,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 			return nil, err
 		}
 		if len(collectedPkg) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestVEXStatement :: multiple packages found")
 		}
 
@@ -150,7 +180,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				return nil, err
 			}
 			if len(collectedCve) != 1 {
-				return nil, gqlerror.Errorf(
+				return nil, backends.InvalidInputf(
 					"IngestVEXStatement :: cve argument must match one, found %d",
 					len(collectedCve))
 			}
@@ -159,6 +189,8 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				nil,
 				collectedCve[0],
 				nil,
+				status,
+				statement,
 				vexStatement.Justification,
 				vexStatement.Origin,
 				vexStatement.Collector,
@@ -173,7 +205,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				return nil, err
 			}
 			if len(collectedGhsa) != 1 {
-				return nil, gqlerror.Errorf(
+				return nil, backends.InvalidInputf(
 					"IngestVEXStatement :: ghsa argument must match one, found %d",
 					len(collectedGhsa))
 			}
@@ -182,6 +214,8 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				nil,
 				nil,
 				collectedGhsa[0],
+				status,
+				statement,
 				vexStatement.Justification,
 				vexStatement.Origin,
 				vexStatement.Collector,
@@ -195,7 +229,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 			return nil, err
 		}
 		if len(collectedArt) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestVEXStatement :: multiple artifacts found")
 		}
 		if vulnerability.Cve != nil {
@@ -206,7 +240,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				return nil, err
 			}
 			if len(collectedCve) != 1 {
-				return nil, gqlerror.Errorf(
+				return nil, backends.InvalidInputf(
 					"IngestVEXStatement :: cve argument must match one, found %d",
 					len(collectedCve))
 			}
@@ -215,6 +249,8 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				collectedArt[0],
 				collectedCve[0],
 				nil,
+				status,
+				statement,
 				vexStatement.Justification,
 				vexStatement.Origin,
 				vexStatement.Collector,
@@ -229,7 +265,7 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				return nil, err
 			}
 			if len(collectedGhsa) != 1 {
-				return nil, gqlerror.Errorf(
+				return nil, backends.InvalidInputf(
 					"IngestVEXStatement :: ghsa argument must match one, found %d",
 					len(collectedGhsa))
 			}
@@ -238,6 +274,8 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 				collectedArt[0],
 				nil,
 				collectedGhsa[0],
+				status,
+				statement,
 				vexStatement.Justification,
 				vexStatement.Origin,
 				vexStatement.Collector,
@@ -245,12 +283,15 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 		}
 	}
 	// it should never reach here else it failed
-	return nil, gqlerror.Errorf("IngestVEXStatement failed")
+	return nil, backends.Internalf("IngestVEXStatement failed")
 }
 
 // Query CertifyPkg
 
 func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	if err := helper.ValidateStringMatch(certifyVEXStatementSpec.StringMatch); err != nil {
+		return nil, err
+	}
 
 	querySubjectAll, err := helper.ValidatePackageOrArtifactQueryInput(certifyVEXStatementSpec.Subject)
 	if err != nil {
@@ -269,16 +310,19 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatemen
 
 	var foundCertifyVEXStatement []*model.CertifyVEXStatement
 
-	for _, h := range c.certifyVEXStatement {
+	for i, h := range c.certifyVEXStatement {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		matchOrSkip := true
 
-		if certifyVEXStatementSpec.Justification != nil && h.Justification != *certifyVEXStatementSpec.Justification {
+		if noMatchString(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Justification, h.Justification) {
 			matchOrSkip = false
 		}
-		if certifyVEXStatementSpec.Collector != nil && h.Collector != *certifyVEXStatementSpec.Collector {
+		if noMatchString(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Collector, h.Collector) {
 			matchOrSkip = false
 		}
-		if certifyVEXStatementSpec.Origin != nil && h.Origin != *certifyVEXStatementSpec.Origin {
+		if noMatchString(certifyVEXStatementSpec.StringMatch, certifyVEXStatementSpec.Origin, h.Origin) {
 			matchOrSkip = false
 		}
 
@@ -0,0 +1,157 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestVEXStatementStatus(t *testing.T) {
+	since := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	status := func(s model.VexStatus) *model.VexStatus { return &s }
+	tests := []struct {
+		Name  string
+		Input model.VexStatementInputSpec
+		// ExpStatus is the status of the ingested VEX statement, ExpErr the
+		// fields named by the error when the input is invalid
+		ExpStatus model.VexStatus
+		ExpErr    []string
+	}{
+		{
+			Name:      "Not affected",
+			Input:     model.VexStatementInputSpec{Status: status(model.VexStatusNotAffected), Justification: "vulnerable_code_not_present", KnownSince: since},
+			ExpStatus: model.VexStatusNotAffected,
+		},
+		{
+			Name:      "Not affected if not set",
+			Input:     model.VexStatementInputSpec{Justification: "vulnerable_code_not_present", KnownSince: since},
+			ExpStatus: model.VexStatusNotAffected,
+		},
+		{
+			Name:   "Not affected without justification",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusNotAffected), KnownSince: since},
+			ExpErr: []string{"vexStatement.justification"},
+		},
+		{
+			Name:   "Not affected with a blank justification",
+			Input:  model.VexStatementInputSpec{Justification: " ", KnownSince: since},
+			ExpErr: []string{"vexStatement.justification"},
+		},
+		{
+			Name:      "Affected",
+			Input:     model.VexStatementInputSpec{Status: status(model.VexStatusAffected), Statement: ptrfrom.String("upgrade to 3.0.4"), KnownSince: since},
+			ExpStatus: model.VexStatusAffected,
+		},
+		{
+			Name:   "Affected without statement",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusAffected), KnownSince: since},
+			ExpErr: []string{"vexStatement.statement"},
+		},
+		{
+			Name:   "Affected with a blank statement",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusAffected), Statement: ptrfrom.String(""), KnownSince: since},
+			ExpErr: []string{"vexStatement.statement"},
+		},
+		{
+			Name:   "Affected with justification",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusAffected), Statement: ptrfrom.String("upgrade to 3.0.4"), Justification: "vulnerable_code_not_present", KnownSince: since},
+			ExpErr: []string{"vexStatement.justification"},
+		},
+		{
+			Name:      "Fixed",
+			Input:     model.VexStatementInputSpec{Status: status(model.VexStatusFixed), KnownSince: since},
+			ExpStatus: model.VexStatusFixed,
+		},
+		{
+			Name:   "Fixed with justification",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusFixed), Justification: "vulnerable_code_not_present", KnownSince: since},
+			ExpErr: []string{"vexStatement.justification"},
+		},
+		{
+			Name:      "Under investigation",
+			Input:     model.VexStatementInputSpec{Status: status(model.VexStatusUnderInvestigation), Statement: ptrfrom.String("triaging"), KnownSince: since},
+			ExpStatus: model.VexStatusUnderInvestigation,
+		},
+		{
+			Name:   "Invalid status",
+			Input:  model.VexStatementInputSpec{Status: status("EXPLOITABLE"), KnownSince: since},
+			ExpErr: []string{"vexStatement.status"},
+		},
+		{
+			Name:   "Zero knownSince",
+			Input:  model.VexStatementInputSpec{Justification: "vulnerable_code_not_present"},
+			ExpErr: []string{"vexStatement.knownSince"},
+		},
+		{
+			Name:   "All failures",
+			Input:  model.VexStatementInputSpec{Status: status(model.VexStatusAffected), Justification: "vulnerable_code_not_present"},
+			ExpErr: []string{"vexStatement.justification", "vexStatement.statement", "vexStatement.knownSince"},
+		},
+	}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			if _, err := b.IngestPackage(ctx, *p4); err != nil {
+				t.Fatalf("Could not ingest package: %v", err)
+			}
+			if _, err := b.IngestCve(ctx, c1); err != nil {
+				t.Fatalf("Could not ingest CVE: %v", err)
+			}
+			got, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: p4}, model.CveOrGhsaInput{Cve: c1}, test.Input)
+			if (err != nil) != (len(test.ExpErr) > 0) {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				if !errors.Is(err, backends.ErrInvalidInput) {
+					t.Errorf("expected an invalid input error, got: %v", err)
+				}
+				for _, field := range test.ExpErr {
+					if !strings.Contains(err.Error(), field) {
+						t.Errorf("expected the error to name %s, got: %v", field, err)
+					}
+				}
+				vexStatements, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if len(vexStatements) != 0 {
+					t.Errorf("expected no VEX statement to be ingested, got %d", len(vexStatements))
+				}
+				return
+			}
+			if got.Status != test.ExpStatus {
+				t.Errorf("expected status %v, got %v", test.ExpStatus, got.Status)
+			}
+			if test.Input.Statement != nil && got.Statement != *test.Input.Statement {
+				t.Errorf("expected statement %q, got %q", *test.Input.Statement, got.Statement)
+			}
+		})
+	}
+}
@@ -17,23 +17,23 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"fmt"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between packages and vulnerabilities (certifyVulnerability)
 type vulnerabilityList []*vulnerabilityLink
 type vulnerabilityLink struct {
-	id             uint32
-	packageID      uint32
-	osvID          uint32
-	cveID          uint32
-	ghsaID         uint32
+	id             string
+	packageID      string
+	osvID          string
+	cveID          string
+	ghsaID         string
+	noVulnID       string
 	timeScanned    time.Time
 	dbURI          string
 	dbVersion      string
@@ -41,9 +41,82 @@ type vulnerabilityLink struct {
 	scannerVersion string
 	origin         string
 	collector      string
+	scannerScore   *float64
 }
 
-func (n *vulnerabilityLink) getID() uint32 { return n.id }
+func (n *vulnerabilityLink) getID() string { return n.id }
+
+func (n *vulnerabilityLink) neighbors() []string {
+	return appendSetIDs([]string{n.packageID}, n.osvID, n.cveID, n.ghsaID, n.noVulnID)
+}
+
+// vulnerabilityLinkKey is the identity of a CertifyVuln: ingesting a link
+// with the same key returns the existing one.
+type vulnerabilityLinkKey struct {
+	packageID      string
+	osvID          string
+	cveID          string
+	ghsaID         string
+	noVulnID       string
+	timeScanned    time.Time
+	dbURI          string
+	dbVersion      string
+	scannerURI     string
+	scannerVersion string
+	origin         string
+	collector      string
+	scored         bool
+	scannerScore   float64
+}
+
+func (n *vulnerabilityLink) key() vulnerabilityLinkKey {
+	key := vulnerabilityLinkKey{
+		packageID:      n.packageID,
+		osvID:          n.osvID,
+		cveID:          n.cveID,
+		ghsaID:         n.ghsaID,
+		noVulnID:       n.noVulnID,
+		timeScanned:    n.timeScanned.UTC(),
+		dbURI:          n.dbURI,
+		dbVersion:      n.dbVersion,
+		scannerURI:     n.scannerURI,
+		scannerVersion: n.scannerVersion,
+		origin:         n.origin,
+		collector:      n.collector,
+	}
+	if n.scannerScore != nil {
+		key.scored = true
+		key.scannerScore = *n.scannerScore
+	}
+	return key
+}
+
+// Internal data: the noVuln node, shared by all the CertifyVuln attesting that
+// no vulnerability was found, so that they are told apart from packages never
+// scanned.
+type noVulnNode struct {
+	id              string
+	certifyVulnLink []string
+}
+
+func (n *noVulnNode) getID() string { return n.id }
+
+func (n *noVulnNode) neighbors() []string { return n.certifyVulnLink }
+
+// certifyVulnerability back edges
+func (n *noVulnNode) setVulnerabilityLink(id string) {
+	n.certifyVulnLink = append(n.certifyVulnLink, id)
+}
+func (n *noVulnNode) getVulnerabilityLink() []string { return n.certifyVulnLink }
+
+// getNoVulnID returns the ID of the noVuln node, creating it on first use.
+func (c *demoClient) getNoVulnID() string {
+	if c.noVuln == nil {
+		c.noVuln = &noVulnNode{id: c.getNextID()}
+		c.index[c.noVuln.id] = c.noVuln
+	}
+	return c.noVuln.id
+}
 
 // Ingest CertifyVuln
 func (c *demoClient) IngestVulnerability(ctx context.Context, packageArg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) (*model.CertifyVuln, error) {
@@ -52,24 +125,23 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, packageArg model.P
 	if err != nil {
 		return nil, err
 	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{&packageArg}, nil); err != nil {
+		return nil, err
+	}
 	packageID, err := getPackageIDFromInput(c, packageArg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion})
 	if err != nil {
 		return nil, err
 	}
 
-	var osvID uint32
-	var cveID uint32
-	var ghsaID uint32
-	vulnerabilityLinks := []uint32{}
+	var osvID string
+	var cveID string
+	var ghsaID string
+	var noVulnID string
 	if vulnerability.Osv != nil {
 		osvID, err = getOsvIDFromInput(c, *vulnerability.Osv)
 		if err != nil {
 			return nil, err
 		}
-		osvNode, ok := c.index[osvID].(*osvIDNode)
-		if ok {
-			vulnerabilityLinks = append(vulnerabilityLinks, osvNode.certifyVulnLink...)
-		}
 	}
 
 	if vulnerability.Cve != nil {
@@ -77,10 +149,6 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, packageArg model.P
 		if err != nil {
 			return nil, err
 		}
-		cveNode, ok := c.index[cveID].(*cveIDNode)
-		if ok {
-			vulnerabilityLinks = append(vulnerabilityLinks, cveNode.certifyVulnLink...)
-		}
 	}
 
 	if vulnerability.Ghsa != nil {
@@ -88,82 +156,58 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, packageArg model.P
 		if err != nil {
 			return nil, err
 		}
-		ghsaNode, ok := c.index[ghsaID].(*ghsaIDNode)
-		if ok {
-			vulnerabilityLinks = append(vulnerabilityLinks, ghsaNode.certifyVulnLink...)
-		}
 	}
 
-	packageVulns := []uint32{}
-	foundPkgVersionNode, ok := c.index[packageID].(*pkgVersionNode)
-	if ok {
-		packageVulns = append(packageVulns, foundPkgVersionNode.certifyVulnLink...)
+	if vulnerability.NoVuln != nil && *vulnerability.NoVuln {
+		noVulnID = c.getNoVulnID()
 	}
 
-	searchIDs := []uint32{}
-	if len(packageVulns) > len(vulnerabilityLinks) {
-		searchIDs = append(searchIDs, vulnerabilityLinks...)
-	} else {
-		searchIDs = append(searchIDs, packageVulns...)
+	collectedCertifyVulnLink := &vulnerabilityLink{
+		packageID:      packageID,
+		osvID:          osvID,
+		cveID:          cveID,
+		ghsaID:         ghsaID,
+		noVulnID:       noVulnID,
+		timeScanned:    certifyVuln.TimeScanned.UTC(),
+		dbURI:          certifyVuln.DbURI,
+		dbVersion:      certifyVuln.DbVersion,
+		scannerURI:     certifyVuln.ScannerURI,
+		scannerVersion: certifyVuln.ScannerVersion,
+		origin:         certifyVuln.Origin,
+		collector:      certifyVuln.Collector,
+		scannerScore:   certifyVuln.ScannerScore,
 	}
 
 	// Don't insert duplicates
-	duplicate := false
-	collectedCertifyVulnLink := vulnerabilityLink{}
-	for _, id := range searchIDs {
-		v, _ := c.certifyVulnByID(id)
-		vulnMatch := false
-		if osvID != 0 && osvID == v.osvID {
-			vulnMatch = true
-		}
-		if cveID != 0 && cveID == v.cveID {
-			vulnMatch = true
-		}
-		if ghsaID != 0 && ghsaID == v.ghsaID {
-			vulnMatch = true
-		}
-		if vulnMatch && packageID == v.packageID && certifyVuln.TimeScanned.UTC() == v.timeScanned && certifyVuln.DbURI == v.dbURI &&
-			certifyVuln.DbVersion == v.dbVersion && certifyVuln.ScannerURI == v.scannerURI && certifyVuln.ScannerVersion == v.scannerVersion &&
-			certifyVuln.Origin == v.origin && certifyVuln.Collector == v.collector {
-
-			collectedCertifyVulnLink = *v
-			duplicate = true
-			break
-		}
-	}
-	if !duplicate {
+	if existing, ok := c.certifyVulnKeys[collectedCertifyVulnLink.key()]; ok {
+		collectedCertifyVulnLink = existing
+		c.countIngestion(model.NodeTypeCertifyVuln, false)
+	} else {
 		// store the link
-		collectedCertifyVulnLink = vulnerabilityLink{
-			id:             c.getNextID(),
-			packageID:      packageID,
-			osvID:          osvID,
-			cveID:          cveID,
-			ghsaID:         ghsaID,
-			timeScanned:    certifyVuln.TimeScanned,
-			dbURI:          certifyVuln.DbURI,
-			dbVersion:      certifyVuln.DbVersion,
-			scannerURI:     certifyVuln.ScannerURI,
-			scannerVersion: certifyVuln.ScannerVersion,
-			origin:         certifyVuln.Origin,
-			collector:      certifyVuln.Collector,
-		}
-		c.index[collectedCertifyVulnLink.id] = &collectedCertifyVulnLink
-		c.vulnerabilities = append(c.vulnerabilities, &collectedCertifyVulnLink)
+		collectedCertifyVulnLink.id = c.getNextID()
+		c.index[collectedCertifyVulnLink.id] = collectedCertifyVulnLink
+		c.certifyVulnKeys[collectedCertifyVulnLink.key()] = collectedCertifyVulnLink
+		c.recordChange(collectedCertifyVulnLink.id, model.NodeTypeCertifyVuln)
+		c.vulnerabilities = append(c.vulnerabilities, collectedCertifyVulnLink)
 		// set the backlinks
 		c.index[packageID].(*pkgVersionNode).setVulnerabilityLink(collectedCertifyVulnLink.id)
-		if osvID != 0 {
+		if osvID != "" {
 			c.index[osvID].(*osvIDNode).setVulnerabilityLink(collectedCertifyVulnLink.id)
 		}
-		if cveID != 0 {
+		if cveID != "" {
 			c.index[cveID].(*cveIDNode).setVulnerabilityLink(collectedCertifyVulnLink.id)
 		}
-		if ghsaID != 0 {
+		if ghsaID != "" {
 			c.index[ghsaID].(*ghsaIDNode).setVulnerabilityLink(collectedCertifyVulnLink.id)
 		}
+		if noVulnID != "" {
+			c.noVuln.setVulnerabilityLink(collectedCertifyVulnLink.id)
+		}
+		c.detectCertifyVulnConflicts(collectedCertifyVulnLink)
 	}
 
 	// build return GraphQL type
-	builtCertifyVuln, err := buildCertifyVulnerability(c, &collectedCertifyVulnLink, nil, true)
+	builtCertifyVuln, err := buildCertifyVulnerability(c, collectedCertifyVulnLink, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -172,31 +216,151 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, packageArg model.P
 
 // Query CertifyVuln
 func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
-	out := []*model.CertifyVuln{}
+	page, err := c.CertifyVulnList(ctx, filter, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return page.CertifyVulns, nil
+}
+
+func (c *demoClient) CertifyVulnList(ctx context.Context, filter *model.CertifyVulnSpec, first *int, after *string) (*model.CertifyVulnConnection, error) {
+	if filter != nil {
+		if _, err := helper.ValidateOsvCveOrGhsaQueryInput(filter.Vulnerability); err != nil {
+			return nil, err
+		}
+	}
+	p, err := c.newPaginator("CertifyVuln", first, after)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		if err := checkSortDirection("CertifyVuln", filter.OrderByTimeScanned); err != nil {
+			return nil, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
+	out := &model.CertifyVulnConnection{CertifyVulns: []*model.CertifyVuln{}}
 
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		node, ok := c.index[uint32(id)]
+		node, ok := c.index[id]
 		if !ok {
-			return nil, gqlerror.Errorf("ID does not match existing node")
+			return nil, backends.NotFoundf("ID does not match existing node")
 		}
-		if link, ok := node.(*vulnerabilityLink); ok {
-			foundCertifyVuln, err := buildCertifyVulnerability(c, link, filter, true)
-			if err != nil {
-				return nil, err
+		link, ok := node.(*vulnerabilityLink)
+		if !ok {
+			return nil, backends.InvalidInputf("ID does not match expected node type for certifyVuln")
+		}
+		foundCertifyVuln, err := buildCertifyVulnerability(c, link, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		if p.add(link.id) {
+			out.CertifyVulns = append(out.CertifyVulns, foundCertifyVuln)
+		}
+		out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+		return out, nil
+	}
+
+	matches, err := c.certifyVulnMatches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil && filter.OrderByTimeScanned != nil {
+		dir := *filter.OrderByTimeScanned
+		less := func(a, b *vulnerabilityLink) bool {
+			return sortedBefore(dir, compareTimes(a.timeScanned, b.timeScanned), a.id, b.id)
+		}
+		if err := sortMatches(p, matches, less, c.certifyVulnByID); err != nil {
+			return nil, fmt.Errorf("CertifyVuln :: %w", err)
+		}
+	}
+
+	for i, link := range matches {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if !p.add(link.id) {
+			continue
+		}
+		foundCertifyVuln, err := buildCertifyVulnerability(c, link, filter, false)
+		if err != nil {
+			return nil, err
+		}
+		out.CertifyVulns = append(out.CertifyVulns, foundCertifyVuln)
+	}
+	out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+
+	return out, nil
+}
+
+// CertifyVulnCount returns the number of CertifyVuln matching filter, without
+// building them. It is not capped by the result limits.
+func (c *demoClient) CertifyVulnCount(ctx context.Context, filter *model.CertifyVulnSpec) (int, error) {
+	if filter != nil && filter.ID != nil {
+		page, err := c.CertifyVulnList(ctx, filter, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		return page.TotalCount, nil
+	}
+	if filter != nil {
+		if _, err := helper.ValidateOsvCveOrGhsaQueryInput(filter.Vulnerability); err != nil {
+			return 0, err
+		}
+		if err := checkSortDirection("CertifyVuln", filter.OrderByTimeScanned); err != nil {
+			return 0, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return 0, err
+		}
+	}
+	matches, err := c.certifyVulnMatches(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return len(matches), nil
+}
+
+// certifyVulnMatches returns the links matching filter, without an ID, in
+// ingestion order.
+func (c *demoClient) certifyVulnMatches(ctx context.Context, filter *model.CertifyVulnSpec) (vulnerabilityList, error) {
+	// If the package is specified, only search its backedges
+	// TODO if the vulnerability is specified, only search its backedges too
+	search := c.vulnerabilities
+	if filter != nil {
+		ids := c.packageBackedges(filter.Package, func(p pkgNameOrVersion) []string {
+			if version, ok := p.(*pkgVersionNode); ok {
+				return version.getVulnerabilityLink()
+			}
+			return nil
+		})
+		if ids != nil {
+			search = make(vulnerabilityList, 0, len(ids))
+			for _, id := range ids {
+				link, err := c.certifyVulnByID(id)
+				if err != nil {
+					return nil, backends.Internalf("CertifyVuln :: Bad certifyVuln id stored on existing package: %s", err)
+				}
+				search = append(search, link)
 			}
-			return []*model.CertifyVuln{foundCertifyVuln}, nil
-		} else {
-			return nil, gqlerror.Errorf("ID does not match expected node type for certifyVuln")
 		}
 	}
 
-	// TODO if any of the pkg/vulnerabilities are specified, ony search those backedges
-	for _, link := range c.vulnerabilities {
-		if filter != nil && filter.TimeScanned != nil && filter.TimeScanned.UTC() == link.timeScanned {
+	matches := vulnerabilityList{}
+	for i, link := range search {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatchTime(filter.TimeScanned, nil, nil, link.timeScanned) {
+			continue
+		}
+		if filter != nil && noMatchTimeBetween(filter.TimeScannedAfter, filter.TimeScannedBefore, link.timeScanned) {
 			continue
 		}
 		if filter != nil && noMatch(filter.DbURI, link.dbURI) {
@@ -211,24 +375,51 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 		if filter != nil && noMatch(filter.ScannerVersion, link.scannerVersion) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Collector, link.collector) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Origin, link.origin) {
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Collectors, link.collector) {
 			continue
 		}
-
-		foundCertifyVuln, err := buildCertifyVulnerability(c, link, filter, false)
-		if err != nil {
-			return nil, err
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
+			continue
 		}
-		if foundCertifyVuln == nil {
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Origins, link.origin) {
 			continue
 		}
-		out = append(out, foundCertifyVuln)
+		if c.matchCertifyVuln(link, filter) {
+			matches = append(matches, link)
+		}
 	}
+	return matches, nil
+}
 
-	return out, nil
+// matchCertifyVuln reports whether buildCertifyVulnerability would return a
+// result for link on query, without building it.
+func (c *demoClient) matchCertifyVuln(link *vulnerabilityLink, filter *model.CertifyVulnSpec) bool {
+	if filter == nil {
+		return c.matchPackage(link.packageID, nil)
+	}
+	if !c.matchPackage(link.packageID, filter.Package) {
+		return false
+	}
+	if filter.Vulnerability == nil {
+		return true
+	}
+	if filter.Vulnerability.NoVuln != nil {
+		return *filter.Vulnerability.NoVuln == (link.noVulnID != "")
+	}
+	switch {
+	case link.noVulnID != "":
+		return false
+	case link.osvID != "":
+		return filter.Vulnerability.Osv != nil && c.matchOsv(link.osvID, filter.Vulnerability.Osv)
+	case link.cveID != "":
+		return filter.Vulnerability.Cve != nil && c.matchCve(link.cveID, filter.Vulnerability.Cve)
+	case link.ghsaID != "":
+		return filter.Vulnerability.Ghsa != nil && c.matchGhsa(link.ghsaID, filter.Vulnerability.Ghsa)
+	}
+	return true
 }
 
 func buildCertifyVulnerability(c *demoClient, link *vulnerabilityLink, filter *model.CertifyVulnSpec, ingestOrIDProvided bool) (*model.CertifyVuln, error) {
@@ -249,39 +440,39 @@ func buildCertifyVulnerability(c *demoClient, link *vulnerabilityLink, filter *m
 		}
 	}
 
-	if filter != nil && filter.Vulnerability != nil {
-		if filter.Vulnerability.Osv != nil && link.osvID != 0 {
+	if filter != nil && filter.Vulnerability != nil && filter.Vulnerability.NoVuln == nil {
+		if filter.Vulnerability.Osv != nil && link.osvID != "" {
 			osv, err = c.buildOsvResponse(link.osvID, filter.Vulnerability.Osv)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if filter.Vulnerability.Cve != nil && link.cveID != 0 {
+		if filter.Vulnerability.Cve != nil && link.cveID != "" {
 			cve, err = c.buildCveResponse(link.cveID, filter.Vulnerability.Cve)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if filter.Vulnerability.Ghsa != nil && link.ghsaID != 0 {
+		if filter.Vulnerability.Ghsa != nil && link.ghsaID != "" {
 			ghsa, err = c.buildGhsaResponse(link.ghsaID, filter.Vulnerability.Ghsa)
 			if err != nil {
 				return nil, err
 			}
 		}
 	} else {
-		if link.osvID != 0 {
+		if link.osvID != "" {
 			osv, err = c.buildOsvResponse(link.osvID, nil)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if link.cveID != 0 {
+		if link.cveID != "" {
 			cve, err = c.buildCveResponse(link.cveID, nil)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if link.ghsaID != 0 {
+		if link.ghsaID != "" {
 			ghsa, err = c.buildGhsaResponse(link.ghsaID, nil)
 			if err != nil {
 				return nil, err
@@ -290,36 +481,39 @@ func buildCertifyVulnerability(c *demoClient, link *vulnerabilityLink, filter *m
 	}
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, backends.Internalf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	var vuln model.OsvCveOrGhsa
-	if link.osvID != 0 {
+	if link.osvID != "" {
 		if osv == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve osv via osvID")
+			return nil, backends.Internalf("failed to retrieve osv via osvID")
 		} else if osv == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
 		vuln = osv
 	}
-	if link.cveID != 0 {
+	if link.cveID != "" {
 		if cve == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve cve via cveID")
+			return nil, backends.Internalf("failed to retrieve cve via cveID")
 		} else if cve == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
 		vuln = cve
 	}
-	if link.ghsaID != 0 {
+	if link.ghsaID != "" {
 		if ghsa == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve ghsa via ghsaID")
+			return nil, backends.Internalf("failed to retrieve ghsa via ghsaID")
 		} else if ghsa == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
 		vuln = ghsa
 	}
+	if link.noVulnID != "" {
+		vuln = &model.NoVuln{ID: link.noVulnID}
+	}
 
 	metadata := &model.VulnerabilityMetaData{
 		TimeScanned:    link.timeScanned,
@@ -329,25 +523,38 @@ func buildCertifyVulnerability(c *demoClient, link *vulnerabilityLink, filter *m
 		ScannerVersion: link.scannerVersion,
 		Origin:         link.origin,
 		Collector:      link.collector,
+		ScannerScore:   link.scannerScore,
 	}
 
 	certifyVuln := model.CertifyVuln{
-		ID:            nodeID(link.id),
+		ID:            link.id,
 		Package:       p,
 		Vulnerability: vuln,
 		Metadata:      metadata,
 	}
+	if link.noVulnID == "" {
+		certifyVuln.EffectiveSeverity, err = c.effectiveSeverity(link.osvID, link.cveID, link.ghsaID, link.packageID, "", link.scannerScore)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter != nil && filter.IncludeSuccessors != nil && *filter.IncludeSuccessors {
+		certifyVuln.Successors, err = c.buildSuccessors(link.packageID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &certifyVuln, nil
 }
 
-func (c *demoClient) certifyVulnByID(id uint32) (*vulnerabilityLink, error) {
+func (c *demoClient) certifyVulnByID(id string) (*vulnerabilityLink, error) {
 	node, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find vulnerabilityLink")
+		return nil, backends.NotFoundf("could not find vulnerabilityLink")
 	}
 	link, ok := node.(*vulnerabilityLink)
 	if !ok {
-		return nil, errors.New("not an vulnerabilityLink")
+		return nil, backends.InvalidInputf("not an vulnerabilityLink")
 	}
 	return link, nil
 }
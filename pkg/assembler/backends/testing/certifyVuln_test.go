@@ -0,0 +1,324 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestCertifyVulnNoVuln(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p1, p2} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+
+	firstScan := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondScan := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	noVuln := model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(true)}
+	first, err := b.IngestVulnerability(ctx, *p1, noVuln, model.VulnerabilityMetaDataInput{TimeScanned: firstScan})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, ok := first.Vulnerability.(*model.NoVuln); !ok {
+		t.Errorf("expected a NoVuln vulnerability, got %T", first.Vulnerability)
+	}
+	again, err := b.IngestVulnerability(ctx, *p1, noVuln, model.VulnerabilityMetaDataInput{TimeScanned: firstScan})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("expected ingesting again to return ID %s, got %s", first.ID, again.ID)
+	}
+	other, err := b.IngestVulnerability(ctx, *p2, noVuln, model.VulnerabilityMetaDataInput{TimeScanned: firstScan})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if other.Vulnerability.(*model.NoVuln).ID != first.Vulnerability.(*model.NoVuln).ID {
+		t.Errorf("expected all CertifyVuln without vulnerability to share the noVuln node")
+	}
+	// a new scan finding a vulnerability keeps the previous one
+	rescan, err := b.IngestVulnerability(ctx, *p1, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{TimeScanned: secondScan})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+
+	tests := []struct {
+		Name   string
+		Filter *model.CertifyVulnSpec
+		Exp    []string
+		ExpErr bool
+	}{
+		{
+			Name:   "All scans of the package",
+			Filter: &model.CertifyVulnSpec{Package: &model.PkgSpec{Name: &p1.Name, Version: ptrfrom.String("")}},
+			Exp:    []string{first.ID, rescan.ID},
+		},
+		{
+			Name:   "No vulnerability found",
+			Filter: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{NoVuln: ptrfrom.Bool(true)}},
+			Exp:    []string{first.ID, other.ID},
+		},
+		{
+			Name:   "Any vulnerability found",
+			Filter: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{NoVuln: ptrfrom.Bool(false)}},
+			Exp:    []string{rescan.ID},
+		},
+		{
+			Name:   "CVE does not match noVuln",
+			Filter: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Cve: &model.CVESpec{}}},
+			Exp:    []string{rescan.ID},
+		},
+		{
+			Name:   "NoVuln and CVE",
+			Filter: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{NoVuln: ptrfrom.Bool(false), Cve: &model.CVESpec{}}},
+			ExpErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, test.Filter)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			gotIDs := map[string]bool{}
+			for _, v := range got {
+				gotIDs[v.ID] = true
+			}
+			if len(gotIDs) != len(test.Exp) {
+				t.Fatalf("expected %d results, got %d", len(test.Exp), len(gotIDs))
+			}
+			for _, id := range test.Exp {
+				if !gotIDs[id] {
+					t.Errorf("expected result %s, got %v", id, gotIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestCertifyVulnNoVulnInvalid(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p1); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	tests := []struct {
+		Name          string
+		Vulnerability model.OsvCveOrGhsaInput
+	}{
+		{
+			Name:          "False noVuln is no vulnerability",
+			Vulnerability: model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(false)},
+		},
+		{
+			Name:          "NoVuln and CVE",
+			Vulnerability: model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(true), Cve: c1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := b.IngestVulnerability(ctx, *p1, test.Vulnerability, model.VulnerabilityMetaDataInput{}); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+	if _, err := b.IngestSeverityOverride(ctx, model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(true)}, nil, model.SeverityOverrideInputSpec{Score: 5}); err == nil {
+		t.Errorf("expected an error overriding the severity of noVuln")
+	}
+}
+
+func TestCertifyVulnDuplicates(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	scanned := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+
+	first, err := b.IngestVulnerability(ctx, *p2, vuln, model.VulnerabilityMetaDataInput{TimeScanned: scanned, Origin: "scan.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	// the same scan time, in another time zone
+	again, err := b.IngestVulnerability(ctx, *p2, vuln, model.VulnerabilityMetaDataInput{TimeScanned: scanned.In(time.FixedZone("CEST", 2*60*60)), Origin: "scan.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("expected ingesting again to return ID %s, got %s", first.ID, again.ID)
+	}
+	other, err := b.IngestVulnerability(ctx, *p2, vuln, model.VulnerabilityMetaDataInput{TimeScanned: scanned, Origin: "other.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if other.ID == first.ID {
+		t.Errorf("expected a CertifyVuln from another origin to be a new node, got ID %s", other.ID)
+	}
+}
+
+func TestCertifyVulnTimeScanned(t *testing.T) {
+	cest := time.FixedZone("CEST", 2*60*60)
+	noon := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	// scanned in CEST, an hour before noon
+	morning := time.Date(2023, 4, 1, 13, 0, 0, 0, cest)
+	evening := time.Date(2023, 4, 1, 20, 0, 0, 0, time.UTC)
+	scans := map[string]time.Time{
+		"morning": morning,
+		"noon":    noon,
+		"evening": evening,
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		Name   string
+		Filter *model.CertifyVulnSpec
+		Exp    []string
+	}{
+		{
+			Name:   "No filter",
+			Filter: &model.CertifyVulnSpec{},
+			Exp:    []string{"evening", "evening", "morning", "noon"},
+		},
+		{
+			Name:   "Exact",
+			Filter: &model.CertifyVulnSpec{TimeScanned: ptr(noon)},
+			Exp:    []string{"noon"},
+		},
+		{
+			Name:   "Exact in another time zone",
+			Filter: &model.CertifyVulnSpec{TimeScanned: ptr(morning.UTC())},
+			Exp:    []string{"morning"},
+		},
+		{
+			Name:   "After is inclusive",
+			Filter: &model.CertifyVulnSpec{TimeScannedAfter: ptr(noon)},
+			Exp:    []string{"evening", "evening", "noon"},
+		},
+		{
+			Name:   "After in another time zone",
+			Filter: &model.CertifyVulnSpec{TimeScannedAfter: ptr(noon.In(cest))},
+			Exp:    []string{"evening", "evening", "noon"},
+		},
+		{
+			Name:   "Before is inclusive",
+			Filter: &model.CertifyVulnSpec{TimeScannedBefore: ptr(noon)},
+			Exp:    []string{"morning", "noon"},
+		},
+		{
+			Name:   "Range",
+			Filter: &model.CertifyVulnSpec{TimeScannedAfter: ptr(morning), TimeScannedBefore: ptr(noon)},
+			Exp:    []string{"morning", "noon"},
+		},
+		{
+			Name:   "Empty range",
+			Filter: &model.CertifyVulnSpec{TimeScannedAfter: ptr(evening), TimeScannedBefore: ptr(noon)},
+			Exp:    []string{},
+		},
+		{
+			Name: "Range of a package",
+			Filter: &model.CertifyVulnSpec{
+				Package:          &model.PkgSpec{Type: ptrfrom.String("pypi"), Name: ptrfrom.String("numpy")},
+				TimeScannedAfter: ptr(noon),
+			},
+			Exp: []string{"evening"},
+		},
+		{
+			Name: "Range of a vulnerability",
+			Filter: &model.CertifyVulnSpec{
+				Vulnerability:     &model.OsvCveOrGhsaSpec{Cve: &model.CVESpec{CveID: ptrfrom.String(c1.CveID)}},
+				TimeScannedBefore: ptr(noon),
+			},
+			Exp: []string{"morning", "noon"},
+		},
+	}
+
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p2, p5} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	for origin, scanned := range scans {
+		_, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{TimeScanned: scanned, Origin: origin})
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyVuln: %v", err)
+		}
+	}
+	_, err = b.IngestVulnerability(ctx, *p5, model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(true)}, model.VulnerabilityMetaDataInput{TimeScanned: evening, Origin: "evening"})
+	if err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.CertifyVuln(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			origins := []string{}
+			for _, certifyVuln := range got {
+				origins = append(origins, certifyVuln.Metadata.Origin)
+				if certifyVuln.Metadata.TimeScanned.Location() != time.UTC {
+					t.Errorf("timeScanned of %s is not in UTC: %v", certifyVuln.Metadata.Origin, certifyVuln.Metadata.TimeScanned)
+				}
+			}
+			sort.Strings(origins)
+			if diff := cmp.Diff(test.Exp, origins); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// defaultChangeRetention is the number of changes kept in the log if
+// DemoCredentials doesn't set ChangeRetention.
+const defaultChangeRetention = 100000
+
+const changeCursorPrefix = "change:"
+
+// Internal data: the log of ingested nodes, in ingestion order. Each node gets
+// the next sequence number when it is ingested. Only the last retention
+// entries are kept, so entries[0].seq is dropped+1 and sequence numbers are
+// contiguous.
+type changeLog struct {
+	seq       uint64
+	dropped   uint64
+	retention int
+	entries   []changeEntry
+}
+
+type changeEntry struct {
+	seq      uint64
+	id       string
+	nodeType model.NodeType
+	// node is set for the evidence that is stored without an ID, everything
+	// else is built from the index when queried.
+	node model.Nodes
+	// retracted is set once the node has been retracted, it is no longer
+	// returned.
+	retracted bool
+}
+
+// recordChange appends the node with the given ID to the change log, and
+// counts it as created in the stats and the metrics.
+func (c *demoClient) recordChange(id string, nodeType model.NodeType) {
+	c.changes.append(changeEntry{id: id, nodeType: nodeType})
+	c.countNode(nodeType, c.index[id], 1)
+	c.countIngestion(nodeType, true)
+}
+
+// recordChangedNode is recordChange for a node that is not in the index.
+func (c *demoClient) recordChangedNode(node model.Nodes, nodeType model.NodeType) {
+	c.changes.append(changeEntry{nodeType: nodeType, node: node})
+	c.countNode(nodeType, node, 1)
+	c.countIngestion(nodeType, true)
+}
+
+func (l *changeLog) append(e changeEntry) {
+	l.seq++
+	e.seq = l.seq
+	l.entries = append(l.entries, e)
+	if len(l.entries) > l.retention {
+		l.dropped = l.entries[0].seq
+		l.entries[0] = changeEntry{}
+		l.entries = l.entries[1:]
+	}
+}
+
+// encodeCursor returns the opaque cursor of position pos, the prefix telling
+// apart the cursors of different queries.
+func encodeCursor(prefix string, pos uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(prefix + strconv.FormatUint(pos, 10)))
+}
+
+func decodeCursor(prefix string, cursor string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	if !strings.HasPrefix(string(raw), prefix) {
+		return 0, backends.InvalidInputf("unknown cursor format")
+	}
+	return strconv.ParseUint(strings.TrimPrefix(string(raw), prefix), 10, 64)
+}
+
+// Query changes
+//
+// The cursor is the sequence number of the last change the consumer has seen.
+// If changes after it were dropped from the log the consumer missed them, so
+// it has to resync instead of silently skipping ahead.
+func (c *demoClient) Changes(ctx context.Context, after *string, types []model.NodeType, first *int) (*model.ChangeConnection, error) {
+	if first != nil && *first < 0 {
+		return nil, backends.InvalidInputf("changes :: first must not be negative")
+	}
+	pos := c.changes.dropped
+	if after != nil {
+		seq, err := decodeCursor(changeCursorPrefix, *after)
+		if err != nil {
+			return nil, backends.InvalidInputf("changes :: invalid cursor %s", err)
+		}
+		if seq > c.changes.seq {
+			return nil, backends.InvalidInputf("changes :: invalid cursor, it is ahead of the last change")
+		}
+		if seq < c.changes.dropped {
+			return nil, backends.InvalidInputf("changes :: cursor expired, full resync required")
+		}
+		pos = seq
+	}
+	var wanted map[model.NodeType]bool
+	if len(types) > 0 {
+		wanted = map[model.NodeType]bool{}
+		for _, t := range types {
+			wanted[t] = true
+		}
+	}
+
+	limiter := c.newResultLimiter("changes", first)
+	out := &model.ChangeConnection{Changes: []*model.Change{}}
+	for _, e := range c.changes.entries[pos-c.changes.dropped:] {
+		if e.retracted || (wanted != nil && !wanted[e.nodeType]) {
+			// skip over entries that don't match, as long as the
+			// page isn't complete, so that polling doesn't rescan them
+			if !out.HasNextPage {
+				pos = e.seq
+			}
+			continue
+		}
+		if first != nil && len(out.Changes) == *first {
+			out.HasNextPage = true
+			break
+		}
+		if limiter.full(len(out.Changes)) {
+			out.HasNextPage = true
+			limiter.skip()
+			continue
+		}
+
+		node, err := c.buildChangedNode(e)
+		if err != nil {
+			return nil, err
+		}
+		out.Changes = append(out.Changes, &model.Change{
+			Cursor: encodeCursor(changeCursorPrefix, e.seq),
+			Type:   e.nodeType,
+			Node:   node,
+		})
+		pos = e.seq
+	}
+	out.EndCursor = encodeCursor(changeCursorPrefix, pos)
+	limiter.record(ctx, len(out.Changes))
+	return out, nil
+}
+
+// buildChangedNode returns the node of a change log entry in its current
+// state.
+func (c *demoClient) buildChangedNode(e changeEntry) (model.Nodes, error) {
+	if e.node != nil {
+		return e.node, nil
+	}
+	node, err := c.buildNode(e.id)
+	if err != nil {
+		return nil, fmt.Errorf("changes :: %w", err)
+	}
+	return node, nil
+}
+
+// buildNode returns the node with the given ID, whatever its type.
+func (c *demoClient) buildNode(id string) (model.Nodes, error) {
+	var node model.Nodes
+	var err error
+	switch n := c.index[id].(type) {
+	case *artStruct:
+		node = convArtifact(n)
+	case *builderStruct:
+		node = convBuilder(n)
+	case *pkgNamespaceStruct, *pkgNameStruct, *pkgVersionStruct, *pkgVersionNode:
+		node, err = c.buildPackageResponse(id, nil)
+	case *srcNamespaceStruct, *srcNameStruct, *srcNameNode:
+		node, err = c.buildSourceResponse(id, nil)
+	case *osvNode, *osvIDNode:
+		node, err = c.buildOsvResponse(id, nil)
+	case *cveNode, *cveIDNode:
+		node, err = c.buildCveResponse(id, nil)
+	case *ghsaNode, *ghsaIDNode:
+		node, err = c.buildGhsaResponse(id, nil)
+	case *noVulnNode:
+		node = &model.NoVuln{ID: n.id}
+	case *scorecardLink:
+		node, err = buildScorecard(c, n, nil, true)
+	case *certifySignedStruct:
+		node, err = c.convCertifySigned(n)
+	case *vulnerabilityLink:
+		node, err = buildCertifyVulnerability(c, n, nil, true)
+	case *hasSLSAStruct:
+		node = c.convSLSA(n)
+	case *srcMapLink:
+		node, err = buildHasSourceAt(c, n, nil, true)
+	case *hashEqualStruct:
+		node = c.convHashEqual(n)
+	case *isDependencyLink:
+		node, err = buildIsDependency(c, n, nil, true)
+	case *isOccurrenceStruct:
+		node = c.convOccurrence(n)
+	case *equalVulnerabilityLink:
+		node, err = buildIsVulnerability(c, n, nil, true)
+	case *severityOverrideLink:
+		node, err = c.buildSeverityOverride(n, nil, true)
+	case *supersededByLink:
+		node, err = buildSupersededBy(c, n, nil, true)
+	default:
+		return nil, backends.Internalf("unexpected node type %T for ID %s", n, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -0,0 +1,182 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func changeTypes(conn *model.ChangeConnection) []model.NodeType {
+	var types []model.NodeType
+	for _, change := range conn.Changes {
+		types = append(types, change.Type)
+	}
+	return types
+}
+
+func TestChanges(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	poll := func(after *string, types []model.NodeType, first *int) *model.ChangeConnection {
+		t.Helper()
+		conn, err := b.Changes(ctx, after, types, first)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return conn
+	}
+	expect := func(conn *model.ChangeConnection, want []model.NodeType, hasNextPage bool) {
+		t.Helper()
+		if diff := cmp.Diff(want, changeTypes(conn), cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Unexpected changes. (-want +got):\n%s", diff)
+		}
+		if conn.HasNextPage != hasNextPage {
+			t.Errorf("Unexpected hasNextPage, want: %v, got: %v", hasNextPage, conn.HasNextPage)
+		}
+	}
+
+	conn := poll(nil, nil, nil)
+	expect(conn, nil, false)
+	start := conn.EndCursor
+
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	conn = poll(&start, nil, nil)
+	expect(conn, []model.NodeType{model.NodeTypeArtifact, model.NodeTypePackage}, false)
+	cursor := conn.EndCursor
+
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{Justification: "built"}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, a2); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Artifact: a2}, nil, model.CertifyBadInputSpec{Justification: "malware"}); err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	conn = poll(&cursor, nil, nil)
+	expect(conn, []model.NodeType{model.NodeTypeIsOccurrence, model.NodeTypeArtifact, model.NodeTypeCertifyBad}, false)
+	if o, ok := conn.Changes[0].Node.(*model.IsOccurrence); !ok || o.Justification != "built" {
+		t.Errorf("Unexpected node for IS_OCCURRENCE change: %#v", conn.Changes[0].Node)
+	}
+	if cb, ok := conn.Changes[2].Node.(*model.CertifyBad); !ok || cb.Justification != "malware" {
+		t.Errorf("Unexpected node for CERTIFY_BAD change: %#v", conn.Changes[2].Node)
+	}
+	if conn.EndCursor != conn.Changes[2].Cursor {
+		t.Errorf("Unexpected endCursor, want the cursor of the last change")
+	}
+	cursor = conn.EndCursor
+
+	// Ingesting existing nodes doesn't produce changes
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	conn = poll(&cursor, nil, nil)
+	expect(conn, nil, false)
+	if conn.EndCursor != cursor {
+		t.Errorf("Unexpected endCursor, want the cursor it was polled from")
+	}
+
+	// Paginate through a single type
+	artifacts := []model.NodeType{model.NodeTypeArtifact}
+	conn = poll(&start, artifacts, ptrfrom.Int(1))
+	expect(conn, artifacts, true)
+	conn = poll(&conn.EndCursor, artifacts, ptrfrom.Int(1))
+	expect(conn, artifacts, false)
+	if a, ok := conn.Changes[0].Node.(*model.Artifact); !ok || a.Algorithm != "sha1" {
+		t.Errorf("Unexpected node for ARTIFACT change: %#v", conn.Changes[0].Node)
+	}
+
+	// Filtered polls move past the changes that don't match
+	if _, err := b.IngestPackage(ctx, *p4); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	conn = poll(&cursor, []model.NodeType{model.NodeTypeCertifyBad}, nil)
+	expect(conn, nil, false)
+	conn = poll(&conn.EndCursor, nil, nil)
+	expect(conn, nil, false)
+}
+
+func TestChangesExpiry(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{ChangeRetention: 3})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingested := 0
+	ingest := func(n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			ingested++
+			digest := fmt.Sprintf("%064x", ingested)
+			if _, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: digest}); err != nil {
+				t.Fatalf("Could not ingest artifact: %v", err)
+			}
+		}
+	}
+	poll := func(after *string, want int) *model.ChangeConnection {
+		t.Helper()
+		conn, err := b.Changes(ctx, after, nil, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(conn.Changes) != want {
+			t.Errorf("Unexpected number of changes, want: %d, got: %d", want, len(conn.Changes))
+		}
+		return conn
+	}
+
+	ingest(2)
+	old := poll(nil, 2).EndCursor
+
+	// The oldest change is dropped, but not any after the cursor
+	ingest(2)
+	recent := poll(&old, 2).EndCursor
+
+	ingest(3)
+	if _, err := b.Changes(ctx, &old, nil, nil); err == nil || !strings.Contains(err.Error(), "cursor expired, full resync required") {
+		t.Errorf("Unexpected error for an expired cursor: %v", err)
+	}
+	poll(&recent, 3)
+
+	// After a resync, consumers start again from the oldest retained change
+	conn := poll(nil, 3)
+	if a, ok := conn.Changes[0].Node.(*model.Artifact); !ok || a.Digest != fmt.Sprintf("%064x", 5) {
+		t.Errorf("Unexpected oldest retained change: %#v", conn.Changes[0].Node)
+	}
+
+	for _, cursor := range []string{"garbage", conn.EndCursor + "x"} {
+		if _, err := b.Changes(ctx, &cursor, nil, nil); err == nil {
+			t.Errorf("Expected error for invalid cursor %q", cursor)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+const defaultDiffSampleSize = 10
+
+// finding is a piece of evidence reduced to what it states, so that the
+// evidence of two collectors can be compared.
+type finding struct {
+	key string
+	id  string
+}
+
+// Query collectorDiff
+//
+// The evidence of both collectors is reduced to keys, and the distinct keys
+// of each collector are compared. Only the sampled findings are built.
+func (c *demoClient) CollectorDiff(ctx context.Context, verb model.Verb, collectorA string, collectorB string, subject model.PkgSpec, sampleSize *int) (*model.CollectorDiff, error) {
+	if collectorA == collectorB {
+		return nil, backends.InvalidInputf("collectorDiff :: collectorA and collectorB must be different")
+	}
+	size := defaultDiffSampleSize
+	if sampleSize != nil {
+		if *sampleSize < 0 {
+			return nil, backends.InvalidInputf("collectorDiff :: sampleSize must not be negative")
+		}
+		size = *sampleSize
+	}
+
+	var findingsA, findingsB []finding
+	collect := func(collector string, f finding) {
+		switch collector {
+		case collectorA:
+			findingsA = append(findingsA, f)
+		case collectorB:
+			findingsB = append(findingsB, f)
+		}
+	}
+	switch verb {
+	case model.VerbCertifyVuln:
+		alias := c.vulnerabilityAliases()
+		for _, link := range c.vulnerabilities {
+			if (link.collector == collectorA || link.collector == collectorB) && c.matchPackage(link.packageID, &subject) {
+				vulnID := link.osvID
+				if link.cveID != "" {
+					vulnID = link.cveID
+				} else if link.ghsaID != "" {
+					vulnID = link.ghsaID
+				} else if link.noVulnID != "" {
+					vulnID = link.noVulnID
+				}
+				collect(link.collector, finding{key: fmt.Sprintf("%s/%s", link.packageID, alias(vulnID)), id: link.id})
+			}
+		}
+	case model.VerbIsDependency:
+		for _, link := range c.isDependencies {
+			if (link.collector == collectorA || link.collector == collectorB) && c.matchPackage(link.packageID, &subject) {
+				collect(link.collector, finding{key: fmt.Sprintf("%s/%s/%s", link.packageID, link.depPackageID, link.versionRange), id: link.id})
+			}
+		}
+	case model.VerbHasSourceAt:
+		for _, link := range c.hasSources {
+			if (link.collector == collectorA || link.collector == collectorB) && c.matchPackage(link.packageID, &subject) {
+				collect(link.collector, finding{key: fmt.Sprintf("%s/%s", link.packageID, link.sourceID), id: link.id})
+			}
+		}
+	case model.VerbIsOccurrence:
+		for _, o := range c.occurrences {
+			if o.pkg == "" {
+				continue
+			}
+			if (o.collector == collectorA || o.collector == collectorB) && c.matchPackage(o.pkg, &subject) {
+				collect(o.collector, finding{key: fmt.Sprintf("%s/%s", o.pkg, o.artifact), id: o.id})
+			}
+		}
+	default:
+		return nil, backends.InvalidInputf("collectorDiff :: unsupported verb %s", verb)
+	}
+
+	keysB := map[string]bool{}
+	for _, f := range findingsB {
+		keysB[f.key] = true
+	}
+	keysA := map[string]bool{}
+	onlyA, both, onlyB := c.newDiffPartition(size), c.newDiffPartition(size), c.newDiffPartition(size)
+	for _, f := range findingsA {
+		if keysA[f.key] {
+			continue
+		}
+		keysA[f.key] = true
+		if keysB[f.key] {
+			both.add(f)
+		} else {
+			onlyA.add(f)
+		}
+	}
+	for _, f := range findingsB {
+		if keysA[f.key] {
+			continue
+		}
+		// mark as seen so duplicates of collectorB are only counted once
+		keysA[f.key] = true
+		onlyB.add(f)
+	}
+
+	out := &model.CollectorDiff{}
+	var err error
+	if out.OnlyA, err = onlyA.build(); err != nil {
+		return nil, fmt.Errorf("collectorDiff :: %w", err)
+	}
+	if out.OnlyB, err = onlyB.build(); err != nil {
+		return nil, fmt.Errorf("collectorDiff :: %w", err)
+	}
+	if out.Both, err = both.build(); err != nil {
+		return nil, fmt.Errorf("collectorDiff :: %w", err)
+	}
+	return out, nil
+}
+
+type diffPartition struct {
+	c      *demoClient
+	size   int
+	count  int
+	sample []string
+}
+
+func (c *demoClient) newDiffPartition(size int) *diffPartition {
+	return &diffPartition{c: c, size: size}
+}
+
+func (p *diffPartition) add(f finding) {
+	p.count++
+	if len(p.sample) < p.size {
+		p.sample = append(p.sample, f.id)
+	}
+}
+
+func (p *diffPartition) build() (*model.CollectorDiffPartition, error) {
+	out := &model.CollectorDiffPartition{Count: p.count, Sample: []model.Nodes{}}
+	for _, id := range p.sample {
+		node, err := p.c.buildNode(id)
+		if err != nil {
+			return nil, err
+		}
+		out.Sample = append(out.Sample, node)
+	}
+	return out, nil
+}
+
+// vulnerabilityAliases returns a function mapping the ID of an OSV, CVE or
+// GHSA to the smallest ID among the vulnerabilities it is linked to, directly
+// or not, by IsVulnerability.
+func (c *demoClient) vulnerabilityAliases() func(string) string {
+	parent := map[string]string{}
+	var find func(string) string
+	find = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	union := func(a, b string) {
+		if a == "" || b == "" {
+			return
+		}
+		rootA, rootB := find(a), find(b)
+		if rootB < rootA {
+			rootA, rootB = rootB, rootA
+		}
+		if rootA != rootB {
+			parent[rootB] = rootA
+		}
+	}
+	for _, link := range c.equalVulnerabilities {
+		union(link.osvID, link.cveID)
+		union(link.osvID, link.ghsaID)
+	}
+	return find
+}
@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// diffSummary is a partition of a collectorDiff result, with the sampled
+// findings reduced to what identifies them in the tests.
+type diffSummary struct {
+	Count  int
+	Sample []string
+}
+
+func summarizeDiffPartition(p *model.CollectorDiffPartition) diffSummary {
+	s := diffSummary{Count: p.Count}
+	for _, node := range p.Sample {
+		switch n := node.(type) {
+		case *model.CertifyVuln:
+			name := n.Package.Namespaces[0].Names[0].Name + " "
+			switch v := n.Vulnerability.(type) {
+			case *model.Cve:
+				name += v.CveIds[0].CveID
+			case *model.Ghsa:
+				name += v.GhsaIds[0].GhsaID
+			case *model.Osv:
+				name += v.OsvIds[0].OsvID
+			}
+			s.Sample = append(s.Sample, name)
+		case *model.IsDependency:
+			s.Sample = append(s.Sample, n.Package.Namespaces[0].Names[0].Name+" "+n.VersionRange)
+		}
+	}
+	return s
+}
+
+func TestCollectorDiff(t *testing.T) {
+	ctx := context.Background()
+	c2 := &model.CVEInputSpec{Year: 2023, CveID: "CVE-2023-0286"}
+	c3 := &model.CVEInputSpec{Year: 2022, CveID: "CVE-2022-3602"}
+	osv := &model.OSVInputSpec{OsvID: "PYSEC-2022-1"}
+	ghsa := &model.GHSAInputSpec{GhsaID: "GHSA-h4gh-qq45-vh27"}
+	type vuln struct {
+		Pkg       *model.PkgInputSpec
+		Vuln      model.OsvCveOrGhsaInput
+		Collector string
+		Origin    string
+	}
+	type dep struct {
+		VersionRange string
+		Collector    string
+	}
+	vulns := []vuln{
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c1}, Collector: "trivy", Origin: "trivy-1.json"},
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c1}, Collector: "trivy", Origin: "trivy-2.json"},
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c2}, Collector: "trivy", Origin: "trivy-1.json"},
+		{Pkg: p4, Vuln: model.OsvCveOrGhsaInput{Osv: osv}, Collector: "trivy", Origin: "trivy-1.json"},
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c1}, Collector: "grype", Origin: "grype.json"},
+		{Pkg: p4, Vuln: model.OsvCveOrGhsaInput{Ghsa: ghsa}, Collector: "grype", Origin: "grype.json"},
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c3}, Collector: "grype", Origin: "grype.json"},
+		{Pkg: p2, Vuln: model.OsvCveOrGhsaInput{Cve: c3}, Collector: "osv-scanner", Origin: "osv.json"},
+	}
+	deps := []dep{
+		{VersionRange: "3.0.3", Collector: "trivy"},
+		{VersionRange: ">=3.0.0", Collector: "grype"},
+	}
+
+	tests := []struct {
+		Name       string
+		Verb       model.Verb
+		CollectorA string
+		CollectorB string
+		Subject    model.PkgSpec
+		SampleSize *int
+		ExpOnlyA   diffSummary
+		ExpOnlyB   diffSummary
+		ExpBoth    diffSummary
+		ExpErr     bool
+	}{
+		{
+			Name:       "Overlapping vulnerabilities",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "trivy",
+			CollectorB: "grype",
+			ExpOnlyA:   diffSummary{Count: 1, Sample: []string{"tensorflow cve-2023-0286"}},
+			ExpOnlyB:   diffSummary{Count: 1, Sample: []string{"tensorflow cve-2022-3602"}},
+			ExpBoth:    diffSummary{Count: 2, Sample: []string{"tensorflow cve-2019-13110", "openssl pysec-2022-1"}},
+		},
+		{
+			Name:       "Subject namespace",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "trivy",
+			CollectorB: "grype",
+			Subject:    model.PkgSpec{Type: ptrfrom.String("conan"), Namespace: ptrfrom.String("openssl.org")},
+			ExpBoth:    diffSummary{Count: 1, Sample: []string{"openssl pysec-2022-1"}},
+		},
+		{
+			Name:       "Sample size",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "grype",
+			CollectorB: "trivy",
+			SampleSize: ptrfrom.Int(1),
+			ExpOnlyA:   diffSummary{Count: 1, Sample: []string{"tensorflow cve-2022-3602"}},
+			ExpOnlyB:   diffSummary{Count: 1, Sample: []string{"tensorflow cve-2023-0286"}},
+			ExpBoth:    diffSummary{Count: 2, Sample: []string{"tensorflow cve-2019-13110"}},
+		},
+		{
+			Name:       "Disjoint dependencies",
+			Verb:       model.VerbIsDependency,
+			CollectorA: "trivy",
+			CollectorB: "grype",
+			ExpOnlyA:   diffSummary{Count: 1, Sample: []string{"tensorflow 3.0.3"}},
+			ExpOnlyB:   diffSummary{Count: 1, Sample: []string{"tensorflow >=3.0.0"}},
+		},
+		{
+			Name:       "Unknown collector",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "grype",
+			CollectorB: "snyk",
+			ExpOnlyA:   diffSummary{Count: 3, Sample: []string{"tensorflow cve-2019-13110", "openssl ghsa-h4gh-qq45-vh27", "tensorflow cve-2022-3602"}},
+		},
+		{
+			Name:       "Same collector",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "trivy",
+			CollectorB: "trivy",
+			ExpErr:     true,
+		},
+		{
+			Name:       "Negative sample size",
+			Verb:       model.VerbCertifyVuln,
+			CollectorA: "trivy",
+			CollectorB: "grype",
+			SampleSize: ptrfrom.Int(-1),
+			ExpErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			for _, p := range []*model.PkgInputSpec{p2, p4} {
+				if _, err := b.IngestPackage(ctx, *p); err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+			}
+			for _, c := range []*model.CVEInputSpec{c1, c2, c3} {
+				if _, err := b.IngestCve(ctx, c); err != nil {
+					t.Fatalf("Could not ingest cve: %v", err)
+				}
+			}
+			if _, err := b.IngestOsv(ctx, osv); err != nil {
+				t.Fatalf("Could not ingest osv: %v", err)
+			}
+			if _, err := b.IngestGhsa(ctx, ghsa); err != nil {
+				t.Fatalf("Could not ingest ghsa: %v", err)
+			}
+			if _, err := b.IngestIsVulnerability(ctx, *osv, model.CveOrGhsaInput{Ghsa: ghsa}, model.IsVulnerabilityInputSpec{}); err != nil {
+				t.Fatalf("Could not ingest isVulnerability: %v", err)
+			}
+			for _, v := range vulns {
+				if _, err := b.IngestVulnerability(ctx, *v.Pkg, v.Vuln, model.VulnerabilityMetaDataInput{Origin: v.Origin, Collector: v.Collector}); err != nil {
+					t.Fatalf("Could not ingest vulnerability: %v", err)
+				}
+			}
+			for _, d := range deps {
+				if _, err := b.IngestDependency(ctx, *p2, *p4, model.IsDependencyInputSpec{VersionRange: d.VersionRange, Collector: d.Collector}); err != nil {
+					t.Fatalf("Could not ingest dependency: %v", err)
+				}
+			}
+
+			got, err := b.CollectorDiff(ctx, test.Verb, test.CollectorA, test.CollectorB, test.Subject, test.SampleSize)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpOnlyA, summarizeDiffPartition(got.OnlyA), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected onlyA. (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpOnlyB, summarizeDiffPartition(got.OnlyB), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected onlyB. (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpBoth, summarizeDiffPartition(got.Both), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected both. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
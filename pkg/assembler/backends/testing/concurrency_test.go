@@ -0,0 +1,121 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TestConcurrentIngestion ingests from several goroutines while others query
+// the same namespace. Run it with -race to catch unguarded accesses.
+func TestConcurrentIngestion(t *testing.T) {
+	const writers = 8
+	const perWriter = 10
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p4); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var writing, reading sync.WaitGroup
+	done := make(chan struct{})
+	for w := 0; w < writers; w++ {
+		writing.Add(1)
+		go func(w int) {
+			defer writing.Done()
+			for i := 0; i < perWriter; i++ {
+				version := fmt.Sprintf("%d.%d.0", w, i)
+				pkg := model.PkgInputSpec{Type: "pypi", Name: "concurrency", Version: &version}
+				if _, err := b.IngestPackage(ctx, pkg); err != nil {
+					t.Errorf("Could not ingest package: %v", err)
+					return
+				}
+				if _, err := b.IngestHasSourceAt(ctx, pkg, specificVersion, *s1, model.HasSourceAtInputSpec{KnownSince: now, Justification: version}); err != nil {
+					t.Errorf("Could not ingest HasSourceAt: %v", err)
+				}
+				if _, err := b.IngestDependency(ctx, pkg, *p4, model.IsDependencyInputSpec{Justification: version}); err != nil {
+					t.Errorf("Could not ingest IsDependency: %v", err)
+				}
+				if _, err := b.IngestVulnerability(ctx, pkg, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{TimeScanned: now, DbVersion: version}); err != nil {
+					t.Errorf("Could not ingest CertifyVuln: %v", err)
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		reading.Add(1)
+		go func() {
+			defer reading.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if _, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{}); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				if _, err := b.IsDependency(ctx, &model.IsDependencySpec{}); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				if _, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{}); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				if _, err := b.Packages(ctx, &model.PkgSpec{}); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+		}()
+	}
+	writing.Wait()
+	close(done)
+	reading.Wait()
+
+	hasSourceAts, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(hasSourceAts) != writers*perWriter || len(deps) != writers*perWriter || len(vulns) != writers*perWriter {
+		t.Errorf("expected %d of each, got %d HasSourceAt, %d IsDependency, %d CertifyVuln",
+			writers*perWriter, len(hasSourceAts), len(deps), len(vulns))
+	}
+}
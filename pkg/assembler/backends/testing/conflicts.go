@@ -0,0 +1,605 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: the conflicts between evidence. They are detected when the
+// second piece of evidence is ingested, by looking up the evidence it could
+// contradict through the backlinks and the indexes below, so that querying
+// them doesn't need to join all the evidence.
+type conflictState struct {
+	patterns map[model.ConflictPattern]bool
+	// certifications are the CertifyBad and CertifyGood by the ID of the
+	// node they are attached to
+	certifications map[string][]model.ConflictEvidence
+	// vexStatements are the CertifyVEXStatement on packages by package
+	// version and vulnerability
+	vexStatements map[vexKey][]*model.CertifyVEXStatement
+	// repositories are the repositories each package name or version is
+	// mapped to by HasSourceAt, by package ID
+	repositories map[string][]*sourceRepository
+	list         []*conflictLink
+}
+
+// sourceRepository is a repository a package is mapped to, with the IDs of
+// the HasSourceAt to it in ingestion order. Sources that only differ by tag
+// or commit are the same repository.
+type sourceRepository struct {
+	namespaceID string
+	name        string
+	links       []string
+}
+
+type vexKey struct {
+	packageID       string
+	vulnerabilityID string
+}
+
+type conflictLink struct {
+	pattern    model.ConflictPattern
+	first      conflictSide
+	second     conflictSide
+	detectedAt time.Time
+}
+
+// conflictSide is one of the contradicting evidence: either a link in the
+// index, or a certification that is stored without an ID.
+type conflictSide struct {
+	id   string
+	node model.ConflictEvidence
+}
+
+func newConflictState(patterns []model.ConflictPattern) conflictState {
+	s := conflictState{
+		patterns:       map[model.ConflictPattern]bool{},
+		certifications: map[string][]model.ConflictEvidence{},
+		vexStatements:  map[vexKey][]*model.CertifyVEXStatement{},
+		repositories:   map[string][]*sourceRepository{},
+	}
+	for _, p := range patterns {
+		s.patterns[p] = true
+	}
+	return s
+}
+
+func (c *demoClient) addConflict(pattern model.ConflictPattern, first, second conflictSide) {
+	c.conflicts.list = append(c.conflicts.list, &conflictLink{
+		pattern:    pattern,
+		first:      first,
+		second:     second,
+		detectedAt: c.now().UTC(),
+	})
+}
+
+// detectCertifyConflicts records the conflicts between a CertifyBad or
+// CertifyGood that was just ingested and the opposite certifications of the
+// same subject whose validity windows overlap, see certificationWindows. As
+// the new certification ends the windows of the earlier ones of its
+// collector, it also resolves the conflicts that no longer overlap.
+func (c *demoClient) detectCertifyConflicts(subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certification model.ConflictEvidence) error {
+	if !c.conflicts.patterns[model.ConflictPatternCertifyBadGood] {
+		return nil
+	}
+	subjectID, err := c.certifySubjectID(subject, pkgMatchType)
+	if err != nil {
+		return err
+	}
+	existing := c.conflicts.certifications[subjectID]
+	for _, other := range existing {
+		if other == certification {
+			// duplicate, its conflicts are already recorded
+			return nil
+		}
+	}
+	before := certificationWindows(existing)
+	certifications := append(existing, certification)
+	after := certificationWindows(certifications)
+	added := after[len(existing)]
+	for i, other := range existing {
+		if after[i].good != added.good && after[i].overlaps(added) {
+			c.addConflict(model.ConflictPatternCertifyBadGood, conflictSide{node: other}, conflictSide{node: certification})
+		}
+	}
+	resolved := map[certificationPair]bool{}
+	for i := range existing {
+		if before[i] == after[i] {
+			continue
+		}
+		for j := range existing {
+			if after[j].good != after[i].good && before[i].overlaps(before[j]) && !after[i].overlaps(after[j]) {
+				resolved[certificationPair{existing[i], existing[j]}] = true
+				resolved[certificationPair{existing[j], existing[i]}] = true
+			}
+		}
+	}
+	if len(resolved) > 0 {
+		conflicts := c.conflicts.list[:0]
+		for _, conflict := range c.conflicts.list {
+			if conflict.pattern != model.ConflictPatternCertifyBadGood || !resolved[certificationPair{conflict.first.node, conflict.second.node}] {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+		c.conflicts.list = conflicts
+	}
+	c.conflicts.certifications[subjectID] = certifications
+	return nil
+}
+
+// redetectCertifyConflicts records the conflicts between the certifications
+// of a subject whose validity windows overlap and that are not recorded yet,
+// as after a retraction extends the windows of the certifications it
+// superseded.
+func (c *demoClient) redetectCertifyConflicts(certifications []model.ConflictEvidence, recorded map[certificationPair]bool) {
+	windows := certificationWindows(certifications)
+	for i := range certifications {
+		for j := i + 1; j < len(certifications); j++ {
+			pair := certificationPair{certifications[i], certifications[j]}
+			if windows[i].good == windows[j].good || !windows[i].overlaps(windows[j]) || recorded[pair] || recorded[certificationPair{pair.second, pair.first}] {
+				continue
+			}
+			c.addConflict(model.ConflictPatternCertifyBadGood, conflictSide{node: pair.first}, conflictSide{node: pair.second})
+		}
+	}
+}
+
+type certificationPair struct {
+	first  model.ConflictEvidence
+	second model.ConflictEvidence
+}
+
+// certificationWindow is the time a CertifyBad or CertifyGood holds: from
+// its knownSince until end, or indefinitely if open.
+type certificationWindow struct {
+	good      bool
+	collector string
+	start     time.Time
+	end       time.Time
+	open      bool
+}
+
+func (w certificationWindow) overlaps(other certificationWindow) bool {
+	return (w.open || other.start.Before(w.end)) && (other.open || w.start.Before(other.end))
+}
+
+// certificationWindows returns the validity windows of the certifications of
+// a subject. A certification holds from its knownSince until the next
+// certification of the subject from the same collector, which supersedes it
+// whether it is a CertifyBad or a CertifyGood.
+func certificationWindows(certifications []model.ConflictEvidence) []certificationWindow {
+	windows := make([]certificationWindow, len(certifications))
+	byCollector := map[string][]int{}
+	for i, certification := range certifications {
+		if good, ok := certification.(*model.CertifyGood); ok {
+			windows[i] = certificationWindow{good: true, collector: good.Collector, start: good.KnownSince}
+		} else {
+			bad := certification.(*model.CertifyBad)
+			windows[i] = certificationWindow{collector: bad.Collector, start: bad.KnownSince}
+		}
+		byCollector[windows[i].collector] = append(byCollector[windows[i].collector], i)
+	}
+	for _, ids := range byCollector {
+		sort.SliceStable(ids, func(a, b int) bool { return windows[ids[a]].start.Before(windows[ids[b]].start) })
+		end, open := time.Time{}, true
+		for n := len(ids) - 1; n >= 0; n-- {
+			w := &windows[ids[n]]
+			if n+1 < len(ids) && windows[ids[n+1]].start.After(w.start) {
+				end, open = windows[ids[n+1]].start, false
+			}
+			w.end, w.open = end, open
+		}
+	}
+	return windows
+}
+
+// certifySubjectID returns the ID of the package name or version, source name
+// or artifact a CertifyBad or CertifyGood is attached to.
+func (c *demoClient) certifySubjectID(subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags) (string, error) {
+	switch {
+	case subject.Package != nil:
+		return getPackageIDFromInput(c, *subject.Package, *pkgMatchType)
+	case subject.Source != nil:
+		return getSourceIDFromInput(c, *subject.Source)
+	default:
+		art, err := c.artifactByKey(subject.Artifact.Algorithm, subject.Artifact.Digest)
+		if err != nil {
+			return "", err
+		}
+		return art.id, nil
+	}
+}
+
+// detectVEXConflicts records the conflicts between a CertifyVEXStatement that
+// was just ingested and the CertifyVuln reporting the same package version as
+// affected by the same vulnerability. Only the VEX statements stating that the
+// package is not affected conflict with a CertifyVuln.
+func (c *demoClient) detectVEXConflicts(subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vex *model.CertifyVEXStatement) error {
+	// CertifyVuln is only attached to package versions
+	if !c.conflicts.patterns[model.ConflictPatternVexCertifyVuln] || subject.Package == nil || vex.Status != model.VexStatusNotAffected {
+		return nil
+	}
+	packageID, err := getPackageIDFromInput(c, *subject.Package, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion})
+	if err != nil {
+		return err
+	}
+	var vulnerabilityID string
+	if vulnerability.Cve != nil {
+		vulnerabilityID, err = getCveIDFromInput(c, *vulnerability.Cve)
+	} else {
+		vulnerabilityID, err = getGhsaIDFromInput(c, *vulnerability.Ghsa)
+	}
+	if err != nil {
+		return err
+	}
+
+	key := vexKey{packageID: packageID, vulnerabilityID: vulnerabilityID}
+	existing := c.conflicts.vexStatements[key]
+	for _, other := range existing {
+		if other == vex {
+			// duplicate, its conflicts are already recorded
+			return nil
+		}
+	}
+	if pkg, ok := c.index[packageID].(*pkgVersionNode); ok {
+		for _, linkID := range pkg.certifyVulnLink {
+			link, err := c.certifyVulnByID(linkID)
+			if err != nil {
+				return err
+			}
+			if link.cveID == vulnerabilityID || link.ghsaID == vulnerabilityID {
+				c.addConflict(model.ConflictPatternVexCertifyVuln, conflictSide{id: link.id}, conflictSide{node: vex})
+			}
+		}
+	}
+	c.conflicts.vexStatements[key] = append(existing, vex)
+	return nil
+}
+
+// detectCertifyVulnConflicts records the conflicts between a CertifyVuln that
+// was just ingested and the VEX statements on the same package version and
+// vulnerability.
+func (c *demoClient) detectCertifyVulnConflicts(link *vulnerabilityLink) {
+	if !c.conflicts.patterns[model.ConflictPatternVexCertifyVuln] {
+		return
+	}
+	// VEX statements don't refer to OSV
+	for _, vulnerabilityID := range []string{link.cveID, link.ghsaID} {
+		if vulnerabilityID == "" {
+			continue
+		}
+		for _, vex := range c.conflicts.vexStatements[vexKey{packageID: link.packageID, vulnerabilityID: vulnerabilityID}] {
+			c.addConflict(model.ConflictPatternVexCertifyVuln, conflictSide{node: vex}, conflictSide{id: link.id})
+		}
+	}
+}
+
+// detectHasSourceAtConflicts records the conflicts between a HasSourceAt that
+// was just ingested and the ones mapping the same package to another
+// repository. A single conflict is recorded per pair of repositories, between
+// the first HasSourceAt to each, so only the first HasSourceAt to a
+// repository can raise conflicts.
+func (c *demoClient) detectHasSourceAtConflicts(link *srcMapLink) {
+	if !c.conflicts.patterns[model.ConflictPatternHasSourceAtRepository] {
+		return
+	}
+	repo := c.indexHasSourceAt(link)
+	if len(repo.links) > 1 {
+		return
+	}
+	for _, other := range c.conflicts.repositories[link.packageID] {
+		if other != repo {
+			c.addConflict(model.ConflictPatternHasSourceAtRepository, conflictSide{id: other.links[0]}, conflictSide{id: link.id})
+		}
+	}
+}
+
+// indexHasSourceAt adds a HasSourceAt to the repositories of its package and
+// returns the repository it maps the package to.
+func (c *demoClient) indexHasSourceAt(link *srcMapLink) *sourceRepository {
+	src := c.index[link.sourceID].(*srcNameNode)
+	repos := c.conflicts.repositories[link.packageID]
+	for _, repo := range repos {
+		if repo.namespaceID == src.parent && repo.name == src.name {
+			repo.links = append(repo.links, link.id)
+			return repo
+		}
+	}
+	repo := &sourceRepository{namespaceID: src.parent, name: src.name, links: []string{link.id}}
+	c.conflicts.repositories[link.packageID] = append(repos, repo)
+	return repo
+}
+
+// unindexHasSourceAt removes a retracted HasSourceAt from the repositories of
+// its package and returns the repository it mapped the package to, which has
+// no links left if it was the last HasSourceAt to it.
+func (c *demoClient) unindexHasSourceAt(link *srcMapLink) *sourceRepository {
+	repos := c.conflicts.repositories[link.packageID]
+	for i, repo := range repos {
+		for _, id := range repo.links {
+			if id != link.id {
+				continue
+			}
+			repo.links = removeLink(repo.links, link.id)
+			if len(repo.links) == 0 {
+				c.conflicts.repositories[link.packageID] = append(repos[:i:i], repos[i+1:]...)
+			}
+			return repo
+		}
+	}
+	return nil
+}
+
+// Query conflicts
+
+func (c *demoClient) Conflicts(ctx context.Context, patterns []model.ConflictPattern) ([]*model.Conflict, error) {
+	var wanted map[model.ConflictPattern]bool
+	if len(patterns) > 0 {
+		wanted = map[model.ConflictPattern]bool{}
+		for _, p := range patterns {
+			wanted[p] = true
+		}
+	}
+
+	limiter := c.newResultLimiter("conflicts", nil)
+	out := []*model.Conflict{}
+	for _, conflict := range c.conflicts.list {
+		if wanted != nil && !wanted[conflict.pattern] {
+			continue
+		}
+		if limiter.full(len(out)) {
+			limiter.skip()
+			continue
+		}
+		first, err := c.buildConflictSide(conflict.first)
+		if err != nil {
+			return nil, fmt.Errorf("conflicts :: %w", err)
+		}
+		second, err := c.buildConflictSide(conflict.second)
+		if err != nil {
+			return nil, fmt.Errorf("conflicts :: %w", err)
+		}
+		out = append(out, &model.Conflict{
+			Pattern:    conflict.pattern,
+			First:      first,
+			Second:     second,
+			DetectedAt: conflict.detectedAt,
+		})
+	}
+	limiter.record(ctx, len(out))
+	return out, nil
+}
+
+func (c *demoClient) buildConflictSide(side conflictSide) (model.ConflictEvidence, error) {
+	if side.node != nil {
+		return side.node, nil
+	}
+	switch link := c.index[side.id].(type) {
+	case *vulnerabilityLink:
+		return buildCertifyVulnerability(c, link, nil, true)
+	case *srcMapLink:
+		return buildHasSourceAt(c, link, nil, true)
+	default:
+		return nil, backends.Internalf("unexpected node type %T for ID %s", link, side.id)
+	}
+}
+
+// Retract evidence
+
+// RetractEvidence removes the evidence ingested from origin that can be in
+// conflict, along with its backlinks, its conflicts and its entries in the
+// change log.
+func (c *demoClient) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	if origin == "" {
+		return 0, backends.InvalidInputf("retractEvidence :: origin must not be empty")
+	}
+	// the certifications stored without an ID, and the IDs of the links
+	retracted := map[any]bool{}
+	retractedIDs := map[string]bool{}
+	// the repositories of the retracted HasSourceAt, by ID
+	retractedRepos := map[string]*sourceRepository{}
+
+	certifyBad := c.certifyBad[:0]
+	for _, v := range c.certifyBad {
+		if v.Origin == origin {
+			retracted[v] = true
+			c.countNode(model.NodeTypeCertifyBad, v, -1)
+			continue
+		}
+		certifyBad = append(certifyBad, v)
+	}
+	c.certifyBad = certifyBad
+
+	certifyGood := c.certifyGood[:0]
+	for _, v := range c.certifyGood {
+		if v.Origin == origin {
+			retracted[v] = true
+			c.countNode(model.NodeTypeCertifyGood, v, -1)
+			continue
+		}
+		certifyGood = append(certifyGood, v)
+	}
+	c.certifyGood = certifyGood
+
+	certifyVEXStatement := c.certifyVEXStatement[:0]
+	for _, v := range c.certifyVEXStatement {
+		if v.Origin == origin {
+			retracted[v] = true
+			c.countNode(model.NodeTypeCertifyVexStatement, v, -1)
+			continue
+		}
+		certifyVEXStatement = append(certifyVEXStatement, v)
+	}
+	c.certifyVEXStatement = certifyVEXStatement
+
+	hasSources := c.hasSources[:0]
+	for _, link := range c.hasSources {
+		if link.origin != origin {
+			hasSources = append(hasSources, link)
+			continue
+		}
+		retractedIDs[link.id] = true
+		c.countNode(model.NodeTypeHasSourceAt, link, -1)
+		delete(c.index, link.id)
+		delete(c.hasSourceKeys, link.key())
+		switch p := c.index[link.packageID].(type) {
+		case *pkgVersionNode:
+			p.srcMapLink = removeLink(p.srcMapLink, link.id)
+		case *pkgVersionStruct:
+			p.srcMapLink = removeLink(p.srcMapLink, link.id)
+		}
+		src := c.index[link.sourceID].(*srcNameNode)
+		src.srcMapLink = removeLink(src.srcMapLink, link.id)
+		if repo := c.unindexHasSourceAt(link); repo != nil {
+			retractedRepos[link.id] = repo
+		}
+	}
+	c.hasSources = hasSources
+
+	vulnerabilities := c.vulnerabilities[:0]
+	for _, link := range c.vulnerabilities {
+		if link.origin != origin {
+			vulnerabilities = append(vulnerabilities, link)
+			continue
+		}
+		retractedIDs[link.id] = true
+		c.countNode(model.NodeTypeCertifyVuln, link, -1)
+		delete(c.index, link.id)
+		delete(c.certifyVulnKeys, link.key())
+		pkg := c.index[link.packageID].(*pkgVersionNode)
+		pkg.certifyVulnLink = removeLink(pkg.certifyVulnLink, link.id)
+		if link.osvID != "" {
+			osv := c.index[link.osvID].(*osvIDNode)
+			osv.certifyVulnLink = removeLink(osv.certifyVulnLink, link.id)
+		}
+		if link.cveID != "" {
+			cve := c.index[link.cveID].(*cveIDNode)
+			cve.certifyVulnLink = removeLink(cve.certifyVulnLink, link.id)
+		}
+		if link.ghsaID != "" {
+			ghsa := c.index[link.ghsaID].(*ghsaIDNode)
+			ghsa.certifyVulnLink = removeLink(ghsa.certifyVulnLink, link.id)
+		}
+		if link.noVulnID != "" {
+			c.noVuln.certifyVulnLink = removeLink(c.noVuln.certifyVulnLink, link.id)
+		}
+	}
+	c.vulnerabilities = vulnerabilities
+
+	// subjects whose certifications were retracted
+	var retractedSubjects []string
+	for id, certifications := range c.conflicts.certifications {
+		kept := certifications[:0]
+		for _, v := range certifications {
+			if !retracted[v] {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) < len(certifications) {
+			retractedSubjects = append(retractedSubjects, id)
+		}
+		c.conflicts.certifications[id] = kept
+	}
+	for key, vexStatements := range c.conflicts.vexStatements {
+		kept := vexStatements[:0]
+		for _, v := range vexStatements {
+			if !retracted[v] {
+				kept = append(kept, v)
+			}
+		}
+		c.conflicts.vexStatements[key] = kept
+	}
+	conflicts := c.conflicts.list[:0]
+	for _, conflict := range c.conflicts.list {
+		if conflict.pattern == model.ConflictPatternHasSourceAtRepository {
+			// the conflict is between repositories, it holds as long as
+			// both still have a HasSourceAt
+			if !conflict.first.replaceRetracted(retractedRepos) || !conflict.second.replaceRetracted(retractedRepos) {
+				continue
+			}
+			if conflict.first.id > conflict.second.id {
+				conflict.first, conflict.second = conflict.second, conflict.first
+			}
+		} else if conflict.first.retracted(retracted, retractedIDs) || conflict.second.retracted(retracted, retractedIDs) {
+			continue
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	c.conflicts.list = conflicts
+
+	// a retracted certification no longer ends the windows of the earlier
+	// ones of its collector
+	if len(retractedSubjects) > 0 {
+		recorded := map[certificationPair]bool{}
+		for _, conflict := range c.conflicts.list {
+			if conflict.pattern == model.ConflictPatternCertifyBadGood {
+				recorded[certificationPair{conflict.first.node, conflict.second.node}] = true
+			}
+		}
+		sort.Strings(retractedSubjects)
+		for _, id := range retractedSubjects {
+			c.redetectCertifyConflicts(c.conflicts.certifications[id], recorded)
+		}
+	}
+
+	for i := range c.changes.entries {
+		e := &c.changes.entries[i]
+		if (e.node != nil && retracted[e.node]) || (e.node == nil && retractedIDs[e.id]) {
+			e.retracted = true
+		}
+	}
+
+	return len(retracted) + len(retractedIDs), nil
+}
+
+func (s conflictSide) retracted(retracted map[any]bool, retractedIDs map[string]bool) bool {
+	if s.node != nil {
+		return retracted[s.node]
+	}
+	return retractedIDs[s.id]
+}
+
+// replaceRetracted replaces a retracted HasSourceAt by the first one left to
+// the same repository, and returns whether there is one.
+func (s *conflictSide) replaceRetracted(retractedRepos map[string]*sourceRepository) bool {
+	repo, ok := retractedRepos[s.id]
+	if !ok {
+		return true
+	}
+	if len(repo.links) == 0 {
+		return false
+	}
+	s.id = repo.links[0]
+	return true
+}
+
+// removeLink returns links without id.
+func removeLink(links []string, id string) []string {
+	kept := links[:0]
+	for _, l := range links {
+		if l != id {
+			kept = append(kept, l)
+		}
+	}
+	return kept
+}
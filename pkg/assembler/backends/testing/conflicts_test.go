@@ -0,0 +1,444 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var gh1 = &model.GHSAInputSpec{
+	GhsaID: "GHSA-h45f-rjvw-2rv2",
+}
+
+// conflictSummary describes a conflict by its pattern and the kind and
+// collector of both sides.
+func conflictSummary(conflict *model.Conflict) string {
+	side := func(evidence model.ConflictEvidence) string {
+		switch e := evidence.(type) {
+		case *model.CertifyBad:
+			return "CertifyBad/" + e.Collector
+		case *model.CertifyGood:
+			return "CertifyGood/" + e.Collector
+		case *model.CertifyVEXStatement:
+			return "CertifyVEXStatement/" + e.Collector
+		case *model.CertifyVuln:
+			return "CertifyVuln/" + e.Metadata.Collector
+		case *model.HasSourceAt:
+			return "HasSourceAt/" + e.Collector
+		}
+		return fmt.Sprintf("%T", evidence)
+	}
+	return fmt.Sprintf("%s %s %s", conflict.Pattern, side(conflict.First), side(conflict.Second))
+}
+
+func TestConflicts(t *testing.T) {
+	ctx := context.Background()
+	specificVersion := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	allVersions := &model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}
+	since := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	s1v1 := &model.SourceInputSpec{Type: "git", Namespace: "github.com/tensorflow", Name: "tensorflow", Tag: ptrfrom.String("v2.11.1")}
+	s2v1 := &model.SourceInputSpec{Type: "git", Namespace: "github.com/numpy", Name: "numpy", Tag: ptrfrom.String("v1.24.2")}
+
+	certifyBad := func(subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestCertifyBad(ctx, subject, pkgMatchType, model.CertifyBadInputSpec{Justification: "malware", Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	certifyGood := func(subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestCertifyGood(ctx, subject, pkgMatchType, model.CertifyGoodInputSpec{Justification: "vetted", Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	certifyBadSince := func(subject model.PackageSourceOrArtifactInput, collector string, knownSince time.Time) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestCertifyBad(ctx, subject, nil, model.CertifyBadInputSpec{Justification: "malware", KnownSince: &knownSince, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	certifyGoodSince := func(subject model.PackageSourceOrArtifactInput, collector string, knownSince time.Time) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestCertifyGood(ctx, subject, nil, model.CertifyGoodInputSpec{Justification: "vetted", KnownSince: &knownSince, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	vex := func(pkg *model.PkgInputSpec, vulnerability model.CveOrGhsaInput, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkg}, vulnerability, model.VexStatementInputSpec{Justification: "not in the execution path", KnownSince: since, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	affectedVEX := func(pkg *model.PkgInputSpec, vulnerability model.CveOrGhsaInput, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			affected := model.VexStatusAffected
+			_, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkg}, vulnerability, model.VexStatementInputSpec{Status: &affected, Statement: ptrfrom.String("upgrade"), KnownSince: since, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	certifyVuln := func(pkg *model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestVulnerability(ctx, *pkg, vulnerability, model.VulnerabilityMetaDataInput{TimeScanned: since, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+	hasSourceAt := func(pkg *model.PkgInputSpec, src *model.SourceInputSpec, collector string) func(backends.Backend) error {
+		return func(b backends.Backend) error {
+			_, err := b.IngestHasSourceAt(ctx, *pkg, *specificVersion, *src, model.HasSourceAtInputSpec{KnownSince: since, Origin: collector + ".json", Collector: collector})
+			return err
+		}
+	}
+
+	tests := []struct {
+		Name     string
+		Patterns []model.ConflictPattern
+		Ingests  []func(backends.Backend) error
+		Query    []model.ConflictPattern
+		Exp      []string
+		// Retract is the origin retracted after checking Exp, ExpRetracted
+		// the number of evidence removed and ExpAfter the conflicts left
+		Retract      string
+		ExpRetracted int
+		ExpAfter     []string
+	}{
+		{
+			Name: "CertifyBad and CertifyGood on the same subject",
+			Ingests: []func(backends.Backend) error{
+				certifyBad(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "review"),
+				certifyGood(model.PackageSourceOrArtifactInput{Package: p1}, allVersions, "review"),
+				certifyBad(model.PackageSourceOrArtifactInput{Source: s2}, nil, "scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Source: s2}, nil, "review"),
+				certifyGood(model.PackageSourceOrArtifactInput{Artifact: a1}, nil, "review"),
+				certifyBad(model.PackageSourceOrArtifactInput{Artifact: a1}, nil, "scanner"),
+				certifyBad(model.PackageSourceOrArtifactInput{Artifact: a2}, nil, "scanner"),
+				certifyBad(model.PackageSourceOrArtifactInput{Artifact: a2}, nil, "other-scanner"),
+			},
+			Exp: []string{
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/review",
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/review",
+				"CERTIFY_BAD_GOOD CertifyGood/review CertifyBad/scanner",
+			},
+		},
+		{
+			Name: "CertifyBad and CertifyGood known since different times",
+			Ingests: []func(backends.Backend) error{
+				// the later scan supersedes the earlier one
+				certifyBadSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "scanner", since),
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "scanner", since.Add(time.Hour)),
+				// both still hold
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Source: s2}, "review", since),
+				certifyBadSince(model.PackageSourceOrArtifactInput{Source: s2}, "scanner", since.Add(time.Hour).In(time.FixedZone("CET", 3600))),
+			},
+			Exp: []string{
+				"CERTIFY_BAD_GOOD CertifyGood/review CertifyBad/scanner",
+			},
+		},
+		{
+			Name: "CertifyBad superseded after the conflict",
+			Ingests: []func(backends.Backend) error{
+				certifyBadSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "scanner", since),
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "review", since.Add(2*time.Hour)),
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a2}, "review", since.Add(2*time.Hour)),
+				certifyBadSince(model.PackageSourceOrArtifactInput{Artifact: a2}, "scanner", since),
+				// the scanner clears a1 before the review, another scanner
+				// doesn't supersede the scan of a2
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "scanner", since.Add(time.Hour)),
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a2}, "other-scanner", since.Add(time.Hour)),
+			},
+			Exp: []string{
+				"CERTIFY_BAD_GOOD CertifyGood/review CertifyBad/scanner",
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/other-scanner",
+			},
+		},
+		{
+			Name: "VEX not affected and CertifyVuln",
+			Ingests: []func(backends.Backend) error{
+				vex(p2, model.CveOrGhsaInput{Cve: c1}, "vendor"),
+				certifyVuln(p2, model.OsvCveOrGhsaInput{Cve: c1}, "scanner"),
+				certifyVuln(p2, model.OsvCveOrGhsaInput{Ghsa: gh1}, "scanner"),
+				vex(p2, model.CveOrGhsaInput{Ghsa: gh1}, "vendor"),
+				certifyVuln(p4, model.OsvCveOrGhsaInput{Cve: c1}, "scanner"),
+				certifyVuln(p2, model.OsvCveOrGhsaInput{Osv: &model.OSVInputSpec{OsvID: "CVE-2019-13110"}}, "scanner"),
+				// agrees with the CertifyVuln
+				affectedVEX(p2, model.CveOrGhsaInput{Cve: c1}, "advisory"),
+			},
+			Exp: []string{
+				"VEX_CERTIFY_VULN CertifyVEXStatement/vendor CertifyVuln/scanner",
+				"VEX_CERTIFY_VULN CertifyVuln/scanner CertifyVEXStatement/vendor",
+			},
+		},
+		{
+			Name: "HasSourceAt to different repositories",
+			Ingests: []func(backends.Backend) error{
+				hasSourceAt(p2, s1, "sbom"),
+				hasSourceAt(p2, s1v1, "slsa"),
+				hasSourceAt(p2, s2, "typosquat"),
+				hasSourceAt(p2, s2v1, "typosquat-tag"),
+				hasSourceAt(p5, s2, "sbom"),
+			},
+			Exp: []string{
+				"HAS_SOURCE_AT_REPOSITORY HasSourceAt/sbom HasSourceAt/typosquat",
+			},
+		},
+		{
+			Name:     "Patterns detected",
+			Patterns: []model.ConflictPattern{model.ConflictPatternHasSourceAtRepository},
+			Ingests: []func(backends.Backend) error{
+				certifyBad(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "review"),
+				hasSourceAt(p2, s1, "sbom"),
+				hasSourceAt(p2, s2, "typosquat"),
+			},
+			Exp: []string{
+				"HAS_SOURCE_AT_REPOSITORY HasSourceAt/sbom HasSourceAt/typosquat",
+			},
+		},
+		{
+			Name: "Patterns queried",
+			Ingests: []func(backends.Backend) error{
+				certifyBad(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "review"),
+				hasSourceAt(p2, s1, "sbom"),
+				hasSourceAt(p2, s2, "typosquat"),
+			},
+			Query: []model.ConflictPattern{model.ConflictPatternCertifyBadGood},
+			Exp: []string{
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/review",
+			},
+		},
+		{
+			Name: "Retracting a CertifyBad",
+			Ingests: []func(backends.Backend) error{
+				certifyBad(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Package: p2}, specificVersion, "review"),
+				certifyBad(model.PackageSourceOrArtifactInput{Artifact: a1}, nil, "other-scanner"),
+				certifyGood(model.PackageSourceOrArtifactInput{Artifact: a1}, nil, "review"),
+			},
+			Exp: []string{
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/review",
+				"CERTIFY_BAD_GOOD CertifyBad/other-scanner CertifyGood/review",
+			},
+			Retract:      "scanner.json",
+			ExpRetracted: 1,
+			ExpAfter: []string{
+				"CERTIFY_BAD_GOOD CertifyBad/other-scanner CertifyGood/review",
+			},
+		},
+		{
+			Name: "Retracting a superseding certification",
+			Ingests: []func(backends.Backend) error{
+				certifyBadSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "scanner", since),
+				certifyGoodSince(model.PackageSourceOrArtifactInput{Artifact: a1}, "review", since.Add(2*time.Hour)),
+				func(b backends.Backend) error {
+					knownSince := since.Add(time.Hour)
+					_, err := b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Artifact: a1}, nil, model.CertifyGoodInputSpec{Justification: "false positive", KnownSince: &knownSince, Origin: "rescan.json", Collector: "scanner"})
+					return err
+				},
+			},
+			Exp:          []string{},
+			Retract:      "rescan.json",
+			ExpRetracted: 1,
+			ExpAfter: []string{
+				"CERTIFY_BAD_GOOD CertifyBad/scanner CertifyGood/review",
+			},
+		},
+		{
+			Name: "Retracting a CertifyVuln",
+			Ingests: []func(backends.Backend) error{
+				vex(p2, model.CveOrGhsaInput{Cve: c1}, "vendor"),
+				certifyVuln(p2, model.OsvCveOrGhsaInput{Cve: c1}, "scanner"),
+				certifyVuln(p2, model.OsvCveOrGhsaInput{Ghsa: gh1}, "scanner"),
+			},
+			Exp: []string{
+				"VEX_CERTIFY_VULN CertifyVEXStatement/vendor CertifyVuln/scanner",
+			},
+			Retract:      "scanner.json",
+			ExpRetracted: 2,
+			ExpAfter:     []string{},
+		},
+		{
+			Name: "Retracting a HasSourceAt",
+			Ingests: []func(backends.Backend) error{
+				hasSourceAt(p2, s1, "sbom"),
+				hasSourceAt(p2, s2, "typosquat"),
+			},
+			Exp: []string{
+				"HAS_SOURCE_AT_REPOSITORY HasSourceAt/sbom HasSourceAt/typosquat",
+			},
+			Retract:      "typosquat.json",
+			ExpRetracted: 1,
+			ExpAfter:     []string{},
+		},
+		{
+			Name: "Retracting the first HasSourceAt to a repository",
+			Ingests: []func(backends.Backend) error{
+				hasSourceAt(p2, s1, "sbom"),
+				hasSourceAt(p2, s2, "typosquat"),
+				hasSourceAt(p2, s1v1, "slsa"),
+			},
+			Exp: []string{
+				"HAS_SOURCE_AT_REPOSITORY HasSourceAt/sbom HasSourceAt/typosquat",
+			},
+			Retract:      "sbom.json",
+			ExpRetracted: 1,
+			ExpAfter: []string{
+				"HAS_SOURCE_AT_REPOSITORY HasSourceAt/typosquat HasSourceAt/slsa",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{ConflictPatterns: test.Patterns})
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			for _, p := range []*model.PkgInputSpec{p1, p2, p4, p5} {
+				if _, err := b.IngestPackage(ctx, *p); err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+			}
+			for _, s := range []*model.SourceInputSpec{s1, s1v1, s2, s2v1} {
+				if _, err := b.IngestSource(ctx, *s); err != nil {
+					t.Fatalf("Could not ingest source: %v", err)
+				}
+			}
+			for _, a := range []*model.ArtifactInputSpec{a1, a2} {
+				if _, err := b.IngestArtifact(ctx, a); err != nil {
+					t.Fatalf("Could not ingest artifact: %v", err)
+				}
+			}
+			if _, err := b.IngestCve(ctx, c1); err != nil {
+				t.Fatalf("Could not ingest CVE: %v", err)
+			}
+			if _, err := b.IngestGhsa(ctx, gh1); err != nil {
+				t.Fatalf("Could not ingest GHSA: %v", err)
+			}
+			if _, err := b.IngestOsv(ctx, &model.OSVInputSpec{OsvID: "CVE-2019-13110"}); err != nil {
+				t.Fatalf("Could not ingest OSV: %v", err)
+			}
+			for _, ingest := range test.Ingests {
+				if err := ingest(b); err != nil {
+					t.Fatalf("Could not ingest evidence: %v", err)
+				}
+			}
+
+			check := func(exp []string) {
+				t.Helper()
+				conflicts, err := b.Conflicts(ctx, test.Query)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				got := []string{}
+				for _, conflict := range conflicts {
+					got = append(got, conflictSummary(conflict))
+				}
+				if diff := cmp.Diff(exp, got); diff != "" {
+					t.Errorf("Unexpected conflicts (-want +got):\n%s", diff)
+				}
+			}
+			check(test.Exp)
+			if test.Retract == "" {
+				return
+			}
+
+			retracted, err := b.RetractEvidence(ctx, test.Retract)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if retracted != test.ExpRetracted {
+				t.Errorf("expected %d evidence retracted, got %d", test.ExpRetracted, retracted)
+			}
+			check(test.ExpAfter)
+
+			// the retracted evidence is gone, and ingesting it again
+			// raises the conflicts again
+			origin := test.Retract
+			bad, err := b.CertifyBad(ctx, &model.CertifyBadSpec{Origin: &origin})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{Origin: &origin})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			hasSourceAts, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Origin: &origin})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(bad)+len(vulns)+len(hasSourceAts) != 0 {
+				t.Errorf("retracted evidence is still returned: %v CertifyBad, %v CertifyVuln, %v HasSourceAt", len(bad), len(vulns), len(hasSourceAts))
+			}
+			for _, ingest := range test.Ingests {
+				if err := ingest(b); err != nil {
+					t.Fatalf("Could not ingest evidence: %v", err)
+				}
+			}
+			conflicts, err := b.Conflicts(ctx, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(conflicts) < len(test.Exp) {
+				t.Errorf("expected the conflicts to be raised again, got %d", len(conflicts))
+			}
+		})
+	}
+}
+
+func TestRetractEvidenceChanges(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	subject := model.PackageSourceOrArtifactInput{Artifact: a1}
+	if _, err := b.IngestCertifyBad(ctx, subject, nil, model.CertifyBadInputSpec{Justification: "malware", Origin: "bad.json", Collector: "scanner"}); err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+	if _, err := b.IngestCertifyGood(ctx, subject, nil, model.CertifyGoodInputSpec{Justification: "vetted", Origin: "good.json", Collector: "review"}); err != nil {
+		t.Fatalf("Could not ingest CertifyGood: %v", err)
+	}
+	if _, err := b.RetractEvidence(ctx, ""); err == nil {
+		t.Errorf("expected an error retracting an empty origin")
+	}
+	if _, err := b.RetractEvidence(ctx, "bad.json"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	conn, err := b.Changes(ctx, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var got []model.NodeType
+	for _, change := range conn.Changes {
+		got = append(got, change.Type)
+	}
+	if diff := cmp.Diff([]model.NodeType{model.NodeTypeArtifact, model.NodeTypeCertifyGood}, got); diff != "" {
+		t.Errorf("Unexpected changes (-want +got):\n%s", diff)
+	}
+}
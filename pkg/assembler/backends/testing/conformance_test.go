@@ -0,0 +1,34 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/conformance"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.RunSuite(t, func() backends.Backend {
+		b, err := inmem.GetEmptyBackend(nil)
+		if err != nil {
+			t.Fatalf("Could not instantiate testing backend: %v", err)
+		}
+		return b
+	})
+}
@@ -0,0 +1,246 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TestCountsMatchQueries ingests random evidence and checks that the count
+// queries agree with the length of the list queries for random filters.
+func TestCountsMatchQueries(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	pick := func(values ...string) string { return values[rnd.Intn(len(values))] }
+	// maybe returns a filter on one of values or, one time in three, no filter.
+	// The values are expected to include some which were not ingested.
+	maybe := func(values ...string) *string {
+		if rnd.Intn(3) == 0 {
+			return nil
+		}
+		return ptrfrom.String(pick(values...))
+	}
+	day := func(d int) time.Time { return time.Date(2023, 4, d, 0, 0, 0, 0, time.UTC) }
+
+	pkgs := []*model.PkgInputSpec{p1, p2, p4, p5}
+	for _, p := range pkgs {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	srcs := []*model.SourceInputSpec{s1, s2}
+	for _, s := range srcs {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	if _, err := b.IngestGhsa(ctx, gh1); err != nil {
+		t.Fatalf("Could not ingest GHSA: %v", err)
+	}
+	vulns := []model.OsvCveOrGhsaInput{{Cve: c1}, {Ghsa: gh1}, {NoVuln: ptrfrom.Bool(true)}}
+
+	for i := 0; i < 200; i++ {
+		p := pkgs[rnd.Intn(len(pkgs))]
+		matchFlags := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+		if p.Version == nil {
+			matchFlags.Pkg = model.PkgMatchTypeAllVersions
+		}
+		_, err := b.IngestHasSourceAt(ctx, *p, matchFlags, *srcs[rnd.Intn(len(srcs))], model.HasSourceAtInputSpec{
+			KnownSince:    day(1 + rnd.Intn(5)),
+			Justification: pick("j1", "j2"),
+			Origin:        pick("o1", "o2"),
+			Collector:     pick("c1", "c2"),
+		})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+		if p.Version != nil {
+			_, err = b.IngestVulnerability(ctx, *p, vulns[rnd.Intn(len(vulns))], model.VulnerabilityMetaDataInput{
+				TimeScanned: day(1 + rnd.Intn(5)),
+				DbURI:       pick("db1", "db2"),
+				ScannerURI:  pick("scanner1", "scanner2"),
+				Origin:      pick("o1", "o2"),
+				Collector:   pick("c1", "c2"),
+			})
+			if err != nil {
+				t.Fatalf("Could not ingest CertifyVuln: %v", err)
+			}
+			_, err = b.IngestDependency(ctx, *p, *pkgs[rnd.Intn(len(pkgs))], model.IsDependencyInputSpec{
+				VersionRange:  pick(">=1.0", "<2.0"),
+				Justification: pick("j1", "j2"),
+				Origin:        pick("o1", "o2"),
+				Collector:     pick("c1", "c2"),
+			})
+			if err != nil {
+				t.Fatalf("Could not ingest IsDependency: %v", err)
+			}
+		}
+	}
+
+	pkgSpec := func() *model.PkgSpec {
+		if rnd.Intn(2) == 0 {
+			return nil
+		}
+		return &model.PkgSpec{
+			Type:    maybe("pypi", "conan", "npm"),
+			Name:    maybe("tensorflow", "numpy", "openssl", "left-pad"),
+			Version: maybe("2.11.1", "1.24.0", "0.0.1"),
+		}
+	}
+	srcSpec := func() *model.SourceSpec {
+		if rnd.Intn(2) == 0 {
+			return nil
+		}
+		return &model.SourceSpec{Name: maybe("tensorflow", "numpy", "left-pad")}
+	}
+	timeSpec := func() *time.Time {
+		if rnd.Intn(2) == 0 {
+			return nil
+		}
+		t := day(rnd.Intn(7))
+		return &t
+	}
+
+	// the number of filters with results, to check the filters are not all
+	// too narrow to be meaningful
+	nonEmpty := map[string]int{}
+	for i := 0; i < 200; i++ {
+		hasSourceAtSpec := &model.HasSourceAtSpec{
+			Package:          pkgSpec(),
+			Source:           srcSpec(),
+			KnownSinceAfter:  timeSpec(),
+			KnownSinceBefore: timeSpec(),
+			Justification:    maybe("j1", "j2", "j3"),
+			Origin:           maybe("o1", "o2", "o3"),
+			Collector:        maybe("c1", "c2", "c3"),
+		}
+		hasSourceAts, err := b.HasSourceAt(ctx, hasSourceAtSpec)
+		if err != nil {
+			t.Fatalf("Unexpected HasSourceAt error: %v", err)
+		}
+		count, err := b.HasSourceAtCount(ctx, hasSourceAtSpec)
+		if err != nil {
+			t.Fatalf("Unexpected HasSourceAtCount error: %v", err)
+		}
+		if len(hasSourceAts) > 0 {
+			nonEmpty["HasSourceAt"]++
+		}
+		if count != len(hasSourceAts) {
+			t.Errorf("HasSourceAtCount(%+v) = %d, HasSourceAt returned %d", hasSourceAtSpec, count, len(hasSourceAts))
+		}
+
+		certifyVulnSpec := &model.CertifyVulnSpec{
+			Package:    pkgSpec(),
+			DbURI:      maybe("db1", "db2", "db3"),
+			ScannerURI: maybe("scanner1", "scanner2", "scanner3"),
+			Origin:     maybe("o1", "o2", "o3"),
+			Collector:  maybe("c1", "c2", "c3"),
+		}
+		switch rnd.Intn(4) {
+		case 0:
+			certifyVulnSpec.Vulnerability = &model.OsvCveOrGhsaSpec{Cve: &model.CVESpec{CveID: ptrfrom.String(c1.CveID)}}
+		case 1:
+			certifyVulnSpec.Vulnerability = &model.OsvCveOrGhsaSpec{NoVuln: ptrfrom.Bool(rnd.Intn(2) == 0)}
+		}
+		certifyVulns, err := b.CertifyVuln(ctx, certifyVulnSpec)
+		if err != nil {
+			t.Fatalf("Unexpected CertifyVuln error: %v", err)
+		}
+		count, err = b.CertifyVulnCount(ctx, certifyVulnSpec)
+		if err != nil {
+			t.Fatalf("Unexpected CertifyVulnCount error: %v", err)
+		}
+		if len(certifyVulns) > 0 {
+			nonEmpty["CertifyVuln"]++
+		}
+		if count != len(certifyVulns) {
+			t.Errorf("CertifyVulnCount(%+v) = %d, CertifyVuln returned %d", certifyVulnSpec, count, len(certifyVulns))
+		}
+
+		isDependencySpec := &model.IsDependencySpec{
+			Package:       pkgSpec(),
+			VersionRange:  maybe(">=1.0", "<2.0", "*"),
+			Justification: maybe("j1", "j2", "j3"),
+			Origin:        maybe("o1", "o2", "o3"),
+			Collector:     maybe("c1", "c2", "c3"),
+		}
+		if rnd.Intn(2) == 0 {
+			isDependencySpec.DependentPackage = &model.PkgNameSpec{
+				Type: maybe("pypi", "conan", "npm"),
+				Name: maybe("tensorflow", "numpy", "openssl", "left-pad"),
+			}
+		}
+		isDependencies, err := b.IsDependency(ctx, isDependencySpec)
+		if err != nil {
+			t.Fatalf("Unexpected IsDependency error: %v", err)
+		}
+		count, err = b.IsDependencyCount(ctx, isDependencySpec)
+		if err != nil {
+			t.Fatalf("Unexpected IsDependencyCount error: %v", err)
+		}
+		if len(isDependencies) > 0 {
+			nonEmpty["IsDependency"]++
+		}
+		if count != len(isDependencies) {
+			t.Errorf("IsDependencyCount(%+v) = %d, IsDependency returned %d", isDependencySpec, count, len(isDependencies))
+		}
+	}
+	for _, query := range []string{"HasSourceAt", "CertifyVuln", "IsDependency"} {
+		if nonEmpty[query] < 20 {
+			t.Errorf("only %d random filters of %s had results", nonEmpty[query], query)
+		}
+	}
+}
+
+func TestCountByID(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+
+	count, err := b.HasSourceAtCount(ctx, &model.HasSourceAtSpec{ID: &hasSourceAt.ID})
+	if err != nil || count != 1 {
+		t.Errorf("expected a count of 1 by ID, got %d, error: %v", count, err)
+	}
+	if _, err := b.HasSourceAtCount(ctx, &model.HasSourceAtSpec{ID: ptrfrom.String("not an ID")}); err == nil {
+		t.Errorf("expected an error counting by an invalid ID")
+	}
+}
@@ -18,11 +18,11 @@ package testing
 import (
 	"context"
 	"log"
-	"strconv"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func registerAllCVE(client *demoClient) {
@@ -55,36 +55,59 @@ func registerAllCVE(client *demoClient) {
 // Internal data: osv
 type cveMap map[int]*cveNode
 type cveNode struct {
-	id     uint32
+	id     string
 	year   int
 	cveIDs cveIDMap
 }
 type cveIDMap map[string]*cveIDNode
 type cveIDNode struct {
-	id              uint32
-	parent          uint32
-	cveID           string
-	certifyVulnLink []uint32
-	equalVulnLink   []uint32
+	id                   string
+	parent               string
+	cveID                string
+	certifyVulnLink      []string
+	equalVulnLink        []string
+	severityOverrideLink []string
 }
 
-func (n *cveIDNode) getID() uint32 { return n.id }
-func (n *cveNode) getID() uint32   { return n.id }
+func (n *cveIDNode) getID() string { return n.id }
+func (n *cveNode) getID() string   { return n.id }
+
+func (n *cveIDNode) neighbors() []string {
+	return appendIDs([]string{n.parent}, n.certifyVulnLink, n.equalVulnLink, n.severityOverrideLink)
+}
+
+func (n *cveNode) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.cveIDs {
+		ids = append(ids, child.id)
+	}
+	return sortedIDs(ids)
+}
 
 // certifyVulnerability back edges
-func (n *cveIDNode) setVulnerabilityLink(id uint32) {
+func (n *cveIDNode) setVulnerabilityLink(id string) {
 	n.certifyVulnLink = append(n.certifyVulnLink, id)
 }
-func (n *cveIDNode) getVulnerabilityLink() []uint32 { return n.certifyVulnLink }
+func (n *cveIDNode) getVulnerabilityLink() []string { return n.certifyVulnLink }
 
 // isVulnerability back edges
-func (n *cveIDNode) setEqualVulnLink(id uint32) {
+func (n *cveIDNode) setEqualVulnLink(id string) {
 	n.equalVulnLink = append(n.equalVulnLink, id)
 }
-func (n *cveIDNode) gettEqualVulnLink() []uint32 { return n.equalVulnLink }
+func (n *cveIDNode) gettEqualVulnLink() []string { return n.equalVulnLink }
+
+// severityOverride back edges
+func (n *cveIDNode) setSeverityOverrideLink(id string) {
+	n.severityOverrideLink = append(n.severityOverrideLink, id)
+}
+func (n *cveIDNode) getSeverityOverrideLink() []string { return n.severityOverrideLink }
 
 // Ingest CVE
 func (c *demoClient) IngestCve(ctx context.Context, input *model.CVEInputSpec) (*model.Cve, error) {
+	cveID, err := helper.CanonicalCveInput(input.Year, input.CveID)
+	if err != nil {
+		return nil, err
+	}
 	cveStruct, hasCve := c.cves[input.Year]
 	if !hasCve {
 		cveStruct = &cveNode{
@@ -96,7 +119,6 @@ func (c *demoClient) IngestCve(ctx context.Context, input *model.CVEInputSpec) (
 		c.cves[input.Year] = cveStruct
 	}
 	cveIDs := cveStruct.cveIDs
-	cveID := strings.ToLower(input.CveID)
 
 	cveIDStruct, hasCveID := cveIDs[cveID]
 	if !hasCveID {
@@ -106,7 +128,10 @@ func (c *demoClient) IngestCve(ctx context.Context, input *model.CVEInputSpec) (
 			cveID:  cveID,
 		}
 		c.index[cveIDStruct.id] = cveIDStruct
+		c.recordChange(cveIDStruct.id, model.NodeTypeCve)
 		cveIDs[cveID] = cveIDStruct
+	} else {
+		c.countIngestion(model.NodeTypeCve, false)
 	}
 
 	// build return GraphQL type
@@ -115,12 +140,17 @@ func (c *demoClient) IngestCve(ctx context.Context, input *model.CVEInputSpec) (
 
 // Query CVE
 func (c *demoClient) Cve(ctx context.Context, filter *model.CVESpec) ([]*model.Cve, error) {
+	if filter != nil && filter.CveID != nil {
+		if _, err := helper.CanonicalCveID(*filter.CveID); err != nil {
+			return nil, err
+		}
+	}
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		osv, err := c.buildCveResponse(uint32(id), filter)
+		osv, err := c.buildCveResponse(id, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -134,7 +164,7 @@ func (c *demoClient) Cve(ctx context.Context, filter *model.CVESpec) ([]*model.C
 			cveIDList := buildCveID(foundCveNode, filter)
 			if len(cveIDList) > 0 {
 				out = append(out, &model.Cve{
-					ID:     nodeID(foundCveNode.id),
+					ID:     foundCveNode.id,
 					Year:   foundCveNode.year,
 					CveIds: cveIDList,
 				})
@@ -145,7 +175,7 @@ func (c *demoClient) Cve(ctx context.Context, filter *model.CVESpec) ([]*model.C
 			cveIDList := buildCveID(cveNode, filter)
 			if len(cveIDList) > 0 {
 				out = append(out, &model.Cve{
-					ID:     nodeID(cveNode.id),
+					ID:     cveNode.id,
 					Year:   cveNode.year,
 					CveIds: cveIDList,
 				})
@@ -158,17 +188,17 @@ func (c *demoClient) Cve(ctx context.Context, filter *model.CVESpec) ([]*model.C
 func buildCveID(foundCveNode *cveNode, filter *model.CVESpec) []*model.CVEId {
 	cveIDList := []*model.CVEId{}
 	if filter != nil && filter.CveID != nil {
-		cveIDNode, hasCveIDNode := foundCveNode.cveIDs[strings.ToLower(*filter.CveID)]
+		cveIDNode, hasCveIDNode := foundCveNode.cveIDs[helper.NormalizeVulnerabilityID(*filter.CveID)]
 		if hasCveIDNode {
 			cveIDList = append(cveIDList, &model.CVEId{
-				ID:    nodeID(cveIDNode.id),
+				ID:    cveIDNode.id,
 				CveID: cveIDNode.cveID,
 			})
 		}
 	} else {
 		for _, cveIDNode := range foundCveNode.cveIDs {
 			cveIDList = append(cveIDList, &model.CVEId{
-				ID:    nodeID(cveIDNode.id),
+				ID:    cveIDNode.id,
 				CveID: cveIDNode.cveID,
 			})
 		}
@@ -178,29 +208,29 @@ func buildCveID(foundCveNode *cveNode, filter *model.CVESpec) []*model.CVEId {
 
 // Builds a model.Cve to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
-func (c *demoClient) buildCveResponse(id uint32, filter *model.CVESpec) (*model.Cve, error) {
+func (c *demoClient) buildCveResponse(id string, filter *model.CVESpec) (*model.Cve, error) {
 	if filter != nil && filter.ID != nil {
-		filteredID, err := strconv.Atoi(*filter.ID)
+		filteredID, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		if uint32(filteredID) != id {
+		if filteredID != id {
 			return nil, nil
 		}
 	}
 
 	node, ok := c.index[id]
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match existing node")
+		return nil, backends.NotFoundf("ID does not match existing node")
 	}
 
 	cveIDList := []*model.CVEId{}
 	if cveIDNode, ok := node.(*cveIDNode); ok {
-		if filter != nil && noMatch(toLower(filter.CveID), cveIDNode.cveID) {
+		if filter != nil && noMatch(normalizeVulnID(filter.CveID), cveIDNode.cveID) {
 			return nil, nil
 		}
 		cveIDList = append(cveIDList, &model.CVEId{
-			ID:    nodeID(cveIDNode.id),
+			ID:    cveIDNode.id,
 			CveID: cveIDNode.cveID,
 		})
 		node = c.index[cveIDNode.parent]
@@ -208,27 +238,45 @@ func (c *demoClient) buildCveResponse(id uint32, filter *model.CVESpec) (*model.
 
 	cveNode, ok := node.(*cveNode)
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match expected node type for cve root")
+		return nil, backends.InvalidInputf("ID does not match expected node type for cve root")
 	}
 	s := model.Cve{
-		ID:     nodeID(cveNode.id),
+		ID:     cveNode.id,
 		Year:   cveNode.year,
 		CveIds: cveIDList,
 	}
 	return &s, nil
 }
 
-func getCveIDFromInput(c *demoClient, input model.CVEInputSpec) (uint32, error) {
+// matchCve reports whether buildCveResponse would return a cve for id and
+// filter, without building it.
+func (c *demoClient) matchCve(id string, filter *model.CVESpec) bool {
+	if filter == nil {
+		return true
+	}
+	if !filterMatchesID(filter.ID, id) {
+		return false
+	}
+	if cveIDNode, ok := c.index[id].(*cveIDNode); ok {
+		return !noMatch(normalizeVulnID(filter.CveID), cveIDNode.cveID)
+	}
+	return false
+}
+
+func getCveIDFromInput(c *demoClient, input model.CVEInputSpec) (string, error) {
+	cveID, err := helper.CanonicalCveInput(input.Year, input.CveID)
+	if err != nil {
+		return "", err
+	}
 	cveStruct, hasCve := c.cves[input.Year]
 	if !hasCve {
-		return 0, gqlerror.Errorf("cve year \"%d\" not found", input.Year)
+		return "", backends.NotFoundf("cve year \"%d\" not found", input.Year)
 	}
 	cveIDs := cveStruct.cveIDs
-	cveID := strings.ToLower(input.CveID)
 
 	cveIDStruct, hasCveID := cveIDs[cveID]
 	if !hasCveID {
-		return 0, gqlerror.Errorf("cve id \"%s\" not found", input.CveID)
+		return "", backends.NotFoundf("cve id \"%s\" not found", input.CveID)
 	}
 
 	return cveIDStruct.id, nil
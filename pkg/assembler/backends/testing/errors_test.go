@@ -0,0 +1,157 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// unknownID is a well formed ID that the testing backend never hands out.
+const unknownID = "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+
+func TestErrorKinds(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	pkg, err := b.IngestPackage(ctx, *p2)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	pkgID := pkg.Namespaces[0].Names[0].Versions[0].ID
+
+	tests := []struct {
+		Name    string
+		Call    func() error
+		ExpKind error
+	}{
+		{
+			Name: "Query by unknown ID",
+			Call: func() error {
+				_, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: ptrfrom.String(unknownID)})
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Query by numeric ID",
+			Call: func() error {
+				_, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: ptrfrom.String("1000000")})
+				return err
+			},
+			ExpKind: backends.ErrInvalidInput,
+		},
+		{
+			Name: "Query by malformed ID",
+			Call: func() error {
+				_, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: ptrfrom.String("tensorflow")})
+				return err
+			},
+			ExpKind: backends.ErrInvalidInput,
+		},
+		{
+			Name: "Query by ID of another node type",
+			Call: func() error {
+				_, err := b.IsDependency(ctx, &model.IsDependencySpec{ID: &hasSourceAt.ID})
+				return err
+			},
+			ExpKind: backends.ErrInvalidInput,
+		},
+		{
+			Name: "Ingest with unknown package",
+			Call: func() error {
+				_, err := b.IngestHasSourceAt(ctx, *p4, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{})
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Ingest with unknown source",
+			Call: func() error {
+				_, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s2, model.HasSourceAtInputSpec{})
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Ingest with unknown artifact",
+			Call: func() error {
+				_, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a2, model.IsOccurrenceInputSpec{})
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Ingest in a batch with unknown package",
+			Call: func() error {
+				_, err := b.IngestHasSourceAts(ctx, []*model.PkgInputSpec{p2, p4}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+					[]*model.SourceInputSpec{s1, s1}, []*model.HasSourceAtInputSpec{{}, {}})
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Ingest with two subjects",
+			Call: func() error {
+				_, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2, Source: s1}, *a1, model.IsOccurrenceInputSpec{})
+				return err
+			},
+			ExpKind: backends.ErrInvalidInput,
+		},
+		{
+			Name: "Neighbors of unknown node",
+			Call: func() error {
+				_, err := b.Neighbors(ctx, unknownID)
+				return err
+			},
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name: "Path from malformed ID",
+			Call: func() error {
+				_, err := b.Path(ctx, "tensorflow", pkgID, 10)
+				return err
+			},
+			ExpKind: backends.ErrInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Call()
+			if !errors.Is(err, test.ExpKind) {
+				t.Errorf("expected error of kind %v, got: %v", test.ExpKind, err)
+			}
+		})
+	}
+}
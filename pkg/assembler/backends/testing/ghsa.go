@@ -18,11 +18,11 @@ package testing
 import (
 	"context"
 	"log"
-	"strconv"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // TODO: convert to unit test
@@ -49,36 +49,59 @@ const ghsa string = "ghsa"
 // Internal data: osv
 type ghsaMap map[string]*ghsaNode
 type ghsaNode struct {
-	id      uint32
+	id      string
 	typeKey string
 	ghsaIDs ghsaIDMap
 }
 type ghsaIDMap map[string]*ghsaIDNode
 type ghsaIDNode struct {
-	id              uint32
-	parent          uint32
-	ghsaID          string
-	certifyVulnLink []uint32
-	equalVulnLink   []uint32
+	id                   string
+	parent               string
+	ghsaID               string
+	certifyVulnLink      []string
+	equalVulnLink        []string
+	severityOverrideLink []string
 }
 
-func (n *ghsaIDNode) getID() uint32 { return n.id }
-func (n *ghsaNode) getID() uint32   { return n.id }
+func (n *ghsaIDNode) getID() string { return n.id }
+func (n *ghsaNode) getID() string   { return n.id }
+
+func (n *ghsaIDNode) neighbors() []string {
+	return appendIDs([]string{n.parent}, n.certifyVulnLink, n.equalVulnLink, n.severityOverrideLink)
+}
+
+func (n *ghsaNode) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.ghsaIDs {
+		ids = append(ids, child.id)
+	}
+	return sortedIDs(ids)
+}
 
 // certifyVulnerability back edges
-func (n *ghsaIDNode) setVulnerabilityLink(id uint32) {
+func (n *ghsaIDNode) setVulnerabilityLink(id string) {
 	n.certifyVulnLink = append(n.certifyVulnLink, id)
 }
-func (n *ghsaIDNode) getVulnerabilityLink() []uint32 { return n.certifyVulnLink }
+func (n *ghsaIDNode) getVulnerabilityLink() []string { return n.certifyVulnLink }
 
 // isVulnerability back edges
-func (n *ghsaIDNode) setEqualVulnLink(id uint32) {
+func (n *ghsaIDNode) setEqualVulnLink(id string) {
 	n.equalVulnLink = append(n.equalVulnLink, id)
 }
-func (n *ghsaIDNode) gettEqualVulnLink() []uint32 { return n.equalVulnLink }
+func (n *ghsaIDNode) gettEqualVulnLink() []string { return n.equalVulnLink }
+
+// severityOverride back edges
+func (n *ghsaIDNode) setSeverityOverrideLink(id string) {
+	n.severityOverrideLink = append(n.severityOverrideLink, id)
+}
+func (n *ghsaIDNode) getSeverityOverrideLink() []string { return n.severityOverrideLink }
 
 // Ingest GHSA
 func (c *demoClient) IngestGhsa(ctx context.Context, input *model.GHSAInputSpec) (*model.Ghsa, error) {
+	ghsaID, err := helper.CanonicalGhsaID(input.GhsaID)
+	if err != nil {
+		return nil, err
+	}
 	ghsaStruct, hasGhsa := c.ghsas[ghsa]
 	if !hasGhsa {
 		ghsaStruct = &ghsaNode{
@@ -90,7 +113,6 @@ func (c *demoClient) IngestGhsa(ctx context.Context, input *model.GHSAInputSpec)
 		c.ghsas[ghsa] = ghsaStruct
 	}
 	ghsaIDs := ghsaStruct.ghsaIDs
-	ghsaID := strings.ToLower(input.GhsaID)
 
 	ghsaIDStruct, hasGhsaID := ghsaIDs[ghsaID]
 	if !hasGhsaID {
@@ -100,7 +122,10 @@ func (c *demoClient) IngestGhsa(ctx context.Context, input *model.GHSAInputSpec)
 			ghsaID: ghsaID,
 		}
 		c.index[ghsaIDStruct.id] = ghsaIDStruct
+		c.recordChange(ghsaIDStruct.id, model.NodeTypeGhsa)
 		ghsaIDs[ghsaID] = ghsaIDStruct
+	} else {
+		c.countIngestion(model.NodeTypeGhsa, false)
 	}
 
 	// build return GraphQL type
@@ -109,12 +134,17 @@ func (c *demoClient) IngestGhsa(ctx context.Context, input *model.GHSAInputSpec)
 
 // Query GHSA
 func (c *demoClient) Ghsa(ctx context.Context, filter *model.GHSASpec) ([]*model.Ghsa, error) {
+	if filter != nil && filter.GhsaID != nil {
+		if _, err := helper.CanonicalGhsaID(*filter.GhsaID); err != nil {
+			return nil, err
+		}
+	}
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		osv, err := c.buildGhsaResponse(uint32(id), filter)
+		osv, err := c.buildGhsaResponse(id, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -124,24 +154,24 @@ func (c *demoClient) Ghsa(ctx context.Context, filter *model.GHSASpec) ([]*model
 	for _, ghsaNode := range c.ghsas {
 		ghsaIDList := []*model.GHSAId{}
 		if filter != nil && filter.GhsaID != nil {
-			ghsaIDNode, hasGhsaIDNode := ghsaNode.ghsaIDs[strings.ToLower(*filter.GhsaID)]
+			ghsaIDNode, hasGhsaIDNode := ghsaNode.ghsaIDs[helper.NormalizeVulnerabilityID(*filter.GhsaID)]
 			if hasGhsaIDNode {
 				ghsaIDList = append(ghsaIDList, &model.GHSAId{
-					ID:     nodeID(ghsaIDNode.id),
+					ID:     ghsaIDNode.id,
 					GhsaID: ghsaIDNode.ghsaID,
 				})
 			}
 		} else {
 			for _, ghsaIDNode := range ghsaNode.ghsaIDs {
 				ghsaIDList = append(ghsaIDList, &model.GHSAId{
-					ID:     nodeID(ghsaIDNode.id),
+					ID:     ghsaIDNode.id,
 					GhsaID: ghsaIDNode.ghsaID,
 				})
 			}
 		}
 		if len(ghsaIDList) > 0 {
 			out = append(out, &model.Ghsa{
-				ID:      nodeID(ghsaNode.id),
+				ID:      ghsaNode.id,
 				GhsaIds: ghsaIDList,
 			})
 		}
@@ -151,29 +181,29 @@ func (c *demoClient) Ghsa(ctx context.Context, filter *model.GHSASpec) ([]*model
 
 // Builds a model.Ghsa to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
-func (c *demoClient) buildGhsaResponse(id uint32, filter *model.GHSASpec) (*model.Ghsa, error) {
+func (c *demoClient) buildGhsaResponse(id string, filter *model.GHSASpec) (*model.Ghsa, error) {
 	if filter != nil && filter.ID != nil {
-		filteredID, err := strconv.Atoi(*filter.ID)
+		filteredID, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		if uint32(filteredID) != id {
+		if filteredID != id {
 			return nil, nil
 		}
 	}
 
 	node, ok := c.index[id]
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match existing node")
+		return nil, backends.NotFoundf("ID does not match existing node")
 	}
 
 	ghsaIDList := []*model.GHSAId{}
 	if ghsaIDNode, ok := node.(*ghsaIDNode); ok {
-		if filter != nil && noMatch(toLower(filter.GhsaID), ghsaIDNode.ghsaID) {
+		if filter != nil && noMatch(normalizeVulnID(filter.GhsaID), ghsaIDNode.ghsaID) {
 			return nil, nil
 		}
 		ghsaIDList = append(ghsaIDList, &model.GHSAId{
-			ID:     nodeID(ghsaIDNode.id),
+			ID:     ghsaIDNode.id,
 			GhsaID: ghsaIDNode.ghsaID,
 		})
 		node = c.index[ghsaIDNode.parent]
@@ -181,26 +211,44 @@ func (c *demoClient) buildGhsaResponse(id uint32, filter *model.GHSASpec) (*mode
 
 	ghsaNode, ok := node.(*ghsaNode)
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match expected node type for ghsa root")
+		return nil, backends.InvalidInputf("ID does not match expected node type for ghsa root")
 	}
 	s := model.Ghsa{
-		ID:      nodeID(ghsaNode.id),
+		ID:      ghsaNode.id,
 		GhsaIds: ghsaIDList,
 	}
 	return &s, nil
 }
 
-func getGhsaIDFromInput(c *demoClient, input model.GHSAInputSpec) (uint32, error) {
+// matchGhsa reports whether buildGhsaResponse would return a ghsa for id and
+// filter, without building it.
+func (c *demoClient) matchGhsa(id string, filter *model.GHSASpec) bool {
+	if filter == nil {
+		return true
+	}
+	if !filterMatchesID(filter.ID, id) {
+		return false
+	}
+	if ghsaIDNode, ok := c.index[id].(*ghsaIDNode); ok {
+		return !noMatch(normalizeVulnID(filter.GhsaID), ghsaIDNode.ghsaID)
+	}
+	return false
+}
+
+func getGhsaIDFromInput(c *demoClient, input model.GHSAInputSpec) (string, error) {
+	ghsaID, err := helper.CanonicalGhsaID(input.GhsaID)
+	if err != nil {
+		return "", err
+	}
 	ghsaStruct, hasGhsa := c.ghsas[ghsa]
 	if !hasGhsa {
-		return 0, gqlerror.Errorf("ghsa type \"%s\" not found", ghsa)
+		return "", backends.NotFoundf("ghsa type \"%s\" not found", ghsa)
 	}
 	ghsaIDs := ghsaStruct.ghsaIDs
-	ghsaID := strings.ToLower(input.GhsaID)
 
 	ghsaIDStruct, hasGhsaID := ghsaIDs[ghsaID]
 	if !hasGhsaID {
-		return 0, gqlerror.Errorf("ghsa id \"%s\" not found", input.GhsaID)
+		return "", backends.NotFoundf("ghsa id \"%s\" not found", input.GhsaID)
 	}
 
 	return ghsaIDStruct.id, nil
@@ -17,12 +17,11 @@ package testing
 
 import (
 	"context"
-	"fmt"
 	"reflect"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func registerAllhasSBOM(client *demoClient) error {
@@ -40,7 +39,7 @@ func registerAllhasSBOM(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerHasSBOM(selectedPackage[0], nil, "uri:location of SBOM", "testing backend", "testing backend")
+	_, err = client.registerHasSBOM(selectedPackage[0], nil, "uri:location of SBOM", "testing backend", "testing backend", nil, nil)
 	if err != nil {
 		return err
 	}
@@ -54,7 +53,7 @@ func registerAllhasSBOM(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerHasSBOM(nil, selectedSource[0], "uri:location of SBOM", "testing backend", "testing backend")
+	_, err = client.registerHasSBOM(nil, selectedSource[0], "uri:location of SBOM", "testing backend", "testing backend", nil, nil)
 	if err != nil {
 		return err
 	}
@@ -63,19 +62,21 @@ func registerAllhasSBOM(client *demoClient) error {
 
 // Ingest HasSBOM
 
-func (c *demoClient) registerHasSBOM(selectedPackage *model.Package, selectedSource *model.Source, uri, origin, collector string) (*model.HasSbom, error) {
+func (c *demoClient) registerHasSBOM(selectedPackage *model.Package, selectedSource *model.Source, uri, origin, collector string, documentHash, fileMode *string) (*model.HasSbom, error) {
 
 	if selectedPackage != nil && selectedSource != nil {
-		return nil, fmt.Errorf("cannot specify both package and source for HasSBOM")
+		return nil, backends.InvalidInputf("cannot specify both package and source for HasSBOM")
 	}
 	for _, h := range c.hasSBOM {
-		if h.URI == uri {
+		if h.URI == uri && reflect.DeepEqual(h.DocumentHash, documentHash) && reflect.DeepEqual(h.FileMode, fileMode) {
 			if val, ok := h.Subject.(model.Package); ok {
 				if reflect.DeepEqual(val, *selectedPackage) {
+					c.countIngestion(model.NodeTypeHasSbom, false)
 					return h, nil
 				}
 			} else if val, ok := h.Subject.(model.Source); ok {
 				if reflect.DeepEqual(val, *selectedSource) {
+					c.countIngestion(model.NodeTypeHasSbom, false)
 					return h, nil
 				}
 			}
@@ -83,9 +84,11 @@ func (c *demoClient) registerHasSBOM(selectedPackage *model.Package, selectedSou
 	}
 
 	newHasSBOM := &model.HasSbom{
-		URI:       uri,
-		Origin:    origin,
-		Collector: collector,
+		URI:          uri,
+		Origin:       origin,
+		Collector:    collector,
+		DocumentHash: documentHash,
+		FileMode:     fileMode,
 	}
 	if selectedPackage != nil {
 		newHasSBOM.Subject = selectedPackage
@@ -94,6 +97,7 @@ func (c *demoClient) registerHasSBOM(selectedPackage *model.Package, selectedSou
 	}
 
 	c.hasSBOM = append(c.hasSBOM, newHasSBOM)
+	c.recordChangedNode(newHasSBOM, model.NodeTypeHasSbom)
 	return newHasSBOM, nil
 }
 
@@ -102,6 +106,9 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrS
 	if err != nil {
 		return nil, err
 	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, []*model.SourceInputSpec{subject.Source}); err != nil {
+		return nil, err
+	}
 
 	if subject.Package != nil {
 		selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(subject.Package)
@@ -112,7 +119,7 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrS
 		}
 
 		if len(collectedPkg) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestHasSbom :: multiple packages found")
 		}
 		return c.registerHasSBOM(
@@ -120,7 +127,9 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrS
 			nil,
 			hasSbom.URI,
 			hasSbom.Origin,
-			hasSbom.Collector)
+			hasSbom.Collector,
+			hasSbom.DocumentHash,
+			hasSbom.FileMode)
 	}
 
 	if subject.Source != nil {
@@ -131,7 +140,7 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrS
 			return nil, err
 		}
 		if len(sources) != 1 {
-			return nil, gqlerror.Errorf(
+			return nil, backends.InvalidInputf(
 				"IngestHasSbom :: source argument must match one"+
 					" single source repository, found %d",
 				len(sources))
@@ -141,15 +150,20 @@ func (c *demoClient) IngestHasSbom(ctx context.Context, subject model.PackageOrS
 			sources[0],
 			hasSbom.URI,
 			hasSbom.Origin,
-			hasSbom.Collector)
+			hasSbom.Collector,
+			hasSbom.DocumentHash,
+			hasSbom.FileMode)
 	}
 	// it should never reach here else it failed
-	return nil, gqlerror.Errorf("IngestHasSBOM failed")
+	return nil, backends.Internalf("IngestHasSBOM failed")
 }
 
 // Query HasSBOM
 
 func (c *demoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := helper.ValidateStringMatch(hasSBOMSpec.StringMatch); err != nil {
+		return nil, err
+	}
 
 	queryAll, err := helper.ValidatePackageOrSourceQueryInput(hasSBOMSpec.Subject)
 	if err != nil {
@@ -158,16 +172,25 @@ func (c *demoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec
 
 	var collectedHasSBOM []*model.HasSbom
 
-	for _, h := range c.hasSBOM {
+	for i, h := range c.hasSBOM {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		matchOrSkip := true
 
 		if hasSBOMSpec.URI != nil && h.URI != *hasSBOMSpec.URI {
 			matchOrSkip = false
 		}
-		if hasSBOMSpec.Collector != nil && h.Collector != *hasSBOMSpec.Collector {
+		if noMatchString(hasSBOMSpec.StringMatch, hasSBOMSpec.Collector, h.Collector) {
+			matchOrSkip = false
+		}
+		if noMatchString(hasSBOMSpec.StringMatch, hasSBOMSpec.Origin, h.Origin) {
+			matchOrSkip = false
+		}
+		if hasSBOMSpec.DocumentHash != nil && (h.DocumentHash == nil || *h.DocumentHash != *hasSBOMSpec.DocumentHash) {
 			matchOrSkip = false
 		}
-		if hasSBOMSpec.Origin != nil && h.Origin != *hasSBOMSpec.Origin {
+		if hasSBOMSpec.FileMode != nil && (h.FileMode == nil || *h.FileMode != *hasSBOMSpec.FileMode) {
 			matchOrSkip = false
 		}
 
@@ -17,23 +17,23 @@ package testing
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"sort"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 	"golang.org/x/exp/slices"
 )
 
 type hasSLSAList []*hasSLSAStruct
 type hasSLSAStruct struct {
-	id         uint32
-	subject    uint32
-	builtFrom  []uint32
-	builtBy    uint32
+	id         string
+	subject    string
+	builtFrom  []string
+	builtBy    string
 	buildType  string
 	predicates []*model.SLSAPredicate
 	version    string
@@ -41,19 +41,27 @@ type hasSLSAStruct struct {
 	finish     time.Time
 	origin     string
 	collector  string
+	docHash    string
+	ingestedAt time.Time
 }
 
-func (n *hasSLSAStruct) getID() uint32 { return n.id }
+func (n *hasSLSAStruct) getID() string { return n.id }
+
+func (n *hasSLSAStruct) neighbors() []string {
+	return appendIDs([]string{n.subject, n.builtBy}, n.builtFrom)
+}
 
 // Query HasSlsa
 
 func (c *demoClient) HasSlsa(ctx context.Context, hSpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	if err := helper.ValidateStringMatch(hSpec.StringMatch); err != nil {
+		return nil, err
+	}
 	if hSpec.ID != nil {
-		id64, err := strconv.ParseUint(*hSpec.ID, 10, 32)
+		id, err := parseID(*hSpec.ID)
 		if err != nil {
-			return nil, gqlerror.Errorf("HasSLSA :: invalid ID %s", err)
+			return nil, fmt.Errorf("HasSLSA :: %w", err)
 		}
-		id := uint32(id64)
 		h, err := c.hasSLSAByID(id)
 		if err != nil {
 			// Not found
@@ -66,18 +74,22 @@ func (c *demoClient) HasSlsa(ctx context.Context, hSpec *model.HasSLSASpec) ([]*
 	// TODO if subject, builtfrom, or builtby are provided, only search those
 	// backedges instead of all hasslsa here
 	var rv []*model.HasSlsa
-	for _, h := range c.hasSLSAs {
+	for i, h := range c.hasSLSAs {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
 		bb, _ := c.builderByID(h.builtBy)
 		if noMatch(hSpec.BuildType, h.buildType) ||
 			noMatch(hSpec.SlsaVersion, h.version) ||
-			noMatch(hSpec.Origin, h.origin) ||
-			noMatch(hSpec.Collector, h.collector) ||
+			noMatchString(hSpec.StringMatch, hSpec.Origin, h.origin) ||
+			noMatchString(hSpec.StringMatch, hSpec.Collector, h.collector) ||
+			noMatch(hSpec.DocumentHash, h.docHash) ||
 			(hSpec.StartedOn != nil && !hSpec.StartedOn.Equal(h.start)) ||
 			(hSpec.FinishedOn != nil && !hSpec.FinishedOn.Equal(h.finish)) ||
-			(hSpec.BuiltBy != nil && hSpec.BuiltBy.ID != nil && *hSpec.BuiltBy.ID != nodeID(bb.id)) ||
+			(hSpec.BuiltBy != nil && hSpec.BuiltBy.ID != nil && *hSpec.BuiltBy.ID != bb.id) ||
 			(hSpec.BuiltBy != nil && hSpec.BuiltBy.URI != nil && *hSpec.BuiltBy.URI != bb.uri) ||
 			!matchSLSAPreds(h.predicates, hSpec.Predicate) ||
-			!c.matchArtifacts([]*model.ArtifactSpec{hSpec.Subject}, []uint32{h.subject}) ||
+			!c.matchArtifacts([]*model.ArtifactSpec{hSpec.Subject}, []string{h.subject}) ||
 			!c.matchArtifacts(hSpec.BuiltFrom, h.builtFrom) {
 			continue
 		}
@@ -117,14 +129,14 @@ func (c *demoClient) IngestMaterials(ctx context.Context,
 	return output, nil
 }
 
-func (c *demoClient) hasSLSAByID(id uint32) (*hasSLSAStruct, error) {
+func (c *demoClient) hasSLSAByID(id string) (*hasSLSAStruct, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find hasSLSA")
+		return nil, backends.NotFoundf("could not find hasSLSA")
 	}
 	s, ok := o.(*hasSLSAStruct)
 	if !ok {
-		return nil, errors.New("not a hasSLSA")
+		return nil, backends.InvalidInputf("not a hasSLSA")
 	}
 	return s, nil
 }
@@ -134,19 +146,19 @@ func (c *demoClient) IngestSLSA(ctx context.Context,
 	builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) (*model.HasSlsa, error) {
 
 	if len(builtFrom) < 1 {
-		return nil, gqlerror.Errorf("IngestSLSA :: Must have at least 1 builtFrom")
+		return nil, backends.InvalidInputf("IngestSLSA :: Must have at least 1 builtFrom")
 	}
 
 	s, err := c.artifactByKey(subject.Algorithm, subject.Digest)
 	if err != nil {
-		return nil, gqlerror.Errorf("IngestSLSA :: Subject artifact not found")
+		return nil, backends.NotFoundf("IngestSLSA :: Subject artifact not found")
 	}
 	var bfs []*artStruct
-	var bfIDs []uint32
+	var bfIDs []string
 	for i, a := range builtFrom {
 		b, err := c.artifactByKey(a.Algorithm, a.Digest)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestSLSA :: BuiltFrom %d artifact not found", i)
+			return nil, backends.NotFoundf("IngestSLSA :: BuiltFrom %d artifact not found", i)
 		}
 		bfs = append(bfs, b)
 		bfIDs = append(bfIDs, b.id)
@@ -155,16 +167,17 @@ func (c *demoClient) IngestSLSA(ctx context.Context,
 
 	b, err := c.builderByKey(builtBy.URI)
 	if err != nil {
-		return nil, gqlerror.Errorf("IngestSLSA :: Builder not found")
+		return nil, backends.NotFoundf("IngestSLSA :: Builder not found")
 	}
 
 	preds := convSLSAP(slsa.SlsaPredicate)
+	docHash := nilToEmpty(slsa.DocumentHash)
 
 	// Just picking the first builtFrom found to search the backedges
 	for _, slID := range bfs[0].getHasSLSAs() {
 		sl, err := c.hasSLSAByID(slID)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestSLSA :: Internal db error, bad backedge")
+			return nil, backends.Internalf("IngestSLSA :: Internal db error, bad backedge")
 		}
 		if sl.subject == s.id &&
 			slices.Equal(sl.builtFrom, bfIDs) &&
@@ -175,7 +188,9 @@ func (c *demoClient) IngestSLSA(ctx context.Context,
 			sl.start == slsa.StartedOn &&
 			sl.finish == slsa.FinishedOn &&
 			sl.origin == slsa.Origin &&
-			sl.collector == slsa.Collector {
+			sl.collector == slsa.Collector &&
+			sl.docHash == docHash {
+			c.countIngestion(model.NodeTypeHasSlsa, false)
 			return c.convSLSA(sl), nil
 		}
 	}
@@ -192,8 +207,11 @@ func (c *demoClient) IngestSLSA(ctx context.Context,
 		finish:     slsa.FinishedOn,
 		origin:     slsa.Origin,
 		collector:  slsa.Collector,
+		docHash:    docHash,
+		ingestedAt: c.now(),
 	}
 	c.index[sl.id] = sl
+	c.recordChange(sl.id, model.NodeTypeHasSlsa)
 	c.hasSLSAs = append(c.hasSLSAs, sl)
 	s.setHasSLSAs(sl.id)
 	for _, a := range bfs {
@@ -227,7 +245,7 @@ func (c *demoClient) convSLSA(in *hasSLSAStruct) *model.HasSlsa {
 	bb, _ := c.builderByID(in.builtBy)
 
 	return &model.HasSlsa{
-		ID:      nodeID(in.id),
+		ID:      in.id,
 		Subject: convArtifact(sub),
 		Slsa: &model.Slsa{
 			BuiltFrom:     bfs,
@@ -239,6 +257,7 @@ func (c *demoClient) convSLSA(in *hasSLSAStruct) *model.HasSlsa {
 			FinishedOn:    in.finish,
 			Origin:        in.origin,
 			Collector:     in.collector,
+			DocumentHash:  emptyToNil(in.docHash),
 		},
 	}
 }
@@ -17,32 +17,59 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"fmt"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between sources and packages (HasSourceAt)
 type hasSrcList []*srcMapLink
 type srcMapLink struct {
-	id            uint32
-	sourceID      uint32
-	packageID     uint32
+	id            string
+	sourceID      string
+	packageID     string
 	knownSince    time.Time
 	justification string
 	origin        string
 	collector     string
 }
 
-func (n *srcMapLink) getID() uint32 { return n.id }
+func (n *srcMapLink) getID() string { return n.id }
+
+func (n *srcMapLink) neighbors() []string { return []string{n.packageID, n.sourceID} }
+
+// srcMapLinkKey is the identity of a HasSourceAt: ingesting a link with the
+// same key returns the existing one.
+type srcMapLinkKey struct {
+	sourceID      string
+	packageID     string
+	knownSince    time.Time
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *srcMapLink) key() srcMapLinkKey {
+	return srcMapLinkKey{
+		sourceID:      n.sourceID,
+		packageID:     n.packageID,
+		knownSince:    n.knownSince.UTC(),
+		justification: n.justification,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+}
 
 // Ingest HasSourceAt
 func (c *demoClient) IngestHasSourceAt(ctx context.Context, packageArg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
 	// Note: This assumes that the package and source have already been
-	// ingested (and should error otherwise).
+	// ingested (and should error otherwise), unless AutoIngestSubjects is set.
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{&packageArg}, []*model.SourceInputSpec{&source}); err != nil {
+		return nil, err
+	}
 
 	sourceID, err := getSourceIDFromInput(c, source)
 	if err != nil {
@@ -54,56 +81,74 @@ func (c *demoClient) IngestHasSourceAt(ctx context.Context, packageArg model.Pkg
 		return nil, err
 	}
 
-	packageHasSourceLinks := []uint32{}
-	pkgNameOrVersionNode, ok := c.index[packageID].(pkgNameOrVersion)
-	if ok {
-		packageHasSourceLinks = append(packageHasSourceLinks, pkgNameOrVersionNode.getSrcMapLink()...)
+	return c.ingestHasSourceAt(packageID, sourceID, hasSourceAt)
+}
+
+// IngestHasSourceAts ingests the HasSourceAt at every index of hasSourceAts
+// between the package and the source at the same index. The result is in
+// input order, with duplicates within the batch returning the same node.
+func (c *demoClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) ([]*model.HasSourceAt, error) {
+	if err := helper.ValidateInputLengths("IngestHasSourceAts",
+		helper.InputList{Name: "packages", Len: len(pkgs)},
+		helper.InputList{Name: "sources", Len: len(sources)},
+		helper.InputList{Name: "hasSourceAts", Len: len(hasSourceAts)}); err != nil {
+		return nil, err
 	}
-	sourceHasSourceLinks := []uint32{}
-	srcName, ok := c.index[sourceID].(*srcNameNode)
-	if ok {
-		sourceHasSourceLinks = append(sourceHasSourceLinks, srcName.srcMapLink...)
+
+	out := make([]*model.HasSourceAt, 0, len(hasSourceAts))
+	for i := range hasSourceAts {
+		if pkgs[i] == nil || sources[i] == nil || hasSourceAts[i] == nil {
+			return nil, backends.InvalidInputf("IngestHasSourceAts :: index %d: missing package, source or hasSourceAt", i)
+		}
+		if err := c.ingestSubjects(ctx, pkgs[i:i+1], sources[i:i+1]); err != nil {
+			return nil, fmt.Errorf("IngestHasSourceAts :: index %d: %w", i, err)
+		}
+		sourceID, err := getSourceIDFromInput(c, *sources[i])
+		if err != nil {
+			return nil, fmt.Errorf("IngestHasSourceAts :: index %d: %w", i, err)
+		}
+		packageID, err := getPackageIDFromInput(c, *pkgs[i], pkgMatchType)
+		if err != nil {
+			return nil, fmt.Errorf("IngestHasSourceAts :: index %d: %w", i, err)
+		}
+		hasSourceAt, err := c.ingestHasSourceAt(packageID, sourceID, *hasSourceAts[i])
+		if err != nil {
+			return nil, fmt.Errorf("IngestHasSourceAts :: index %d: %w", i, err)
+		}
+		out = append(out, hasSourceAt)
 	}
+	return out, nil
+}
 
-	searchIDs := []uint32{}
-	if len(packageHasSourceLinks) > len(sourceHasSourceLinks) {
-		searchIDs = append(searchIDs, sourceHasSourceLinks...)
-	} else {
-		searchIDs = append(searchIDs, packageHasSourceLinks...)
+func (c *demoClient) ingestHasSourceAt(packageID string, sourceID string, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	collectedSrcMapLink := &srcMapLink{
+		sourceID:      sourceID,
+		packageID:     packageID,
+		knownSince:    hasSourceAt.KnownSince.UTC(),
+		justification: hasSourceAt.Justification,
+		origin:        hasSourceAt.Origin,
+		collector:     hasSourceAt.Collector,
 	}
 
 	// Don't insert duplicates
-	duplicate := false
-	collectedSrcMapLink := srcMapLink{}
-	for _, id := range searchIDs {
-		v, _ := c.hasSourceAtByID(id)
-		if packageID == v.packageID && sourceID == v.sourceID && hasSourceAt.Justification == v.justification &&
-			hasSourceAt.Origin == v.origin && hasSourceAt.Collector == v.collector && hasSourceAt.KnownSince.UTC() == v.knownSince {
-			collectedSrcMapLink = *v
-			duplicate = true
-			break
-		}
-	}
-	if !duplicate {
+	if existing, ok := c.hasSourceKeys[collectedSrcMapLink.key()]; ok {
+		collectedSrcMapLink = existing
+		c.countIngestion(model.NodeTypeHasSourceAt, false)
+	} else {
 		// store the link
-		collectedSrcMapLink = srcMapLink{
-			id:            c.getNextID(),
-			sourceID:      sourceID,
-			packageID:     packageID,
-			knownSince:    hasSourceAt.KnownSince.UTC(),
-			justification: hasSourceAt.Justification,
-			origin:        hasSourceAt.Origin,
-			collector:     hasSourceAt.Collector,
-		}
-		c.index[collectedSrcMapLink.id] = &collectedSrcMapLink
-		c.hasSources = append(c.hasSources, &collectedSrcMapLink)
+		collectedSrcMapLink.id = c.getNextID()
+		c.index[collectedSrcMapLink.id] = collectedSrcMapLink
+		c.hasSourceKeys[collectedSrcMapLink.key()] = collectedSrcMapLink
+		c.recordChange(collectedSrcMapLink.id, model.NodeTypeHasSourceAt)
+		c.hasSources = append(c.hasSources, collectedSrcMapLink)
 		// set the backlinks
 		c.index[packageID].(pkgNameOrVersion).setSrcMapLink(collectedSrcMapLink.id)
 		c.index[sourceID].(*srcNameNode).setSrcMapLink(collectedSrcMapLink.id)
+		c.detectHasSourceAtConflicts(collectedSrcMapLink)
 	}
 
 	// build return GraphQL type
-	foundHasSourceAt, err := buildHasSourceAt(c, &collectedSrcMapLink, nil, true)
+	foundHasSourceAt, err := buildHasSourceAt(c, collectedSrcMapLink, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -113,53 +158,195 @@ func (c *demoClient) IngestHasSourceAt(ctx context.Context, packageArg model.Pkg
 // Query HasSourceAt
 
 func (c *demoClient) HasSourceAt(ctx context.Context, filter *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
-	out := []*model.HasSourceAt{}
+	page, err := c.HasSourceAtList(ctx, filter, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return page.HasSourceAts, nil
+}
+
+func (c *demoClient) HasSourceAtList(ctx context.Context, filter *model.HasSourceAtSpec, first *int, after *string) (*model.HasSourceAtConnection, error) {
+	p, err := c.newPaginator("HasSourceAt", first, after)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		if err := checkSortDirection("HasSourceAt", filter.OrderByKnownSince); err != nil {
+			return nil, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
+	out := &model.HasSourceAtConnection{HasSourceAts: []*model.HasSourceAt{}}
 
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		node, ok := c.index[uint32(id)]
+		node, ok := c.index[id]
 		if !ok {
-			return nil, gqlerror.Errorf("ID does not match existing node")
+			return nil, backends.NotFoundf("ID does not match existing node")
 		}
-		if link, ok := node.(*srcMapLink); ok {
-			foundHasSourceAt, err := buildHasSourceAt(c, link, filter, true)
-			if err != nil {
-				return nil, err
+		link, ok := node.(*srcMapLink)
+		if !ok {
+			return nil, backends.InvalidInputf("ID does not match expected node type for hasSourceAt")
+		}
+		foundHasSourceAt, err := buildHasSourceAt(c, link, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		if p.add(link.id) {
+			out.HasSourceAts = append(out.HasSourceAts, foundHasSourceAt)
+		}
+		out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+		return out, nil
+	}
+
+	matches, err := c.hasSourceAtMatches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil && filter.OrderByKnownSince != nil {
+		dir := *filter.OrderByKnownSince
+		less := func(a, b *srcMapLink) bool {
+			return sortedBefore(dir, compareTimes(a.knownSince, b.knownSince), a.id, b.id)
+		}
+		if err := sortMatches(p, matches, less, c.hasSourceAtByID); err != nil {
+			return nil, fmt.Errorf("HasSourceAt :: %w", err)
+		}
+	}
+
+	for i, link := range matches {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if !p.add(link.id) {
+			continue
+		}
+		foundHasSourceAt, err := buildHasSourceAt(c, link, filter, false)
+		if err != nil {
+			return nil, err
+		}
+		out.HasSourceAts = append(out.HasSourceAts, foundHasSourceAt)
+	}
+	out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+
+	return out, nil
+}
+
+// HasSourceAtCount returns the number of HasSourceAt matching filter, without
+// building them. It is not capped by the result limits.
+func (c *demoClient) HasSourceAtCount(ctx context.Context, filter *model.HasSourceAtSpec) (int, error) {
+	if filter != nil && filter.ID != nil {
+		page, err := c.HasSourceAtList(ctx, filter, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		return page.TotalCount, nil
+	}
+	if filter != nil {
+		if err := checkSortDirection("HasSourceAt", filter.OrderByKnownSince); err != nil {
+			return 0, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return 0, err
+		}
+	}
+	matches, err := c.hasSourceAtMatches(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return len(matches), nil
+}
+
+// hasSourceAtMatches returns the links matching filter, without an ID, in
+// ingestion order.
+func (c *demoClient) hasSourceAtMatches(ctx context.Context, filter *model.HasSourceAtSpec) (hasSrcList, error) {
+	// If the package or source are specified, only search their backedges
+	search := c.hasSources
+	if filter != nil {
+		ids := shortestBackedges(
+			c.packageBackedges(filter.Package, pkgNameOrVersion.getSrcMapLink),
+			c.sourceBackedges(filter.Source, (*srcNameNode).getSrcMapLink))
+		if ids != nil {
+			search = make(hasSrcList, 0, len(ids))
+			for _, id := range ids {
+				link, err := c.hasSourceAtByID(id)
+				if err != nil {
+					return nil, backends.Internalf("HasSourceAt :: Bad hasSourceAt id stored on existing node: %s", err)
+				}
+				search = append(search, link)
 			}
-			return []*model.HasSourceAt{foundHasSourceAt}, nil
-		} else {
-			return nil, gqlerror.Errorf("ID does not match expected node type for hasSourceAt")
 		}
 	}
 
-	// TODO if any of the pkg/source are specified, ony search those backedges
-	for _, link := range c.hasSources {
-		if filter != nil && noMatch(filter.Justification, link.justification) {
+	matches := hasSrcList{}
+	for i, link := range search {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Justification, link.justification) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Origin, link.origin) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Collector, link.collector) {
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Origins, link.origin) {
 			continue
 		}
-		if filter != nil && filter.KnownSince != nil && filter.KnownSince.UTC() == link.knownSince {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
 			continue
 		}
-		foundHasSourceAt, err := buildHasSourceAt(c, link, filter, false)
-		if err != nil {
-			return nil, err
+		if filter != nil && noMatchAnyString(filter.StringMatch, filter.Collectors, link.collector) {
+			continue
 		}
-		if foundHasSourceAt == nil {
+		if filter != nil && noMatchTime(filter.KnownSince, filter.KnownSinceAfter, filter.KnownSinceBefore, link.knownSince) {
 			continue
 		}
-		out = append(out, foundHasSourceAt)
+		if c.matchHasSourceAt(link, filter) {
+			matches = append(matches, link)
+		}
+	}
+	if filter != nil && filter.Latest != nil && *filter.Latest {
+		return latestHasSourceAts(matches), nil
 	}
+	return matches, nil
+}
 
-	return out, nil
+// latestHasSourceAts keeps the link with the latest knownSince of every
+// package, in ingestion order. Links attached to a package name and to one of
+// its versions belong to different packages. On a tie the link ingested last,
+// with the highest ID, is kept.
+func latestHasSourceAts(links hasSrcList) hasSrcList {
+	latest := map[string]*srcMapLink{}
+	for _, link := range links {
+		l, ok := latest[link.packageID]
+		if !ok {
+			latest[link.packageID] = link
+			continue
+		}
+		if cmp := compareTimes(link.knownSince, l.knownSince); cmp > 0 || cmp == 0 && link.id > l.id {
+			latest[link.packageID] = link
+		}
+	}
+	out := make(hasSrcList, 0, len(latest))
+	for _, link := range links {
+		if latest[link.packageID] == link {
+			out = append(out, link)
+		}
+	}
+	return out
+}
+
+// matchHasSourceAt reports whether buildHasSourceAt would return a result for
+// link on query, without building it.
+func (c *demoClient) matchHasSourceAt(link *srcMapLink, filter *model.HasSourceAtSpec) bool {
+	if filter == nil {
+		return c.matchPackage(link.packageID, nil) && c.matchSource(link.sourceID, nil)
+	}
+	return c.matchPackage(link.packageID, filter.Package) && c.matchSource(link.sourceID, filter.Source)
 }
 
 func buildHasSourceAt(c *demoClient, link *srcMapLink, filter *model.HasSourceAtSpec, ingestOrIDProvided bool) (*model.HasSourceAt, error) {
@@ -187,19 +374,19 @@ func buildHasSourceAt(c *demoClient, link *srcMapLink, filter *model.HasSourceAt
 	}
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, backends.Internalf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 	// if source not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if s == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve source via sourceID")
+		return nil, backends.Internalf("failed to retrieve source via sourceID")
 	} else if s == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	newHSA := model.HasSourceAt{
-		ID:            nodeID(link.id),
+		ID:            link.id,
 		Package:       p,
 		Source:        s,
 		KnownSince:    link.knownSince,
@@ -210,14 +397,14 @@ func buildHasSourceAt(c *demoClient, link *srcMapLink, filter *model.HasSourceAt
 	return &newHSA, nil
 }
 
-func (c *demoClient) hasSourceAtByID(id uint32) (*srcMapLink, error) {
+func (c *demoClient) hasSourceAtByID(id string) (*srcMapLink, error) {
 	node, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find srcMapLink")
+		return nil, backends.NotFoundf("could not find srcMapLink")
 	}
 	link, ok := node.(*srcMapLink)
 	if !ok {
-		return nil, errors.New("not an srcMapLink")
+		return nil, backends.InvalidInputf("not an srcMapLink")
 	}
 	return link, nil
 }
@@ -0,0 +1,550 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestHasSourceAts(t *testing.T) {
+	since := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	h1 := &model.HasSourceAtInputSpec{KnownSince: since, Justification: "SBOM", Origin: "sbom.json", Collector: "file"}
+	h2 := &model.HasSourceAtInputSpec{KnownSince: since, Justification: "provenance", Origin: "slsa.json", Collector: "file"}
+	unknown := &model.SourceInputSpec{Type: "git", Namespace: "github.com/unknown", Name: "unknown"}
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+
+	tests := []struct {
+		Name         string
+		Pkgs         []*model.PkgInputSpec
+		Sources      []*model.SourceInputSpec
+		HasSourceAts []*model.HasSourceAtInputSpec
+		// ExpSame lists, for each result, the index of the first result
+		// that must be the same node
+		ExpSame   []int
+		ExpLinks  int
+		ExpIngErr string
+	}{
+		{
+			Name:         "Distinct",
+			Pkgs:         []*model.PkgInputSpec{p2, p5, p2},
+			Sources:      []*model.SourceInputSpec{s1, s2, s1},
+			HasSourceAts: []*model.HasSourceAtInputSpec{h1, h1, h2},
+			ExpSame:      []int{0, 1, 2},
+			ExpLinks:     3,
+		},
+		{
+			Name:         "Duplicate within the batch",
+			Pkgs:         []*model.PkgInputSpec{p2, p5, p2},
+			Sources:      []*model.SourceInputSpec{s1, s2, s1},
+			HasSourceAts: []*model.HasSourceAtInputSpec{h1, h1, h1},
+			ExpSame:      []int{0, 1, 0},
+			ExpLinks:     2,
+		},
+		{
+			Name:         "Uneven lengths",
+			Pkgs:         []*model.PkgInputSpec{p2, p5},
+			Sources:      []*model.SourceInputSpec{s1},
+			HasSourceAts: []*model.HasSourceAtInputSpec{h1, h1},
+			ExpIngErr:    "uneven number",
+		},
+		{
+			Name:         "Error names the index",
+			Pkgs:         []*model.PkgInputSpec{p2, p5},
+			Sources:      []*model.SourceInputSpec{s1, unknown},
+			HasSourceAts: []*model.HasSourceAtInputSpec{h1, h1},
+			ExpIngErr:    "index 1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			for _, p := range []*model.PkgInputSpec{p2, p5} {
+				if _, err := b.IngestPackage(ctx, *p); err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+			}
+			for _, s := range []*model.SourceInputSpec{s1, s2} {
+				if _, err := b.IngestSource(ctx, *s); err != nil {
+					t.Fatalf("Could not ingest source: %v", err)
+				}
+			}
+
+			got, err := b.IngestHasSourceAts(ctx, test.Pkgs, specificVersion, test.Sources, test.HasSourceAts)
+			if (err != nil) != (test.ExpIngErr != "") {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngErr, err)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), test.ExpIngErr) {
+					t.Errorf("expected error containing %q, got: %v", test.ExpIngErr, err)
+				}
+				return
+			}
+			if len(got) != len(test.ExpSame) {
+				t.Fatalf("expected %d results, got %d", len(test.ExpSame), len(got))
+			}
+			for i, same := range test.ExpSame {
+				if got[i].ID != got[same].ID {
+					t.Errorf("result %d: expected the node of result %d (%s), got %s", i, same, got[same].ID, got[i].ID)
+				}
+				for j := 0; i == same && j < i; j++ {
+					if got[i].ID == got[j].ID {
+						t.Errorf("result %d: expected a new node, got the one of result %d", i, j)
+					}
+				}
+				if got[i].Justification != test.HasSourceAts[i].Justification || got[i].Source.Namespaces[0].Names[0].Name != test.Sources[i].Name {
+					t.Errorf("result %d is not in input order: %+v", i, got[i])
+				}
+			}
+
+			links, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(links) != test.ExpLinks {
+				t.Errorf("expected %d HasSourceAt, got %d", test.ExpLinks, len(links))
+			}
+		})
+	}
+}
+
+func TestHasSourceAtKnownSince(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	newYear := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	// ingested in CET, half an hour before newYear
+	lastYear := time.Date(2023, 1, 1, 0, 30, 0, 0, cet)
+	later := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	links := map[string]time.Time{
+		"zero":     {},
+		"lastYear": lastYear,
+		"newYear":  newYear,
+		"later":    later,
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		Name   string
+		Filter *model.HasSourceAtSpec
+		Exp    []string
+	}{
+		{
+			Name:   "No filter",
+			Filter: &model.HasSourceAtSpec{},
+			Exp:    []string{"lastYear", "later", "newYear", "zero"},
+		},
+		{
+			Name:   "Exact",
+			Filter: &model.HasSourceAtSpec{KnownSince: ptr(newYear)},
+			Exp:    []string{"newYear"},
+		},
+		{
+			Name:   "Exact in another time zone",
+			Filter: &model.HasSourceAtSpec{KnownSince: ptr(lastYear.UTC())},
+			Exp:    []string{"lastYear"},
+		},
+		{
+			Name:   "Exact zero",
+			Filter: &model.HasSourceAtSpec{KnownSince: ptr(time.Time{})},
+			Exp:    []string{"zero"},
+		},
+		{
+			Name:   "After is inclusive",
+			Filter: &model.HasSourceAtSpec{KnownSinceAfter: ptr(newYear)},
+			Exp:    []string{"later", "newYear"},
+		},
+		{
+			Name:   "After in another time zone",
+			Filter: &model.HasSourceAtSpec{KnownSinceAfter: ptr(newYear.In(cet))},
+			Exp:    []string{"later", "newYear"},
+		},
+		{
+			Name:   "Before is exclusive",
+			Filter: &model.HasSourceAtSpec{KnownSinceBefore: ptr(newYear)},
+			Exp:    []string{"lastYear", "zero"},
+		},
+		{
+			Name:   "Range",
+			Filter: &model.HasSourceAtSpec{KnownSinceAfter: ptr(lastYear), KnownSinceBefore: ptr(later)},
+			Exp:    []string{"lastYear", "newYear"},
+		},
+		{
+			Name:   "Empty range",
+			Filter: &model.HasSourceAtSpec{KnownSinceAfter: ptr(later), KnownSinceBefore: ptr(newYear)},
+			Exp:    []string{},
+		},
+		{
+			Name:   "Exact and range",
+			Filter: &model.HasSourceAtSpec{KnownSince: ptr(later), KnownSinceAfter: ptr(newYear)},
+			Exp:    []string{"later"},
+		},
+	}
+
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	for justification, knownSince := range links {
+		_, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1,
+			model.HasSourceAtInputSpec{KnownSince: knownSince, Justification: justification, Origin: "test", Collector: "test"})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.HasSourceAt(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			justifications := []string{}
+			for _, hasSourceAt := range got {
+				justifications = append(justifications, hasSourceAt.Justification)
+				if hasSourceAt.KnownSince.Location() != time.UTC {
+					t.Errorf("knownSince of %s is not in UTC: %v", hasSourceAt.Justification, hasSourceAt.KnownSince)
+				}
+			}
+			sort.Strings(justifications)
+			if diff := cmp.Diff(test.Exp, justifications); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasSourceAtBackedges(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	var p4ID string
+	for _, p := range []*model.PkgInputSpec{p2, p3, p4} {
+		pkg, err := b.IngestPackage(ctx, *p)
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		p4ID = pkg.Namespaces[0].Names[0].Versions[0].ID
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	// ingested out of package order, to check the results come back in
+	// ingestion order
+	calls := []struct {
+		Pkg       *model.PkgInputSpec
+		MatchType model.PkgMatchType
+		Src       *model.SourceInputSpec
+		Name      string
+	}{
+		{p3, model.PkgMatchTypeSpecificVersion, s2, "p3s2"},
+		{p2, model.PkgMatchTypeSpecificVersion, s1, "p2s1"},
+		{p1, model.PkgMatchTypeAllVersions, s1, "p1s1"},
+		{p4, model.PkgMatchTypeSpecificVersion, s2, "p4s2"},
+	}
+	for _, call := range calls {
+		_, err := b.IngestHasSourceAt(ctx, *call.Pkg, model.MatchFlags{Pkg: call.MatchType}, *call.Src,
+			model.HasSourceAtInputSpec{Justification: call.Name})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name   string
+		Filter *model.HasSourceAtSpec
+		Exp    []string
+	}{
+		{
+			Name:   "Package name includes all versions",
+			Filter: &model.HasSourceAtSpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}},
+			Exp:    []string{"p3s2", "p2s1", "p1s1"},
+		},
+		{
+			Name: "Package version",
+			Filter: &model.HasSourceAtSpec{Package: &model.PkgSpec{
+				Name: ptrfrom.String("tensorflow"), Version: ptrfrom.String("2.11.1"), Subpath: ptrfrom.String(""),
+			}},
+			Exp: []string{"p2s1", "p1s1"},
+		},
+		{
+			Name:   "Package ID",
+			Filter: &model.HasSourceAtSpec{Package: &model.PkgSpec{ID: &p4ID}},
+			Exp:    []string{"p4s2"},
+		},
+		{
+			Name:   "Source",
+			Filter: &model.HasSourceAtSpec{Source: &model.SourceSpec{Name: ptrfrom.String("numpy")}},
+			Exp:    []string{"p3s2", "p4s2"},
+		},
+		{
+			Name: "Package and source",
+			Filter: &model.HasSourceAtSpec{
+				Package: &model.PkgSpec{Type: ptrfrom.String("pypi")},
+				Source:  &model.SourceSpec{Name: ptrfrom.String("numpy")},
+			},
+			Exp: []string{"p3s2"},
+		},
+		{
+			Name:   "Unknown package",
+			Filter: &model.HasSourceAtSpec{Package: &model.PkgSpec{Name: ptrfrom.String("unknown")}},
+			Exp:    []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.HasSourceAt(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			justifications := []string{}
+			for _, hasSourceAt := range got {
+				justifications = append(justifications, hasSourceAt.Justification)
+			}
+			if diff := cmp.Diff(test.Exp, justifications); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// BenchmarkHasSourceAt queries a graph of 100k HasSourceAt, linking each of
+// 1000 packages to each of 100 sources.
+func BenchmarkHasSourceAt(b *testing.B) {
+	const packages = 1000
+	const sources = 100
+	ctx := context.Background()
+	backend, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		b.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	pkgs := make([]model.PkgInputSpec, packages)
+	for i := range pkgs {
+		pkgs[i] = model.PkgInputSpec{Type: "pypi", Name: fmt.Sprintf("package-%d", i), Version: ptrfrom.String("1.0.0")}
+		if _, err := backend.IngestPackage(ctx, pkgs[i]); err != nil {
+			b.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	srcs := make([]model.SourceInputSpec, sources)
+	for i := range srcs {
+		srcs[i] = model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: fmt.Sprintf("source-%d", i)}
+		if _, err := backend.IngestSource(ctx, srcs[i]); err != nil {
+			b.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	for i := range pkgs {
+		for j := range srcs {
+			_, err := backend.IngestHasSourceAt(ctx, pkgs[i], model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, srcs[j],
+				model.HasSourceAtInputSpec{Justification: fmt.Sprintf("%d-%d", i, j)})
+			if err != nil {
+				b.Fatalf("Could not ingest HasSourceAt: %v", err)
+			}
+		}
+	}
+
+	pkgSpec := &model.PkgSpec{Type: ptrfrom.String("pypi"), Name: ptrfrom.String("package-500"), Version: ptrfrom.String("1.0.0")}
+	srcSpec := &model.SourceSpec{Type: ptrfrom.String("git"), Namespace: ptrfrom.String("github.com/guacsec"), Name: ptrfrom.String("source-50")}
+	benchmarks := []struct {
+		Name   string
+		Filter *model.HasSourceAtSpec
+		Exp    int
+	}{
+		{"package", &model.HasSourceAtSpec{Package: pkgSpec}, sources},
+		{"source", &model.HasSourceAtSpec{Source: srcSpec}, packages},
+		{"package and source", &model.HasSourceAtSpec{Package: pkgSpec, Source: srcSpec}, 1},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.Name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				got, err := backend.HasSourceAt(ctx, bm.Filter)
+				if err != nil {
+					b.Fatalf("Unexpected error: %v", err)
+				}
+				if len(got) != bm.Exp {
+					b.Fatalf("expected %d results, got %d", bm.Exp, len(got))
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkIngestHasSourceAt ingests HasSourceAt on a package that already
+// has 100k of them, all to the same source.
+func BenchmarkIngestHasSourceAt(b *testing.B) {
+	const links = 100000
+	ctx := context.Background()
+	backend, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		b.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	pkg := model.PkgInputSpec{Type: "pypi", Name: "popular", Version: ptrfrom.String("1.0.0")}
+	if _, err := backend.IngestPackage(ctx, pkg); err != nil {
+		b.Fatalf("Could not ingest package: %v", err)
+	}
+	src := model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "popular"}
+	if _, err := backend.IngestSource(ctx, src); err != nil {
+		b.Fatalf("Could not ingest source: %v", err)
+	}
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	for i := 0; i < links; i++ {
+		_, err := backend.IngestHasSourceAt(ctx, pkg, specificVersion, src, model.HasSourceAtInputSpec{Justification: fmt.Sprintf("existing-%d", i)})
+		if err != nil {
+			b.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+
+	b.Run("duplicate", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, err := backend.IngestHasSourceAt(ctx, pkg, specificVersion, src, model.HasSourceAtInputSpec{Justification: fmt.Sprintf("existing-%d", n%links)})
+			if err != nil {
+				b.Fatalf("Could not ingest HasSourceAt: %v", err)
+			}
+		}
+	})
+	b.Run("new", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, err := backend.IngestHasSourceAt(ctx, pkg, specificVersion, src, model.HasSourceAtInputSpec{Justification: fmt.Sprintf("new-%d-%d", b.N, n)})
+			if err != nil {
+				b.Fatalf("Could not ingest HasSourceAt: %v", err)
+			}
+		}
+	})
+}
+
+func TestHasSourceAtLatest(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p2, p5} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	earlier := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	calls := []struct {
+		Pkg        *model.PkgInputSpec
+		MatchType  model.PkgMatchType
+		Src        *model.SourceInputSpec
+		KnownSince time.Time
+		Name       string
+	}{
+		{p2, model.PkgMatchTypeSpecificVersion, s1, later, "p2 later"},
+		{p2, model.PkgMatchTypeSpecificVersion, s2, earlier, "p2 earlier"},
+		{p1, model.PkgMatchTypeAllVersions, s1, earlier, "p1 first"},
+		{p1, model.PkgMatchTypeAllVersions, s2, earlier, "p1 second"},
+		{p5, model.PkgMatchTypeSpecificVersion, s2, earlier, "p5"},
+	}
+	for _, call := range calls {
+		_, err := b.IngestHasSourceAt(ctx, *call.Pkg, model.MatchFlags{Pkg: call.MatchType}, *call.Src,
+			model.HasSourceAtInputSpec{KnownSince: call.KnownSince, Justification: call.Name})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+
+	tests := []struct {
+		Name   string
+		Filter *model.HasSourceAtSpec
+		Exp    []string
+	}{
+		{
+			Name:   "Latest of every package",
+			Filter: &model.HasSourceAtSpec{Latest: ptrfrom.Bool(true)},
+			Exp:    []string{"p2 later", "p1 second", "p5"},
+		},
+		{
+			Name: "Name and version are different packages",
+			Filter: &model.HasSourceAtSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")},
+				Latest:  ptrfrom.Bool(true),
+			},
+			Exp: []string{"p2 later", "p1 second"},
+		},
+		{
+			Name: "Latest among the matching links",
+			Filter: &model.HasSourceAtSpec{
+				Source: &model.SourceSpec{Name: ptrfrom.String("tensorflow")},
+				Latest: ptrfrom.Bool(true),
+			},
+			Exp: []string{"p2 later", "p1 first"},
+		},
+		{
+			Name: "Latest ordered",
+			Filter: &model.HasSourceAtSpec{
+				Latest:            ptrfrom.Bool(true),
+				OrderByKnownSince: sortDirection(model.SortDirectionDesc),
+			},
+			Exp: []string{"p2 later", "p1 second", "p5"},
+		},
+		{
+			Name:   "All links",
+			Filter: &model.HasSourceAtSpec{Latest: ptrfrom.Bool(false)},
+			Exp:    []string{"p2 later", "p2 earlier", "p1 first", "p1 second", "p5"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.HasSourceAt(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			justifications := []string{}
+			for _, hasSourceAt := range got {
+				justifications = append(justifications, hasSourceAt.Justification)
+			}
+			if diff := cmp.Diff(test.Exp, justifications); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+			count, err := b.HasSourceAtCount(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if count != len(test.Exp) {
+				t.Errorf("expected a count of %d, got %d", len(test.Exp), count)
+			}
+		})
+	}
+}
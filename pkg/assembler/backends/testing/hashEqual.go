@@ -17,14 +17,14 @@ package testing
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"sort"
-	"strconv"
 	"strings"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
 	"golang.org/x/exp/slices"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -32,14 +32,36 @@ import (
 
 type hashEqualList []*hashEqualStruct
 type hashEqualStruct struct {
-	id            uint32
-	artifacts     []uint32
+	id            string
+	artifacts     []string
 	justification string
 	origin        string
 	collector     string
 }
 
-func (n *hashEqualStruct) getID() uint32 { return n.id }
+func (n *hashEqualStruct) getID() string { return n.id }
+
+func (n *hashEqualStruct) neighbors() []string { return n.artifacts }
+
+// hashEqualKey is the identity of a HashEqual: ingesting a HashEqual with the
+// same key returns the existing one. The artifacts are sorted by ID, so the
+// key does not depend on their order at ingestion.
+type hashEqualKey struct {
+	artifacts     [2]string
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *hashEqualStruct) key() hashEqualKey {
+	k := hashEqualKey{
+		justification: n.justification,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+	copy(k.artifacts[:], n.artifacts)
+	return k
+}
 
 // TODO convert to unit tests
 // func registerAllHashEqual(client *demoClient) {
@@ -62,14 +84,14 @@ func (n *hashEqualStruct) getID() uint32 { return n.id }
 // 		})
 // }
 
-func (c *demoClient) hashEqualByID(id uint32) (*hashEqualStruct, error) {
+func (c *demoClient) hashEqualByID(id string) (*hashEqualStruct, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find hashEqual")
+		return nil, backends.NotFoundf("could not find hashEqual")
 	}
 	a, ok := o.(*hashEqualStruct)
 	if !ok {
-		return nil, errors.New("not a hashEqual")
+		return nil, backends.InvalidInputf("not a hashEqual")
 	}
 	return a, nil
 }
@@ -79,41 +101,29 @@ func (c *demoClient) IngestHashEqual(ctx context.Context, artifact model.Artifac
 
 	aInt1, err := c.artifactByKey(artifact.Algorithm, artifact.Digest)
 	if err != nil {
-		return nil, gqlerror.Errorf("IngestHashEqual :: Artifact not found")
+		return nil, backends.NotFoundf("IngestHashEqual :: Artifact not found")
 	}
 	aInt2, err := c.artifactByKey(equalArtifact.Algorithm, equalArtifact.Digest)
 	if err != nil {
-		return nil, gqlerror.Errorf("IngestHashEqual :: Artifact not found")
+		return nil, backends.NotFoundf("IngestHashEqual :: Artifact not found")
 	}
-	artIDs := []uint32{aInt1.id, aInt2.id}
+	artIDs := []string{aInt1.id, aInt2.id}
 	sort.Slice(artIDs, func(i, j int) bool { return artIDs[i] < artIDs[j] })
 
-	// Search backedges for existing.
-	searchHEs := slices.Clone(aInt1.getHashEquals())
-	searchHEs = append(searchHEs, aInt2.getHashEquals()...)
-
-	for _, he := range searchHEs {
-		h, err := c.hashEqualByID(he)
-		if err != nil {
-			return nil, gqlerror.Errorf(
-				"IngestHashEqual :: Bad hashEqual id stored on existing artifact: %s", err)
-		}
-		if h.justification == hashEqual.Justification &&
-			h.origin == hashEqual.Origin &&
-			h.collector == hashEqual.Collector &&
-			slices.Equal(h.artifacts, artIDs) {
-			return c.convHashEqual(h), nil
-		}
-	}
-
 	he := &hashEqualStruct{
-		id:            c.getNextID(),
 		artifacts:     artIDs,
 		justification: hashEqual.Justification,
 		origin:        hashEqual.Origin,
 		collector:     hashEqual.Collector,
 	}
+	if existing, ok := c.hashEqualKeys[he.key()]; ok {
+		c.countIngestion(model.NodeTypeHashEqual, false)
+		return c.convHashEqual(existing), nil
+	}
+	he.id = c.getNextID()
 	c.index[he.id] = he
+	c.hashEqualKeys[he.key()] = he
+	c.recordChange(he.id, model.NodeTypeHashEqual)
 	c.hashEquals = append(c.hashEquals, he)
 	aInt1.setHashEquals(he.id)
 	aInt2.setHashEquals(he.id)
@@ -121,9 +131,9 @@ func (c *demoClient) IngestHashEqual(ctx context.Context, artifact model.Artifac
 	return c.convHashEqual(he), nil
 }
 
-func (c *demoClient) matchArtifacts(filter []*model.ArtifactSpec, value []uint32) bool {
+func (c *demoClient) matchArtifacts(filter []*model.ArtifactSpec, value []string) bool {
 	val := slices.Clone(value)
-	var matchID []uint32
+	var matchID []string
 	var matchPartial []*model.ArtifactSpec
 	for _, aSpec := range filter {
 		if aSpec == nil {
@@ -166,18 +176,20 @@ func (c *demoClient) matchArtifacts(filter []*model.ArtifactSpec, value []uint32
 // Query HashEqual
 
 func (c *demoClient) HashEqual(ctx context.Context, hSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	if err := helper.ValidateStringMatch(hSpec.StringMatch); err != nil {
+		return nil, err
+	}
 	if len(hSpec.Artifacts) > 2 {
-		return nil, gqlerror.Errorf(
+		return nil, backends.InvalidInputf(
 			"HashEqual :: Provided spec has too many Artifacts")
 	}
 
 	// If ID is provided, try to look up, then check if rest matches
 	if hSpec.ID != nil {
-		id64, err := strconv.ParseUint(*hSpec.ID, 10, 32)
+		id, err := parseID(*hSpec.ID)
 		if err != nil {
-			return nil, gqlerror.Errorf("HashEqual :: invalid ID %s", err)
+			return nil, fmt.Errorf("HashEqual :: %w", err)
 		}
-		id := uint32(id64)
 		h, err := c.hashEqualByID(id)
 		if err != nil {
 			// Not found
@@ -189,10 +201,13 @@ func (c *demoClient) HashEqual(ctx context.Context, hSpec *model.HashEqualSpec)
 
 	var hashEquals []*model.HashEqual
 	// TODO if any artifacts are exact matches only search those backedges
-	for _, h := range c.hashEquals {
-		if noMatch(hSpec.Justification, h.justification) ||
-			noMatch(hSpec.Origin, h.origin) ||
-			noMatch(hSpec.Collector, h.collector) ||
+	for i, h := range c.hashEquals {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if noMatchString(hSpec.StringMatch, hSpec.Justification, h.justification) ||
+			noMatchString(hSpec.StringMatch, hSpec.Origin, h.origin) ||
+			noMatchString(hSpec.StringMatch, hSpec.Collector, h.collector) ||
 			!c.matchArtifacts(hSpec.Artifacts, h.artifacts) {
 			continue
 		}
@@ -210,10 +225,63 @@ func (c *demoClient) convHashEqual(h *hashEqualStruct) *model.HashEqual {
 		artifacts = append(artifacts, convArtifact(a))
 	}
 	return &model.HashEqual{
-		ID:            nodeID(h.id),
+		ID:            h.id,
 		Justification: h.justification,
 		Artifacts:     artifacts,
 		Origin:        h.origin,
 		Collector:     h.collector,
 	}
 }
+
+// Query EqualArtifacts
+//
+// The HashEqual links are pairwise, so the artifacts equal to an artifact are
+// found by walking the HashEqual backedges of the artifacts reached, which
+// are each visited once. The artifacts are sorted by algorithm and digest.
+
+func (c *demoClient) EqualArtifacts(ctx context.Context, artifact model.ArtifactSpec) ([]*model.Artifact, error) {
+	start, err := c.artifactBySpec(artifact)
+	if err != nil {
+		return nil, fmt.Errorf("equalArtifacts :: %w", err)
+	}
+	out := []*model.Artifact{}
+	if len(start.hashEquals) == 0 {
+		return out, nil
+	}
+	visited := map[string]bool{start.id: true}
+	frontier := []*artStruct{start}
+	steps := 0
+	for len(frontier) > 0 {
+		a := frontier[0]
+		frontier = frontier[1:]
+		out = append(out, convArtifact(a))
+		for _, id := range a.hashEquals {
+			if err := checkCanceled(ctx, steps); err != nil {
+				return nil, err
+			}
+			steps++
+			he, err := c.hashEqualByID(id)
+			if err != nil {
+				continue
+			}
+			for _, linked := range he.artifacts {
+				if visited[linked] {
+					continue
+				}
+				visited[linked] = true
+				next, err := c.artifactByID(linked)
+				if err != nil {
+					return nil, fmt.Errorf("equalArtifacts :: %w", err)
+				}
+				frontier = append(frontier, next)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Algorithm != out[j].Algorithm {
+			return out[i].Algorithm < out[j].Algorithm
+		}
+		return out[i].Digest < out[j].Digest
+	})
+	return out, nil
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestEqualArtifacts(t *testing.T) {
+	ctx := context.Background()
+	a4 := &model.ArtifactInputSpec{Algorithm: "md5", Digest: "d41d8cd98f00b204e9800998ecf8427e"}
+	lonely := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ids := map[*model.ArtifactInputSpec]string{}
+	for _, a := range []*model.ArtifactInputSpec{a1, a2, a3, a4, lonely} {
+		artifact, err := b.IngestArtifact(ctx, a)
+		if err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+		ids[a] = artifact.ID
+	}
+	// a chain a1=a2, a2=a3, a3=a4, with a4=a2 closing a cycle
+	for _, link := range [][2]*model.ArtifactInputSpec{{a1, a2}, {a2, a3}, {a3, a4}, {a4, a2}} {
+		if _, err := b.IngestHashEqual(ctx, *link[0], *link[1], model.HashEqualInputSpec{Justification: "same image"}); err != nil {
+			t.Fatalf("Could not ingest HashEqual: %v", err)
+		}
+	}
+	// sorted by algorithm and digest
+	chain := []string{ids[a4], ids[a2], ids[a1], ids[a3]}
+
+	tests := []struct {
+		Name     string
+		Artifact model.ArtifactSpec
+		Exp      []string
+		ExpErr   error
+	}{
+		{
+			Name:     "First of the chain",
+			Artifact: model.ArtifactSpec{Algorithm: &a1.Algorithm, Digest: &a1.Digest},
+			Exp:      chain,
+		},
+		{
+			Name:     "Middle of the chain by ID",
+			Artifact: model.ArtifactSpec{ID: ptrfrom.String(ids[a2])},
+			Exp:      chain,
+		},
+		{
+			Name:     "Middle of the chain",
+			Artifact: model.ArtifactSpec{Algorithm: &a3.Algorithm, Digest: &a3.Digest},
+			Exp:      chain,
+		},
+		{
+			Name:     "Last of the chain",
+			Artifact: model.ArtifactSpec{Algorithm: ptrfrom.String("MD5"), Digest: &a4.Digest},
+			Exp:      chain,
+		},
+		{
+			Name:     "Without HashEqual",
+			Artifact: model.ArtifactSpec{ID: ptrfrom.String(ids[lonely])},
+			Exp:      []string{},
+		},
+		{
+			Name:     "Unknown artifact",
+			Artifact: model.ArtifactSpec{ID: ptrfrom.String(unknownID)},
+			ExpErr:   backends.ErrNotFound,
+		},
+		{
+			Name:     "Unknown digest",
+			Artifact: model.ArtifactSpec{Algorithm: ptrfrom.String("sha1"), Digest: ptrfrom.String("da39a3ee5e6b4b0d3255bfef95601890afd80709")},
+			ExpErr:   backends.ErrNotFound,
+		},
+		{
+			Name:     "Digest only",
+			Artifact: model.ArtifactSpec{Digest: &a1.Digest},
+			ExpErr:   backends.ErrInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.EqualArtifacts(ctx, test.Artifact)
+			if test.ExpErr != nil {
+				if !errors.Is(err, test.ExpErr) {
+					t.Fatalf("expected error %v, got: %v", test.ExpErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			gotIDs := []string{}
+			for _, a := range got {
+				gotIDs = append(gotIDs, a.ID)
+			}
+			if diff := cmp.Diff(test.Exp, gotIDs); diff != "" {
+				t.Errorf("Unexpected artifacts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
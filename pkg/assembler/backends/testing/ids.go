@@ -0,0 +1,153 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// IDs are ULIDs: 48 bits of milliseconds since the epoch followed by 80
+// random bits, written as 26 characters of Crockford's base32. They are
+// opaque to clients, and stay the same across an export and an import.
+//
+// The generator is monotonic: an ID is always greater than the ones handed
+// out before it, both as bytes and as a string. The queries rely on this to
+// return links, and to page through them, in ingestion order.
+
+const (
+	idLength   = 26
+	idAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// idGenerator hands out the IDs of all the namespaces of a backend, so that
+// an ID never refers to nodes in two of them.
+type idGenerator struct {
+	mu   sync.Mutex
+	now  func() time.Time
+	last [16]byte
+}
+
+func newIDGenerator() *idGenerator {
+	return &idGenerator{now: time.Now}
+}
+
+// next returns a new ID, greater than all the IDs handed out or restored.
+func (g *idGenerator) next() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var id [16]byte
+	putTime(&id, g.now())
+	if _, err := rand.Read(id[6:]); err != nil {
+		panic(err)
+	}
+	if bytes.Compare(id[:], g.last[:]) <= 0 {
+		// the clock did not move past the last ID, increment it instead
+		id = g.last
+		increment(&id)
+	}
+	g.last = id
+	return encodeID(id)
+}
+
+// lastID returns the last ID handed out or restored.
+func (g *idGenerator) lastID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return encodeID(g.last)
+}
+
+// advance moves the generator past last, the last ID of a snapshot, so that
+// the IDs handed out next sort after the ones of the snapshot.
+func (g *idGenerator) advance(last string) error {
+	l, err := decodeID(last)
+	if err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if bytes.Compare(g.last[:], l[:]) < 0 {
+		g.last = l
+	}
+	return nil
+}
+
+// parseID checks that id is an ID of this backend. The numeric IDs handed out
+// by earlier versions, which are shorter, get an error of their own, as
+// clients may have cached them.
+func parseID(id string) (string, error) {
+	if id != "" && len(id) < idLength && strings.Trim(id, "0123456789") == "" {
+		return "", backends.InvalidInputf("invalid ID %s: numeric IDs are no longer supported, query the node again for its current ID", id)
+	}
+	if _, err := decodeID(id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func putTime(id *[16]byte, t time.Time) {
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(t.UnixMilli()))
+	copy(id[:6], ms[2:])
+}
+
+func increment(id *[16]byte) {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]++
+		if id[i] != 0 {
+			return
+		}
+	}
+}
+
+// encodeID writes the 128 bits of id in base32, most significant first. The
+// first character only carries 3 bits.
+func encodeID(id [16]byte) string {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+	var out [idLength]byte
+	for i := idLength - 1; i >= 0; i-- {
+		out[i] = idAlphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
+
+func decodeID(s string) ([16]byte, error) {
+	var id [16]byte
+	if len(s) != idLength || s[0] > '7' {
+		return id, backends.InvalidInputf("invalid ID %s", s)
+	}
+	var hi, lo uint64
+	for i := 0; i < idLength; i++ {
+		v := strings.IndexByte(idAlphabet, s[i])
+		if v < 0 {
+			return id, backends.InvalidInputf("invalid ID %s", s)
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return id, nil
+}
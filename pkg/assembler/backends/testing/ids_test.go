@@ -0,0 +1,50 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIDsGrowWithIngestion(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	// many IDs are handed out within the same millisecond
+	last := ""
+	for i := 0; i < 1000; i++ {
+		pkg, err := b.IngestPackage(ctx, model.PkgInputSpec{Type: "pypi", Name: fmt.Sprintf("package-%d", i), Version: ptrfrom.String("1.0.0")})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		id := pkg.Namespaces[0].Names[0].Versions[0].ID
+		if len(id) != 26 {
+			t.Fatalf("expected an ID of 26 characters, got %s", id)
+		}
+		if id <= last {
+			t.Fatalf("expected ID %s to be greater than the previous ID %s", id, last)
+		}
+		last = id
+	}
+}
@@ -17,29 +17,56 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between packages and dependent packages (isDependency)
 type isDependencyList []*isDependencyLink
 type isDependencyLink struct {
-	id            uint32
-	packageID     uint32
-	depPackageID  uint32
+	id            string
+	packageID     string
+	depPackageID  string
 	versionRange  string
 	justification string
 	origin        string
 	collector     string
 }
 
-func (n *isDependencyLink) getID() uint32 { return n.id }
+func (n *isDependencyLink) getID() string { return n.id }
+
+func (n *isDependencyLink) neighbors() []string { return []string{n.packageID, n.depPackageID} }
+
+// isDependencyLinkKey is the identity of an IsDependency: ingesting a link
+// with the same key returns the existing one.
+type isDependencyLinkKey struct {
+	packageID     string
+	depPackageID  string
+	versionRange  string
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *isDependencyLink) key() isDependencyLinkKey {
+	return isDependencyLinkKey{
+		packageID:     n.packageID,
+		depPackageID:  n.depPackageID,
+		versionRange:  n.versionRange,
+		justification: n.justification,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+}
 
 // Ingest IsDependency
 func (c *demoClient) IngestDependency(ctx context.Context, packageArg model.PkgInputSpec, dependentPackageArg model.PkgInputSpec, dependency model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{&packageArg, &dependentPackageArg}, nil); err != nil {
+		return nil, err
+	}
 	packageID, err := getPackageIDFromInput(c, packageArg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion})
 	if err != nil {
 		return nil, err
@@ -52,57 +79,33 @@ func (c *demoClient) IngestDependency(ctx context.Context, packageArg model.PkgI
 		return nil, err
 	}
 
-	packageDependencies := []uint32{}
-	pkgVersionNode, ok := c.index[packageID].(*pkgVersionNode)
-	if ok {
-		packageDependencies = append(packageDependencies, pkgVersionNode.isDependencyLink...)
-	}
-	depPackageDependencies := []uint32{}
-	pkgName, ok := c.index[depPackageID].(*pkgVersionStruct)
-	if ok {
-		depPackageDependencies = append(depPackageDependencies, pkgName.isDependencyLink...)
-	}
-
-	searchIDs := []uint32{}
-	if len(packageDependencies) > len(depPackageDependencies) {
-		searchIDs = append(searchIDs, depPackageDependencies...)
-	} else {
-		searchIDs = append(searchIDs, packageDependencies...)
+	collectedIsDependencyLink := &isDependencyLink{
+		packageID:     packageID,
+		depPackageID:  depPackageID,
+		versionRange:  dependency.VersionRange,
+		justification: dependency.Justification,
+		origin:        dependency.Origin,
+		collector:     dependency.Collector,
 	}
 
 	// Don't insert duplicates
-	duplicate := false
-	collectedIsDependencyLink := isDependencyLink{}
-	for _, id := range searchIDs {
-		v, _ := c.dependencyByID(id)
-		if packageID == v.packageID && depPackageID == v.depPackageID && dependency.Justification == v.justification &&
-			dependency.Origin == v.origin && dependency.Collector == v.collector && dependency.VersionRange == v.versionRange {
-
-			collectedIsDependencyLink = *v
-			duplicate = true
-			break
-		}
-	}
-	if !duplicate {
+	if existing, ok := c.isDependencyKeys[collectedIsDependencyLink.key()]; ok {
+		collectedIsDependencyLink = existing
+		c.countIngestion(model.NodeTypeIsDependency, false)
+	} else {
 		// store the link
-		collectedIsDependencyLink = isDependencyLink{
-			id:            c.getNextID(),
-			packageID:     packageID,
-			depPackageID:  depPackageID,
-			versionRange:  dependency.VersionRange,
-			justification: dependency.Justification,
-			origin:        dependency.Origin,
-			collector:     dependency.Collector,
-		}
-		c.index[collectedIsDependencyLink.id] = &collectedIsDependencyLink
-		c.isDependencies = append(c.isDependencies, &collectedIsDependencyLink)
+		collectedIsDependencyLink.id = c.getNextID()
+		c.index[collectedIsDependencyLink.id] = collectedIsDependencyLink
+		c.isDependencyKeys[collectedIsDependencyLink.key()] = collectedIsDependencyLink
+		c.recordChange(collectedIsDependencyLink.id, model.NodeTypeIsDependency)
+		c.isDependencies = append(c.isDependencies, collectedIsDependencyLink)
 		// set the backlinks
 		c.index[packageID].(pkgNameOrVersion).setIsDependencyLink(collectedIsDependencyLink.id)
 		c.index[depPackageID].(pkgNameOrVersion).setIsDependencyLink(collectedIsDependencyLink.id)
 	}
 
 	// build return GraphQL type
-	foundIsDependency, err := buildIsDependency(c, &collectedIsDependencyLink, nil, true)
+	foundIsDependency, err := buildIsDependency(c, collectedIsDependencyLink, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -112,54 +115,159 @@ func (c *demoClient) IngestDependency(ctx context.Context, packageArg model.PkgI
 
 // Query IsDependency
 func (c *demoClient) IsDependency(ctx context.Context, filter *model.IsDependencySpec) ([]*model.IsDependency, error) {
-	out := []*model.IsDependency{}
+	page, err := c.IsDependencyList(ctx, filter, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return page.IsDependencies, nil
+}
+
+func (c *demoClient) IsDependencyList(ctx context.Context, filter *model.IsDependencySpec, first *int, after *string) (*model.IsDependencyConnection, error) {
+	if filter != nil {
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
+	p, err := c.newPaginator("IsDependency", first, after)
+	if err != nil {
+		return nil, err
+	}
+	out := &model.IsDependencyConnection{IsDependencies: []*model.IsDependency{}}
 
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		node, ok := c.index[uint32(id)]
+		node, ok := c.index[id]
 		if !ok {
-			return nil, gqlerror.Errorf("ID does not match existing node")
+			return nil, backends.NotFoundf("ID does not match existing node")
+		}
+		link, ok := node.(*isDependencyLink)
+		if !ok {
+			return nil, backends.InvalidInputf("ID does not match expected node type for isDependency")
+		}
+		foundIsDependency, err := buildIsDependency(c, link, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		if p.add(link.id) {
+			out.IsDependencies = append(out.IsDependencies, foundIsDependency)
+		}
+		out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+		return out, nil
+	}
+
+	matches, err := c.isDependencyMatches(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i, link := range matches {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if !p.add(link.id) {
+			continue
+		}
+		foundIsDependency, err := buildIsDependency(c, link, filter, false)
+		if err != nil {
+			return nil, err
+		}
+		out.IsDependencies = append(out.IsDependencies, foundIsDependency)
+	}
+	out.TotalCount, out.EndCursor, out.HasNextPage = p.finish(ctx)
+
+	return out, nil
+}
+
+// IsDependencyCount returns the number of IsDependency matching filter,
+// without building them. It is not capped by the result limits.
+func (c *demoClient) IsDependencyCount(ctx context.Context, filter *model.IsDependencySpec) (int, error) {
+	if filter != nil && filter.ID != nil {
+		page, err := c.IsDependencyList(ctx, filter, nil, nil)
+		if err != nil {
+			return 0, err
 		}
-		if link, ok := node.(*isDependencyLink); ok {
-			foundIsDependency, err := buildIsDependency(c, link, filter, true)
-			if err != nil {
-				return nil, err
+		return page.TotalCount, nil
+	}
+	if filter != nil {
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return 0, err
+		}
+	}
+	matches, err := c.isDependencyMatches(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return len(matches), nil
+}
+
+// isDependencyMatches returns the links matching filter, without an ID, in
+// ingestion order.
+func (c *demoClient) isDependencyMatches(ctx context.Context, filter *model.IsDependencySpec) (isDependencyList, error) {
+	// If the package or dependent package are specified, only search their
+	// backedges. Both ends of a link hold a backedge to it.
+	search := c.isDependencies
+	if filter != nil {
+		ids := shortestBackedges(
+			c.packageBackedges(filter.Package, pkgNameOrVersion.getIsDependencyLink),
+			c.packageBackedges(dependentPackageFilter(filter), pkgNameOrVersion.getIsDependencyLink))
+		if ids != nil {
+			search = make(isDependencyList, 0, len(ids))
+			for _, id := range ids {
+				link, err := c.dependencyByID(id)
+				if err != nil {
+					return nil, backends.Internalf("IsDependency :: Bad isDependency id stored on existing package: %s", err)
+				}
+				search = append(search, link)
 			}
-			return []*model.IsDependency{foundIsDependency}, nil
-		} else {
-			return nil, gqlerror.Errorf("ID does not match expected node type for isDependency")
 		}
 	}
 
-	// TODO if any of the pkg/dependent pkg are specified, ony search those backedges
-	for _, link := range c.isDependencies {
-		if filter != nil && noMatch(filter.Justification, link.justification) {
+	matches := isDependencyList{}
+	for i, link := range search {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Justification, link.justification) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Origin, link.origin) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Collector, link.collector) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
 			continue
 		}
 		if filter != nil && noMatch(filter.VersionRange, link.versionRange) {
 			continue
 		}
-
-		foundIsDependency, err := buildIsDependency(c, link, filter, false)
-		if err != nil {
-			return nil, err
-		}
-		if foundIsDependency == nil {
+		if filter != nil && filter.DependentVersion != nil && !helper.VersionInRange(link.versionRange, *filter.DependentVersion) {
 			continue
 		}
-		out = append(out, foundIsDependency)
+		if c.matchIsDependency(link, filter) {
+			matches = append(matches, link)
+		}
 	}
+	return matches, nil
+}
 
-	return out, nil
+// matchIsDependency reports whether buildIsDependency would return a result
+// for link on query, without building it.
+func (c *demoClient) matchIsDependency(link *isDependencyLink, filter *model.IsDependencySpec) bool {
+	if filter == nil {
+		return c.matchPackage(link.packageID, nil) && c.matchPackage(link.depPackageID, nil)
+	}
+	return c.matchPackage(link.packageID, filter.Package) && c.matchPackage(link.depPackageID, dependentPackageFilter(filter))
+}
+
+// dependentPackageFilter returns the package filter of the dependent package
+// of filter, which only selects package names.
+func dependentPackageFilter(filter *model.IsDependencySpec) *model.PkgSpec {
+	if filter.DependentPackage == nil {
+		return nil
+	}
+	return &model.PkgSpec{Type: filter.DependentPackage.Type, Namespace: filter.DependentPackage.Namespace,
+		Name: filter.DependentPackage.Name}
 }
 
 func buildIsDependency(c *demoClient, link *isDependencyLink, filter *model.IsDependencySpec, ingestOrIDProvided bool) (*model.IsDependency, error) {
@@ -193,19 +301,19 @@ func buildIsDependency(c *demoClient, link *isDependencyLink, filter *model.IsDe
 
 	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if p == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve package via packageID")
+		return nil, backends.Internalf("failed to retrieve package via packageID")
 	} else if p == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 	// if dependent package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if dep == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve dependent package via dependent packageID")
+		return nil, backends.Internalf("failed to retrieve dependent package via dependent packageID")
 	} else if dep == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	foundIsDependency := model.IsDependency{
-		ID:               nodeID(link.id),
+		ID:               link.id,
 		Package:          p,
 		DependentPackage: dep,
 		VersionRange:     link.versionRange,
@@ -213,17 +321,115 @@ func buildIsDependency(c *demoClient, link *isDependencyLink, filter *model.IsDe
 		Origin:           link.origin,
 		Collector:        link.collector,
 	}
+	if filter != nil && filter.IncludeSuccessors != nil && *filter.IncludeSuccessors {
+		foundIsDependency.Successors, err = c.buildSuccessors(link.depPackageID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &foundIsDependency, nil
 }
 
-func (c *demoClient) dependencyByID(id uint32) (*isDependencyLink, error) {
+func (c *demoClient) dependencyByID(id string) (*isDependencyLink, error) {
 	node, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find isDependencyLink")
+		return nil, backends.NotFoundf("could not find isDependencyLink")
 	}
 	link, ok := node.(*isDependencyLink)
 	if !ok {
-		return nil, errors.New("not an isDependencyLink")
+		return nil, backends.InvalidInputf("not an isDependencyLink")
 	}
 	return link, nil
 }
+
+// Dependencies walks the IsDependency links breadth-first from the package
+// versions matching pkg. The dependencies of a dependent package, which is a
+// package name, are those of the name and of all its versions.
+func (c *demoClient) Dependencies(ctx context.Context, pkg model.PkgSpec, maxDepth int) (*model.DependencyTree, error) {
+	if maxDepth <= 0 {
+		return nil, backends.InvalidInputf("dependencies :: maxDepth must be positive")
+	}
+	startIDs, err := c.matchingPkgNameOrVersionIDs(&pkg)
+	if err != nil {
+		return nil, fmt.Errorf("dependencies :: %w", err)
+	}
+	out := &model.DependencyTree{
+		Packages:     []*model.Package{},
+		Dependencies: []*model.IsDependency{},
+		Occurrences:  []*model.IsOccurrence{},
+	}
+	visited := map[string]bool{}
+	for _, id := range startIDs {
+		visited[id] = true
+		if v, ok := c.index[id].(*pkgVersionNode); ok {
+			// the package name of a root is not a dependency to walk
+			visited[v.parent] = true
+		}
+	}
+	frontier := startIDs
+	steps := 0
+	for depth := 0; len(frontier) > 0; depth++ {
+		next := []string{}
+		for _, id := range frontier {
+			for _, link := range c.outgoingDependencies(id) {
+				if err := checkCanceled(ctx, steps); err != nil {
+					return nil, err
+				}
+				steps++
+				if depth == maxDepth {
+					out.Truncated = true
+					return out, nil
+				}
+				dependency, err := buildIsDependency(c, link, nil, true)
+				if err != nil {
+					return nil, err
+				}
+				out.Dependencies = append(out.Dependencies, dependency)
+				if visited[link.depPackageID] {
+					continue
+				}
+				visited[link.depPackageID] = true
+				dependent, err := c.buildPackageResponse(link.depPackageID, nil)
+				if err != nil {
+					return nil, err
+				}
+				out.Packages = append(out.Packages, dependent)
+				next = append(next, link.depPackageID)
+				name, ok := c.index[link.depPackageID].(*pkgVersionStruct)
+				if !ok {
+					continue
+				}
+				for _, v := range name.versions {
+					visited[v.id] = true
+					next = append(next, v.id)
+					for _, occurrenceID := range v.occurrences {
+						occurrence, err := c.occurrenceByID(occurrenceID)
+						if err != nil {
+							return nil, err
+						}
+						out.Occurrences = append(out.Occurrences, c.convOccurrence(occurrence))
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	return out, nil
+}
+
+// outgoingDependencies returns the IsDependency links whose package is the
+// package name or version id, in ingestion order.
+func (c *demoClient) outgoingDependencies(id string) []*isDependencyLink {
+	node, ok := c.index[id].(pkgNameOrVersion)
+	if !ok {
+		return nil
+	}
+	var out []*isDependencyLink
+	for _, linkID := range node.getIsDependencyLink() {
+		link, err := c.dependencyByID(linkID)
+		if err == nil && link.packageID == id {
+			out = append(out, link)
+		}
+	}
+	return out
+}
@@ -0,0 +1,177 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestDependencies(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	npm := func(name, version string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "npm", Name: name, Version: ptrfrom.String(version)}
+	}
+	app, libB, libC, libD, leaf := npm("app", "1.0.0"), npm("b", "1.0.0"), npm("c", "2.0.0"), npm("d", "1.0.0"), npm("leaf", "1.0.0")
+	for _, p := range []*model.PkgInputSpec{app, libB, libC, libD, leaf} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	// a diamond, app to d through b and c, with d depending back on app and
+	// b
+	for _, edge := range [][2]*model.PkgInputSpec{{app, libB}, {app, libC}, {libB, libD}, {libC, libD}, {libD, app}, {libD, libB}} {
+		_, err := b.IngestDependency(ctx, *edge[0], *edge[1], model.IsDependencyInputSpec{VersionRange: "^1.0.0", Justification: "direct"})
+		if err != nil {
+			t.Fatalf("Could not ingest IsDependency: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: libD}, *a1, model.IsOccurrenceInputSpec{Justification: "built"}); err != nil {
+		t.Fatalf("Could not ingest IsOccurrence: %v", err)
+	}
+
+	name := func(p *model.Package) string { return p.Namespaces[0].Names[0].Name }
+	tests := []struct {
+		Name         string
+		Pkg          model.PkgSpec
+		MaxDepth     int
+		ExpPackages  []string
+		ExpEdges     []string
+		ExpArtifacts []string
+		ExpTruncated bool
+		ExpErr       error
+	}{
+		{
+			Name:         "Whole closure",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("app")},
+			MaxDepth:     10,
+			ExpPackages:  []string{"b", "c", "d"},
+			ExpEdges:     []string{"app b", "app c", "b d", "c d", "d app", "d b"},
+			ExpArtifacts: []string{a1.Digest},
+		},
+		{
+			Name:         "Depth of the closure",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("app")},
+			MaxDepth:     3,
+			ExpPackages:  []string{"b", "c", "d"},
+			ExpEdges:     []string{"app b", "app c", "b d", "c d", "d app", "d b"},
+			ExpArtifacts: []string{a1.Digest},
+		},
+		{
+			Name:         "Direct dependencies",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("app")},
+			MaxDepth:     1,
+			ExpPackages:  []string{"b", "c"},
+			ExpEdges:     []string{"app b", "app c"},
+			ExpArtifacts: []string{},
+			ExpTruncated: true,
+		},
+		{
+			Name:         "Stopped before the cycle",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("app"), Version: ptrfrom.String("1.0.0")},
+			MaxDepth:     2,
+			ExpPackages:  []string{"b", "c", "d"},
+			ExpEdges:     []string{"app b", "app c", "b d", "c d"},
+			ExpArtifacts: []string{a1.Digest},
+			ExpTruncated: true,
+		},
+		{
+			Name:         "From the end of the diamond",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("d")},
+			MaxDepth:     10,
+			ExpPackages:  []string{"app", "b", "c"},
+			ExpEdges:     []string{"d app", "d b", "app b", "app c", "b d", "c d"},
+			ExpArtifacts: []string{},
+		},
+		{
+			Name:         "No dependencies",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("leaf")},
+			MaxDepth:     10,
+			ExpPackages:  []string{},
+			ExpEdges:     []string{},
+			ExpArtifacts: []string{},
+		},
+		{
+			Name:         "Unknown package",
+			Pkg:          model.PkgSpec{Name: ptrfrom.String("unknown")},
+			MaxDepth:     10,
+			ExpPackages:  []string{},
+			ExpEdges:     []string{},
+			ExpArtifacts: []string{},
+		},
+		{
+			Name:     "Zero depth",
+			Pkg:      model.PkgSpec{Name: ptrfrom.String("app")},
+			MaxDepth: 0,
+			ExpErr:   backends.ErrInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.Dependencies(ctx, test.Pkg, test.MaxDepth)
+			if !errors.Is(err, test.ExpErr) {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Packages == nil || got.Dependencies == nil || got.Occurrences == nil {
+				t.Errorf("expected empty lists rather than nil, got: %+v", got)
+			}
+			packages := []string{}
+			for _, p := range got.Packages {
+				packages = append(packages, name(p))
+			}
+			if diff := cmp.Diff(test.ExpPackages, packages); diff != "" {
+				t.Errorf("Unexpected packages (-want +got):\n%s", diff)
+			}
+			edges := []string{}
+			for _, d := range got.Dependencies {
+				edges = append(edges, name(d.Package)+" "+name(d.DependentPackage))
+				if d.VersionRange != "^1.0.0" || d.Justification != "direct" {
+					t.Errorf("expected the properties of the IsDependency, got: %+v", d)
+				}
+			}
+			if diff := cmp.Diff(test.ExpEdges, edges); diff != "" {
+				t.Errorf("Unexpected dependencies (-want +got):\n%s", diff)
+			}
+			artifacts := []string{}
+			for _, o := range got.Occurrences {
+				artifacts = append(artifacts, o.Artifact.Digest)
+			}
+			if diff := cmp.Diff(test.ExpArtifacts, artifacts); diff != "" {
+				t.Errorf("Unexpected occurrences (-want +got):\n%s", diff)
+			}
+			if got.Truncated != test.ExpTruncated {
+				t.Errorf("expected truncated to be %v, got %v", test.ExpTruncated, got.Truncated)
+			}
+		})
+	}
+}
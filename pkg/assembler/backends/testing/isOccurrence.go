@@ -17,32 +17,63 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"fmt"
 	"strings"
+	"time"
 
-	"github.com/vektah/gqlparser/v2/gqlerror"
-
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
-const maxUint32 = ^uint32(0)
-
 // Internal isOccurrence
 
 type isOccurrenceList []*isOccurrenceStruct
 type isOccurrenceStruct struct {
-	id            uint32
-	pkg           uint32
-	source        uint32
-	artifact      uint32
+	id            string
+	pkg           string
+	source        string
+	artifact      string
+	justification string
+	origin        string
+	collector     string
+	ingestedAt    time.Time
+}
+
+func (n *isOccurrenceStruct) getID() string { return n.id }
+
+func (n *isOccurrenceStruct) neighbors() []string {
+	ids := []string{n.artifact}
+	if n.pkg != "" {
+		ids = append(ids, n.pkg)
+	}
+	if n.source != "" {
+		ids = append(ids, n.source)
+	}
+	return ids
+}
+
+// isOccurrenceKey is the identity of an IsOccurrence: ingesting an
+// occurrence with the same key returns the existing one.
+type isOccurrenceKey struct {
+	pkg           string
+	source        string
+	artifact      string
 	justification string
 	origin        string
 	collector     string
 }
 
-func (n *isOccurrenceStruct) getID() uint32 { return n.id }
+func (n *isOccurrenceStruct) key() isOccurrenceKey {
+	return isOccurrenceKey{
+		pkg:           n.pkg,
+		source:        n.source,
+		artifact:      n.artifact,
+		justification: n.justification,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+}
 
 // TODO convert to unit tests
 // func registerAllIsOccurrence(client *demoClient) error {
@@ -60,7 +91,7 @@ func (n *isOccurrenceStruct) getID() uint32 { return n.id }
 // 	if err != nil {
 // 		return err
 // 	}
-// 	_, err = client.registerIsOccurrence(selectedPackage[0], nil, &model.Artifact{Digest: "5a787865sd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, "this artifact is an occurrence of this package", "testing backend", "testing backend")
+// 	_, err = client.registerIsOccurrence(selectedPackage[0], nil, &model.Artifact{Digest: "5a787865fd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, "this artifact is an occurrence of this package", "testing backend", "testing backend")
 // 	if err != nil {
 // 		return err
 // 	}
@@ -87,46 +118,36 @@ func (c *demoClient) IngestOccurrence(ctx context.Context, subject model.Package
 	if err != nil {
 		return nil, err
 	}
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, []*model.SourceInputSpec{subject.Source}); err != nil {
+		return nil, err
+	}
 
 	a, err := c.artifactByKey(artifact.Algorithm, artifact.Digest)
 	if err != nil {
-		return nil, gqlerror.Errorf("IngestOccurrence :: Artifact not found")
+		return nil, backends.NotFoundf("IngestOccurrence :: Artifact not found")
 	}
 
-	packageID := maxUint32
+	var packageID string
 	if subject.Package != nil {
 		var pmt model.MatchFlags
 		pmt.Pkg = model.PkgMatchTypeSpecificVersion
 		pid, err := getPackageIDFromInput(c, *subject.Package, pmt)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestOccurrence :: %v", err)
+			return nil, fmt.Errorf("IngestOccurrence :: %w", err)
 		}
 		packageID = pid
 	}
 
-	sourceID := maxUint32
+	var sourceID string
 	if subject.Source != nil {
 		sid, err := getSourceIDFromInput(c, *subject.Source)
 		if err != nil {
-			return nil, gqlerror.Errorf("IngestOccurrence :: %v", err)
+			return nil, fmt.Errorf("IngestOccurrence :: %w", err)
 		}
 		sourceID = sid
 	}
 
-	// could search backedges for pkg/src or artifiact, just do artifact
-	for _, id := range a.occurrences {
-		o, _ := c.occurrenceByID(id)
-		if o.pkg == packageID &&
-			o.source == sourceID &&
-			o.artifact == a.id &&
-			o.justification == occurrence.Justification &&
-			o.origin == occurrence.Origin &&
-			o.collector == occurrence.Collector {
-			return c.convOccurrence(o), nil
-		}
-	}
 	o := &isOccurrenceStruct{
-		id:            c.getNextID(),
 		pkg:           packageID,
 		source:        sourceID,
 		artifact:      a.id,
@@ -134,9 +155,17 @@ func (c *demoClient) IngestOccurrence(ctx context.Context, subject model.Package
 		origin:        occurrence.Origin,
 		collector:     occurrence.Collector,
 	}
+	if existing, ok := c.occurrenceKeys[o.key()]; ok {
+		c.countIngestion(model.NodeTypeIsOccurrence, false)
+		return c.convOccurrence(existing), nil
+	}
+	o.id = c.getNextID()
+	o.ingestedAt = c.now()
 	c.index[o.id] = o
+	c.occurrenceKeys[o.key()] = o
+	c.recordChange(o.id, model.NodeTypeIsOccurrence)
 	a.setOccurrences(o.id)
-	if packageID != maxUint32 {
+	if packageID != "" {
 		p, _ := c.pkgVersionByID(packageID)
 		p.setOccurrenceLink(o.id)
 	} else {
@@ -148,14 +177,14 @@ func (c *demoClient) IngestOccurrence(ctx context.Context, subject model.Package
 	return c.convOccurrence(o), nil
 }
 
-func (c *demoClient) occurrenceByID(id uint32) (*isOccurrenceStruct, error) {
+func (c *demoClient) occurrenceByID(id string) (*isOccurrenceStruct, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find occurrence")
+		return nil, backends.NotFoundf("could not find occurrence")
 	}
 	a, ok := o.(*isOccurrenceStruct)
 	if !ok {
-		return nil, errors.New("not an occurrence")
+		return nil, backends.InvalidInputf("not an occurrence")
 	}
 	return a, nil
 }
@@ -163,13 +192,13 @@ func (c *demoClient) occurrenceByID(id uint32) (*isOccurrenceStruct, error) {
 func (c *demoClient) convOccurrence(in *isOccurrenceStruct) *model.IsOccurrence {
 	a, _ := c.artifactByID(in.artifact)
 	o := &model.IsOccurrence{
-		ID:            nodeID(in.id),
+		ID:            in.id,
 		Artifact:      convArtifact(a),
 		Justification: in.justification,
 		Origin:        in.origin,
 		Collector:     in.collector,
 	}
-	if in.pkg != maxUint32 {
+	if in.pkg != "" {
 		p, _ := c.buildPackageResponse(in.pkg, nil)
 		o.Subject = p
 	} else {
@@ -179,7 +208,7 @@ func (c *demoClient) convOccurrence(in *isOccurrenceStruct) *model.IsOccurrence
 	return o
 }
 
-func (c *demoClient) artifactMatch(aID uint32, artifactSpec *model.ArtifactSpec) bool {
+func (c *demoClient) artifactMatch(aID string, artifactSpec *model.ArtifactSpec) bool {
 	if artifactSpec.Digest == nil && artifactSpec.Algorithm == nil {
 		return true
 	}
@@ -200,17 +229,19 @@ func (c *demoClient) artifactMatch(aID uint32, artifactSpec *model.ArtifactSpec)
 // Query IsOccurrence
 
 func (c *demoClient) IsOccurrence(ctx context.Context, ioSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	if err := helper.ValidateStringMatch(ioSpec.StringMatch); err != nil {
+		return nil, err
+	}
 	_, err := helper.ValidatePackageOrSourceQueryInput(ioSpec.Subject)
 	if err != nil {
 		return nil, err
 	}
 
 	if ioSpec.ID != nil {
-		id64, err := strconv.ParseUint(*ioSpec.ID, 10, 32)
+		id, err := parseID(*ioSpec.ID)
 		if err != nil {
-			return nil, gqlerror.Errorf("IsOccurrence :: invalid ID %s", err)
+			return nil, fmt.Errorf("IsOccurrence :: %w", err)
 		}
-		id := uint32(id64)
 		o, err := c.occurrenceByID(id)
 		if err != nil {
 			// Not found
@@ -220,12 +251,44 @@ func (c *demoClient) IsOccurrence(ctx context.Context, ioSpec *model.IsOccurrenc
 		return []*model.IsOccurrence{c.convOccurrence(o)}, nil
 	}
 
+	// If the package or source are specified, only search their backedges
+	// TODO if the artifact is specified, only search its backedges too
+	search := c.occurrences
+	if ioSpec.Subject != nil {
+		var ids []string
+		if ioSpec.Subject.Package != nil {
+			ids = c.packageBackedges(ioSpec.Subject.Package, func(p pkgNameOrVersion) []string {
+				if version, ok := p.(*pkgVersionNode); ok {
+					return version.getOccurrenceLink()
+				}
+				return nil
+			})
+		} else if ioSpec.Subject.Source != nil {
+			ids = c.sourceBackedges(ioSpec.Subject.Source, (*srcNameNode).getOccurrences)
+		}
+		if ids != nil {
+			search = make(isOccurrenceList, 0, len(ids))
+			for _, id := range ids {
+				o, err := c.occurrenceByID(id)
+				if err != nil {
+					return nil, backends.Internalf("IsOccurrence :: Bad occurrence id stored on existing node: %s", err)
+				}
+				search = append(search, o)
+			}
+		}
+	}
+
 	var rv []*model.IsOccurrence
-	// TODO if any of the pkg/src/artifact are specified, ony search those backedges
-	for _, o := range c.occurrences {
-		if noMatch(ioSpec.Justification, o.justification) ||
-			noMatch(ioSpec.Origin, o.origin) ||
-			noMatch(ioSpec.Collector, o.collector) {
+	limiter := c.newResultLimiter("IsOccurrence", nil)
+	for i, o := range search {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if noMatchString(ioSpec.StringMatch, ioSpec.Justification, o.justification) ||
+			noMatchString(ioSpec.StringMatch, ioSpec.Origin, o.origin) ||
+			noMatchString(ioSpec.StringMatch, ioSpec.Collector, o.collector) ||
+			noMatchAnyString(ioSpec.StringMatch, ioSpec.Origins, o.origin) ||
+			noMatchAnyString(ioSpec.StringMatch, ioSpec.Collectors, o.collector) {
 			continue
 		}
 		if ioSpec.Artifact != nil && !c.artifactMatch(o.artifact, ioSpec.Artifact) {
@@ -233,31 +296,28 @@ func (c *demoClient) IsOccurrence(ctx context.Context, ioSpec *model.IsOccurrenc
 		}
 		if ioSpec.Subject != nil {
 			if ioSpec.Subject.Package != nil {
-				if o.pkg == maxUint32 {
+				if o.pkg == "" {
 					continue
 				}
-				p, err := c.buildPackageResponse(o.pkg, ioSpec.Subject.Package)
-				if err != nil {
-					return nil, err
-				}
-				if p == nil {
+				if !c.matchPackage(o.pkg, ioSpec.Subject.Package) {
 					continue
 				}
 			} else if ioSpec.Subject.Source != nil {
-				if o.source == maxUint32 {
+				if o.source == "" {
 					continue
 				}
-				s, err := c.buildSourceResponse(o.source, ioSpec.Subject.Source)
-				if err != nil {
-					return nil, err
-				}
-				if s == nil {
+				if !c.matchSource(o.source, ioSpec.Subject.Source) {
 					continue
 				}
 			}
 		}
+		if limiter.full(len(rv)) {
+			limiter.skip()
+			continue
+		}
 		rv = append(rv, c.convOccurrence(o))
 	}
+	limiter.record(ctx, len(rv))
 
 	return rv, nil
 }
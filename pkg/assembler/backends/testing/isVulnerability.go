@@ -17,27 +17,51 @@ package testing
 
 import (
 	"context"
-	"errors"
-	"strconv"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Internal data: link between equal vulnerabilities (isVulnerability)
 type equalVulnerabilityList []*equalVulnerabilityLink
 type equalVulnerabilityLink struct {
-	id            uint32
-	osvID         uint32
-	cveID         uint32
-	ghsaID        uint32
+	id            string
+	osvID         string
+	cveID         string
+	ghsaID        string
 	justification string
 	origin        string
 	collector     string
 }
 
-func (n *equalVulnerabilityLink) getID() uint32 { return n.id }
+func (n *equalVulnerabilityLink) getID() string { return n.id }
+
+func (n *equalVulnerabilityLink) neighbors() []string {
+	return appendSetIDs(nil, n.osvID, n.cveID, n.ghsaID)
+}
+
+// equalVulnerabilityLinkKey is the identity of an IsVulnerability: ingesting
+// a link with the same key returns the existing one.
+type equalVulnerabilityLinkKey struct {
+	osvID         string
+	cveID         string
+	ghsaID        string
+	justification string
+	origin        string
+	collector     string
+}
+
+func (n *equalVulnerabilityLink) key() equalVulnerabilityLinkKey {
+	return equalVulnerabilityLinkKey{
+		osvID:         n.osvID,
+		cveID:         n.cveID,
+		ghsaID:        n.ghsaID,
+		justification: n.justification,
+		origin:        n.origin,
+		collector:     n.collector,
+	}
+}
 
 // Ingest CertifyPkg
 func (c *demoClient) IngestIsVulnerability(ctx context.Context, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) (*model.IsVulnerability, error) {
@@ -51,24 +75,13 @@ func (c *demoClient) IngestIsVulnerability(ctx context.Context, osv model.OSVInp
 		return nil, err
 	}
 
-	osvEqualVulns := []uint32{}
-	osvNode, ok := c.index[osvID].(*osvIDNode)
-	if ok {
-		osvEqualVulns = append(osvEqualVulns, osvNode.equalVulnLink...)
-	}
-
-	var cveID uint32
-	var ghsaID uint32
-	vulnerabilityLinks := []uint32{}
+	var cveID string
+	var ghsaID string
 	if vulnerability.Cve != nil {
 		cveID, err = getCveIDFromInput(c, *vulnerability.Cve)
 		if err != nil {
 			return nil, err
 		}
-		cveNode, ok := c.index[cveID].(*cveIDNode)
-		if ok {
-			vulnerabilityLinks = append(vulnerabilityLinks, cveNode.equalVulnLink...)
-		}
 	}
 
 	if vulnerability.Ghsa != nil {
@@ -76,64 +89,40 @@ func (c *demoClient) IngestIsVulnerability(ctx context.Context, osv model.OSVInp
 		if err != nil {
 			return nil, err
 		}
-		ghsaNode, ok := c.index[ghsaID].(*ghsaIDNode)
-		if ok {
-			vulnerabilityLinks = append(vulnerabilityLinks, ghsaNode.equalVulnLink...)
-		}
 	}
 
-	searchIDs := []uint32{}
-	if len(osvEqualVulns) > len(vulnerabilityLinks) {
-		searchIDs = append(searchIDs, vulnerabilityLinks...)
-	} else {
-		searchIDs = append(searchIDs, osvEqualVulns...)
+	collectedEqualVulnLink := &equalVulnerabilityLink{
+		osvID:         osvID,
+		cveID:         cveID,
+		ghsaID:        ghsaID,
+		justification: isVulnerability.Justification,
+		origin:        isVulnerability.Origin,
+		collector:     isVulnerability.Collector,
 	}
 
 	// Don't insert duplicates
-	duplicate := false
-	collectedEqualVulnLink := equalVulnerabilityLink{}
-	for _, id := range searchIDs {
-		v, _ := c.equalVulnByID(id)
-		vulnMatch := false
-		if cveID != 0 && cveID == v.cveID {
-			vulnMatch = true
-		}
-		if ghsaID != 0 && ghsaID == v.ghsaID {
-			vulnMatch = true
-		}
-		if vulnMatch && osvID == v.osvID && isVulnerability.Justification == v.justification &&
-			isVulnerability.Origin == v.origin && isVulnerability.Collector == v.collector {
-
-			collectedEqualVulnLink = *v
-			duplicate = true
-			break
-		}
-	}
-	if !duplicate {
+	if existing, ok := c.isVulnerabilityKeys[collectedEqualVulnLink.key()]; ok {
+		collectedEqualVulnLink = existing
+		c.countIngestion(model.NodeTypeIsVulnerability, false)
+	} else {
 		// store the link
-		collectedEqualVulnLink = equalVulnerabilityLink{
-			id:            c.getNextID(),
-			osvID:         osvID,
-			cveID:         cveID,
-			ghsaID:        ghsaID,
-			justification: isVulnerability.Justification,
-			origin:        isVulnerability.Origin,
-			collector:     isVulnerability.Collector,
-		}
-		c.index[collectedEqualVulnLink.id] = &collectedEqualVulnLink
-		c.equalVulnerabilities = append(c.equalVulnerabilities, &collectedEqualVulnLink)
+		collectedEqualVulnLink.id = c.getNextID()
+		c.index[collectedEqualVulnLink.id] = collectedEqualVulnLink
+		c.isVulnerabilityKeys[collectedEqualVulnLink.key()] = collectedEqualVulnLink
+		c.recordChange(collectedEqualVulnLink.id, model.NodeTypeIsVulnerability)
+		c.equalVulnerabilities = append(c.equalVulnerabilities, collectedEqualVulnLink)
 		// set the backlinks
 		c.index[osvID].(*osvIDNode).setEqualVulnLink(collectedEqualVulnLink.id)
-		if cveID != 0 {
+		if cveID != "" {
 			c.index[cveID].(*cveIDNode).setEqualVulnLink(collectedEqualVulnLink.id)
 		}
-		if ghsaID != 0 {
+		if ghsaID != "" {
 			c.index[ghsaID].(*ghsaIDNode).setEqualVulnLink(collectedEqualVulnLink.id)
 		}
 	}
 
 	// build return GraphQL type
-	builtIsVuln, err := buildIsVulnerability(c, &collectedEqualVulnLink, nil, true)
+	builtIsVuln, err := buildIsVulnerability(c, collectedEqualVulnLink, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -142,16 +131,21 @@ func (c *demoClient) IngestIsVulnerability(ctx context.Context, osv model.OSVInp
 
 // Query CertifyPkg
 func (c *demoClient) IsVulnerability(ctx context.Context, filter *model.IsVulnerabilitySpec) ([]*model.IsVulnerability, error) {
+	if filter != nil {
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
 	out := []*model.IsVulnerability{}
 
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		node, ok := c.index[uint32(id)]
+		node, ok := c.index[id]
 		if !ok {
-			return nil, gqlerror.Errorf("ID does not match existing node")
+			return nil, backends.NotFoundf("ID does not match existing node")
 		}
 		if link, ok := node.(*equalVulnerabilityLink); ok {
 			foundIsVuln, err := buildIsVulnerability(c, link, filter, true)
@@ -160,19 +154,22 @@ func (c *demoClient) IsVulnerability(ctx context.Context, filter *model.IsVulner
 			}
 			return []*model.IsVulnerability{foundIsVuln}, nil
 		} else {
-			return nil, gqlerror.Errorf("ID does not match expected node type for equalVulnerabilityLink")
+			return nil, backends.InvalidInputf("ID does not match expected node type for equalVulnerabilityLink")
 		}
 	}
 
 	// TODO if any of the osv/vulnerabilities are specified, ony search those backedges
-	for _, link := range c.equalVulnerabilities {
-		if filter != nil && noMatch(filter.Justification, link.justification) {
+	for i, link := range c.equalVulnerabilities {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Justification, link.justification) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Collector, link.collector) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
 			continue
 		}
-		if filter != nil && noMatch(filter.Origin, link.origin) {
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
 			continue
 		}
 
@@ -208,26 +205,26 @@ func buildIsVulnerability(c *demoClient, link *equalVulnerabilityLink, filter *m
 	}
 
 	if filter != nil && filter.Vulnerability != nil {
-		if filter.Vulnerability.Cve != nil && link.cveID != 0 {
+		if filter.Vulnerability.Cve != nil && link.cveID != "" {
 			cve, err = c.buildCveResponse(link.cveID, filter.Vulnerability.Cve)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if filter.Vulnerability.Ghsa != nil && link.ghsaID != 0 {
+		if filter.Vulnerability.Ghsa != nil && link.ghsaID != "" {
 			ghsa, err = c.buildGhsaResponse(link.ghsaID, filter.Vulnerability.Ghsa)
 			if err != nil {
 				return nil, err
 			}
 		}
 	} else {
-		if link.cveID != 0 {
+		if link.cveID != "" {
 			cve, err = c.buildCveResponse(link.cveID, nil)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if link.ghsaID != 0 {
+		if link.ghsaID != "" {
 			ghsa, err = c.buildGhsaResponse(link.ghsaID, nil)
 			if err != nil {
 				return nil, err
@@ -236,23 +233,23 @@ func buildIsVulnerability(c *demoClient, link *equalVulnerabilityLink, filter *m
 	}
 	// if osv not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
 	if osv == nil && ingestOrIDProvided {
-		return nil, gqlerror.Errorf("failed to retrieve osv via osvID")
+		return nil, backends.Internalf("failed to retrieve osv via osvID")
 	} else if osv == nil && !ingestOrIDProvided {
 		return nil, nil
 	}
 
 	var vuln model.CveOrGhsa
-	if link.cveID != 0 {
+	if link.cveID != "" {
 		if cve == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve cve via cveID")
+			return nil, backends.Internalf("failed to retrieve cve via cveID")
 		} else if cve == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
 		vuln = cve
 	}
-	if link.ghsaID != 0 {
+	if link.ghsaID != "" {
 		if ghsa == nil && ingestOrIDProvided {
-			return nil, gqlerror.Errorf("failed to retrieve ghsa via ghsaID")
+			return nil, backends.Internalf("failed to retrieve ghsa via ghsaID")
 		} else if ghsa == nil && !ingestOrIDProvided {
 			return nil, nil
 		}
@@ -260,7 +257,7 @@ func buildIsVulnerability(c *demoClient, link *equalVulnerabilityLink, filter *m
 	}
 
 	isVuln := model.IsVulnerability{
-		ID:            nodeID(link.id),
+		ID:            link.id,
 		Osv:           osv,
 		Vulnerability: vuln,
 		Justification: link.justification,
@@ -270,14 +267,14 @@ func buildIsVulnerability(c *demoClient, link *equalVulnerabilityLink, filter *m
 	return &isVuln, nil
 }
 
-func (c *demoClient) equalVulnByID(id uint32) (*equalVulnerabilityLink, error) {
+func (c *demoClient) equalVulnByID(id string) (*equalVulnerabilityLink, error) {
 	node, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find equalVulnerabilityLink")
+		return nil, backends.NotFoundf("could not find equalVulnerabilityLink")
 	}
 	link, ok := node.(*equalVulnerabilityLink)
 	if !ok {
-		return nil, errors.New("not an equalVulnerabilityLink")
+		return nil, backends.InvalidInputf("not an equalVulnerabilityLink")
 	}
 	return link, nil
 }
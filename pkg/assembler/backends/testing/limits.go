@@ -0,0 +1,91 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+)
+
+// ResultLimitQueries are the names of the queries whose results can be
+// capped, the keys of DemoCredentials.ResultLimits. They are the GraphQL field
+// names of the queries. The caps of CertifyVuln, HasSourceAt and IsDependency
+// also apply to CertifyVulnList, HasSourceAtList and IsDependencyList queried
+// without first.
+var ResultLimitQueries = []string{
+	"CertifyVuln",
+	"HasSourceAt",
+	"IsDependency",
+	"IsOccurrence",
+	"changes",
+	"conflicts",
+	"findSoftware",
+	"riskyPackages",
+}
+
+// resultLimiter applies the server's default result cap to a query. Once the
+// cap is reached the query only counts the remaining matches, without
+// building them, so that the total available can be reported to the client
+// through helper.RecordResultLimit.
+type resultLimiter struct {
+	query   string
+	limit   int
+	skipped int
+}
+
+// newResultLimiter returns the limiter for query. Explicit pagination through
+// first bypasses the default cap, as the client already bounds the page size.
+func (c *demoClient) newResultLimiter(query string, first *int) *resultLimiter {
+	l := &resultLimiter{query: query}
+	if first != nil {
+		return l
+	}
+	l.limit = c.defaultResultLimit
+	if limit, ok := c.resultLimits[query]; ok {
+		l.limit = limit
+	}
+	if l.limit < 0 {
+		l.limit = 0
+	}
+	return l
+}
+
+// full reports whether the returned results reached the cap, in which case
+// further matches must only be counted with skip.
+func (l *resultLimiter) full(returned int) bool {
+	return l.limit > 0 && returned >= l.limit
+}
+
+func (l *resultLimiter) skip() {
+	l.skipped++
+}
+
+// record reports the truncation, if any, on ctx.
+func (l *resultLimiter) record(ctx context.Context, returned int) {
+	if l.skipped > 0 {
+		helper.RecordResultLimit(ctx, l.query, returned, returned+l.skipped)
+	}
+}
+
+// filterMatchesID reports whether the ID filter, if any, selects node id.
+func filterMatchesID(filterID *string, id string) bool {
+	if filterID == nil {
+		return true
+	}
+	filteredID, err := parseID(*filterID)
+	return err == nil && filteredID == id
+}
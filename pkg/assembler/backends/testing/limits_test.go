@@ -0,0 +1,206 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestResultLimits(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Args      inmem.DemoCredentials
+		Query     *model.CertifyVulnSpec
+		ExpCount  int
+		ExpLimits map[string]helper.ResultLimit
+	}{
+		{
+			Name:     "No cap",
+			Query:    &model.CertifyVulnSpec{},
+			ExpCount: 4,
+		},
+		{
+			Name:     "Default cap",
+			Args:     inmem.DemoCredentials{DefaultResultLimit: 2},
+			Query:    &model.CertifyVulnSpec{},
+			ExpCount: 2,
+			ExpLimits: map[string]helper.ResultLimit{
+				"CertifyVuln": {Returned: 2, TotalAvailable: 4, Truncated: true},
+			},
+		},
+		{
+			Name:     "Total counts only matches",
+			Args:     inmem.DemoCredentials{DefaultResultLimit: 2},
+			Query:    &model.CertifyVulnSpec{Package: &model.PkgSpec{Type: ptrfrom.String("pypi")}},
+			ExpCount: 2,
+			ExpLimits: map[string]helper.ResultLimit{
+				"CertifyVuln": {Returned: 2, TotalAvailable: 3, Truncated: true},
+			},
+		},
+		{
+			Name: "Total counts only vulnerability matches",
+			Args: inmem.DemoCredentials{DefaultResultLimit: 1},
+			Query: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{
+				Cve: &model.CVESpec{CveID: ptrfrom.String("CVE-2019-13110")},
+			}},
+			ExpCount: 1,
+			ExpLimits: map[string]helper.ResultLimit{
+				"CertifyVuln": {Returned: 1, TotalAvailable: 4, Truncated: true},
+			},
+		},
+		{
+			Name: "No match for other vulnerability type",
+			Args: inmem.DemoCredentials{DefaultResultLimit: 1},
+			Query: &model.CertifyVulnSpec{Vulnerability: &model.OsvCveOrGhsaSpec{
+				Osv: &model.OSVSpec{},
+			}},
+		},
+		{
+			Name:     "Cap above results",
+			Args:     inmem.DemoCredentials{DefaultResultLimit: 4},
+			Query:    &model.CertifyVulnSpec{},
+			ExpCount: 4,
+		},
+		{
+			Name: "Per query cap",
+			Args: inmem.DemoCredentials{
+				DefaultResultLimit: 1,
+				ResultLimits:       map[string]int{"CertifyVuln": 3},
+			},
+			Query:    &model.CertifyVulnSpec{},
+			ExpCount: 3,
+			ExpLimits: map[string]helper.ResultLimit{
+				"CertifyVuln": {Returned: 3, TotalAvailable: 4, Truncated: true},
+			},
+		},
+		{
+			Name: "Per query cap disabled",
+			Args: inmem.DemoCredentials{
+				DefaultResultLimit: 1,
+				ResultLimits:       map[string]int{"CertifyVuln": -1},
+			},
+			Query:    &model.CertifyVulnSpec{},
+			ExpCount: 4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			b, err := inmem.GetEmptyBackend(&test.Args)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			if _, err := b.IngestCve(ctx, c1); err != nil {
+				t.Fatalf("Could not ingest cve: %v", err)
+			}
+			for _, p := range []*model.PkgInputSpec{p2, p3, p4, p5} {
+				if _, err := b.IngestPackage(ctx, *p); err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+				if _, err := b.IngestVulnerability(ctx, *p, model.OsvCveOrGhsaInput{Cve: c1},
+					model.VulnerabilityMetaDataInput{TimeScanned: time.Unix(1e9, 0)}); err != nil {
+					t.Fatalf("Could not ingest vulnerability: %v", err)
+				}
+			}
+
+			ctx, limits := helper.WithResultLimits(ctx)
+			got, err := b.CertifyVuln(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != test.ExpCount {
+				t.Errorf("Unexpected number of results, want: %d, got: %d", test.ExpCount, len(got))
+			}
+			if diff := cmp.Diff(test.ExpLimits, limits.Truncated()); diff != "" {
+				t.Errorf("Unexpected result limits. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResultLimitsPagination(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{DefaultResultLimit: 2})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedRiskyPackages(t, ctx, b)
+	conditions := model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(1)}
+
+	tests := []struct {
+		Name        string
+		First       *int
+		ExpNames    []string
+		ExpNextPage bool
+		ExpLimits   map[string]helper.ResultLimit
+	}{
+		{
+			Name:        "Default cap",
+			ExpNames:    []string{"tensorflow", "openssl"},
+			ExpNextPage: true,
+			ExpLimits: map[string]helper.ResultLimit{
+				"riskyPackages": {Returned: 2, TotalAvailable: 3, Truncated: true},
+			},
+		},
+		{
+			Name:     "Explicit page above the cap",
+			First:    ptrfrom.Int(3),
+			ExpNames: []string{"tensorflow", "openssl", "numpy"},
+		},
+		{
+			Name:        "Explicit page below the cap",
+			First:       ptrfrom.Int(1),
+			ExpNames:    []string{"tensorflow"},
+			ExpNextPage: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, limits := helper.WithResultLimits(ctx)
+			got, err := b.RiskyPackages(ctx, conditions, test.First, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.ExpNames, riskyPackageNames(got)); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+			if got.HasNextPage != test.ExpNextPage {
+				t.Errorf("Unexpected hasNextPage, want: %v, got: %v", test.ExpNextPage, got.HasNextPage)
+			}
+			if diff := cmp.Diff(test.ExpLimits, limits.Truncated()); diff != "" {
+				t.Errorf("Unexpected result limits. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResultLimitQueries(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	for _, query := range inmem.ResultLimitQueries {
+		if schema.Query.Fields.ForName(query) == nil {
+			t.Errorf("%s is not a query of the GraphQL schema", query)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Metrics instruments the testing backend, for instance to feed counters and
+// latency histograms of a metrics library. Either callback may be nil, and a
+// backend configured without Metrics doesn't measure anything.
+type Metrics struct {
+	// NodeIngested is called for each node of nodeType ingested in
+	// namespace, with created false if the ingestion found the node already
+	// there. It is called while the namespace is locked, so it must return
+	// quickly and must not call the backend.
+	NodeIngested func(namespace string, nodeType model.NodeType, created bool)
+	// OperationDone is called when a method of the backend returns, with its
+	// name (e.g. "IngestPackage") and how long it took, waiting for the lock
+	// of namespace included.
+	OperationDone func(namespace string, operation string, duration time.Duration)
+}
+
+// countIngestion reports the ingestion of a node to the metrics.
+func (c *demoClient) countIngestion(nodeType model.NodeType, created bool) {
+	if c.metrics.NodeIngested != nil {
+		c.metrics.NodeIngested(c.namespace, nodeType, created)
+	}
+}
+
+// observe starts timing operation, which is reported to the metrics by the
+// returned function. It is deferred by the namespace methods, after the lock
+// of the namespace is released.
+func (c *demoClient) observe(operation string) func() {
+	if c.metrics.OperationDone == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		c.metrics.OperationDone(c.namespace, operation, time.Since(start))
+	}
+}
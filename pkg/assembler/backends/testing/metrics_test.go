@@ -0,0 +1,303 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestMetrics(t *testing.T) {
+	ctx := context.Background()
+	teamA := helper.WithNamespace(ctx, "team-a")
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+
+	ingested := map[string]int{}
+	operations := map[string]int{}
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{Metrics: &inmem.Metrics{
+		NodeIngested: func(namespace string, nodeType model.NodeType, created bool) {
+			ingested[fmt.Sprintf("%s %s %t", namespace, nodeType, created)]++
+		},
+		OperationDone: func(namespace string, operation string, duration time.Duration) {
+			if duration < 0 {
+				t.Errorf("negative duration of %s: %v", operation, duration)
+			}
+			operations[namespace+" "+operation]++
+		},
+	}})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+
+	script := []func() error{
+		func() error { _, err := b.IngestPackage(ctx, *p2); return err },
+		func() error { _, err := b.IngestPackage(ctx, *p2); return err },
+		func() error { _, err := b.IngestPackage(ctx, *p4); return err },
+		func() error { _, err := b.IngestSource(ctx, *s1); return err },
+		func() error { _, err := b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{a1, a2, a1}); return err },
+		func() error {
+			_, err := b.IngestHasSourceAt(ctx, *p2, specificVersion, *s1, model.HasSourceAtInputSpec{Justification: "sbom"})
+			return err
+		},
+		func() error {
+			_, err := b.IngestHasSourceAt(ctx, *p2, specificVersion, *s1, model.HasSourceAtInputSpec{Justification: "sbom"})
+			return err
+		},
+		func() error {
+			_, err := b.IngestHashEqual(ctx, *a1, *a2, model.HashEqualInputSpec{Justification: "same image"})
+			return err
+		},
+		func() error {
+			_, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Source: s1}, nil, model.CertifyBadInputSpec{Justification: "typosquat"})
+			return err
+		},
+		// a failed ingestion counts no node
+		func() error {
+			_, err := b.IngestHashEqual(ctx, *a1, *a3, model.HashEqualInputSpec{Justification: "same image"})
+			if !errors.Is(err, backends.ErrNotFound) {
+				return fmt.Errorf("expected not found, got: %w", err)
+			}
+			return nil
+		},
+		func() error { _, err := b.IngestPackage(teamA, *p2); return err },
+		func() error { _, err := b.Packages(teamA, &model.PkgSpec{}); return err },
+	}
+	for i, step := range script {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	expIngested := map[string]int{
+		"default PACKAGE true":        2,
+		"default PACKAGE false":       1,
+		"default SOURCE true":         1,
+		"default ARTIFACT true":       2,
+		"default ARTIFACT false":      1,
+		"default HAS_SOURCE_AT true":  1,
+		"default HAS_SOURCE_AT false": 1,
+		"default HASH_EQUAL true":     1,
+		"default CERTIFY_BAD true":    1,
+		"team-a PACKAGE true":         1,
+	}
+	if diff := cmp.Diff(expIngested, ingested); diff != "" {
+		t.Errorf("Unexpected nodes ingested (-want +got):\n%s", diff)
+	}
+	expOperations := map[string]int{
+		"default IngestPackage":     3,
+		"default IngestSource":      1,
+		"default IngestArtifacts":   1,
+		"default IngestHasSourceAt": 2,
+		"default IngestHashEqual":   2,
+		"default IngestCertifyBad":  1,
+		"team-a IngestPackage":      1,
+		"team-a Packages":           1,
+	}
+	if diff := cmp.Diff(expOperations, operations); diff != "" {
+		t.Errorf("Unexpected operations (-want +got):\n%s", diff)
+	}
+}
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p1, p2, p4} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifacts(ctx, []*model.ArtifactInputSpec{a1, a2}); err != nil {
+		t.Fatalf("Could not ingest artifacts: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	if _, err := b.IngestHasSourceAt(ctx, *p2, specificVersion, *s1, model.HasSourceAtInputSpec{Justification: "sbom"}); err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{Justification: "sbom"}); err != nil {
+		t.Fatalf("Could not ingest IsOccurrence: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{Origin: "scan.json"}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Artifact: a2}, nil, model.CertifyBadInputSpec{Justification: "malware", Origin: "scan.json"}); err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+
+	got, err := b.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// counted returns the nodes and edges of the types that have some
+	counted := func(stats *model.BackendStats) map[model.NodeType][2]int {
+		out := map[model.NodeType][2]int{}
+		for _, s := range stats.Types {
+			if s.Nodes != 0 || s.Edges != 0 {
+				out[s.Type] = [2]int{s.Nodes, s.Edges}
+			}
+		}
+		return out
+	}
+	types := []model.NodeType{}
+	for _, s := range got.Types {
+		types = append(types, s.Type)
+	}
+	if diff := cmp.Diff(model.AllNodeType, types); diff != "" {
+		t.Errorf("Unexpected node types (-want +got):\n%s", diff)
+	}
+	exp := map[model.NodeType][2]int{
+		model.NodeTypePackage:      {3, 0},
+		model.NodeTypeSource:       {1, 0},
+		model.NodeTypeArtifact:     {2, 0},
+		model.NodeTypeCve:          {1, 0},
+		model.NodeTypeHasSourceAt:  {1, 2},
+		model.NodeTypeIsOccurrence: {1, 2},
+		model.NodeTypeCertifyVuln:  {1, 2},
+		model.NodeTypeCertifyBad:   {1, 1},
+	}
+	if diff := cmp.Diff(exp, counted(got)); diff != "" {
+		t.Errorf("Unexpected counts (-want +got):\n%s", diff)
+	}
+	if got.Nodes != 11 || got.Edges != 7 {
+		t.Errorf("expected 11 nodes and 7 edges, got %d and %d", got.Nodes, got.Edges)
+	}
+
+	// other namespaces are counted apart
+	empty, err := b.Stats(helper.WithNamespace(ctx, "team-a"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if empty.Nodes != 0 || empty.Edges != 0 {
+		t.Errorf("expected an empty namespace, got %d nodes and %d edges", empty.Nodes, empty.Edges)
+	}
+
+	// retracted evidence is no longer counted
+	if _, err := b.RetractEvidence(ctx, "scan.json"); err != nil {
+		t.Fatalf("Could not retract evidence: %v", err)
+	}
+	retracted, err := b.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	delete(exp, model.NodeTypeCertifyVuln)
+	delete(exp, model.NodeTypeCertifyBad)
+	if diff := cmp.Diff(exp, counted(retracted)); diff != "" {
+		t.Errorf("Unexpected counts after retraction (-want +got):\n%s", diff)
+	}
+	if retracted.Nodes != 9 || retracted.Edges != 4 {
+		t.Errorf("expected 9 nodes and 4 edges, got %d and %d", retracted.Nodes, retracted.Edges)
+	}
+
+	// and a restored snapshot is counted as the exported namespace
+	var exported bytes.Buffer
+	if err := b.(inmem.Snapshotter).Export(ctx, &exported); err != nil {
+		t.Fatalf("Could not export: %v", err)
+	}
+	teamB := helper.WithNamespace(ctx, "team-b")
+	if err := b.(inmem.Snapshotter).Import(teamB, &exported); err != nil {
+		t.Fatalf("Could not import: %v", err)
+	}
+	restored, err := b.Stats(teamB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(retracted, restored); diff != "" {
+		t.Errorf("Unexpected restored counts (-want +got):\n%s", diff)
+	}
+
+	// a purged namespace starts over from no nodes
+	if _, err := b.PurgeNamespace(teamB); err != nil {
+		t.Fatalf("Could not purge namespace: %v", err)
+	}
+	purged, err := b.Stats(teamB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if purged.Nodes != 0 || purged.Edges != 0 {
+		t.Errorf("expected a purged namespace to be empty, got %d nodes and %d edges", purged.Nodes, purged.Edges)
+	}
+	if _, err := b.IngestPackage(teamB, *p1); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	reingested, err := b.Stats(teamB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[model.NodeType][2]int{model.NodeTypePackage: {1, 0}}, counted(reingested)); diff != "" {
+		t.Errorf("Unexpected counts after purge (-want +got):\n%s", diff)
+	}
+	// and purging it leaves the other namespaces alone
+	after, err := b.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(retracted, after); diff != "" {
+		t.Errorf("Unexpected counts of the default namespace after purge (-want +got):\n%s", diff)
+	}
+}
+
+// BenchmarkMetrics ingests a package that exists already, with and without
+// metrics, to measure the overhead of the hooks.
+func BenchmarkMetrics(b *testing.B) {
+	ctx := context.Background()
+	benchmarks := []struct {
+		Name    string
+		Metrics *inmem.Metrics
+	}{
+		{"none", nil},
+		{"empty", &inmem.Metrics{}},
+		{"configured", &inmem.Metrics{
+			NodeIngested:  func(string, model.NodeType, bool) {},
+			OperationDone: func(string, string, time.Duration) {},
+		}},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.Name, func(b *testing.B) {
+			backend, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{Metrics: bm.Metrics})
+			if err != nil {
+				b.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			if _, err := backend.IngestPackage(ctx, *p2); err != nil {
+				b.Fatalf("Could not ingest package: %v", err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if _, err := backend.IngestPackage(ctx, *p2); err != nil {
+					b.Fatalf("Could not ingest package: %v", err)
+				}
+			}
+		})
+	}
+}
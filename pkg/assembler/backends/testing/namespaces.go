@@ -0,0 +1,941 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// namespaces partitions the backend per namespace (tenant). Each namespace
+// has its own demoClient, created on first use, and requests only see the
+// namespace selected on their context. All namespaces draw their IDs from the
+// same generator, so an ID from one namespace doesn't match anything in the
+// others, unless the same snapshot was imported into both.
+//
+// Requests to a namespace may run concurrently: mutations hold the write lock
+// of its demoClient and queries the read lock.
+//
+// Each request is timed for Metrics.OperationDone, see demoClient.observe.
+type namespaces struct {
+	mu        sync.Mutex
+	ids       *idGenerator
+	args      backends.BackendArgs
+	newClient func(backends.BackendArgs, *idGenerator, string) *demoClient
+	clients   map[string]*demoClient
+	// maxClients caps the number of namespaces in use, including the default
+	// one. Zero means no cap.
+	maxClients int
+}
+
+func newNamespaces(args backends.BackendArgs, newClient func(backends.BackendArgs, *idGenerator, string) *demoClient) *namespaces {
+	n := &namespaces{
+		ids:       newIDGenerator(),
+		args:      args,
+		newClient: newClient,
+		clients:   map[string]*demoClient{},
+	}
+	if creds, ok := args.(*DemoCredentials); ok && creds != nil {
+		n.maxClients = creds.MaxNamespaces
+	}
+	n.clients[helper.DefaultNamespace] = newClient(args, n.ids, helper.DefaultNamespace)
+	return n
+}
+
+// client returns the state of the namespace selected on ctx, if the caller
+// has access to it. A namespace not in use yet is only created below the
+// MaxNamespaces cap.
+func (n *namespaces) client(ctx context.Context) (*demoClient, error) {
+	namespace, err := helper.AuthorizeNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	c, ok := n.clients[namespace]
+	if !ok {
+		if n.maxClients > 0 && len(n.clients) >= n.maxClients {
+			return nil, backends.InvalidInputf("namespace %q :: %d namespaces already in use, purge one first", namespace, len(n.clients))
+		}
+		c = n.newClient(n.args, n.ids, namespace)
+		n.clients[namespace] = c
+	}
+	return c, nil
+}
+
+// PurgeNamespace drops the state of the namespace selected on ctx. The next
+// request to it starts over from a new demoClient.
+func (n *namespaces) PurgeNamespace(ctx context.Context) (bool, error) {
+	namespace, err := helper.AuthorizeNamespace(ctx)
+	if err != nil {
+		return false, err
+	}
+	if namespace == helper.DefaultNamespace {
+		return false, backends.InvalidInputf("purgeNamespace :: the default namespace cannot be purged")
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, ok := n.clients[namespace]
+	delete(n.clients, namespace)
+	return ok, nil
+}
+
+// Export writes the state of the namespace selected on ctx to w, see
+// demoClient.Export.
+func (n *namespaces) Export(ctx context.Context, w io.Writer) error {
+	c, err := n.client(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.observe("Export")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Export(w)
+}
+
+// Import restores the state of the namespace selected on ctx from r, see
+// demoClient.Import.
+func (n *namespaces) Import(ctx context.Context, r io.Reader) error {
+	c, err := n.client(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.observe("Import")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Import(r)
+}
+
+// Visualize draws the graph of the namespace selected on ctx to w, see
+// demoClient.Visualize.
+func (n *namespaces) Visualize(ctx context.Context, w io.Writer, format string, options *VisualizeOptions) error {
+	c, err := n.client(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.observe("Visualize")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Visualize(w, format, options)
+}
+
+func (n *namespaces) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Packages")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Packages(ctx, pkgSpec)
+}
+
+func (n *namespaces) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Sources")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Sources(ctx, sourceSpec)
+}
+
+func (n *namespaces) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model.Cve, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Cve")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Cve(ctx, cveSpec)
+}
+
+func (n *namespaces) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*model.Ghsa, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Ghsa")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Ghsa(ctx, ghsaSpec)
+}
+
+func (n *namespaces) Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model.Osv, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Osv")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Osv(ctx, osvSpec)
+}
+
+func (n *namespaces) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Artifacts")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Artifacts(ctx, artifactSpec)
+}
+
+func (n *namespaces) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Builders")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Builders(ctx, builderSpec)
+}
+
+func (n *namespaces) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("FindSoftware")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.FindSoftware(ctx, searchText)
+}
+
+func (n *namespaces) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("HashEqual")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HashEqual(ctx, hashEqualSpec)
+}
+
+func (n *namespaces) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IsOccurrence")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.IsOccurrence(ctx, isOccurrenceSpec)
+}
+
+func (n *namespaces) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("HasSBOM")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HasSBOM(ctx, hasSBOMSpec)
+}
+
+func (n *namespaces) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IsDependency")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.IsDependency(ctx, isDependencySpec)
+}
+
+func (n *namespaces) CertifyPkg(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec) ([]*model.CertifyPkg, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyPkg")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyPkg(ctx, certifyPkgSpec)
+}
+
+func (n *namespaces) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("HasSourceAt")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HasSourceAt(ctx, hasSourceAtSpec)
+}
+
+func (n *namespaces) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyBad")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyBad(ctx, certifyBadSpec)
+}
+
+func (n *namespaces) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyGood")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyGood(ctx, certifyGoodSpec)
+}
+
+func (n *namespaces) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Scorecards")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Scorecards(ctx, certifyScorecardSpec)
+}
+
+func (n *namespaces) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyVuln")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyVuln(ctx, certifyVulnSpec)
+}
+
+func (n *namespaces) IsVulnerability(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec) ([]*model.IsVulnerability, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IsVulnerability")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.IsVulnerability(ctx, isVulnerabilitySpec)
+}
+
+func (n *namespaces) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyVEXStatement")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+}
+
+func (n *namespaces) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("HasSlsa")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HasSlsa(ctx, hasSLSASpec)
+}
+
+func (n *namespaces) SeverityOverride(ctx context.Context, severityOverrideSpec *model.SeverityOverrideSpec) ([]*model.SeverityOverride, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("SeverityOverride")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.SeverityOverride(ctx, severityOverrideSpec)
+}
+
+func (n *namespaces) EffectiveSeverity(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) (*model.EffectiveSeverity, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("EffectiveSeverity")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.EffectiveSeverity(ctx, vulnerability, subject, scannerScore)
+}
+
+func (n *namespaces) CertifySigned(ctx context.Context, certifySignedSpec *model.CertifySignedSpec) ([]*model.CertifySigned, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifySigned")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifySigned(ctx, certifySignedSpec)
+}
+
+func (n *namespaces) SupersededBy(ctx context.Context, supersededBySpec *model.SupersededBySpec) ([]*model.SupersededBy, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("SupersededBy")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.SupersededBy(ctx, supersededBySpec)
+}
+
+func (n *namespaces) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, first *int, after *string) (*model.HasSourceAtConnection, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("HasSourceAtList")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HasSourceAtList(ctx, hasSourceAtSpec, first, after)
+}
+
+func (n *namespaces) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, first *int, after *string) (*model.CertifyVulnConnection, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyVulnList")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyVulnList(ctx, certifyVulnSpec, first, after)
+}
+
+func (n *namespaces) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, first *int, after *string) (*model.IsDependencyConnection, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IsDependencyList")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.IsDependencyList(ctx, isDependencySpec, first, after)
+}
+
+func (n *namespaces) HasSourceAtCount(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) (int, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.observe("HasSourceAtCount")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.HasSourceAtCount(ctx, hasSourceAtSpec)
+}
+
+func (n *namespaces) CertifyVulnCount(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) (int, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.observe("CertifyVulnCount")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CertifyVulnCount(ctx, certifyVulnSpec)
+}
+
+func (n *namespaces) IsDependencyCount(ctx context.Context, isDependencySpec *model.IsDependencySpec) (int, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.observe("IsDependencyCount")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.IsDependencyCount(ctx, isDependencySpec)
+}
+
+func (n *namespaces) RiskyPackages(ctx context.Context, conditions model.RiskyPackageConditions, first *int, after *string) (*model.RiskyPackageConnection, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("RiskyPackages")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.RiskyPackages(ctx, conditions, first, after)
+}
+
+func (n *namespaces) Successors(ctx context.Context, pkg model.PkgSpec) ([]*model.SupersededBy, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Successors")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Successors(ctx, pkg)
+}
+
+func (n *namespaces) Dependencies(ctx context.Context, pkg model.PkgSpec, maxDepth int) (*model.DependencyTree, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Dependencies")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Dependencies(ctx, pkg, maxDepth)
+}
+
+func (n *namespaces) EqualArtifacts(ctx context.Context, artifact model.ArtifactSpec) ([]*model.Artifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("EqualArtifacts")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.EqualArtifacts(ctx, artifact)
+}
+
+func (n *namespaces) StitchingProposals(ctx context.Context, artifact model.ArtifactSpec, windowSeconds *int) ([]*model.StitchProposal, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("StitchingProposals")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.StitchingProposals(ctx, artifact, windowSeconds)
+}
+
+func (n *namespaces) Node(ctx context.Context, node string) (model.Nodes, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Node")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Node(ctx, node)
+}
+
+func (n *namespaces) Nodes(ctx context.Context, nodes []string) ([]model.Nodes, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Nodes")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Nodes(ctx, nodes)
+}
+
+func (n *namespaces) Neighbors(ctx context.Context, node string) ([]model.Nodes, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Neighbors")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Neighbors(ctx, node)
+}
+
+func (n *namespaces) Path(ctx context.Context, subject string, target string, maxPathLength int) ([]model.Nodes, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Path")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Path(ctx, subject, target, maxPathLength)
+}
+
+func (n *namespaces) Changes(ctx context.Context, after *string, types []model.NodeType, first *int) (*model.ChangeConnection, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Changes")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Changes(ctx, after, types, first)
+}
+
+func (n *namespaces) CollectorDiff(ctx context.Context, verb model.Verb, collectorA string, collectorB string, subject model.PkgSpec, sampleSize *int) (*model.CollectorDiff, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CollectorDiff")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CollectorDiff(ctx, verb, collectorA, collectorB, subject, sampleSize)
+}
+
+func (n *namespaces) Conflicts(ctx context.Context, patterns []model.ConflictPattern) ([]*model.Conflict, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Conflicts")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Conflicts(ctx, patterns)
+}
+
+func (n *namespaces) Stats(ctx context.Context) (*model.BackendStats, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("Stats")()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Stats(ctx)
+}
+
+func (n *namespaces) IngestPackage(ctx context.Context, pkg model.PkgInputSpec) (*model.Package, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestPackage")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestPackage(ctx, pkg)
+}
+
+func (n *namespaces) IngestSource(ctx context.Context, source model.SourceInputSpec) (*model.Source, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestSource")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestSource(ctx, source)
+}
+
+func (n *namespaces) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestArtifact")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestArtifact(ctx, artifact)
+}
+
+func (n *namespaces) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestPackages")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestPackages(ctx, pkgs)
+}
+
+func (n *namespaces) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestSources")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestSources(ctx, sources)
+}
+
+func (n *namespaces) IngestArtifacts(ctx context.Context, artifacts []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestArtifacts")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestArtifacts(ctx, artifacts)
+}
+
+func (n *namespaces) IngestMaterials(ctx context.Context, materials []*model.ArtifactInputSpec) ([]*model.Artifact, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestMaterials")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestMaterials(ctx, materials)
+}
+
+func (n *namespaces) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestBuilder")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestBuilder(ctx, builder)
+}
+
+func (n *namespaces) IngestCve(ctx context.Context, cve *model.CVEInputSpec) (*model.Cve, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestCve")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestCve(ctx, cve)
+}
+
+func (n *namespaces) IngestGhsa(ctx context.Context, ghsa *model.GHSAInputSpec) (*model.Ghsa, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestGhsa")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestGhsa(ctx, ghsa)
+}
+
+func (n *namespaces) IngestOsv(ctx context.Context, osv *model.OSVInputSpec) (*model.Osv, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestOsv")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestOsv(ctx, osv)
+}
+
+func (n *namespaces) CertifyScorecard(ctx context.Context, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("CertifyScorecard")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.CertifyScorecard(ctx, source, scorecard)
+}
+
+func (n *namespaces) IngestSLSA(ctx context.Context, subject model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) (*model.HasSlsa, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestSLSA")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestSLSA(ctx, subject, builtFrom, builtBy, slsa)
+}
+
+func (n *namespaces) IngestDependency(ctx context.Context, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, dependency model.IsDependencyInputSpec) (*model.IsDependency, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestDependency")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestDependency(ctx, pkg, depPkg, dependency)
+}
+
+func (n *namespaces) IngestOccurrence(ctx context.Context, subject model.PackageOrSourceInput, artifact model.ArtifactInputSpec, occurrence model.IsOccurrenceInputSpec) (*model.IsOccurrence, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestOccurrence")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestOccurrence(ctx, subject, artifact, occurrence)
+}
+
+func (n *namespaces) IngestVulnerability(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) (*model.CertifyVuln, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestVulnerability")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestVulnerability(ctx, pkg, vulnerability, certifyVuln)
+}
+
+func (n *namespaces) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) (*model.CertifyPkg, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestCertifyPkg")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestCertifyPkg(ctx, pkg, depPkg, certifyPkg)
+}
+
+func (n *namespaces) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (*model.CertifyBad, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestCertifyBad")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+}
+
+func (n *namespaces) IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (*model.CertifyGood, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestCertifyGood")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestCertifyGood(ctx, subject, pkgMatchType, certifyGood)
+}
+
+func (n *namespaces) IngestHashEqual(ctx context.Context, artifact model.ArtifactInputSpec, equalArtifact model.ArtifactInputSpec, hashEqual model.HashEqualInputSpec) (*model.HashEqual, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestHashEqual")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestHashEqual(ctx, artifact, equalArtifact, hashEqual)
+}
+
+func (n *namespaces) IngestHasSbom(ctx context.Context, subject model.PackageOrSourceInput, hasSbom model.HasSBOMInputSpec) (*model.HasSbom, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestHasSbom")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestHasSbom(ctx, subject, hasSbom)
+}
+
+func (n *namespaces) IngestHasSourceAt(ctx context.Context, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestHasSourceAt")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestHasSourceAt(ctx, pkg, pkgMatchType, source, hasSourceAt)
+}
+
+func (n *namespaces) IngestHasSourceAts(ctx context.Context, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) ([]*model.HasSourceAt, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestHasSourceAts")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestHasSourceAts(ctx, pkgs, pkgMatchType, sources, hasSourceAts)
+}
+
+func (n *namespaces) IngestIsVulnerability(ctx context.Context, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) (*model.IsVulnerability, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestIsVulnerability")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestIsVulnerability(ctx, osv, vulnerability, isVulnerability)
+}
+
+func (n *namespaces) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestVEXStatement")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+}
+
+func (n *namespaces) IngestSeverityOverride(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) (*model.SeverityOverride, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestSeverityOverride")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestSeverityOverride(ctx, vulnerability, subject, severityOverride)
+}
+
+func (n *namespaces) IngestCertifySigned(ctx context.Context, artifact model.ArtifactInputSpec, certifySigned model.CertifySignedInputSpec) (*model.CertifySigned, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestCertifySigned")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestCertifySigned(ctx, artifact, certifySigned)
+}
+
+func (n *namespaces) IngestSupersededBy(ctx context.Context, pkg model.PkgInputSpec, successor model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) (*model.SupersededBy, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.observe("IngestSupersededBy")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.IngestSupersededBy(ctx, pkg, successor, pkgMatchType, supersededBy)
+}
+
+func (n *namespaces) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	c, err := n.client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.observe("RetractEvidence")()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.RetractEvidence(ctx, origin)
+}
@@ -0,0 +1,219 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestNamespaces(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	teamA := helper.WithNamespace(ctx, "team-a")
+	teamB := helper.WithNamespace(ctx, "team-b")
+
+	pkgIDs := map[string]string{}
+	for name, nsCtx := range map[string]context.Context{"team-a": teamA, "team-b": teamB} {
+		pkg, err := b.IngestPackage(nsCtx, *p2)
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs[name] = pkg.Namespaces[0].Names[0].Versions[0].ID
+		if _, err := b.IngestPackage(nsCtx, *p4); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		if _, err := b.IngestDependency(nsCtx, *p2, *p4, model.IsDependencyInputSpec{Justification: name}); err != nil {
+			t.Fatalf("Could not ingest dependency: %v", err)
+		}
+	}
+	if pkgIDs["team-a"] == pkgIDs["team-b"] {
+		t.Errorf("Same package got the same ID %s in both namespaces", pkgIDs["team-a"])
+	}
+
+	for name, nsCtx := range map[string]context.Context{"team-a": teamA, "team-b": teamB} {
+		deps, err := b.IsDependency(nsCtx, &model.IsDependencySpec{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(deps) != 1 || deps[0].Justification != name {
+			t.Errorf("Unexpected dependencies in namespace %s: %+v", name, deps)
+		}
+	}
+
+	// IDs of one namespace don't match anything in another
+	idA := pkgIDs["team-a"]
+	if pkgs, err := b.Packages(teamB, &model.PkgSpec{ID: &idA}); err == nil {
+		t.Errorf("Found package of namespace team-a in team-b: %+v", pkgs)
+	}
+	pkgs, err := b.Packages(teamA, &model.PkgSpec{ID: &idA})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Errorf("Unexpected packages for ID %s in team-a: %+v", idA, pkgs)
+	}
+
+	// The default namespace is separate too
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(deps) != 0 {
+		t.Errorf("Unexpected dependencies in the default namespace: %+v", deps)
+	}
+}
+
+func TestNamespaceScopes(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	tests := []struct {
+		Name      string
+		Scopes    []string
+		Namespace string
+		ExpErr    bool
+	}{
+		{
+			Name:      "In scope",
+			Scopes:    []string{"team-a", "team-b"},
+			Namespace: "team-b",
+		},
+		{
+			Name:      "Cross namespace",
+			Scopes:    []string{"team-a"},
+			Namespace: "team-b",
+			ExpErr:    true,
+		},
+		{
+			Name:      "Default namespace out of scope",
+			Scopes:    []string{"team-a"},
+			Namespace: "",
+			ExpErr:    true,
+		},
+		{
+			Name:      "Wildcard",
+			Scopes:    []string{helper.WildcardScope},
+			Namespace: "team-b",
+		},
+		{
+			Name:      "Invalid namespace",
+			Scopes:    []string{helper.WildcardScope},
+			Namespace: "../team-b",
+			ExpErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reqCtx := helper.WithNamespace(helper.WithScopes(ctx, test.Scopes), test.Namespace)
+			_, err := b.IngestPackage(reqCtx, *p2)
+			if (err != nil) != test.ExpErr {
+				t.Errorf("did not get expected ingest error, want: %v, got: %v", test.ExpErr, err)
+			}
+			_, err = b.Packages(reqCtx, &model.PkgSpec{})
+			if (err != nil) != test.ExpErr {
+				t.Errorf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+		})
+	}
+}
+
+func TestPurgeNamespace(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	teamA := helper.WithNamespace(ctx, "team-a")
+	for _, nsCtx := range []context.Context{ctx, teamA} {
+		if _, err := b.IngestPackage(nsCtx, *p2); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+
+	if _, err := b.PurgeNamespace(ctx); err == nil {
+		t.Errorf("Purged the default namespace")
+	}
+	if _, err := b.PurgeNamespace(helper.WithNamespace(helper.WithScopes(ctx, []string{"team-b"}), "team-a")); err == nil {
+		t.Errorf("Purged a namespace out of the scope of the caller")
+	}
+
+	purged, err := b.PurgeNamespace(teamA)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !purged {
+		t.Errorf("Namespace team-a was not purged")
+	}
+	pkgs, err := b.Packages(teamA, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkgs) != 0 {
+		t.Errorf("Unexpected packages left in team-a: %+v", pkgs)
+	}
+	pkgs, err = b.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Errorf("Unexpected packages in the default namespace: %+v", pkgs)
+	}
+
+	// The query above put team-a back in use, team-b never was
+	if purged, err := b.PurgeNamespace(helper.WithNamespace(ctx, "team-b")); err != nil || purged {
+		t.Errorf("Unexpected purge of unused namespace team-b: %v, %v", purged, err)
+	}
+}
+
+func TestMaxNamespaces(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{MaxNamespaces: 2})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	teamA := helper.WithNamespace(ctx, "team-a")
+	teamB := helper.WithNamespace(ctx, "team-b")
+
+	if _, err := b.IngestPackage(teamA, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.Packages(teamB, &model.PkgSpec{}); err == nil {
+		t.Errorf("Namespace team-b was created past the cap")
+	}
+	// namespaces in use are still available
+	for _, nsCtx := range []context.Context{ctx, teamA} {
+		if _, err := b.Packages(nsCtx, &model.PkgSpec{}); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+
+	if _, err := b.PurgeNamespace(teamA); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := b.IngestPackage(teamB, *p2); err != nil {
+		t.Errorf("Could not ingest package after purging a namespace: %v", err)
+	}
+}
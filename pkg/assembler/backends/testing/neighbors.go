@@ -0,0 +1,101 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Query Node
+//
+// Node and Nodes build any node of the index, as Neighbors does, so that
+// clients can follow an ID without knowing its type. Evidence kept out of the
+// index has no ID and retracted evidence is removed from it, so neither is
+// found.
+
+func (c *demoClient) Node(ctx context.Context, node string) (model.Nodes, error) {
+	built, err := c.nodeByID(node)
+	if err != nil {
+		return nil, fmt.Errorf("node :: %w", err)
+	}
+	return built, nil
+}
+
+func (c *demoClient) Nodes(ctx context.Context, nodes []string) ([]model.Nodes, error) {
+	out := make([]model.Nodes, 0, len(nodes))
+	for i, node := range nodes {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		built, err := c.nodeByID(node)
+		if err != nil {
+			return nil, fmt.Errorf("nodes[%d] :: %w", i, err)
+		}
+		out = append(out, built)
+	}
+	return out, nil
+}
+
+func (c *demoClient) nodeByID(node string) (model.Nodes, error) {
+	id, err := parseID(node)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := c.index[id]; !ok {
+		return nil, backends.NotFoundf("ID %s does not match existing node", id)
+	}
+	return c.buildNode(id)
+}
+
+// Query Neighbors
+//
+// The neighbors of a node are listed by the node itself, from its references
+// and backedges, so that this doesn't search any of the evidence lists. Each
+// neighbor is returned once, even if several edges lead to it. Evidence kept
+// out of the index (CertifyBad, CertifyGood, CertifyPkg, CertifyVEXStatement
+// and HasSBOM) has no ID to start from and is never a neighbor.
+func (c *demoClient) Neighbors(ctx context.Context, node string) ([]model.Nodes, error) {
+	id, err := parseID(node)
+	if err != nil {
+		return nil, fmt.Errorf("neighbors :: %w", err)
+	}
+	n, ok := c.index[id]
+	if !ok {
+		return nil, backends.NotFoundf("neighbors :: ID does not match existing node")
+	}
+
+	out := []model.Nodes{}
+	seen := map[string]bool{id: true}
+	for i, neighbor := range n.neighbors() {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if seen[neighbor] {
+			continue
+		}
+		seen[neighbor] = true
+		built, err := c.buildNode(neighbor)
+		if err != nil {
+			return nil, fmt.Errorf("neighbors :: %w", err)
+		}
+		out = append(out, built)
+	}
+	return out, nil
+}
@@ -0,0 +1,286 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestNeighbors(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	var pkgIDs []string
+	for _, p := range []*model.PkgInputSpec{p2, p4} {
+		pkg, err := b.IngestPackage(ctx, *p)
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgIDs = append(pkgIDs, pkg.Namespaces[0].Names[0].Versions[0].ID)
+	}
+	src, err := b.IngestSource(ctx, *s1)
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, *p2, *p4, model.IsDependencyInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest IsDependency: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest IsOccurrence: %v", err)
+	}
+
+	tests := []struct {
+		Name   string
+		Node   string
+		Exp    []string
+		ExpErr string
+	}{
+		{
+			Name: "Package version",
+			Node: pkgIDs[0],
+			Exp:  []string{"CertifyVuln", "HasSourceAt", "IsDependency", "IsOccurrence", "Package"},
+		},
+		{
+			Name: "Package version without evidence",
+			Node: pkgIDs[1],
+			Exp:  []string{"Package"},
+		},
+		{
+			Name: "Source name",
+			Node: src.Namespaces[0].Names[0].ID,
+			Exp:  []string{"HasSourceAt", "Source"},
+		},
+		{
+			Name: "Source type",
+			Node: src.ID,
+			Exp:  []string{"Source"},
+		},
+		{
+			Name: "HasSourceAt",
+			Node: hasSourceAt.ID,
+			Exp:  []string{"Package", "Source"},
+		},
+		{
+			Name:   "Invalid ID",
+			Node:   "tensorflow",
+			ExpErr: "invalid ID",
+		},
+		{
+			Name:   "Unknown ID",
+			Node:   unknownID,
+			ExpErr: "does not match existing node",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.Neighbors(ctx, test.Node)
+			if (err != nil) != (test.ExpErr != "") {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), test.ExpErr) {
+					t.Errorf("expected error containing %q, got: %v", test.ExpErr, err)
+				}
+				return
+			}
+			types := []string{}
+			for _, node := range got {
+				types = append(types, strings.TrimPrefix(fmt.Sprintf("%T", node), "*model."))
+			}
+			sort.Strings(types)
+			if diff := cmp.Diff(test.Exp, types); diff != "" {
+				t.Errorf("Unexpected neighbors (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// the neighbors of HasSourceAt are the nodes it links
+	got, err := b.Neighbors(ctx, hasSourceAt.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, node := range got {
+		switch n := node.(type) {
+		case *model.Package:
+			if id := n.Namespaces[0].Names[0].Versions[0].ID; id != pkgIDs[0] {
+				t.Errorf("expected package version %s, got %s", pkgIDs[0], id)
+			}
+		case *model.Source:
+			if id := n.Namespaces[0].Names[0].ID; id != src.Namespaces[0].Names[0].ID {
+				t.Errorf("expected source name %s, got %s", src.Namespaces[0].Names[0].ID, id)
+			}
+		}
+	}
+}
+
+func TestNodes(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	pkg, err := b.IngestPackage(ctx, *p2)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	src, err := b.IngestSource(ctx, *s1)
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	art, err := b.IngestArtifact(ctx, a1)
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	builder, err := b.IngestBuilder(ctx, &model.BuilderInputSpec{URI: "https://github.com/CreateFork/HubHostedActions@v1"})
+	if err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+	cve, err := b.IngestCve(ctx, c1)
+	if err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{Origin: "origin-a"})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	pkgID := pkg.Namespaces[0].Names[0].Versions[0].ID
+
+	tests := []struct {
+		Name   string
+		Nodes  []string
+		Exp    []string
+		ExpErr error
+		ErrID  string
+	}{
+		{
+			Name:  "Every type",
+			Nodes: []string{hasSourceAt.ID, pkgID, src.Namespaces[0].Names[0].ID, art.ID, builder.ID, cve.ID},
+			Exp:   []string{"HasSourceAt " + hasSourceAt.ID, "Package " + pkgID, "Source " + src.Namespaces[0].Names[0].ID, "Artifact " + art.ID, "Builder " + builder.ID, "Cve " + cve.ID},
+		},
+		{
+			Name:  "Package name",
+			Nodes: []string{pkg.Namespaces[0].Names[0].ID},
+			Exp:   []string{"Package " + pkg.Namespaces[0].Names[0].ID},
+		},
+		{
+			Name:  "Same ID twice",
+			Nodes: []string{art.ID, art.ID},
+			Exp:   []string{"Artifact " + art.ID, "Artifact " + art.ID},
+		},
+		{
+			Name:  "No IDs",
+			Nodes: []string{},
+			Exp:   []string{},
+		},
+		{
+			Name:   "Unknown ID",
+			Nodes:  []string{art.ID, unknownID},
+			ExpErr: backends.ErrNotFound,
+			ErrID:  "nodes[1] :: ID " + unknownID,
+		},
+		{
+			Name:   "Invalid ID",
+			Nodes:  []string{"tensorflow"},
+			ExpErr: backends.ErrInvalidInput,
+			ErrID:  "nodes[0] :: invalid ID tensorflow",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.Nodes(ctx, test.Nodes)
+			if !errors.Is(err, test.ExpErr) {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), test.ErrID) {
+					t.Errorf("expected error containing %q, got: %v", test.ErrID, err)
+				}
+				return
+			}
+			nodes := []string{}
+			for _, node := range got {
+				nodes = append(nodes, strings.TrimPrefix(fmt.Sprintf("%T", node), "*model.")+" "+nodeID(node))
+			}
+			if diff := cmp.Diff(test.Exp, nodes); diff != "" {
+				t.Errorf("Unexpected nodes (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	node, err := b.Node(ctx, hasSourceAt.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if n, ok := node.(*model.HasSourceAt); !ok || n.Package.Namespaces[0].Names[0].Versions[0].ID != pkgID {
+		t.Errorf("expected the HasSourceAt of package %s, got: %+v", pkgID, node)
+	}
+	// retracted evidence is no longer found
+	if _, err := b.RetractEvidence(ctx, "origin-a"); err != nil {
+		t.Fatalf("Could not retract evidence: %v", err)
+	}
+	if _, err := b.Node(ctx, hasSourceAt.ID); !errors.Is(err, backends.ErrNotFound) {
+		t.Errorf("expected retracted HasSourceAt not to be found, got: %v", err)
+	}
+}
+
+// nodeID returns the ID of the most specific node of a software tree, or of
+// the evidence.
+func nodeID(node model.Nodes) string {
+	switch n := node.(type) {
+	case *model.Package:
+		name := n.Namespaces[0].Names[0]
+		if len(name.Versions) > 0 {
+			return name.Versions[0].ID
+		}
+		return name.ID
+	case *model.Source:
+		return n.Namespaces[0].Names[0].ID
+	case *model.Artifact:
+		return n.ID
+	case *model.Builder:
+		return n.ID
+	case *model.Cve:
+		return n.ID
+	case *model.HasSourceAt:
+		return n.ID
+	}
+	return ""
+}
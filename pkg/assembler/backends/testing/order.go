@@ -0,0 +1,91 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Queries with a sort direction order their matches by one value of the
+// links, after filtering. Links with equal values are ordered by ID, in
+// ascending order whatever the direction, so that the order is total.
+
+// checkSortDirection fails on directions outside of the enum, which only
+// clients bypassing the GraphQL layer can send.
+func checkSortDirection(query string, dir *model.SortDirection) error {
+	if dir != nil && !dir.IsValid() {
+		return backends.InvalidInputf("%s :: invalid sort direction %s", query, *dir)
+	}
+	return nil
+}
+
+// sortedBefore reports whether a link sorts before another, given cmp, the
+// comparison of their values, and their IDs.
+func sortedBefore(dir model.SortDirection, cmp int, idA, idB string) bool {
+	if dir == model.SortDirectionDesc {
+		cmp = -cmp
+	}
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return idA < idB
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// sortMatches sorts the matches of a query by less and has p page through
+// them in this order. The cursor still encodes the ID of the last result of
+// the previous page: lookup returns its link, which must not have been
+// retracted since, and the page starts with the first match sorting after it.
+func sortMatches[L hasID](p *paginator, matches []L, less func(a, b L) bool, lookup func(id string) (L, error)) error {
+	sort.Slice(matches, func(i, j int) bool { return less(matches[i], matches[j]) })
+	if p.after == "" {
+		return nil
+	}
+	cursor, err := lookup(p.after)
+	if err != nil {
+		return backends.InvalidInputf("cursor does not match an existing node: %s", err)
+	}
+	n := sort.Search(len(matches), func(i int) bool { return less(cursor, matches[i]) })
+	skipped := make(map[string]bool, n)
+	for _, m := range matches[:n] {
+		skipped[m.getID()] = true
+	}
+	p.skip = func(id string) bool { return skipped[id] }
+	return nil
+}
@@ -0,0 +1,245 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestHasSourceAtOrder(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	day := func(d int) time.Time { return time.Date(2023, 4, d, 0, 0, 0, 0, time.UTC) }
+	secondDay := day(2)
+	// ingested out of order, "b" and "c" are known since the same day
+	for _, h := range []struct {
+		Justification string
+		KnownSince    time.Time
+	}{
+		{"d", day(4)},
+		{"b", day(2)},
+		{"e", day(5)},
+		{"a", day(1)},
+		{"c", day(2)},
+	} {
+		_, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1,
+			model.HasSourceAtInputSpec{Justification: h.Justification, KnownSince: h.KnownSince})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+	justifications := func(hasSourceAts []*model.HasSourceAt) []string {
+		out := []string{}
+		for _, h := range hasSourceAts {
+			out = append(out, h.Justification)
+		}
+		return out
+	}
+
+	tests := []struct {
+		Name string
+		Spec *model.HasSourceAtSpec
+		Exp  []string
+	}{
+		{
+			Name: "Unsorted",
+			Spec: &model.HasSourceAtSpec{},
+			Exp:  []string{"d", "b", "e", "a", "c"},
+		},
+		{
+			Name: "Ascending",
+			Spec: &model.HasSourceAtSpec{OrderByKnownSince: sortDirection(model.SortDirectionAsc)},
+			Exp:  []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			Name: "Descending, ties still by ID",
+			Spec: &model.HasSourceAtSpec{OrderByKnownSince: sortDirection(model.SortDirectionDesc)},
+			Exp:  []string{"e", "d", "b", "c", "a"},
+		},
+		{
+			Name: "Sorted after filtering",
+			Spec: &model.HasSourceAtSpec{
+				KnownSinceAfter:   &secondDay,
+				OrderByKnownSince: sortDirection(model.SortDirectionAsc),
+			},
+			Exp: []string{"b", "c", "d", "e"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.HasSourceAt(ctx, test.Spec)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Exp, justifications(got)); diff != "" {
+				t.Errorf("Unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// pages follow the order, including across ties
+	filter := &model.HasSourceAtSpec{OrderByKnownSince: sortDirection(model.SortDirectionDesc)}
+	var after *string
+	var pages [][]string
+	for len(pages) < 10 {
+		page, err := b.HasSourceAtList(ctx, filter, ptrfrom.Int(2), after)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if page.TotalCount != 5 {
+			t.Errorf("unexpected total count %d", page.TotalCount)
+		}
+		pages = append(pages, justifications(page.HasSourceAts))
+		if !page.HasNextPage {
+			break
+		}
+		after = page.EndCursor
+	}
+	exp := [][]string{{"e", "d"}, {"b", "c"}, {"a"}}
+	if diff := cmp.Diff(exp, pages); diff != "" {
+		t.Errorf("Unexpected pages (-want +got):\n%s", diff)
+	}
+
+	_, err = b.HasSourceAt(ctx, &model.HasSourceAtSpec{OrderByKnownSince: sortDirection("SIDEWAYS")})
+	if err == nil || !strings.Contains(err.Error(), "invalid sort direction") {
+		t.Errorf("expected an invalid sort direction error, got: %v", err)
+	}
+}
+
+func TestCertifyVulnOrder(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	for _, hour := range []int{3, 1, 2} {
+		_, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{
+			TimeScanned: time.Date(2023, 4, 1, hour, 0, 0, 0, time.UTC),
+			Origin:      "scan.json",
+		})
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyVuln: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		Dir model.SortDirection
+		Exp []int
+	}{
+		{Dir: model.SortDirectionAsc, Exp: []int{1, 2, 3}},
+		{Dir: model.SortDirectionDesc, Exp: []int{3, 2, 1}},
+	} {
+		t.Run(test.Dir.String(), func(t *testing.T) {
+			// one vulnerability per page, to check the cursors follow the order
+			filter := &model.CertifyVulnSpec{OrderByTimeScanned: sortDirection(test.Dir)}
+			var after *string
+			hours := []int{}
+			for len(hours) < 10 {
+				page, err := b.CertifyVulnList(ctx, filter, ptrfrom.Int(1), after)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				for _, v := range page.CertifyVulns {
+					hours = append(hours, v.Metadata.TimeScanned.Hour())
+				}
+				if !page.HasNextPage {
+					break
+				}
+				after = page.EndCursor
+			}
+			if diff := cmp.Diff(test.Exp, hours); diff != "" {
+				t.Errorf("Unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScorecardsOrder(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	for _, sc := range []struct {
+		Source *model.SourceInputSpec
+		Score  float64
+		Origin string
+	}{
+		{s1, 7.5, "a"},
+		{s2, 3, "b"},
+		{s1, 9, "c"},
+		{s2, 7.5, "d"},
+	} {
+		_, err := b.CertifyScorecard(ctx, *sc.Source, model.ScorecardInputSpec{AggregateScore: sc.Score, Origin: sc.Origin})
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyScorecard: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		Dir model.SortDirection
+		Exp []string
+	}{
+		{Dir: model.SortDirectionAsc, Exp: []string{"b", "a", "d", "c"}},
+		{Dir: model.SortDirectionDesc, Exp: []string{"c", "a", "d", "b"}},
+	} {
+		t.Run(test.Dir.String(), func(t *testing.T) {
+			got, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{OrderByScore: sortDirection(test.Dir)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			origins := []string{}
+			for _, sc := range got {
+				origins = append(origins, sc.Scorecard.Origin)
+			}
+			if diff := cmp.Diff(test.Exp, origins); diff != "" {
+				t.Errorf("Unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func sortDirection(dir model.SortDirection) *model.SortDirection {
+	return &dir
+}
@@ -18,11 +18,10 @@ package testing
 import (
 	"context"
 	"log"
-	"strconv"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // TODO: convert to unit test
@@ -53,33 +52,52 @@ const osv string = "osv"
 // Internal data: osv
 type osvMap map[string]*osvNode
 type osvNode struct {
-	id      uint32
+	id      string
 	typeKey string
 	osvIDs  osvIDMap
 }
 type osvIDMap map[string]*osvIDNode
 type osvIDNode struct {
-	id              uint32
-	parent          uint32
-	osvID           string
-	certifyVulnLink []uint32
-	equalVulnLink   []uint32
+	id                   string
+	parent               string
+	osvID                string
+	certifyVulnLink      []string
+	equalVulnLink        []string
+	severityOverrideLink []string
 }
 
-func (n *osvIDNode) getID() uint32 { return n.id }
-func (n *osvNode) getID() uint32   { return n.id }
+func (n *osvIDNode) getID() string { return n.id }
+func (n *osvNode) getID() string   { return n.id }
+
+func (n *osvIDNode) neighbors() []string {
+	return appendIDs([]string{n.parent}, n.certifyVulnLink, n.equalVulnLink, n.severityOverrideLink)
+}
+
+func (n *osvNode) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.osvIDs {
+		ids = append(ids, child.id)
+	}
+	return sortedIDs(ids)
+}
 
 // certifyVulnerability back edges
-func (n *osvIDNode) setVulnerabilityLink(id uint32) {
+func (n *osvIDNode) setVulnerabilityLink(id string) {
 	n.certifyVulnLink = append(n.certifyVulnLink, id)
 }
-func (n *osvIDNode) getVulnerabilityLink() []uint32 { return n.certifyVulnLink }
+func (n *osvIDNode) getVulnerabilityLink() []string { return n.certifyVulnLink }
 
 // isVulnerability back edges
-func (n *osvIDNode) setEqualVulnLink(id uint32) {
+func (n *osvIDNode) setEqualVulnLink(id string) {
 	n.equalVulnLink = append(n.equalVulnLink, id)
 }
-func (n *osvIDNode) gettEqualVulnLink() []uint32 { return n.equalVulnLink }
+func (n *osvIDNode) gettEqualVulnLink() []string { return n.equalVulnLink }
+
+// severityOverride back edges
+func (n *osvIDNode) setSeverityOverrideLink(id string) {
+	n.severityOverrideLink = append(n.severityOverrideLink, id)
+}
+func (n *osvIDNode) getSeverityOverrideLink() []string { return n.severityOverrideLink }
 
 // Ingest OSV
 func (c *demoClient) IngestOsv(ctx context.Context, input *model.OSVInputSpec) (*model.Osv, error) {
@@ -104,7 +122,10 @@ func (c *demoClient) IngestOsv(ctx context.Context, input *model.OSVInputSpec) (
 			osvID:  osvID,
 		}
 		c.index[osvIDStruct.id] = osvIDStruct
+		c.recordChange(osvIDStruct.id, model.NodeTypeOsv)
 		osvIDs[osvID] = osvIDStruct
+	} else {
+		c.countIngestion(model.NodeTypeOsv, false)
 	}
 
 	// build return GraphQL type
@@ -114,11 +135,11 @@ func (c *demoClient) IngestOsv(ctx context.Context, input *model.OSVInputSpec) (
 // Query OSV
 func (c *demoClient) Osv(ctx context.Context, filter *model.OSVSpec) ([]*model.Osv, error) {
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		osv, err := c.buildOsvResponse(uint32(id), filter)
+		osv, err := c.buildOsvResponse(id, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -131,21 +152,21 @@ func (c *demoClient) Osv(ctx context.Context, filter *model.OSVSpec) ([]*model.O
 			osvIDNode, hasOsvIDNode := osvNode.osvIDs[strings.ToLower(*filter.OsvID)]
 			if hasOsvIDNode {
 				osvIDList = append(osvIDList, &model.OSVId{
-					ID:    nodeID(osvIDNode.id),
+					ID:    osvIDNode.id,
 					OsvID: osvIDNode.osvID,
 				})
 			}
 		} else {
 			for _, osvIDNode := range osvNode.osvIDs {
 				osvIDList = append(osvIDList, &model.OSVId{
-					ID:    nodeID(osvIDNode.id),
+					ID:    osvIDNode.id,
 					OsvID: osvIDNode.osvID,
 				})
 			}
 		}
 		if len(osvIDList) > 0 {
 			out = append(out, &model.Osv{
-				ID:     nodeID(osvNode.id),
+				ID:     osvNode.id,
 				OsvIds: osvIDList,
 			})
 		}
@@ -155,20 +176,20 @@ func (c *demoClient) Osv(ctx context.Context, filter *model.OSVSpec) ([]*model.O
 
 // Builds a model.osv to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
-func (c *demoClient) buildOsvResponse(id uint32, filter *model.OSVSpec) (*model.Osv, error) {
+func (c *demoClient) buildOsvResponse(id string, filter *model.OSVSpec) (*model.Osv, error) {
 	if filter != nil && filter.ID != nil {
-		filteredID, err := strconv.Atoi(*filter.ID)
+		filteredID, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		if uint32(filteredID) != id {
+		if filteredID != id {
 			return nil, nil
 		}
 	}
 
 	node, ok := c.index[id]
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match existing node")
+		return nil, backends.NotFoundf("ID does not match existing node")
 	}
 
 	osvIDList := []*model.OSVId{}
@@ -177,7 +198,7 @@ func (c *demoClient) buildOsvResponse(id uint32, filter *model.OSVSpec) (*model.
 			return nil, nil
 		}
 		osvIDList = append(osvIDList, &model.OSVId{
-			ID:    nodeID(osvIDNode.id),
+			ID:    osvIDNode.id,
 			OsvID: osvIDNode.osvID,
 		})
 		node = c.index[osvIDNode.parent]
@@ -185,26 +206,41 @@ func (c *demoClient) buildOsvResponse(id uint32, filter *model.OSVSpec) (*model.
 
 	osvNode, ok := node.(*osvNode)
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match expected node type for osv root")
+		return nil, backends.InvalidInputf("ID does not match expected node type for osv root")
 	}
 	s := model.Osv{
-		ID:     nodeID(osvNode.id),
+		ID:     osvNode.id,
 		OsvIds: osvIDList,
 	}
 	return &s, nil
 }
 
-func getOsvIDFromInput(c *demoClient, input model.OSVInputSpec) (uint32, error) {
+// matchOsv reports whether buildOsvResponse would return an osv for id and
+// filter, without building it.
+func (c *demoClient) matchOsv(id string, filter *model.OSVSpec) bool {
+	if filter == nil {
+		return true
+	}
+	if !filterMatchesID(filter.ID, id) {
+		return false
+	}
+	if osvIDNode, ok := c.index[id].(*osvIDNode); ok {
+		return !noMatch(toLower(filter.OsvID), osvIDNode.osvID)
+	}
+	return false
+}
+
+func getOsvIDFromInput(c *demoClient, input model.OSVInputSpec) (string, error) {
 	osvStruct, hasOsv := c.osvs[osv]
 	if !hasOsv {
-		return 0, gqlerror.Errorf("osv type \"%s\" not found", osv)
+		return "", backends.NotFoundf("osv type \"%s\" not found", osv)
 	}
 	osvIDs := osvStruct.osvIDs
 	osvID := strings.ToLower(input.OsvID)
 
 	osvIDStruct, hasOsvID := osvIDs[osvID]
 	if !hasOsvID {
-		return 0, gqlerror.Errorf("osv id \"%s\" not found", input.OsvID)
+		return "", backends.NotFoundf("osv id \"%s\" not found", input.OsvID)
 	}
 
 	return osvIDStruct.id, nil
@@ -0,0 +1,108 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+const pageCursorPrefix = "page:"
+
+// paginator pages through the matches of a query on links. Links are searched
+// in ID order, which is their ingestion order, and the cursor of a result
+// encodes its ID. A cursor thus stays valid while links are ingested, and
+// ingesting only adds results to the last page. Sorted queries page in their
+// own order instead, see sortMatches.
+//
+// Every match is counted, whether it is in the page or not, for the total
+// count. Without first, the page is capped by the server's default result
+// limit instead.
+type paginator struct {
+	limiter     *resultLimiter
+	first       *int
+	after       string
+	skip        func(id string) bool
+	returned    int
+	total       int
+	hasNextPage bool
+	endCursor   *string
+}
+
+func (c *demoClient) newPaginator(query string, first *int, after *string) (*paginator, error) {
+	if first != nil && *first < 0 {
+		return nil, backends.InvalidInputf("%s :: first must not be negative", query)
+	}
+	p := &paginator{limiter: c.newResultLimiter(query, first), first: first}
+	if after != nil {
+		id, err := decodeIDCursor(pageCursorPrefix, *after)
+		if err != nil {
+			return nil, backends.InvalidInputf("%s :: invalid cursor %s", query, err)
+		}
+		p.after = id
+	}
+	p.skip = func(id string) bool { return id <= p.after }
+	return p, nil
+}
+
+// add counts a match, reporting whether it belongs in the page, in which case
+// the caller must build it.
+func (p *paginator) add(id string) bool {
+	p.total++
+	if p.skip(id) {
+		return false
+	}
+	if p.first != nil && p.returned == *p.first {
+		p.hasNextPage = true
+		return false
+	}
+	if p.limiter.full(p.returned) {
+		p.hasNextPage = true
+		p.limiter.skip()
+		return false
+	}
+	p.returned++
+	endCursor := encodeIDCursor(pageCursorPrefix, id)
+	p.endCursor = &endCursor
+	return true
+}
+
+// finish reports the truncation by the default result limit, if any, on ctx
+// and returns the page information of the connection.
+func (p *paginator) finish(ctx context.Context) (totalCount int, endCursor *string, hasNextPage bool) {
+	p.limiter.record(ctx, p.returned)
+	return p.total, p.endCursor, p.hasNextPage
+}
+
+// encodeIDCursor returns the opaque cursor of the node with the given ID, the
+// prefix telling apart the cursors of different queries.
+func encodeIDCursor(prefix string, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(prefix + id))
+}
+
+func decodeIDCursor(prefix string, cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(string(raw), prefix) {
+		return "", backends.InvalidInputf("unknown cursor format")
+	}
+	return parseID(strings.TrimPrefix(string(raw), prefix))
+}
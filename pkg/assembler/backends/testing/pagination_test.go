@@ -0,0 +1,181 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestHasSourceAtList(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	ingest := func(justification string, src *model.SourceInputSpec) {
+		_, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *src,
+			model.HasSourceAtInputSpec{Justification: justification})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+	for _, justification := range []string{"1", "2", "3", "4", "5"} {
+		ingest(justification, s1)
+	}
+	ingest("other source", s2)
+
+	// page through the HasSourceAt of s1, ingesting more of them midway
+	filter := &model.HasSourceAtSpec{Source: &model.SourceSpec{Name: ptrfrom.String("tensorflow")}}
+	var after *string
+	var pages [][]string
+	for len(pages) < 10 {
+		page, err := b.HasSourceAtList(ctx, filter, ptrfrom.Int(2), after)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		justifications := []string{}
+		for _, hasSourceAt := range page.HasSourceAts {
+			justifications = append(justifications, hasSourceAt.Justification)
+		}
+		pages = append(pages, justifications)
+		if page.TotalCount != 5 && page.TotalCount != 6 {
+			t.Errorf("unexpected total count %d", page.TotalCount)
+		}
+		if len(pages) == 1 {
+			ingest("6", s1)
+		}
+		if !page.HasNextPage {
+			break
+		}
+		after = page.EndCursor
+	}
+	exp := [][]string{{"1", "2"}, {"3", "4"}, {"5", "6"}}
+	if diff := cmp.Diff(exp, pages); diff != "" {
+		t.Errorf("Unexpected pages (-want +got):\n%s", diff)
+	}
+
+	// the last cursor stays valid, for polling
+	page, err := b.HasSourceAtList(ctx, filter, ptrfrom.Int(2), after)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.HasSourceAts) != 2 || page.TotalCount != 6 || page.HasNextPage {
+		t.Errorf("unexpected last page: %+v", page)
+	}
+}
+
+func TestPaginationErrors(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	changes, err := b.Changes(ctx, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		Name   string
+		First  *int
+		After  *string
+		ExpErr string
+	}{
+		{
+			Name:   "Negative first",
+			First:  ptrfrom.Int(-1),
+			ExpErr: "first must not be negative",
+		},
+		{
+			Name:   "Malformed cursor",
+			After:  ptrfrom.String("not a cursor"),
+			ExpErr: "invalid cursor",
+		},
+		{
+			Name:   "Cursor of another query",
+			After:  &changes.EndCursor,
+			ExpErr: "invalid cursor",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := b.IsDependencyList(ctx, &model.IsDependencySpec{}, test.First, test.After)
+			if err == nil || !strings.Contains(err.Error(), test.ExpErr) {
+				t.Errorf("expected error containing %q, got: %v", test.ExpErr, err)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnList(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{DefaultResultLimit: 1})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	for _, p := range []*model.PkgInputSpec{p2, p4, p5} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		_, err := b.IngestVulnerability(ctx, *p, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{DbURI: p.Name})
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyVuln: %v", err)
+		}
+	}
+
+	// explicit pagination bypasses the default result limit
+	page, err := b.CertifyVulnList(ctx, &model.CertifyVulnSpec{}, ptrfrom.Int(2), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.CertifyVulns) != 2 || page.TotalCount != 3 || !page.HasNextPage {
+		t.Errorf("unexpected first page: %d results, total %d, hasNextPage %v", len(page.CertifyVulns), page.TotalCount, page.HasNextPage)
+	}
+	page, err = b.CertifyVulnList(ctx, &model.CertifyVulnSpec{}, ptrfrom.Int(2), page.EndCursor)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.CertifyVulns) != 1 || page.CertifyVulns[0].Metadata.DbURI != p5.Name || page.HasNextPage {
+		t.Errorf("unexpected second page: %+v", page)
+	}
+
+	// without first, the default result limit applies
+	page, err = b.CertifyVulnList(ctx, &model.CertifyVulnSpec{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.CertifyVulns) != 1 || page.TotalCount != 3 || !page.HasNextPage {
+		t.Errorf("unexpected capped page: %d results, total %d, hasNextPage %v", len(page.CertifyVulns), page.TotalCount, page.HasNextPage)
+	}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// pathStep records how the breadth first search of Path reached a node: from
+// which node and after how many edges.
+type pathStep struct {
+	parent string
+	depth  int
+}
+
+// Query Path
+//
+// Path searches breadth first from the subject, over the same edges as
+// Neighbors, so the first time the target is reached is on a shortest path.
+// Evidence nodes are nodes of the graph too, so they are in the returned path
+// between the nodes they link. The search stops at maxPathLength edges from
+// the subject, and finding no path is not an error.
+func (c *demoClient) Path(ctx context.Context, subject string, target string, maxPathLength int) ([]model.Nodes, error) {
+	if maxPathLength <= 0 {
+		return nil, backends.InvalidInputf("path :: maxPathLength must be positive")
+	}
+	subjectID, err := c.pathNodeID("subject", subject)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := c.pathNodeID("target", target)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := map[string]pathStep{subjectID: {parent: subjectID}}
+	queue := []string{subjectID}
+	found := subjectID == targetID
+	for visited := 0; len(queue) > 0 && !found; visited++ {
+		if err := checkCanceled(ctx, visited); err != nil {
+			return nil, err
+		}
+		id := queue[0]
+		queue = queue[1:]
+		depth := steps[id].depth
+		if depth == maxPathLength {
+			continue
+		}
+		for _, neighbor := range c.index[id].neighbors() {
+			if _, seen := steps[neighbor]; seen {
+				continue
+			}
+			steps[neighbor] = pathStep{parent: id, depth: depth + 1}
+			if neighbor == targetID {
+				found = true
+				break
+			}
+			queue = append(queue, neighbor)
+		}
+	}
+	if !found {
+		return []model.Nodes{}, nil
+	}
+
+	path := make([]model.Nodes, steps[targetID].depth+1)
+	id := targetID
+	for i := len(path) - 1; i >= 0; i-- {
+		path[i], err = c.buildNode(id)
+		if err != nil {
+			return nil, fmt.Errorf("path :: %w", err)
+		}
+		id = steps[id].parent
+	}
+	return path, nil
+}
+
+func (c *demoClient) pathNodeID(arg string, node string) (string, error) {
+	id, err := parseID(node)
+	if err != nil {
+		return "", fmt.Errorf("path :: invalid %s ID: %w", arg, err)
+	}
+	if _, ok := c.index[id]; !ok {
+		return "", backends.NotFoundf("path :: %s ID does not match existing node", arg)
+	}
+	return id, nil
+}
@@ -0,0 +1,183 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestPath(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	var pkgs []*model.Package
+	for _, p := range []*model.PkgInputSpec{p2, p4} {
+		pkg, err := b.IngestPackage(ctx, *p)
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	src, err := b.IngestSource(ctx, *s1)
+	if err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	var artifacts []*model.Artifact
+	for _, a := range []*model.ArtifactInputSpec{a1, a2, a3} {
+		artifact, err := b.IngestArtifact(ctx, a)
+		if err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	cve, err := b.IngestCve(ctx, c1)
+	if err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	if _, err := b.IngestHashEqual(ctx, *a2, *a1, model.HashEqualInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest HashEqual: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest IsOccurrence: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1, model.HasSourceAtInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, *p2, *p4, model.IsDependencyInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest IsDependency: %v", err)
+	}
+
+	tests := []struct {
+		Name          string
+		Subject       string
+		Target        string
+		MaxPathLength int
+		Exp           []string
+		ExpErr        string
+	}{
+		{
+			Name:          "Artifact to CVE",
+			Subject:       artifacts[1].ID,
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 10,
+			Exp:           []string{"Artifact", "HashEqual", "Artifact", "IsOccurrence", "Package", "CertifyVuln", "Cve"},
+		},
+		{
+			Name:          "Path of exactly maxPathLength",
+			Subject:       artifacts[1].ID,
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 6,
+			Exp:           []string{"Artifact", "HashEqual", "Artifact", "IsOccurrence", "Package", "CertifyVuln", "Cve"},
+		},
+		{
+			Name:          "Path longer than maxPathLength",
+			Subject:       artifacts[1].ID,
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 5,
+			Exp:           []string{},
+		},
+		{
+			Name:          "Source to dependency",
+			Subject:       src.Namespaces[0].Names[0].ID,
+			Target:        pkgs[1].Namespaces[0].Names[0].ID,
+			MaxPathLength: 10,
+			Exp:           []string{"Source", "HasSourceAt", "Package", "IsDependency", "Package"},
+		},
+		{
+			Name:          "Subject is target",
+			Subject:       artifacts[0].ID,
+			Target:        artifacts[0].ID,
+			MaxPathLength: 1,
+			Exp:           []string{"Artifact"},
+		},
+		{
+			Name:          "No path",
+			Subject:       artifacts[2].ID,
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 10,
+			Exp:           []string{},
+		},
+		{
+			Name:          "Invalid subject",
+			Subject:       "tensorflow",
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 10,
+			ExpErr:        "invalid subject ID",
+		},
+		{
+			Name:          "Unknown target",
+			Subject:       artifacts[0].ID,
+			Target:        unknownID,
+			MaxPathLength: 10,
+			ExpErr:        "target ID does not match existing node",
+		},
+		{
+			Name:          "Zero maxPathLength",
+			Subject:       artifacts[0].ID,
+			Target:        cve.CveIds[0].ID,
+			MaxPathLength: 0,
+			ExpErr:        "maxPathLength must be positive",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.Path(ctx, test.Subject, test.Target, test.MaxPathLength)
+			if (err != nil) != (test.ExpErr != "") {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), test.ExpErr) {
+					t.Errorf("expected error containing %q, got: %v", test.ExpErr, err)
+				}
+				return
+			}
+			types := []string{}
+			for _, node := range got {
+				types = append(types, strings.TrimPrefix(fmt.Sprintf("%T", node), "*model."))
+			}
+			if diff := cmp.Diff(test.Exp, types); diff != "" {
+				t.Errorf("Unexpected path (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// the path runs from the subject to the target
+	got, err := b.Path(ctx, artifacts[1].ID, cve.CveIds[0].ID, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if a, ok := got[0].(*model.Artifact); !ok || !strings.EqualFold(a.Digest, a2.Digest) {
+		t.Errorf("expected path to start at artifact %s, got %+v", a2.Digest, got[0])
+	}
+	if a, ok := got[2].(*model.Artifact); !ok || !strings.EqualFold(a.Digest, a1.Digest) {
+		t.Errorf("expected path to go through artifact %s, got %+v", a1.Digest, got[2])
+	}
+	if c, ok := got[len(got)-1].(*model.Cve); !ok || !strings.EqualFold(c.CveIds[0].CveID, c1.CveID) {
+		t.Errorf("expected path to end at %s, got %+v", c1.CveID, got[len(got)-1])
+	}
+}
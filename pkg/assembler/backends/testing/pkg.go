@@ -17,13 +17,12 @@ package testing
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 	"reflect"
-	"strconv"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // TODO: move this into a unit test for this file
@@ -81,81 +80,131 @@ func registerAllPackages(client *demoClient) {
 // Internal data: Packages
 type pkgTypeMap map[string]*pkgNamespaceStruct
 type pkgNamespaceStruct struct {
-	id         uint32
+	id         string
 	typeKey    string
 	namespaces pkgNamespaceMap
 }
 type pkgNamespaceMap map[string]*pkgNameStruct
 type pkgNameStruct struct {
-	id        uint32
-	parent    uint32
+	id        string
+	parent    string
 	namespace string
 	names     pkgNameMap
 }
 type pkgNameMap map[string]*pkgVersionStruct
 type pkgVersionStruct struct {
-	id               uint32
-	parent           uint32
+	id               string
+	parent           string
 	name             string
 	versions         pkgVersionList
-	srcMapLink       []uint32
-	isDependencyLink []uint32
+	srcMapLink       []string
+	isDependencyLink []string
+	supersededByLink []string
 }
 type pkgVersionList []*pkgVersionNode
 type pkgVersionNode struct {
-	id               uint32
-	parent           uint32
-	version          string
-	subpath          string
-	qualifiers       map[string]string
-	srcMapLink       []uint32
-	isDependencyLink []uint32
-	occurrences      []uint32
-	certifyVulnLink  []uint32
+	id                   string
+	parent               string
+	version              string
+	subpath              string
+	qualifiers           map[string]string
+	srcMapLink           []string
+	isDependencyLink     []string
+	occurrences          []string
+	certifyVulnLink      []string
+	severityOverrideLink []string
+	supersededByLink     []string
 }
 
 // Be type safe, don't use any / interface{}
 type pkgNameOrVersion interface {
 	implementsPkgNameOrVersion()
-	setSrcMapLink(id uint32)
-	getSrcMapLink() []uint32
-	setIsDependencyLink(id uint32)
-	getIsDependencyLink() []uint32
+	setSrcMapLink(id string)
+	getSrcMapLink() []string
+	setIsDependencyLink(id string)
+	getIsDependencyLink() []string
+	setSupersededByLink(id string)
+	getSupersededByLink() []string
 }
 
-func (n *pkgNamespaceStruct) getID() uint32 { return n.id }
-func (n *pkgNameStruct) getID() uint32      { return n.id }
-func (n *pkgVersionStruct) getID() uint32   { return n.id }
-func (n *pkgVersionNode) getID() uint32     { return n.id }
+func (n *pkgNamespaceStruct) getID() string { return n.id }
+func (n *pkgNameStruct) getID() string      { return n.id }
+func (n *pkgVersionStruct) getID() string   { return n.id }
+func (n *pkgVersionNode) getID() string     { return n.id }
+
+func (n *pkgNamespaceStruct) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.namespaces {
+		ids = append(ids, child.id)
+	}
+	return sortedIDs(ids)
+}
+
+func (n *pkgNameStruct) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.names {
+		ids = append(ids, child.id)
+	}
+	return append([]string{n.parent}, sortedIDs(ids)...)
+}
+
+func (n *pkgVersionStruct) neighbors() []string {
+	ids := []string{n.parent}
+	for _, child := range n.versions {
+		ids = append(ids, child.id)
+	}
+	return appendIDs(ids, n.srcMapLink, n.isDependencyLink, n.supersededByLink)
+}
+
+func (n *pkgVersionNode) neighbors() []string {
+	return appendIDs([]string{n.parent}, n.srcMapLink, n.isDependencyLink, n.occurrences,
+		n.certifyVulnLink, n.severityOverrideLink, n.supersededByLink)
+}
 
 func (p *pkgVersionStruct) implementsPkgNameOrVersion() {}
 func (p *pkgVersionNode) implementsPkgNameOrVersion()   {}
 
 // hasSourceAt back edges
-func (p *pkgVersionStruct) setSrcMapLink(id uint32) { p.srcMapLink = append(p.srcMapLink, id) }
-func (p *pkgVersionNode) setSrcMapLink(id uint32)   { p.srcMapLink = append(p.srcMapLink, id) }
-func (p *pkgVersionStruct) getSrcMapLink() []uint32 { return p.srcMapLink }
-func (p *pkgVersionNode) getSrcMapLink() []uint32   { return p.srcMapLink }
+func (p *pkgVersionStruct) setSrcMapLink(id string) { p.srcMapLink = append(p.srcMapLink, id) }
+func (p *pkgVersionNode) setSrcMapLink(id string)   { p.srcMapLink = append(p.srcMapLink, id) }
+func (p *pkgVersionStruct) getSrcMapLink() []string { return p.srcMapLink }
+func (p *pkgVersionNode) getSrcMapLink() []string   { return p.srcMapLink }
 
 // isDependency back edges
-func (p *pkgVersionStruct) setIsDependencyLink(id uint32) {
+func (p *pkgVersionStruct) setIsDependencyLink(id string) {
 	p.isDependencyLink = append(p.isDependencyLink, id)
 }
-func (p *pkgVersionNode) setIsDependencyLink(id uint32) {
+func (p *pkgVersionNode) setIsDependencyLink(id string) {
 	p.isDependencyLink = append(p.isDependencyLink, id)
 }
-func (p *pkgVersionStruct) getIsDependencyLink() []uint32 { return p.isDependencyLink }
-func (p *pkgVersionNode) getIsDependencyLink() []uint32   { return p.isDependencyLink }
+func (p *pkgVersionStruct) getIsDependencyLink() []string { return p.isDependencyLink }
+func (p *pkgVersionNode) getIsDependencyLink() []string   { return p.isDependencyLink }
+
+// supersededBy back edges
+func (p *pkgVersionStruct) setSupersededByLink(id string) {
+	p.supersededByLink = append(p.supersededByLink, id)
+}
+func (p *pkgVersionNode) setSupersededByLink(id string) {
+	p.supersededByLink = append(p.supersededByLink, id)
+}
+func (p *pkgVersionStruct) getSupersededByLink() []string { return p.supersededByLink }
+func (p *pkgVersionNode) getSupersededByLink() []string   { return p.supersededByLink }
 
 // isOccurrence back edges
-func (p *pkgVersionNode) setOccurrenceLink(id uint32) { p.occurrences = append(p.occurrences, id) }
-func (p *pkgVersionNode) getOccurrenceLink() []uint32 { return p.occurrences }
+func (p *pkgVersionNode) setOccurrenceLink(id string) { p.occurrences = append(p.occurrences, id) }
+func (p *pkgVersionNode) getOccurrenceLink() []string { return p.occurrences }
 
 // certifyVulnerability back edges
-func (p *pkgVersionNode) setVulnerabilityLink(id uint32) {
+func (p *pkgVersionNode) setVulnerabilityLink(id string) {
 	p.certifyVulnLink = append(p.certifyVulnLink, id)
 }
-func (p *pkgVersionNode) getVulnerabilityLink() []uint32 { return p.certifyVulnLink }
+func (p *pkgVersionNode) getVulnerabilityLink() []string { return p.certifyVulnLink }
+
+// severityOverride back edges
+func (p *pkgVersionNode) setSeverityOverrideLink(id string) {
+	p.severityOverrideLink = append(p.severityOverrideLink, id)
+}
+func (p *pkgVersionNode) getSeverityOverrideLink() []string { return p.severityOverrideLink }
 
 // Ingest Package
 func (c *demoClient) IngestPackage(ctx context.Context, input model.PkgInputSpec) (*model.Package, error) {
@@ -222,26 +271,46 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.PkgInputSpec
 			qualifiers: qualifiersVal,
 		}
 		c.index[collectedVersion.id] = &collectedVersion
+		c.recordChange(collectedVersion.id, model.NodeTypePackage)
 		// Need to append to version and replace field in versionStruct
 		versionStruct.versions = append(versions, &collectedVersion)
 		// All others are refs to maps, so no need to update struct
 		names[input.Name] = versionStruct
 		namespaces[nilToEmpty(input.Namespace)] = namesStruct
 		c.packages[input.Type] = namespacesStruct
+	} else {
+		c.countIngestion(model.NodeTypePackage, false)
 	}
 
 	// build return GraphQL type
 	return c.buildPackageResponse(collectedVersion.id, nil)
 }
 
+// IngestPackages ingests the packages at every index of pkgs. The result is in input
+// order, with duplicates within the batch returning the same node.
+func (c *demoClient) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	out := make([]*model.Package, 0, len(pkgs))
+	for i, pkg := range pkgs {
+		if pkg == nil {
+			return nil, backends.InvalidInputf("IngestPackages :: index %d: missing package", i)
+		}
+		ingested, err := c.IngestPackage(ctx, *pkg)
+		if err != nil {
+			return nil, fmt.Errorf("IngestPackages :: index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
 // Query Package
 func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*model.Package, error) {
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		p, err := c.buildPackageResponse(uint32(id), filter)
+		p, err := c.buildPackageResponse(id, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -255,7 +324,7 @@ func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*mo
 			pNamespaces := buildPkgNamespace(pkgNamespaceStruct, filter)
 			if len(pNamespaces) > 0 {
 				out = append(out, &model.Package{
-					ID:         nodeID(pkgNamespaceStruct.id),
+					ID:         pkgNamespaceStruct.id,
 					Type:       pkgNamespaceStruct.typeKey,
 					Namespaces: pNamespaces,
 				})
@@ -266,7 +335,7 @@ func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*mo
 			pNamespaces := buildPkgNamespace(pkgNamespaceStruct, filter)
 			if len(pNamespaces) > 0 {
 				out = append(out, &model.Package{
-					ID:         nodeID(pkgNamespaceStruct.id),
+					ID:         pkgNamespaceStruct.id,
 					Type:       dbType,
 					Namespaces: pNamespaces,
 				})
@@ -284,7 +353,7 @@ func buildPkgNamespace(pkgNamespaceStruct *pkgNamespaceStruct, filter *model.Pkg
 			pns := buildPkgName(pkgNameStruct, filter)
 			if len(pns) > 0 {
 				pNamespaces = append(pNamespaces, &model.PackageNamespace{
-					ID:        nodeID(pkgNameStruct.id),
+					ID:        pkgNameStruct.id,
 					Namespace: pkgNameStruct.namespace,
 					Names:     pns,
 				})
@@ -295,7 +364,7 @@ func buildPkgNamespace(pkgNamespaceStruct *pkgNamespaceStruct, filter *model.Pkg
 			pns := buildPkgName(pkgNameStruct, filter)
 			if len(pns) > 0 {
 				pNamespaces = append(pNamespaces, &model.PackageNamespace{
-					ID:        nodeID(pkgNameStruct.id),
+					ID:        pkgNameStruct.id,
 					Namespace: namespace,
 					Names:     pns,
 				})
@@ -313,7 +382,7 @@ func buildPkgName(pkgNameStruct *pkgNameStruct, filter *model.PkgSpec) []*model.
 			pvs := buildPkgVersion(pkgVersionStruct, filter)
 			if len(pvs) > 0 {
 				pns = append(pns, &model.PackageName{
-					ID:       nodeID(pkgVersionStruct.id),
+					ID:       pkgVersionStruct.id,
 					Name:     pkgVersionStruct.name,
 					Versions: pvs,
 				})
@@ -324,7 +393,7 @@ func buildPkgName(pkgNameStruct *pkgNameStruct, filter *model.PkgSpec) []*model.
 			pvs := buildPkgVersion(pkgVersionStruct, filter)
 			if len(pvs) > 0 {
 				pns = append(pns, &model.PackageName{
-					ID:       nodeID(pkgVersionStruct.id),
+					ID:       pkgVersionStruct.id,
 					Name:     name,
 					Versions: pvs,
 				})
@@ -347,7 +416,7 @@ func buildPkgVersion(pkgVersionStruct *pkgVersionStruct, filter *model.PkgSpec)
 			continue
 		}
 		pvs = append(pvs, &model.PackageVersion{
-			ID:         nodeID(v.id),
+			ID:         v.id,
 			Version:    v.version,
 			Subpath:    v.subpath,
 			Qualifiers: getCollectedPackageQualifiers(v.qualifiers),
@@ -358,20 +427,20 @@ func buildPkgVersion(pkgVersionStruct *pkgVersionStruct, filter *model.PkgSpec)
 
 // Builds a model.Package to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
-func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*model.Package, error) {
+func (c *demoClient) buildPackageResponse(id string, filter *model.PkgSpec) (*model.Package, error) {
 	if filter != nil && filter.ID != nil {
-		filteredID, err := strconv.Atoi(*filter.ID)
+		filteredID, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		if uint32(filteredID) != id {
+		if filteredID != id {
 			return nil, nil
 		}
 	}
 
 	node, ok := c.index[id]
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match existing node")
+		return nil, backends.NotFoundf("ID does not match existing node")
 	}
 
 	pvl := []*model.PackageVersion{}
@@ -386,7 +455,7 @@ func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*mo
 			return nil, nil
 		}
 		pvl = append(pvl, &model.PackageVersion{
-			ID:         nodeID(versionNode.id),
+			ID:         versionNode.id,
 			Version:    versionNode.version,
 			Subpath:    versionNode.subpath,
 			Qualifiers: getCollectedPackageQualifiers(versionNode.qualifiers),
@@ -400,7 +469,7 @@ func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*mo
 			return nil, nil
 		}
 		pnl = append(pnl, &model.PackageName{
-			ID:       nodeID(versionStruct.id),
+			ID:       versionStruct.id,
 			Name:     versionStruct.name,
 			Versions: pvl,
 		})
@@ -413,7 +482,7 @@ func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*mo
 			return nil, nil
 		}
 		pnsl = append(pnsl, &model.PackageNamespace{
-			ID:        nodeID(nameStruct.id),
+			ID:        nameStruct.id,
 			Namespace: nameStruct.namespace,
 			Names:     pnl,
 		})
@@ -422,10 +491,10 @@ func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*mo
 
 	namespaceStruct, ok := node.(*pkgNamespaceStruct)
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match expected node type for package namespace")
+		return nil, backends.InvalidInputf("ID does not match expected node type for package namespace")
 	}
 	p := model.Package{
-		ID:         nodeID(namespaceStruct.id),
+		ID:         namespaceStruct.id,
 		Type:       namespaceStruct.typeKey,
 		Namespaces: pnsl,
 	}
@@ -435,20 +504,101 @@ func (c *demoClient) buildPackageResponse(id uint32, filter *model.PkgSpec) (*mo
 	return &p, nil
 }
 
-func getPackageIDFromInput(c *demoClient, input model.PkgInputSpec, pkgMatchType model.MatchFlags) (uint32, error) {
+// matchPackage reports whether buildPackageResponse would return a package for
+// id and filter, without building it.
+func (c *demoClient) matchPackage(id string, filter *model.PkgSpec) bool {
+	if filter == nil {
+		return true
+	}
+	if !filterMatchesID(filter.ID, id) {
+		return false
+	}
+	node := c.index[id]
+	if versionNode, ok := node.(*pkgVersionNode); ok {
+		if noMatch(filter.Version, versionNode.version) ||
+			noMatch(filter.Subpath, versionNode.subpath) ||
+			noMatchQualifiers(filter, versionNode.qualifiers) {
+			return false
+		}
+		node = c.index[versionNode.parent]
+	}
+	if versionStruct, ok := node.(*pkgVersionStruct); ok {
+		if noMatch(filter.Name, versionStruct.name) {
+			return false
+		}
+		node = c.index[versionStruct.parent]
+	}
+	if nameStruct, ok := node.(*pkgNameStruct); ok {
+		if noMatch(filter.Namespace, nameStruct.namespace) {
+			return false
+		}
+		node = c.index[nameStruct.parent]
+	}
+	namespaceStruct, ok := node.(*pkgNamespaceStruct)
+	return ok && !noMatch(filter.Type, namespaceStruct.typeKey)
+}
+
+// packageBackedges returns the links, stored on the package name and version
+// nodes that can match filter, that links selects. They are in ingestion
+// order. It returns nil if filter doesn't narrow down the packages, for the
+// caller to search all its links instead.
+func (c *demoClient) packageBackedges(filter *model.PkgSpec, links func(pkgNameOrVersion) []string) []string {
+	if filter == nil || (filter.ID == nil && filter.Type == nil && filter.Namespace == nil && filter.Name == nil && filter.Version == nil) {
+		return nil
+	}
+	ids := []string{}
+	if filter.ID != nil {
+		id, err := parseID(*filter.ID)
+		if err != nil {
+			// let the search report the invalid ID
+			return nil
+		}
+		if node, ok := c.index[id].(pkgNameOrVersion); ok {
+			ids = append(ids, links(node)...)
+		}
+		return sortedBackedges(ids)
+	}
+	for typeKey, namespaces := range c.packages {
+		if noMatch(filter.Type, typeKey) {
+			continue
+		}
+		for namespace, names := range namespaces.namespaces {
+			if noMatch(filter.Namespace, namespace) {
+				continue
+			}
+			for name, versions := range names.names {
+				if noMatch(filter.Name, name) {
+					continue
+				}
+				ids = append(ids, links(versions)...)
+				for _, version := range versions.versions {
+					if noMatch(filter.Version, version.version) ||
+						noMatch(filter.Subpath, version.subpath) ||
+						noMatchQualifiers(filter, version.qualifiers) {
+						continue
+					}
+					ids = append(ids, links(version)...)
+				}
+			}
+		}
+	}
+	return sortedBackedges(ids)
+}
+
+func getPackageIDFromInput(c *demoClient, input model.PkgInputSpec, pkgMatchType model.MatchFlags) (string, error) {
 	pkgNamespace, pkgHasNamespace := c.packages[input.Type]
 	if !pkgHasNamespace {
-		return 0, gqlerror.Errorf("Package type \"%s\" not found", input.Type)
+		return "", backends.NotFoundf("Package type \"%s\" not found", input.Type)
 	}
 	pkgName, pkgHasName := pkgNamespace.namespaces[nilToEmpty(input.Namespace)]
 	if !pkgHasName {
-		return 0, gqlerror.Errorf("Package namespace \"%s\" not found", nilToEmpty(input.Namespace))
+		return "", backends.NotFoundf("Package namespace \"%s\" not found", nilToEmpty(input.Namespace))
 	}
 	pkgVersion, pkgHasVersion := pkgName.names[input.Name]
 	if !pkgHasVersion {
-		return 0, gqlerror.Errorf("Package name \"%s\" not found", input.Name)
+		return "", backends.NotFoundf("Package name \"%s\" not found", input.Name)
 	}
-	var packageID uint32
+	var packageID string
 	if pkgMatchType.Pkg == model.PkgMatchTypeAllVersions {
 		packageID = pkgVersion.id
 	} else {
@@ -464,13 +614,13 @@ func getPackageIDFromInput(c *demoClient, input model.PkgInputSpec, pkgMatchType
 				continue
 			}
 			if found {
-				return 0, gqlerror.Errorf("More than one package matches input")
+				return "", backends.InvalidInputf("More than one package matches input")
 			}
 			packageID = version.id
 			found = true
 		}
 		if !found {
-			return 0, gqlerror.Errorf("No package matches input")
+			return "", backends.NotFoundf("No package matches input")
 		}
 	}
 	return packageID, nil
@@ -619,13 +769,13 @@ func filterQualifiersAndSubpath(v *model.PackageVersion, pkgSpec *model.PkgSpec)
 	return v
 }
 
-func (c *demoClient) pkgVersionByID(id uint32) (*pkgVersionNode, error) {
+func (c *demoClient) pkgVersionByID(id string) (*pkgVersionNode, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find pkg")
+		return nil, backends.NotFoundf("could not find pkg")
 	}
 	if a, ok := o.(*pkgVersionNode); ok {
 		return a, nil
 	}
-	return nil, errors.New("not a pkg")
+	return nil, backends.InvalidInputf("not a pkg")
 }
@@ -0,0 +1,67 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestPackages(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	existing, err := b.IngestPackage(ctx, *p5)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+
+	got, err := b.IngestPackages(ctx, []*model.PkgInputSpec{p2, p4, p5, p2})
+	if err != nil {
+		t.Fatalf("Could not ingest packages: %v", err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("expected 4 packages, got %d", len(got))
+	}
+	for i, name := range []string{"tensorflow", "openssl", "numpy", "tensorflow"} {
+		if gotName := got[i].Namespaces[0].Names[0].Name; gotName != name {
+			t.Errorf("expected package %d to be %s, got %s", i, name, gotName)
+		}
+	}
+	if versionID(got[0]) != versionID(got[3]) {
+		t.Errorf("expected duplicates within the batch to be the same node, got %s and %s", versionID(got[0]), versionID(got[3]))
+	}
+	if versionID(got[2]) != versionID(existing) {
+		t.Errorf("expected the package already ingested, got %s instead of %s", versionID(got[2]), versionID(existing))
+	}
+
+	_, err = b.IngestPackages(ctx, []*model.PkgInputSpec{p2, nil})
+	if !errors.Is(err, backends.ErrInvalidInput) || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("expected an invalid input error naming index 1, got: %v", err)
+	}
+}
+
+func versionID(p *model.Package) string {
+	return p.Namespaces[0].Names[0].Versions[0].ID
+}
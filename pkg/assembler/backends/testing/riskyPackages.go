@@ -0,0 +1,327 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Query riskyPackages
+//
+// Positive conditions (hasVulnAboveSeverity, scorecardBelow) are evaluated by
+// following backlinks from the matching vulnerabilities and scorecards to the
+// package versions, and the resulting sets are intersected starting from the
+// smallest. Negative conditions (lacksProvenance, lacksSBOM) are then checked
+// only for the remaining candidates. All package versions are only enumerated
+// if no positive condition is given.
+func (c *demoClient) RiskyPackages(ctx context.Context, conditions model.RiskyPackageConditions, first *int, after *string) (*model.RiskyPackageConnection, error) {
+	lacksProvenance := conditions.LacksProvenance != nil && *conditions.LacksProvenance
+	lacksSBOM := conditions.LacksSbom != nil && *conditions.LacksSbom
+	if conditions.HasVulnAboveSeverity == nil && conditions.ScorecardBelow == nil && !lacksProvenance && !lacksSBOM {
+		return nil, backends.InvalidInputf("riskyPackages :: at least one condition must be specified")
+	}
+	if first != nil && *first < 0 {
+		return nil, backends.InvalidInputf("riskyPackages :: first must not be negative")
+	}
+	var afterID string
+	if after != nil {
+		id, err := parseID(*after)
+		if err != nil {
+			return nil, backends.InvalidInputf("riskyPackages :: invalid cursor %s", err)
+		}
+		afterID = id
+	}
+
+	var vulnsByPkg map[string][]*vulnerabilityLink
+	var scorecardsByPkg map[string][]*scorecardLink
+	var candidates []string
+	if conditions.HasVulnAboveSeverity != nil {
+		vulnsByPkg = c.packagesWithVulnAboveSeverity(*conditions.HasVulnAboveSeverity)
+		candidates = intersectCandidates(candidates, keys(vulnsByPkg), false)
+	}
+	if conditions.ScorecardBelow != nil {
+		scorecardsByPkg = c.packagesWithScorecardBelow(*conditions.ScorecardBelow)
+		candidates = intersectCandidates(candidates, keys(scorecardsByPkg), conditions.HasVulnAboveSeverity != nil)
+	}
+	if conditions.HasVulnAboveSeverity == nil && conditions.ScorecardBelow == nil {
+		candidates = c.allPackageVersionIDs()
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	var sbomIDs map[string]bool
+	if lacksSBOM {
+		sbomIDs = c.packageIDsWithSBOM()
+	}
+
+	limiter := c.newResultLimiter("riskyPackages", first)
+	out := &model.RiskyPackageConnection{Packages: []*model.RiskyPackage{}}
+	for i, id := range candidates {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if id <= afterID {
+			continue
+		}
+		pkgVersion, err := c.pkgVersionByID(id)
+		if err != nil {
+			return nil, err
+		}
+		hasSBOM := false
+		if lacksSBOM {
+			hasSBOM = sbomIDs[pkgVersion.id] || sbomIDs[pkgVersion.parent]
+			if hasSBOM {
+				continue
+			}
+		}
+		var unprovenanced []*model.Artifact
+		if lacksProvenance {
+			var provenanced bool
+			unprovenanced, provenanced = c.unprovenancedArtifacts(pkgVersion)
+			if provenanced {
+				continue
+			}
+		}
+
+		if first != nil && len(out.Packages) == *first {
+			out.HasNextPage = true
+			break
+		}
+		if limiter.full(len(out.Packages)) {
+			out.HasNextPage = true
+			limiter.skip()
+			continue
+		}
+
+		risky, err := c.buildRiskyPackage(pkgVersion, vulnsByPkg[id], scorecardsByPkg[id], unprovenanced, hasSBOM)
+		if err != nil {
+			return nil, err
+		}
+		out.Packages = append(out.Packages, risky)
+		endCursor := id
+		out.EndCursor = &endCursor
+	}
+	limiter.record(ctx, len(out.Packages))
+	return out, nil
+}
+
+func (c *demoClient) buildRiskyPackage(pkgVersion *pkgVersionNode, vulns []*vulnerabilityLink, scorecards []*scorecardLink, unprovenanced []*model.Artifact, hasSBOM bool) (*model.RiskyPackage, error) {
+	p, err := c.buildPackageResponse(pkgVersion.id, nil)
+	if err != nil {
+		return nil, err
+	}
+	risky := &model.RiskyPackage{
+		Package:                p,
+		Vulnerabilities:        []*model.CertifyVuln{},
+		UnprovenancedArtifacts: []*model.Artifact{},
+		HasSbom:                hasSBOM,
+		Scorecards:             []*model.CertifyScorecard{},
+	}
+	for _, link := range vulns {
+		v, err := buildCertifyVulnerability(c, link, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		risky.Vulnerabilities = append(risky.Vulnerabilities, v)
+	}
+	for _, link := range scorecards {
+		s, err := buildScorecard(c, link, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		risky.Scorecards = append(risky.Scorecards, s)
+	}
+	risky.UnprovenancedArtifacts = append(risky.UnprovenancedArtifacts, unprovenanced...)
+	return risky, nil
+}
+
+// packagesWithVulnAboveSeverity starts from the CertifyVuln scored at or
+// above the score by the scanner, and from the vulnerabilities that have an
+// active override at or above the score, following their CertifyVuln
+// backlinks. It keeps the certifications whose effective severity for the
+// certified package is at or above the score.
+func (c *demoClient) packagesWithVulnAboveSeverity(score float64) map[string][]*vulnerabilityLink {
+	now := c.now().UTC()
+	candidates := map[string]*vulnerabilityLink{}
+	for _, link := range c.vulnerabilities {
+		if link.scannerScore != nil && *link.scannerScore >= score {
+			candidates[link.id] = link
+		}
+	}
+	vulnNodes := map[string]bool{}
+	for _, o := range c.severityOverrides {
+		if o.active(now) && o.score >= score {
+			vulnNodes[o.osvID+o.cveID+o.ghsaID] = true
+		}
+	}
+	for vulnID := range vulnNodes {
+		var certifyVulnLinks []string
+		switch v := c.index[vulnID].(type) {
+		case *osvIDNode:
+			certifyVulnLinks = v.getVulnerabilityLink()
+		case *cveIDNode:
+			certifyVulnLinks = v.getVulnerabilityLink()
+		case *ghsaIDNode:
+			certifyVulnLinks = v.getVulnerabilityLink()
+		}
+		for _, id := range certifyVulnLinks {
+			if link, err := c.certifyVulnByID(id); err == nil {
+				candidates[link.id] = link
+			}
+		}
+	}
+
+	out := map[string][]*vulnerabilityLink{}
+	for _, link := range candidates {
+		effective, source, _, err := c.resolveSeverity(link.osvID, link.cveID, link.ghsaID, link.packageID, "", link.scannerScore)
+		if err != nil || source == "" {
+			continue
+		}
+		if effective >= score {
+			out[link.packageID] = append(out[link.packageID], link)
+		}
+	}
+	for _, links := range out {
+		sort.Slice(links, func(i, j int) bool { return links[i].id < links[j].id })
+	}
+	return out
+}
+
+// packagesWithScorecardBelow follows the scorecards below the score to their
+// source and from there through HasSourceAt to the package versions. A
+// HasSourceAt on a package name applies to all of its versions.
+func (c *demoClient) packagesWithScorecardBelow(score float64) map[string][]*scorecardLink {
+	out := map[string][]*scorecardLink{}
+	for _, scorecard := range c.scorecards {
+		if scorecard.aggregateScore >= score {
+			continue
+		}
+		src, err := c.sourceByID(scorecard.sourceID)
+		if err != nil {
+			continue
+		}
+		for _, id := range src.srcMapLink {
+			link, err := c.hasSourceAtByID(id)
+			if err != nil {
+				continue
+			}
+			switch p := c.index[link.packageID].(type) {
+			case *pkgVersionNode:
+				out[p.id] = append(out[p.id], scorecard)
+			case *pkgVersionStruct:
+				for _, v := range p.versions {
+					out[v.id] = append(out[v.id], scorecard)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// unprovenancedArtifacts returns the artifacts the package version occurs as
+// that are not the subject of a HasSLSA, and whether any artifact has one.
+func (c *demoClient) unprovenancedArtifacts(pkgVersion *pkgVersionNode) ([]*model.Artifact, bool) {
+	out := []*model.Artifact{}
+	for _, id := range pkgVersion.getOccurrenceLink() {
+		o, err := c.occurrenceByID(id)
+		if err != nil {
+			continue
+		}
+		a, err := c.artifactByID(o.artifact)
+		if err != nil {
+			continue
+		}
+		for _, slsaID := range a.getHasSLSAs() {
+			if s, err := c.hasSLSAByID(slsaID); err == nil && s.subject == a.id {
+				return nil, true
+			}
+		}
+		out = append(out, convArtifact(a))
+	}
+	return out, false
+}
+
+// packageIDsWithSBOM returns the IDs of the package names and versions that
+// are the subject of a HasSBOM.
+func (c *demoClient) packageIDsWithSBOM() map[string]bool {
+	out := map[string]bool{}
+	for _, h := range c.hasSBOM {
+		p, ok := h.Subject.(*model.Package)
+		if !ok {
+			continue
+		}
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				if len(n.Versions) == 0 {
+					out[n.ID] = true
+				}
+				for _, v := range n.Versions {
+					out[v.ID] = true
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (c *demoClient) allPackageVersionIDs() []string {
+	out := []string{}
+	for _, namespaces := range c.packages {
+		for _, names := range namespaces.namespaces {
+			for _, versions := range names.names {
+				for _, v := range versions.versions {
+					out = append(out, v.id)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// intersectCandidates intersects the candidates with the next set. If
+// restrict is false the candidates have not been restricted yet and the next
+// set is returned as is.
+func intersectCandidates(candidates, next []string, restrict bool) []string {
+	if !restrict {
+		return next
+	}
+	small, large := candidates, next
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	largeSet := map[string]bool{}
+	for _, id := range large {
+		largeSet[id] = true
+	}
+	out := []string{}
+	for _, id := range small {
+		if largeSet[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,364 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var s1 = &model.SourceInputSpec{
+	Type:      "git",
+	Namespace: "github.com/tensorflow",
+	Name:      "tensorflow",
+}
+var s2 = &model.SourceInputSpec{
+	Type:      "git",
+	Namespace: "github.com/numpy",
+	Name:      "numpy",
+}
+
+var p5 = &model.PkgInputSpec{
+	Type:    "pypi",
+	Name:    "numpy",
+	Version: ptrfrom.String("1.24.0"),
+}
+
+// seedRiskyPackages ingests three package versions:
+//   - tensorflow (p2) matches every condition: a vulnerability with a high
+//     global override, an artifact without provenance, no SBOM and a low
+//     scorecard for the source of all its versions.
+//   - openssl (p4) matches none: the high global override is replaced by a
+//     low scoped one, its artifact has provenance and it has an SBOM.
+//   - numpy (p5) has the high vulnerability, no artifacts at all, an SBOM and
+//     a medium scorecard.
+func seedRiskyPackages(t *testing.T, ctx context.Context, b backends.Backend) {
+	t.Helper()
+	created := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+	builder := model.BuilderInputSpec{URI: "https://github.com/Attestations/GitHubHostedActions@v1"}
+
+	for _, p := range []*model.PkgInputSpec{p2, p4, p5} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{a1, a2, a3} {
+		if _, err := b.IngestArtifact(ctx, a); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+	}
+	if _, err := b.IngestBuilder(ctx, &builder); err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest cve: %v", err)
+	}
+
+	for _, p := range []*model.PkgInputSpec{p2, p4, p5} {
+		if _, err := b.IngestVulnerability(ctx, *p, vuln, model.VulnerabilityMetaDataInput{TimeScanned: created}); err != nil {
+			t.Fatalf("Could not ingest vulnerability: %v", err)
+		}
+	}
+	if _, err := b.IngestSeverityOverride(ctx, vuln, nil, model.SeverityOverrideInputSpec{Score: 9, ScoreType: "CVSSv3", CreatedAt: created}); err != nil {
+		t.Fatalf("Could not ingest severity override: %v", err)
+	}
+	if _, err := b.IngestSeverityOverride(ctx, vuln, &model.PackageOrArtifactInput{Package: p4}, model.SeverityOverrideInputSpec{Score: 2, ScoreType: "CVSSv3", CreatedAt: created}); err != nil {
+		t.Fatalf("Could not ingest severity override: %v", err)
+	}
+
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p4}, *a2, model.IsOccurrenceInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestSLSA(ctx, *a2, []*model.ArtifactInputSpec{a3}, builder, model.SLSAInputSpec{BuildType: "test"}); err != nil {
+		t.Fatalf("Could not ingest slsa: %v", err)
+	}
+
+	for _, p := range []*model.PkgInputSpec{p4, p5} {
+		if _, err := b.IngestHasSbom(ctx, model.PackageOrSourceInput{Package: p}, model.HasSBOMInputSpec{URI: "https://example.com/sbom"}); err != nil {
+			t.Fatalf("Could not ingest sbom: %v", err)
+		}
+	}
+
+	if _, err := b.IngestHasSourceAt(ctx, *p1, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, *s1, model.HasSourceAtInputSpec{KnownSince: created}); err != nil {
+		t.Fatalf("Could not ingest has source at: %v", err)
+	}
+	if _, err := b.IngestHasSourceAt(ctx, *p5, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s2, model.HasSourceAtInputSpec{KnownSince: created}); err != nil {
+		t.Fatalf("Could not ingest has source at: %v", err)
+	}
+	if _, err := b.CertifyScorecard(ctx, *s1, model.ScorecardInputSpec{AggregateScore: 3, TimeScanned: created}); err != nil {
+		t.Fatalf("Could not ingest scorecard: %v", err)
+	}
+	if _, err := b.CertifyScorecard(ctx, *s2, model.ScorecardInputSpec{AggregateScore: 6, TimeScanned: created}); err != nil {
+		t.Fatalf("Could not ingest scorecard: %v", err)
+	}
+}
+
+func riskyPackageNames(conn *model.RiskyPackageConnection) []string {
+	var names []string
+	for _, p := range conn.Packages {
+		names = append(names, p.Package.Namespaces[0].Names[0].Name)
+	}
+	return names
+}
+
+func TestRiskyPackages(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedRiskyPackages(t, ctx, b)
+
+	tests := []struct {
+		Name       string
+		Conditions model.RiskyPackageConditions
+		ExpNames   []string
+		ExpErr     bool
+	}{
+		{
+			Name:       "No conditions",
+			Conditions: model.RiskyPackageConditions{},
+			ExpErr:     true,
+		},
+		{
+			Name:       "Only false conditions",
+			Conditions: model.RiskyPackageConditions{LacksProvenance: ptrfrom.Bool(false)},
+			ExpErr:     true,
+		},
+		{
+			Name:       "Vulnerability above severity",
+			Conditions: model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(7)},
+			ExpNames:   []string{"tensorflow", "numpy"},
+		},
+		{
+			Name:       "Vulnerability above low severity includes scoped override",
+			Conditions: model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(1)},
+			ExpNames:   []string{"tensorflow", "openssl", "numpy"},
+		},
+		{
+			Name:       "Vulnerability above maximum severity",
+			Conditions: model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(9.5)},
+		},
+		{
+			Name:       "Lacks provenance",
+			Conditions: model.RiskyPackageConditions{LacksProvenance: ptrfrom.Bool(true)},
+			ExpNames:   []string{"tensorflow", "numpy"},
+		},
+		{
+			Name:       "Lacks SBOM",
+			Conditions: model.RiskyPackageConditions{LacksSbom: ptrfrom.Bool(true)},
+			ExpNames:   []string{"tensorflow"},
+		},
+		{
+			Name:       "Scorecard below",
+			Conditions: model.RiskyPackageConditions{ScorecardBelow: ptrfrom.Float64(5)},
+			ExpNames:   []string{"tensorflow"},
+		},
+		{
+			Name:       "Scorecard below higher score",
+			Conditions: model.RiskyPackageConditions{ScorecardBelow: ptrfrom.Float64(7)},
+			ExpNames:   []string{"tensorflow", "numpy"},
+		},
+		{
+			Name: "Vulnerability and scorecard",
+			Conditions: model.RiskyPackageConditions{
+				HasVulnAboveSeverity: ptrfrom.Float64(1),
+				ScorecardBelow:       ptrfrom.Float64(7),
+			},
+			ExpNames: []string{"tensorflow", "numpy"},
+		},
+		{
+			Name: "Vulnerability and lacks SBOM",
+			Conditions: model.RiskyPackageConditions{
+				HasVulnAboveSeverity: ptrfrom.Float64(1),
+				LacksSbom:            ptrfrom.Bool(true),
+			},
+			ExpNames: []string{"tensorflow"},
+		},
+		{
+			Name: "Lacks provenance and SBOM",
+			Conditions: model.RiskyPackageConditions{
+				LacksProvenance: ptrfrom.Bool(true),
+				LacksSbom:       ptrfrom.Bool(true),
+			},
+			ExpNames: []string{"tensorflow"},
+		},
+		{
+			Name: "Scorecard and lacks provenance",
+			Conditions: model.RiskyPackageConditions{
+				ScorecardBelow:  ptrfrom.Float64(7),
+				LacksProvenance: ptrfrom.Bool(true),
+			},
+			ExpNames: []string{"tensorflow", "numpy"},
+		},
+		{
+			Name: "All conditions",
+			Conditions: model.RiskyPackageConditions{
+				HasVulnAboveSeverity: ptrfrom.Float64(7),
+				LacksProvenance:      ptrfrom.Bool(true),
+				LacksSbom:            ptrfrom.Bool(true),
+				ScorecardBelow:       ptrfrom.Float64(5),
+			},
+			ExpNames: []string{"tensorflow"},
+		},
+		{
+			Name: "False conditions are ignored",
+			Conditions: model.RiskyPackageConditions{
+				HasVulnAboveSeverity: ptrfrom.Float64(1),
+				LacksProvenance:      ptrfrom.Bool(false),
+				LacksSbom:            ptrfrom.Bool(false),
+			},
+			ExpNames: []string{"tensorflow", "openssl", "numpy"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.RiskyPackages(ctx, test.Conditions, nil, nil)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.ExpNames, riskyPackageNames(got)); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+			if got.HasNextPage {
+				t.Errorf("Unexpected next page")
+			}
+		})
+	}
+}
+
+func TestRiskyPackagesScannerScore(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	created := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest cve: %v", err)
+	}
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+	for _, p := range []*model.PkgInputSpec{p2, p4} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		if _, err := b.IngestVulnerability(ctx, *p, vuln, model.VulnerabilityMetaDataInput{TimeScanned: created, ScannerScore: ptrfrom.Float64(9.8)}); err != nil {
+			t.Fatalf("Could not ingest CertifyVuln: %v", err)
+		}
+	}
+	// the scanner score of openssl is overridden below the threshold
+	if _, err := b.IngestSeverityOverride(ctx, vuln, &model.PackageOrArtifactInput{Package: p4}, model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created}); err != nil {
+		t.Fatalf("Could not ingest severity override: %v", err)
+	}
+
+	got, err := b.RiskyPackages(ctx, model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(9)}, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"tensorflow"}, riskyPackageNames(got)); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
+
+func TestRiskyPackagesDetails(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedRiskyPackages(t, ctx, b)
+
+	got, err := b.RiskyPackages(ctx, model.RiskyPackageConditions{
+		HasVulnAboveSeverity: ptrfrom.Float64(7),
+		LacksProvenance:      ptrfrom.Bool(true),
+		LacksSbom:            ptrfrom.Bool(true),
+		ScorecardBelow:       ptrfrom.Float64(5),
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got.Packages) != 1 {
+		t.Fatalf("Unexpected number of results, want: 1, got: %d", len(got.Packages))
+	}
+	p := got.Packages[0]
+	if len(p.Vulnerabilities) != 1 {
+		t.Errorf("Unexpected number of vulnerabilities, want: 1, got: %d", len(p.Vulnerabilities))
+	}
+	if len(p.UnprovenancedArtifacts) != 1 || p.UnprovenancedArtifacts[0].Digest != a1.Digest {
+		t.Errorf("Unexpected unprovenanced artifacts: %+v", p.UnprovenancedArtifacts)
+	}
+	if p.HasSbom {
+		t.Errorf("Unexpected SBOM")
+	}
+	if len(p.Scorecards) != 1 || p.Scorecards[0].Scorecard.AggregateScore != 3 {
+		t.Errorf("Unexpected scorecards: %+v", p.Scorecards)
+	}
+}
+
+func TestRiskyPackagesPagination(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedRiskyPackages(t, ctx, b)
+
+	conditions := model.RiskyPackageConditions{HasVulnAboveSeverity: ptrfrom.Float64(1)}
+	var names []string
+	var after *string
+	for page := 0; ; page++ {
+		if page > 3 {
+			t.Fatalf("Too many pages")
+		}
+		got, err := b.RiskyPackages(ctx, conditions, ptrfrom.Int(2), after)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		names = append(names, riskyPackageNames(got)...)
+		if !got.HasNextPage {
+			if page != 1 {
+				t.Errorf("Unexpected number of pages, want: 2, got: %d", page+1)
+			}
+			break
+		}
+		if len(got.Packages) != 2 || got.EndCursor == nil {
+			t.Fatalf("Unexpected page: %+v", got)
+		}
+		after = got.EndCursor
+	}
+	if diff := cmp.Diff([]string{"tensorflow", "openssl", "numpy"}, names); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
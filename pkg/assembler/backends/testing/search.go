@@ -0,0 +1,107 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// findSoftwareLimit caps the results of FindSoftware below the default result
+// limit of the server, if any, as a short search text matches most nodes.
+const findSoftwareLimit = 1000
+
+// FindSoftware returns the packages, sources and artifacts whose names,
+// namespaces or digests contain searchText, ignoring case. Packages are
+// returned at the name level and sources at the name, tag and commit level,
+// each kind in ingestion order.
+func (c *demoClient) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
+	searchText = strings.ToLower(strings.TrimSpace(searchText))
+	if searchText == "" {
+		return nil, backends.InvalidInputf("FindSoftware :: search text must not be empty")
+	}
+	contains := func(s string) bool {
+		return strings.Contains(strings.ToLower(s), searchText)
+	}
+
+	var pkgIDs, srcIDs, artIDs []string
+	for _, pkgNamespace := range c.packages {
+		for _, pkgName := range pkgNamespace.namespaces {
+			namespaceMatches := contains(pkgName.namespace)
+			for _, pkgVersion := range pkgName.names {
+				if namespaceMatches || contains(pkgVersion.name) {
+					pkgIDs = append(pkgIDs, pkgVersion.id)
+				}
+			}
+		}
+	}
+	for _, srcNamespace := range c.sources {
+		for _, srcName := range srcNamespace.namespaces {
+			namespaceMatches := contains(srcName.namespace)
+			for _, srcNameNode := range srcName.names {
+				if namespaceMatches || contains(srcNameNode.name) {
+					srcIDs = append(srcIDs, srcNameNode.id)
+				}
+			}
+		}
+	}
+	for _, a := range c.artifacts {
+		if contains(a.digest) {
+			artIDs = append(artIDs, a.id)
+		}
+	}
+
+	out := []model.PackageSourceOrArtifact{}
+	limiter := c.newResultLimiter("findSoftware", nil)
+	if limiter.limit == 0 || limiter.limit > findSoftwareLimit {
+		limiter.limit = findSoftwareLimit
+	}
+	for _, id := range sortedIDs(pkgIDs) {
+		if limiter.full(len(out)) {
+			limiter.skip()
+			continue
+		}
+		p, err := c.buildPackageResponse(id, nil)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	for _, id := range sortedIDs(srcIDs) {
+		if limiter.full(len(out)) {
+			limiter.skip()
+			continue
+		}
+		s, err := c.buildSourceResponse(id, nil)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	for _, id := range sortedIDs(artIDs) {
+		if limiter.full(len(out)) {
+			limiter.skip()
+			continue
+		}
+		out = append(out, convArtifact(c.index[id].(*artStruct)))
+	}
+	limiter.record(ctx, len(out))
+
+	return out, nil
+}
@@ -0,0 +1,203 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var log4jCore = &model.PkgInputSpec{
+	Type:      "maven",
+	Namespace: ptrfrom.String("org.apache.logging.log4j"),
+	Name:      "log4j-core",
+	Version:   ptrfrom.String("2.14.1"),
+}
+var log4jCorePatched = &model.PkgInputSpec{
+	Type:      "maven",
+	Namespace: ptrfrom.String("org.apache.logging.log4j"),
+	Name:      "log4j-core",
+	Version:   ptrfrom.String("2.17.0"),
+}
+var log4jAPI = &model.PkgInputSpec{
+	Type:      "maven",
+	Namespace: ptrfrom.String("org.apache.logging.log4j"),
+	Name:      "log4j-api",
+	Version:   ptrfrom.String("2.17.0"),
+}
+var log4jSrc = &model.SourceInputSpec{
+	Type:      "git",
+	Namespace: "github.com/apache",
+	Name:      "logging-log4j2",
+	Tag:       ptrfrom.String("rel/2.17.0"),
+}
+
+func TestFindSoftware(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestSearchTrees(t, b)
+
+	tests := []struct {
+		Name       string
+		SearchText string
+		Exp        []string
+		ExpErr     bool
+	}{
+		{
+			Name:       "Partial name",
+			SearchText: "log4",
+			Exp: []string{
+				"maven/org.apache.logging.log4j/log4j-core",
+				"maven/org.apache.logging.log4j/log4j-api",
+				"git/github.com/apache/logging-log4j2",
+			},
+		},
+		{
+			Name:       "Case insensitive",
+			SearchText: "LOG4J-Core",
+			Exp:        []string{"maven/org.apache.logging.log4j/log4j-core"},
+		},
+		{
+			Name:       "Namespace",
+			SearchText: "apache",
+			Exp: []string{
+				"maven/org.apache.logging.log4j/log4j-core",
+				"maven/org.apache.logging.log4j/log4j-api",
+				"git/github.com/apache/logging-log4j2",
+			},
+		},
+		{
+			Name:       "Source of another type",
+			SearchText: "tensorflow",
+			Exp: []string{
+				"pypi//tensorflow",
+				"git/github.com/tensorflow/tensorflow",
+			},
+		},
+		{
+			Name:       "Digest",
+			SearchText: "6BBB0DA1891646",
+			Exp:        []string{"sha256:6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"},
+		},
+		{
+			Name:       "No match",
+			SearchText: "left-pad",
+			Exp:        []string{},
+		},
+		{
+			Name:       "Empty search",
+			SearchText: "",
+			ExpErr:     true,
+		},
+		{
+			Name:       "Blank search",
+			SearchText: "  ",
+			ExpErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.FindSoftware(ctx, test.SearchText)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				if !errors.Is(err, backends.ErrInvalidInput) {
+					t.Errorf("expected an invalid input error, got: %v", err)
+				}
+				return
+			}
+			if diff := cmp.Diff(test.Exp, describeSoftware(got)); diff != "" {
+				t.Errorf("Unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindSoftwareLimit(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{ResultLimits: map[string]int{"findSoftware": 2}})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestSearchTrees(t, b)
+
+	got, err := b.FindSoftware(ctx, "log4")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	exp := []string{
+		"maven/org.apache.logging.log4j/log4j-core",
+		"maven/org.apache.logging.log4j/log4j-api",
+	}
+	if diff := cmp.Diff(exp, describeSoftware(got)); diff != "" {
+		t.Errorf("Unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func ingestSearchTrees(t *testing.T, b backends.Backend) {
+	t.Helper()
+	ctx := context.Background()
+	for _, p := range []*model.PkgInputSpec{log4jCore, log4jCorePatched, log4jAPI, p2} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{log4jSrc, s1} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+}
+
+// describeSoftware writes the results of FindSoftware as type/namespace/name
+// for the software trees, and as algorithm:digest for artifacts.
+func describeSoftware(results []model.PackageSourceOrArtifact) []string {
+	out := []string{}
+	for _, r := range results {
+		switch v := r.(type) {
+		case *model.Package:
+			for _, ns := range v.Namespaces {
+				for _, n := range ns.Names {
+					out = append(out, fmt.Sprintf("%s/%s/%s", v.Type, ns.Namespace, n.Name))
+				}
+			}
+		case *model.Source:
+			for _, ns := range v.Namespaces {
+				for _, n := range ns.Names {
+					out = append(out, fmt.Sprintf("%s/%s/%s", v.Type, ns.Namespace, n.Name))
+				}
+			}
+		case *model.Artifact:
+			out = append(out, fmt.Sprintf("%s:%s", v.Algorithm, v.Digest))
+		}
+	}
+	return out
+}
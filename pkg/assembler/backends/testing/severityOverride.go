@@ -0,0 +1,524 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: severity overrides of a vulnerability, optionally scoped to a
+// package version or an artifact. An empty subject ID means the override is
+// not scoped to that kind of subject.
+type severityOverrideList []*severityOverrideLink
+type severityOverrideLink struct {
+	id            string
+	osvID         string
+	cveID         string
+	ghsaID        string
+	packageID     string
+	artifactID    string
+	score         float64
+	scoreType     string
+	reviewer      string
+	justification string
+	createdAt     time.Time
+	expiresAt     *time.Time
+	retracted     bool
+	origin        string
+	collector     string
+}
+
+func (n *severityOverrideLink) getID() string { return n.id }
+
+func (n *severityOverrideLink) neighbors() []string {
+	return appendSetIDs(nil, n.osvID, n.cveID, n.ghsaID, n.packageID, n.artifactID)
+}
+
+// severityOverrideKey is the identity of a SeverityOverride: ingesting an
+// override with the same key updates the existing one in place.
+type severityOverrideKey struct {
+	osvID      string
+	cveID      string
+	ghsaID     string
+	packageID  string
+	artifactID string
+	scoreType  string
+	reviewer   string
+	createdAt  time.Time
+}
+
+func (n *severityOverrideLink) key() severityOverrideKey {
+	return severityOverrideKey{
+		osvID:      n.osvID,
+		cveID:      n.cveID,
+		ghsaID:     n.ghsaID,
+		packageID:  n.packageID,
+		artifactID: n.artifactID,
+		scoreType:  n.scoreType,
+		reviewer:   n.reviewer,
+		createdAt:  n.createdAt.UTC(),
+	}
+}
+
+func (n *severityOverrideLink) isGlobal() bool {
+	return n.packageID == "" && n.artifactID == ""
+}
+
+// active returns true if the override has neither been retracted nor expired
+// at the given time.
+func (n *severityOverrideLink) active(now time.Time) bool {
+	if n.retracted {
+		return false
+	}
+	return n.expiresAt == nil || now.Before(*n.expiresAt)
+}
+
+// Ingest SeverityOverride
+func (c *demoClient) IngestSeverityOverride(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) (*model.SeverityOverride, error) {
+	osvID, cveID, ghsaID, err := c.getVulnerabilityIDsFromInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+	if subject != nil && helper.ValidatePackageOrArtifactInput(subject, "IngestSeverityOverride") == nil {
+		if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{subject.Package}, nil); err != nil {
+			return nil, err
+		}
+	}
+	packageID, artifactID, err := c.getSeverityOverrideSubjectIDs(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := severityOverride.CreatedAt.UTC()
+	var expiresAt *time.Time
+	if severityOverride.ExpiresAt != nil {
+		t := severityOverride.ExpiresAt.UTC()
+		expiresAt = &t
+	}
+
+	link := &severityOverrideLink{
+		osvID:         osvID,
+		cveID:         cveID,
+		ghsaID:        ghsaID,
+		packageID:     packageID,
+		artifactID:    artifactID,
+		score:         severityOverride.Score,
+		scoreType:     severityOverride.ScoreType,
+		reviewer:      severityOverride.Reviewer,
+		justification: severityOverride.Justification,
+		createdAt:     createdAt,
+		expiresAt:     expiresAt,
+		retracted:     severityOverride.Retracted,
+		origin:        severityOverride.Origin,
+		collector:     severityOverride.Collector,
+	}
+
+	// An existing override with the same identity is updated in place, which
+	// is how overrides get retracted or have their expiry changed.
+	if existing, ok := c.severityOverrideKeys[link.key()]; ok {
+		c.countIngestion(model.NodeTypeSeverityOverride, false)
+		existing.score = link.score
+		existing.justification = link.justification
+		existing.expiresAt = link.expiresAt
+		existing.retracted = link.retracted
+		existing.origin = link.origin
+		existing.collector = link.collector
+		return c.buildSeverityOverride(existing, nil, true)
+	}
+
+	link.id = c.getNextID()
+	c.index[link.id] = link
+	c.severityOverrideKeys[link.key()] = link
+	c.recordChange(link.id, model.NodeTypeSeverityOverride)
+	c.severityOverrides = append(c.severityOverrides, link)
+	// set the backlinks
+	if osvID != "" {
+		c.index[osvID].(*osvIDNode).setSeverityOverrideLink(link.id)
+	}
+	if cveID != "" {
+		c.index[cveID].(*cveIDNode).setSeverityOverrideLink(link.id)
+	}
+	if ghsaID != "" {
+		c.index[ghsaID].(*ghsaIDNode).setSeverityOverrideLink(link.id)
+	}
+	if packageID != "" {
+		c.index[packageID].(*pkgVersionNode).setSeverityOverrideLink(link.id)
+	}
+	if artifactID != "" {
+		c.index[artifactID].(*artStruct).setOverrides(link.id)
+	}
+
+	return c.buildSeverityOverride(link, nil, true)
+}
+
+// Query SeverityOverride
+func (c *demoClient) SeverityOverride(ctx context.Context, filter *model.SeverityOverrideSpec) ([]*model.SeverityOverride, error) {
+	if filter != nil {
+		if _, err := helper.ValidateOsvCveOrGhsaQueryInput(filter.Vulnerability); err != nil {
+			return nil, err
+		}
+		if _, err := helper.ValidatePackageOrArtifactQueryInput(filter.Subject); err != nil {
+			return nil, err
+		}
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
+
+	if filter != nil && filter.ID != nil {
+		id, err := parseID(*filter.ID)
+		if err != nil {
+			return nil, err
+		}
+		node, ok := c.index[id]
+		if !ok {
+			return nil, backends.NotFoundf("ID does not match existing node")
+		}
+		link, ok := node.(*severityOverrideLink)
+		if !ok {
+			return nil, backends.InvalidInputf("ID does not match expected node type for severityOverride")
+		}
+		found, err := c.buildSeverityOverride(link, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		return []*model.SeverityOverride{found}, nil
+	}
+
+	out := []*model.SeverityOverride{}
+	for i, link := range c.severityOverrides {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatch(filter.ScoreType, link.scoreType) {
+			continue
+		}
+		if filter != nil && noMatch(filter.Reviewer, link.reviewer) {
+			continue
+		}
+		if filter != nil && filter.Retracted != nil && *filter.Retracted != link.retracted {
+			continue
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
+			continue
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
+			continue
+		}
+
+		found, err := c.buildSeverityOverride(link, filter, false)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			continue
+		}
+		out = append(out, found)
+	}
+
+	return out, nil
+}
+
+// EffectiveSeverity returns the severity of the vulnerability for the
+// (optional) subject. A scoped override beats a global override which beats
+// the scanner score. Retracted and expired overrides are ignored. If multiple
+// overrides apply at the same level, the most recently created one wins.
+func (c *demoClient) EffectiveSeverity(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) (*model.EffectiveSeverity, error) {
+	osvID, cveID, ghsaID, err := c.getVulnerabilityIDsFromInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+	packageID, artifactID, err := c.getSeverityOverrideSubjectIDs(subject)
+	if err != nil {
+		return nil, err
+	}
+	return c.effectiveSeverity(osvID, cveID, ghsaID, packageID, artifactID, &scannerScore)
+}
+
+// effectiveSeverity applies the override precedence of EffectiveSeverity to
+// the scanner score, if any, at the time of the client clock. It returns nil
+// if neither the scanner nor an override scored the vulnerability.
+func (c *demoClient) effectiveSeverity(osvID, cveID, ghsaID, packageID, artifactID string, scannerScore *float64) (*model.EffectiveSeverity, error) {
+	score, source, applied, err := c.resolveSeverity(osvID, cveID, ghsaID, packageID, artifactID, scannerScore)
+	if err != nil || source == "" {
+		return nil, err
+	}
+	vuln, err := c.buildOsvCveOrGhsa(osvID, cveID, ghsaID, nil)
+	if err != nil {
+		return nil, err
+	}
+	effective := &model.EffectiveSeverity{
+		Vulnerability: vuln,
+		Score:         score,
+		Bucket:        helper.SeverityBucketForScore(score),
+		Source:        source,
+	}
+	if applied != nil {
+		effective.Override, err = c.buildSeverityOverride(applied, nil, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return effective, nil
+}
+
+// resolveSeverity returns the effective score of the vulnerability, where it
+// came from and the override applied, if any. The source is empty if neither
+// the scanner nor an override scored the vulnerability.
+func (c *demoClient) resolveSeverity(osvID, cveID, ghsaID, packageID, artifactID string, scannerScore *float64) (float64, model.SeveritySource, *severityOverrideLink, error) {
+	scoped, global, err := c.applicableSeverityOverrides(osvID, cveID, ghsaID, packageID, artifactID, c.now().UTC())
+	if err != nil {
+		return 0, "", nil, err
+	}
+	switch {
+	case scoped != nil:
+		return scoped.score, model.SeveritySourceScopedOverride, scoped, nil
+	case global != nil:
+		return global.score, model.SeveritySourceGlobalOverride, global, nil
+	case scannerScore != nil:
+		return *scannerScore, model.SeveritySourceScanner, nil, nil
+	}
+	return 0, "", nil, nil
+}
+
+// applicableSeverityOverrides returns the most recent active override scoped
+// to the package or artifact and the most recent active global override for
+// the vulnerability. Either can be nil.
+func (c *demoClient) applicableSeverityOverrides(osvID, cveID, ghsaID, packageID, artifactID string, now time.Time) (scoped, global *severityOverrideLink, err error) {
+	for _, id := range c.vulnerabilitySeverityOverrides(osvID, cveID, ghsaID) {
+		link, err := c.severityOverrideByID(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !link.active(now) {
+			continue
+		}
+		if link.isGlobal() {
+			if global == nil || link.createdAt.After(global.createdAt) {
+				global = link
+			}
+			continue
+		}
+		if (packageID != "" && link.packageID == packageID) || (artifactID != "" && link.artifactID == artifactID) {
+			if scoped == nil || link.createdAt.After(scoped.createdAt) {
+				scoped = link
+			}
+		}
+	}
+	return scoped, global, nil
+}
+
+func (c *demoClient) buildSeverityOverride(link *severityOverrideLink, filter *model.SeverityOverrideSpec, ingestOrIDProvided bool) (*model.SeverityOverride, error) {
+	var vulnFilter *model.OsvCveOrGhsaSpec
+	var subjectFilter *model.PackageOrArtifactSpec
+	if filter != nil {
+		vulnFilter = filter.Vulnerability
+		subjectFilter = filter.Subject
+	}
+
+	vuln, err := c.buildOsvCveOrGhsa(link.osvID, link.cveID, link.ghsaID, vulnFilter)
+	if err != nil {
+		return nil, err
+	}
+	if vuln == nil {
+		if ingestOrIDProvided {
+			return nil, backends.Internalf("failed to retrieve vulnerability for severityOverride")
+		}
+		return nil, nil
+	}
+
+	// global overrides never match a subject filter
+	if subjectFilter != nil && link.isGlobal() && !ingestOrIDProvided {
+		return nil, nil
+	}
+
+	var subject model.PackageOrArtifact
+	if link.packageID != "" {
+		if subjectFilter != nil && subjectFilter.Package == nil && !ingestOrIDProvided {
+			return nil, nil
+		}
+		var pkgFilter *model.PkgSpec
+		if subjectFilter != nil {
+			pkgFilter = subjectFilter.Package
+		}
+		p, err := c.buildPackageResponse(link.packageID, pkgFilter)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			if ingestOrIDProvided {
+				return nil, backends.Internalf("failed to retrieve package via packageID")
+			}
+			return nil, nil
+		}
+		subject = p
+	}
+	if link.artifactID != "" {
+		if subjectFilter != nil && subjectFilter.Artifact == nil && !ingestOrIDProvided {
+			return nil, nil
+		}
+		if subjectFilter != nil && subjectFilter.Artifact != nil && !c.artifactMatch(link.artifactID, subjectFilter.Artifact) && !ingestOrIDProvided {
+			return nil, nil
+		}
+		a, err := c.artifactByID(link.artifactID)
+		if err != nil {
+			return nil, backends.Internalf("failed to retrieve artifact via artifactID")
+		}
+		subject = convArtifact(a)
+	}
+
+	return &model.SeverityOverride{
+		ID:            link.id,
+		Vulnerability: vuln,
+		Subject:       subject,
+		Score:         link.score,
+		ScoreType:     link.scoreType,
+		Reviewer:      link.reviewer,
+		Justification: link.justification,
+		CreatedAt:     link.createdAt,
+		ExpiresAt:     link.expiresAt,
+		Retracted:     link.retracted,
+		Origin:        link.origin,
+		Collector:     link.collector,
+	}, nil
+}
+
+// buildOsvCveOrGhsa builds the vulnerability union from whichever of the IDs is
+// set. Returns nil if the vulnerability does not match the filter.
+func (c *demoClient) buildOsvCveOrGhsa(osvID, cveID, ghsaID string, filter *model.OsvCveOrGhsaSpec) (model.OsvCveOrGhsa, error) {
+	if filter != nil && filter.NoVuln != nil {
+		// overrides are never on noVuln, a false noVuln matches all of them
+		if *filter.NoVuln {
+			return nil, nil
+		}
+		filter = nil
+	}
+	switch {
+	case osvID != "":
+		if filter != nil && filter.Osv == nil {
+			return nil, nil
+		}
+		var osvFilter *model.OSVSpec
+		if filter != nil {
+			osvFilter = filter.Osv
+		}
+		osv, err := c.buildOsvResponse(osvID, osvFilter)
+		if err != nil || osv == nil {
+			return nil, err
+		}
+		return osv, nil
+	case cveID != "":
+		if filter != nil && filter.Cve == nil {
+			return nil, nil
+		}
+		var cveFilter *model.CVESpec
+		if filter != nil {
+			cveFilter = filter.Cve
+		}
+		cve, err := c.buildCveResponse(cveID, cveFilter)
+		if err != nil || cve == nil {
+			return nil, err
+		}
+		return cve, nil
+	case ghsaID != "":
+		if filter != nil && filter.Ghsa == nil {
+			return nil, nil
+		}
+		var ghsaFilter *model.GHSASpec
+		if filter != nil {
+			ghsaFilter = filter.Ghsa
+		}
+		ghsa, err := c.buildGhsaResponse(ghsaID, ghsaFilter)
+		if err != nil || ghsa == nil {
+			return nil, err
+		}
+		return ghsa, nil
+	}
+	return nil, nil
+}
+
+func (c *demoClient) getVulnerabilityIDsFromInput(vulnerability model.OsvCveOrGhsaInput) (osvID, cveID, ghsaID string, err error) {
+	if err = helper.ValidateOsvCveOrGhsaIngestionInput(vulnerability); err != nil {
+		return
+	}
+	if vulnerability.NoVuln != nil && *vulnerability.NoVuln {
+		err = backends.InvalidInputf("noVuln is only valid for CertifyVuln")
+		return
+	}
+	if vulnerability.Osv != nil {
+		osvID, err = getOsvIDFromInput(c, *vulnerability.Osv)
+	}
+	if vulnerability.Cve != nil {
+		cveID, err = getCveIDFromInput(c, *vulnerability.Cve)
+	}
+	if vulnerability.Ghsa != nil {
+		ghsaID, err = getGhsaIDFromInput(c, *vulnerability.Ghsa)
+	}
+	return
+}
+
+func (c *demoClient) getSeverityOverrideSubjectIDs(subject *model.PackageOrArtifactInput) (packageID, artifactID string, err error) {
+	if subject == nil {
+		return "", "", nil
+	}
+	if err = helper.ValidatePackageOrArtifactInput(subject, "IngestSeverityOverride"); err != nil {
+		return "", "", err
+	}
+	if subject.Package != nil {
+		packageID, err = getPackageIDFromInput(c, *subject.Package, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion})
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if subject.Artifact != nil {
+		a, err := c.artifactByKey(subject.Artifact.Algorithm, subject.Artifact.Digest)
+		if err != nil {
+			return "", "", backends.NotFoundf("IngestSeverityOverride :: Artifact not found")
+		}
+		artifactID = a.id
+	}
+	return packageID, artifactID, nil
+}
+
+func (c *demoClient) vulnerabilitySeverityOverrides(osvID, cveID, ghsaID string) []string {
+	if osvID != "" {
+		return c.index[osvID].(*osvIDNode).getSeverityOverrideLink()
+	}
+	if cveID != "" {
+		return c.index[cveID].(*cveIDNode).getSeverityOverrideLink()
+	}
+	if ghsaID != "" {
+		return c.index[ghsaID].(*ghsaIDNode).getSeverityOverrideLink()
+	}
+	return nil
+}
+
+func (c *demoClient) severityOverrideByID(id string) (*severityOverrideLink, error) {
+	node, ok := c.index[id]
+	if !ok {
+		return nil, backends.NotFoundf("could not find severityOverrideLink")
+	}
+	link, ok := node.(*severityOverrideLink)
+	if !ok {
+		return nil, backends.InvalidInputf("not a severityOverrideLink")
+	}
+	return link, nil
+}
@@ -0,0 +1,365 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var c1 = &model.CVEInputSpec{
+	Year:  2019,
+	CveID: "CVE-2019-13110",
+}
+
+func TestEffectiveSeverity(t *testing.T) {
+	type call struct {
+		Subject  *model.PackageOrArtifactInput
+		Override model.SeverityOverrideInputSpec
+	}
+	created := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	later := created.Add(24 * time.Hour)
+	now := created.Add(30 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	tests := []struct {
+		Name         string
+		Calls        []call
+		Subject      *model.PackageOrArtifactInput
+		ScannerScore float64
+		ExpScore     float64
+		ExpBucket    model.SeverityBucket
+		ExpSource    model.SeveritySource
+	}{
+		{
+			Name:         "No overrides uses scanner score",
+			ScannerScore: 9.8,
+			ExpScore:     9.8,
+			ExpBucket:    model.SeverityBucketCritical,
+			ExpSource:    model.SeveritySourceScanner,
+		},
+		{
+			Name: "Global override beats scanner",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			}},
+			Subject:      &model.PackageOrArtifactInput{Package: p2},
+			ScannerScore: 9.8,
+			ExpScore:     5,
+			ExpBucket:    model.SeverityBucketMedium,
+			ExpSource:    model.SeveritySourceGlobalOverride,
+		},
+		{
+			Name: "Scoped override beats global override",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			}, {
+				Subject:  &model.PackageOrArtifactInput{Package: p2},
+				Override: model.SeverityOverrideInputSpec{Score: 7.5, ScoreType: "CVSSv3", CreatedAt: created},
+			}},
+			Subject:      &model.PackageOrArtifactInput{Package: p2},
+			ScannerScore: 9.8,
+			ExpScore:     7.5,
+			ExpBucket:    model.SeverityBucketHigh,
+			ExpSource:    model.SeveritySourceScopedOverride,
+		},
+		{
+			Name: "Scoped override on other subject is ignored",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			}, {
+				Subject:  &model.PackageOrArtifactInput{Artifact: a1},
+				Override: model.SeverityOverrideInputSpec{Score: 2, ScoreType: "CVSSv3", CreatedAt: created},
+			}},
+			Subject:      &model.PackageOrArtifactInput{Package: p2},
+			ScannerScore: 9.8,
+			ExpScore:     5,
+			ExpBucket:    model.SeverityBucketMedium,
+			ExpSource:    model.SeveritySourceGlobalOverride,
+		},
+		{
+			Name: "Scoped override on artifact",
+			Calls: []call{{
+				Subject:  &model.PackageOrArtifactInput{Artifact: a1},
+				Override: model.SeverityOverrideInputSpec{Score: 2, ScoreType: "CVSSv3", CreatedAt: created},
+			}},
+			Subject:      &model.PackageOrArtifactInput{Artifact: a1},
+			ScannerScore: 9.8,
+			ExpScore:     2,
+			ExpBucket:    model.SeverityBucketLow,
+			ExpSource:    model.SeveritySourceScopedOverride,
+		},
+		{
+			Name: "Most recent global override wins",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", Reviewer: "a", CreatedAt: created},
+			}, {
+				Override: model.SeverityOverrideInputSpec{Score: 3, ScoreType: "CVSSv3", Reviewer: "b", CreatedAt: later},
+			}},
+			ScannerScore: 9.8,
+			ExpScore:     3,
+			ExpBucket:    model.SeverityBucketLow,
+			ExpSource:    model.SeveritySourceGlobalOverride,
+		},
+		{
+			Name: "Retracted scoped override falls back to global",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			}, {
+				Subject:  &model.PackageOrArtifactInput{Package: p2},
+				Override: model.SeverityOverrideInputSpec{Score: 7.5, ScoreType: "CVSSv3", CreatedAt: created, Retracted: true},
+			}},
+			Subject:      &model.PackageOrArtifactInput{Package: p2},
+			ScannerScore: 9.8,
+			ExpScore:     5,
+			ExpBucket:    model.SeverityBucketMedium,
+			ExpSource:    model.SeveritySourceGlobalOverride,
+		},
+		{
+			Name: "Retraction by re-ingesting falls back to scanner",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			}, {
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created, Retracted: true},
+			}},
+			ScannerScore: 9.8,
+			ExpScore:     9.8,
+			ExpBucket:    model.SeverityBucketCritical,
+			ExpSource:    model.SeveritySourceScanner,
+		},
+		{
+			Name: "Expired override falls back to scanner",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created, ExpiresAt: &past},
+			}},
+			ScannerScore: 9.8,
+			ExpScore:     9.8,
+			ExpBucket:    model.SeverityBucketCritical,
+			ExpSource:    model.SeveritySourceScanner,
+		},
+		{
+			Name: "Unexpired override applies",
+			Calls: []call{{
+				Override: model.SeverityOverrideInputSpec{Score: 0, ScoreType: "CVSSv3", CreatedAt: created, ExpiresAt: &future},
+			}},
+			ScannerScore: 9.8,
+			ExpScore:     0,
+			ExpBucket:    model.SeverityBucketNone,
+			ExpSource:    model.SeveritySourceGlobalOverride,
+		},
+	}
+	ctx := context.Background()
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetBackend(&inmem.DemoCredentials{Clock: func() time.Time { return now }})
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			if _, err := b.IngestPackage(ctx, *p2); err != nil {
+				t.Fatalf("Could not ingest package: %v", err)
+			}
+			if _, err := b.IngestArtifact(ctx, a1); err != nil {
+				t.Fatalf("Could not ingest artifact: %v", err)
+			}
+			for _, o := range test.Calls {
+				if _, err := b.IngestSeverityOverride(ctx, vuln, o.Subject, o.Override); err != nil {
+					t.Fatalf("Could not ingest severity override: %v", err)
+				}
+			}
+			got, err := b.EffectiveSeverity(ctx, vuln, test.Subject, test.ScannerScore)
+			if err != nil {
+				t.Fatalf("Could not compute effective severity: %v", err)
+			}
+			if got.Score != test.ExpScore {
+				t.Errorf("Unexpected score, want: %v, got: %v", test.ExpScore, got.Score)
+			}
+			if got.Bucket != test.ExpBucket {
+				t.Errorf("Unexpected bucket, want: %v, got: %v", test.ExpBucket, got.Bucket)
+			}
+			if got.Source != test.ExpSource {
+				t.Errorf("Unexpected source, want: %v, got: %v", test.ExpSource, got.Source)
+			}
+			if (got.Override == nil) != (test.ExpSource == model.SeveritySourceScanner) {
+				t.Errorf("Unexpected override: %+v", got.Override)
+			}
+		})
+	}
+}
+
+func TestCertifyVulnEffectiveSeverity(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+	b, err := inmem.GetBackend(&inmem.DemoCredentials{Clock: func() time.Time { return now }})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+	if _, err := b.IngestVulnerability(ctx, *p2, vuln, model.VulnerabilityMetaDataInput{TimeScanned: created, ScannerScore: ptrfrom.Float64(9.8)}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p4, vuln, model.VulnerabilityMetaDataInput{TimeScanned: created}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	expires := created.Add(2 * time.Hour)
+
+	tests := []struct {
+		Name       string
+		Override   *model.SeverityOverrideInputSpec
+		Subject    *model.PackageOrArtifactInput
+		Now        time.Time
+		ExpBuckets map[string]model.SeverityBucket
+		ExpSources map[string]model.SeveritySource
+	}{
+		{
+			Name:       "Scanner score, unscored vulnerability has none",
+			Now:        now,
+			ExpBuckets: map[string]model.SeverityBucket{"tensorflow": model.SeverityBucketCritical},
+			ExpSources: map[string]model.SeveritySource{"tensorflow": model.SeveritySourceScanner},
+		},
+		{
+			Name:       "Global override applies to both packages",
+			Override:   &model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", CreatedAt: created},
+			Now:        now,
+			ExpBuckets: map[string]model.SeverityBucket{"tensorflow": model.SeverityBucketMedium, "openssl": model.SeverityBucketMedium},
+			ExpSources: map[string]model.SeveritySource{"tensorflow": model.SeveritySourceGlobalOverride, "openssl": model.SeveritySourceGlobalOverride},
+		},
+		{
+			Name:       "Scoped override beats global override",
+			Override:   &model.SeverityOverrideInputSpec{Score: 7.5, ScoreType: "CVSSv3", CreatedAt: created, ExpiresAt: &expires},
+			Subject:    &model.PackageOrArtifactInput{Package: p2},
+			Now:        now,
+			ExpBuckets: map[string]model.SeverityBucket{"tensorflow": model.SeverityBucketHigh, "openssl": model.SeverityBucketMedium},
+			ExpSources: map[string]model.SeveritySource{"tensorflow": model.SeveritySourceScopedOverride, "openssl": model.SeveritySourceGlobalOverride},
+		},
+		{
+			Name:       "Expired scoped override falls back to global override",
+			Now:        expires,
+			ExpBuckets: map[string]model.SeverityBucket{"tensorflow": model.SeverityBucketMedium, "openssl": model.SeverityBucketMedium},
+			ExpSources: map[string]model.SeveritySource{"tensorflow": model.SeveritySourceGlobalOverride, "openssl": model.SeveritySourceGlobalOverride},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			now = test.Now
+			if test.Override != nil {
+				if _, err := b.IngestSeverityOverride(ctx, vuln, test.Subject, *test.Override); err != nil {
+					t.Fatalf("Could not ingest severity override: %v", err)
+				}
+			}
+			got, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != 2 {
+				t.Fatalf("Unexpected number of results, want: 2, got: %d", len(got))
+			}
+			for _, v := range got {
+				name := v.Package.Namespaces[0].Names[0].Name
+				severity := v.EffectiveSeverity
+				expBucket, ok := test.ExpBuckets[name]
+				if !ok {
+					if severity != nil {
+						t.Errorf("Unexpected effective severity for %s: %+v", name, severity)
+					}
+					continue
+				}
+				if severity == nil {
+					t.Fatalf("Missing effective severity for %s", name)
+				}
+				if severity.Bucket != expBucket {
+					t.Errorf("Unexpected bucket for %s, want: %v, got: %v", name, expBucket, severity.Bucket)
+				}
+				if severity.Source != test.ExpSources[name] {
+					t.Errorf("Unexpected source for %s, want: %v, got: %v", name, test.ExpSources[name], severity.Source)
+				}
+			}
+		})
+	}
+}
+
+func TestSeverityOverride(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	vuln := model.OsvCveOrGhsaInput{Cve: c1}
+	created := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := b.IngestSeverityOverride(ctx, vuln, nil, model.SeverityOverrideInputSpec{Score: 5, ScoreType: "CVSSv3", Reviewer: "appsec", CreatedAt: created}); err != nil {
+		t.Fatalf("Could not ingest severity override: %v", err)
+	}
+	if _, err := b.IngestSeverityOverride(ctx, vuln, &model.PackageOrArtifactInput{Package: p2}, model.SeverityOverrideInputSpec{Score: 7.5, ScoreType: "CVSSv3", Reviewer: "appsec", CreatedAt: created}); err != nil {
+		t.Fatalf("Could not ingest severity override: %v", err)
+	}
+
+	tests := []struct {
+		Name     string
+		Query    *model.SeverityOverrideSpec
+		ExpCount int
+	}{
+		{
+			Name:     "All",
+			Query:    &model.SeverityOverrideSpec{},
+			ExpCount: 2,
+		},
+		{
+			Name:     "Reviewer",
+			Query:    &model.SeverityOverrideSpec{Reviewer: ptrfrom.String("appsec")},
+			ExpCount: 2,
+		},
+		{
+			Name:     "Package subject",
+			Query:    &model.SeverityOverrideSpec{Subject: &model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow")}}},
+			ExpCount: 1,
+		},
+		{
+			Name:     "Artifact subject",
+			Query:    &model.SeverityOverrideSpec{Subject: &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{}}},
+			ExpCount: 0,
+		},
+		{
+			Name:     "Vulnerability",
+			Query:    &model.SeverityOverrideSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Cve: &model.CVESpec{CveID: ptrfrom.String("CVE-2019-13110")}}},
+			ExpCount: 2,
+		},
+		{
+			Name:     "Other vulnerability type",
+			Query:    &model.SeverityOverrideSpec{Vulnerability: &model.OsvCveOrGhsaSpec{Ghsa: &model.GHSASpec{}}},
+			ExpCount: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := b.SeverityOverride(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != test.ExpCount {
+				t.Errorf("Unexpected number of results, want: %d, got: %d", test.ExpCount, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,1412 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"golang.org/x/exp/maps"
+)
+
+// snapshotVersion is the version of the format written by Export. Import
+// rejects snapshots of any other version.
+const snapshotVersion = 2
+
+// snapshot is the JSON representation of the state of a namespace.
+//
+// The nodes of the index are listed by type, each list in ID order, along
+// with the ID of their parent and their backlinks, so that the software trees,
+// the index and the lists of links are rebuilt exactly as they were. The
+// evidence stored without an ID is listed in ingestion order, and the change
+// log and the conflicts refer to it by its position in Evidence.
+type snapshot struct {
+	Version int `json:"version"`
+	// LastID is the last ID handed out when the snapshot was taken
+	LastID string `json:"lastID"`
+
+	PkgTypes      []*snapshotRoot       `json:"pkgTypes"`
+	PkgNamespaces []*snapshotNamespace  `json:"pkgNamespaces"`
+	PkgNames      []*snapshotPkgName    `json:"pkgNames"`
+	PkgVersions   []*snapshotPkgVersion `json:"pkgVersions"`
+	SrcTypes      []*snapshotRoot       `json:"srcTypes"`
+	SrcNamespaces []*snapshotNamespace  `json:"srcNamespaces"`
+	SrcNames      []*snapshotSrcName    `json:"srcNames"`
+	Artifacts     []*snapshotArtifact   `json:"artifacts"`
+	Builders      []*snapshotBuilder    `json:"builders"`
+	OsvTypes      []*snapshotRoot       `json:"osvTypes"`
+	OsvIDs        []*snapshotVulnID     `json:"osvIDs"`
+	CveYears      []*snapshotCveYear    `json:"cveYears"`
+	CveIDs        []*snapshotVulnID     `json:"cveIDs"`
+	GhsaTypes     []*snapshotRoot       `json:"ghsaTypes"`
+	GhsaIDs       []*snapshotVulnID     `json:"ghsaIDs"`
+	NoVuln        *snapshotNoVuln       `json:"noVuln,omitempty"`
+
+	HasSourceAts      []*snapshotHasSourceAt      `json:"hasSourceAts"`
+	IsDependencies    []*snapshotIsDependency     `json:"isDependencies"`
+	Scorecards        []*snapshotScorecard        `json:"scorecards"`
+	HashEquals        []*snapshotHashEqual        `json:"hashEquals"`
+	Occurrences       []*snapshotIsOccurrence     `json:"occurrences"`
+	CertifyVulns      []*snapshotCertifyVuln      `json:"certifyVulns"`
+	IsVulnerabilities []*snapshotIsVulnerability  `json:"isVulnerabilities"`
+	HasSLSAs          []*snapshotHasSLSA          `json:"hasSLSAs"`
+	SeverityOverrides []*snapshotSeverityOverride `json:"severityOverrides"`
+	CertifySigneds    []*snapshotCertifySigned    `json:"certifySigneds"`
+	SupersededBys     []*snapshotSupersededBy     `json:"supersededBys"`
+
+	Evidence  []*snapshotEvidence `json:"evidence"`
+	Changes   snapshotChanges     `json:"changes"`
+	Conflicts snapshotConflicts   `json:"conflicts"`
+}
+
+// Software tree nodes
+
+// snapshotRoot is the root of a software tree: a package or source type, or
+// the root of the OSV or GHSA IDs.
+type snapshotRoot struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+type snapshotNamespace struct {
+	ID        string `json:"id"`
+	Parent    string `json:"parent"`
+	Namespace string `json:"namespace"`
+}
+
+type snapshotPkgName struct {
+	ID               string   `json:"id"`
+	Parent           string   `json:"parent"`
+	Name             string   `json:"name"`
+	SrcMapLink       []string `json:"srcMapLink"`
+	IsDependencyLink []string `json:"isDependencyLink"`
+	SupersededByLink []string `json:"supersededByLink"`
+}
+
+type snapshotPkgVersion struct {
+	ID                   string            `json:"id"`
+	Parent               string            `json:"parent"`
+	Version              string            `json:"version"`
+	Subpath              string            `json:"subpath"`
+	Qualifiers           map[string]string `json:"qualifiers"`
+	SrcMapLink           []string          `json:"srcMapLink"`
+	IsDependencyLink     []string          `json:"isDependencyLink"`
+	Occurrences          []string          `json:"occurrences"`
+	CertifyVulnLink      []string          `json:"certifyVulnLink"`
+	SeverityOverrideLink []string          `json:"severityOverrideLink"`
+	SupersededByLink     []string          `json:"supersededByLink"`
+}
+
+type snapshotSrcName struct {
+	ID            string   `json:"id"`
+	Parent        string   `json:"parent"`
+	Name          string   `json:"name"`
+	Tag           string   `json:"tag"`
+	Commit        string   `json:"commit"`
+	SrcMapLink    []string `json:"srcMapLink"`
+	ScorecardLink []string `json:"scorecardLink"`
+	Occurrences   []string `json:"occurrences"`
+}
+
+type snapshotArtifact struct {
+	ID          string   `json:"id"`
+	Algorithm   string   `json:"algorithm"`
+	Digest      string   `json:"digest"`
+	HashEquals  []string `json:"hashEquals"`
+	Occurrences []string `json:"occurrences"`
+	HasSLSAs    []string `json:"hasSLSAs"`
+	Overrides   []string `json:"overrides"`
+	Signatures  []string `json:"signatures"`
+}
+
+type snapshotBuilder struct {
+	ID       string   `json:"id"`
+	URI      string   `json:"uri"`
+	HasSLSAs []string `json:"hasSLSAs"`
+}
+
+type snapshotCveYear struct {
+	ID   string `json:"id"`
+	Year int    `json:"year"`
+}
+
+// snapshotVulnID is an OSV, CVE or GHSA ID.
+type snapshotVulnID struct {
+	ID                   string   `json:"id"`
+	Parent               string   `json:"parent"`
+	VulnID               string   `json:"vulnID"`
+	CertifyVulnLink      []string `json:"certifyVulnLink"`
+	EqualVulnLink        []string `json:"equalVulnLink"`
+	SeverityOverrideLink []string `json:"severityOverrideLink"`
+}
+
+// snapshotNoVuln is the noVuln node, if any CertifyVuln uses it.
+type snapshotNoVuln struct {
+	ID              string   `json:"id"`
+	CertifyVulnLink []string `json:"certifyVulnLink"`
+}
+
+// Links
+
+type snapshotHasSourceAt struct {
+	ID            string    `json:"id"`
+	SourceID      string    `json:"sourceID"`
+	PackageID     string    `json:"packageID"`
+	KnownSince    time.Time `json:"knownSince"`
+	Justification string    `json:"justification"`
+	Origin        string    `json:"origin"`
+	Collector     string    `json:"collector"`
+}
+
+type snapshotIsDependency struct {
+	ID            string `json:"id"`
+	PackageID     string `json:"packageID"`
+	DepPackageID  string `json:"depPackageID"`
+	VersionRange  string `json:"versionRange"`
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+type snapshotScorecard struct {
+	ID               string         `json:"id"`
+	SourceID         string         `json:"sourceID"`
+	TimeScanned      time.Time      `json:"timeScanned"`
+	AggregateScore   float64        `json:"aggregateScore"`
+	Checks           map[string]int `json:"checks"`
+	ScorecardVersion string         `json:"scorecardVersion"`
+	ScorecardCommit  string         `json:"scorecardCommit"`
+	Origin           string         `json:"origin"`
+	Collector        string         `json:"collector"`
+}
+
+type snapshotHashEqual struct {
+	ID            string   `json:"id"`
+	Artifacts     []string `json:"artifacts"`
+	Justification string   `json:"justification"`
+	Origin        string   `json:"origin"`
+	Collector     string   `json:"collector"`
+}
+
+type snapshotIsOccurrence struct {
+	ID            string    `json:"id"`
+	Package       string    `json:"package"`
+	Source        string    `json:"source"`
+	Artifact      string    `json:"artifact"`
+	Justification string    `json:"justification"`
+	Origin        string    `json:"origin"`
+	Collector     string    `json:"collector"`
+	IngestedAt    time.Time `json:"ingestedAt"`
+}
+
+type snapshotCertifyVuln struct {
+	ID             string    `json:"id"`
+	PackageID      string    `json:"packageID"`
+	OsvID          string    `json:"osvID"`
+	CveID          string    `json:"cveID"`
+	GhsaID         string    `json:"ghsaID"`
+	NoVulnID       string    `json:"noVulnID"`
+	TimeScanned    time.Time `json:"timeScanned"`
+	DbURI          string    `json:"dbURI"`
+	DbVersion      string    `json:"dbVersion"`
+	ScannerURI     string    `json:"scannerURI"`
+	ScannerVersion string    `json:"scannerVersion"`
+	Origin         string    `json:"origin"`
+	Collector      string    `json:"collector"`
+	ScannerScore   *float64  `json:"scannerScore"`
+}
+
+type snapshotIsVulnerability struct {
+	ID            string `json:"id"`
+	OsvID         string `json:"osvID"`
+	CveID         string `json:"cveID"`
+	GhsaID        string `json:"ghsaID"`
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+type snapshotHasSLSA struct {
+	ID         string                 `json:"id"`
+	Subject    string                 `json:"subject"`
+	BuiltFrom  []string               `json:"builtFrom"`
+	BuiltBy    string                 `json:"builtBy"`
+	BuildType  string                 `json:"buildType"`
+	Predicates []*model.SLSAPredicate `json:"predicates"`
+	Version    string                 `json:"version"`
+	Start      time.Time              `json:"start"`
+	Finish     time.Time              `json:"finish"`
+	Origin     string                 `json:"origin"`
+	Collector  string                 `json:"collector"`
+	DocHash    string                 `json:"docHash"`
+	IngestedAt time.Time              `json:"ingestedAt"`
+}
+
+type snapshotSeverityOverride struct {
+	ID            string     `json:"id"`
+	OsvID         string     `json:"osvID"`
+	CveID         string     `json:"cveID"`
+	GhsaID        string     `json:"ghsaID"`
+	PackageID     string     `json:"packageID"`
+	ArtifactID    string     `json:"artifactID"`
+	Score         float64    `json:"score"`
+	ScoreType     string     `json:"scoreType"`
+	Reviewer      string     `json:"reviewer"`
+	Justification string     `json:"justification"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExpiresAt     *time.Time `json:"expiresAt"`
+	Retracted     bool       `json:"retracted"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+}
+
+type snapshotCertifySigned struct {
+	ID            string                `json:"id"`
+	Artifact      string                `json:"artifact"`
+	Signer        string                `json:"signer"`
+	SignatureType model.SignatureType   `json:"signatureType"`
+	Status        model.SignatureStatus `json:"status"`
+	VerifiedAt    time.Time             `json:"verifiedAt"`
+	TrustRoot     string                `json:"trustRoot"`
+	Origin        string                `json:"origin"`
+	Collector     string                `json:"collector"`
+}
+
+type snapshotSupersededBy struct {
+	ID          string    `json:"id"`
+	PackageID   string    `json:"packageID"`
+	SuccessorID string    `json:"successorID"`
+	Reason      string    `json:"reason"`
+	Since       time.Time `json:"since"`
+	Origin      string    `json:"origin"`
+	Collector   string    `json:"collector"`
+}
+
+// Evidence stored without an ID
+
+// snapshotEvidence is a piece of evidence stored without an ID. Exactly one of
+// the values is set.
+type snapshotEvidence struct {
+	HasSBOM             *snapshotHasSBOM      `json:"hasSBOM,omitempty"`
+	CertifyPkg          *model.CertifyPkg     `json:"certifyPkg,omitempty"`
+	CertifyBad          *snapshotCertifyBad   `json:"certifyBad,omitempty"`
+	CertifyGood         *snapshotCertifyGood  `json:"certifyGood,omitempty"`
+	CertifyVEXStatement *snapshotVEXStatement `json:"certifyVEXStatement,omitempty"`
+}
+
+// The model types are encoded as they are, except for their union fields,
+// which are shadowed by a snapshotUnion.
+
+type snapshotHasSBOM struct {
+	model.HasSbom
+	Subject snapshotUnion `json:"subject"`
+}
+
+type snapshotCertifyBad struct {
+	model.CertifyBad
+	Subject snapshotUnion `json:"subject"`
+}
+
+type snapshotCertifyGood struct {
+	model.CertifyGood
+	Subject snapshotUnion `json:"subject"`
+}
+
+type snapshotVEXStatement struct {
+	model.CertifyVEXStatement
+	Subject       snapshotUnion `json:"subject"`
+	Vulnerability snapshotUnion `json:"vulnerability"`
+}
+
+// snapshotUnion holds the value of a union of model types, as JSON can't be
+// decoded into an interface. Exactly one of the values is set.
+type snapshotUnion struct {
+	Package  *model.Package  `json:"package,omitempty"`
+	Source   *model.Source   `json:"source,omitempty"`
+	Artifact *model.Artifact `json:"artifact,omitempty"`
+	Cve      *model.Cve      `json:"cve,omitempty"`
+	Ghsa     *model.Ghsa     `json:"ghsa,omitempty"`
+}
+
+func newSnapshotUnion(value any) snapshotUnion {
+	switch v := value.(type) {
+	case *model.Package:
+		return snapshotUnion{Package: v}
+	case *model.Source:
+		return snapshotUnion{Source: v}
+	case *model.Artifact:
+		return snapshotUnion{Artifact: v}
+	case *model.Cve:
+		return snapshotUnion{Cve: v}
+	case *model.Ghsa:
+		return snapshotUnion{Ghsa: v}
+	}
+	return snapshotUnion{}
+}
+
+// unionValue returns the value held by u as the union T.
+func unionValue[T any](u snapshotUnion) (T, error) {
+	var value any
+	switch {
+	case u.Package != nil:
+		value = u.Package
+	case u.Source != nil:
+		value = u.Source
+	case u.Artifact != nil:
+		value = u.Artifact
+	case u.Cve != nil:
+		value = u.Cve
+	case u.Ghsa != nil:
+		value = u.Ghsa
+	}
+	v, ok := value.(T)
+	if !ok {
+		return v, fmt.Errorf("unexpected union value %T", value)
+	}
+	return v, nil
+}
+
+// Change log and conflicts
+
+type snapshotChanges struct {
+	Seq     uint64            `json:"seq"`
+	Dropped uint64            `json:"dropped"`
+	Entries []*snapshotChange `json:"entries"`
+}
+
+// snapshotChange is an entry of the change log. The evidence stored without
+// an ID is dropped from entries that were retracted.
+type snapshotChange struct {
+	Seq       uint64         `json:"seq"`
+	ID        string         `json:"id,omitempty"`
+	NodeType  model.NodeType `json:"nodeType"`
+	Evidence  *int           `json:"evidence,omitempty"`
+	Retracted bool           `json:"retracted,omitempty"`
+}
+
+type snapshotConflicts struct {
+	Certifications map[string][]int        `json:"certifications"`
+	VEXStatements  []*snapshotVEXKey       `json:"vexStatements"`
+	List           []*snapshotConflictLink `json:"list"`
+}
+
+type snapshotVEXKey struct {
+	PackageID       string `json:"packageID"`
+	VulnerabilityID string `json:"vulnerabilityID"`
+	Evidence        []int  `json:"evidence"`
+}
+
+type snapshotConflictLink struct {
+	Pattern    model.ConflictPattern `json:"pattern"`
+	First      snapshotConflictSide  `json:"first"`
+	Second     snapshotConflictSide  `json:"second"`
+	DetectedAt time.Time             `json:"detectedAt"`
+}
+
+type snapshotConflictSide struct {
+	ID       string `json:"id,omitempty"`
+	Evidence *int   `json:"evidence,omitempty"`
+}
+
+// Export
+
+// Export writes the state of the client to w, as a versioned JSON snapshot
+// that Import restores.
+func (c *demoClient) Export(w io.Writer) error {
+	s := &snapshot{Version: snapshotVersion, LastID: c.ids.lastID()}
+	for _, id := range sortedIDs(maps.Keys(c.index)) {
+		switch n := c.index[id].(type) {
+		case *pkgNamespaceStruct:
+			s.PkgTypes = append(s.PkgTypes, &snapshotRoot{ID: n.id, Key: n.typeKey})
+		case *pkgNameStruct:
+			s.PkgNamespaces = append(s.PkgNamespaces, &snapshotNamespace{ID: n.id, Parent: n.parent, Namespace: n.namespace})
+		case *pkgVersionStruct:
+			s.PkgNames = append(s.PkgNames, &snapshotPkgName{
+				ID:               n.id,
+				Parent:           n.parent,
+				Name:             n.name,
+				SrcMapLink:       n.srcMapLink,
+				IsDependencyLink: n.isDependencyLink,
+				SupersededByLink: n.supersededByLink,
+			})
+		case *pkgVersionNode:
+			s.PkgVersions = append(s.PkgVersions, &snapshotPkgVersion{
+				ID:                   n.id,
+				Parent:               n.parent,
+				Version:              n.version,
+				Subpath:              n.subpath,
+				Qualifiers:           n.qualifiers,
+				SrcMapLink:           n.srcMapLink,
+				IsDependencyLink:     n.isDependencyLink,
+				Occurrences:          n.occurrences,
+				CertifyVulnLink:      n.certifyVulnLink,
+				SeverityOverrideLink: n.severityOverrideLink,
+				SupersededByLink:     n.supersededByLink,
+			})
+		case *srcNamespaceStruct:
+			s.SrcTypes = append(s.SrcTypes, &snapshotRoot{ID: n.id, Key: n.typeKey})
+		case *srcNameStruct:
+			s.SrcNamespaces = append(s.SrcNamespaces, &snapshotNamespace{ID: n.id, Parent: n.parent, Namespace: n.namespace})
+		case *srcNameNode:
+			s.SrcNames = append(s.SrcNames, &snapshotSrcName{
+				ID:            n.id,
+				Parent:        n.parent,
+				Name:          n.name,
+				Tag:           n.tag,
+				Commit:        n.commit,
+				SrcMapLink:    n.srcMapLink,
+				ScorecardLink: n.scorecardLink,
+				Occurrences:   n.occurrences,
+			})
+		case *artStruct:
+			s.Artifacts = append(s.Artifacts, &snapshotArtifact{
+				ID:          n.id,
+				Algorithm:   n.algorithm,
+				Digest:      n.digest,
+				HashEquals:  n.hashEquals,
+				Occurrences: n.occurrences,
+				HasSLSAs:    n.hasSLSAs,
+				Overrides:   n.overrides,
+				Signatures:  n.signatures,
+			})
+		case *builderStruct:
+			s.Builders = append(s.Builders, &snapshotBuilder{ID: n.id, URI: n.uri, HasSLSAs: n.hasSLSAs})
+		case *osvNode:
+			s.OsvTypes = append(s.OsvTypes, &snapshotRoot{ID: n.id, Key: n.typeKey})
+		case *osvIDNode:
+			s.OsvIDs = append(s.OsvIDs, &snapshotVulnID{
+				ID:                   n.id,
+				Parent:               n.parent,
+				VulnID:               n.osvID,
+				CertifyVulnLink:      n.certifyVulnLink,
+				EqualVulnLink:        n.equalVulnLink,
+				SeverityOverrideLink: n.severityOverrideLink,
+			})
+		case *cveNode:
+			s.CveYears = append(s.CveYears, &snapshotCveYear{ID: n.id, Year: n.year})
+		case *cveIDNode:
+			s.CveIDs = append(s.CveIDs, &snapshotVulnID{
+				ID:                   n.id,
+				Parent:               n.parent,
+				VulnID:               n.cveID,
+				CertifyVulnLink:      n.certifyVulnLink,
+				EqualVulnLink:        n.equalVulnLink,
+				SeverityOverrideLink: n.severityOverrideLink,
+			})
+		case *ghsaNode:
+			s.GhsaTypes = append(s.GhsaTypes, &snapshotRoot{ID: n.id, Key: n.typeKey})
+		case *ghsaIDNode:
+			s.GhsaIDs = append(s.GhsaIDs, &snapshotVulnID{
+				ID:                   n.id,
+				Parent:               n.parent,
+				VulnID:               n.ghsaID,
+				CertifyVulnLink:      n.certifyVulnLink,
+				EqualVulnLink:        n.equalVulnLink,
+				SeverityOverrideLink: n.severityOverrideLink,
+			})
+		case *noVulnNode:
+			s.NoVuln = &snapshotNoVuln{ID: n.id, CertifyVulnLink: n.certifyVulnLink}
+		case *srcMapLink:
+			s.HasSourceAts = append(s.HasSourceAts, &snapshotHasSourceAt{
+				ID:            n.id,
+				SourceID:      n.sourceID,
+				PackageID:     n.packageID,
+				KnownSince:    n.knownSince,
+				Justification: n.justification,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *isDependencyLink:
+			s.IsDependencies = append(s.IsDependencies, &snapshotIsDependency{
+				ID:            n.id,
+				PackageID:     n.packageID,
+				DepPackageID:  n.depPackageID,
+				VersionRange:  n.versionRange,
+				Justification: n.justification,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *scorecardLink:
+			s.Scorecards = append(s.Scorecards, &snapshotScorecard{
+				ID:               n.id,
+				SourceID:         n.sourceID,
+				TimeScanned:      n.timeScanned,
+				AggregateScore:   n.aggregateScore,
+				Checks:           n.checks,
+				ScorecardVersion: n.scorecardVersion,
+				ScorecardCommit:  n.scorecardCommit,
+				Origin:           n.origin,
+				Collector:        n.collector,
+			})
+		case *hashEqualStruct:
+			s.HashEquals = append(s.HashEquals, &snapshotHashEqual{
+				ID:            n.id,
+				Artifacts:     n.artifacts,
+				Justification: n.justification,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *isOccurrenceStruct:
+			s.Occurrences = append(s.Occurrences, &snapshotIsOccurrence{
+				ID:            n.id,
+				Package:       n.pkg,
+				Source:        n.source,
+				Artifact:      n.artifact,
+				Justification: n.justification,
+				Origin:        n.origin,
+				Collector:     n.collector,
+				IngestedAt:    n.ingestedAt,
+			})
+		case *vulnerabilityLink:
+			s.CertifyVulns = append(s.CertifyVulns, &snapshotCertifyVuln{
+				ID:             n.id,
+				PackageID:      n.packageID,
+				OsvID:          n.osvID,
+				CveID:          n.cveID,
+				GhsaID:         n.ghsaID,
+				NoVulnID:       n.noVulnID,
+				TimeScanned:    n.timeScanned,
+				DbURI:          n.dbURI,
+				DbVersion:      n.dbVersion,
+				ScannerURI:     n.scannerURI,
+				ScannerVersion: n.scannerVersion,
+				Origin:         n.origin,
+				Collector:      n.collector,
+				ScannerScore:   n.scannerScore,
+			})
+		case *equalVulnerabilityLink:
+			s.IsVulnerabilities = append(s.IsVulnerabilities, &snapshotIsVulnerability{
+				ID:            n.id,
+				OsvID:         n.osvID,
+				CveID:         n.cveID,
+				GhsaID:        n.ghsaID,
+				Justification: n.justification,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *hasSLSAStruct:
+			s.HasSLSAs = append(s.HasSLSAs, &snapshotHasSLSA{
+				ID:         n.id,
+				Subject:    n.subject,
+				BuiltFrom:  n.builtFrom,
+				BuiltBy:    n.builtBy,
+				BuildType:  n.buildType,
+				Predicates: n.predicates,
+				Version:    n.version,
+				Start:      n.start,
+				Finish:     n.finish,
+				Origin:     n.origin,
+				Collector:  n.collector,
+				DocHash:    n.docHash,
+				IngestedAt: n.ingestedAt,
+			})
+		case *severityOverrideLink:
+			s.SeverityOverrides = append(s.SeverityOverrides, &snapshotSeverityOverride{
+				ID:            n.id,
+				OsvID:         n.osvID,
+				CveID:         n.cveID,
+				GhsaID:        n.ghsaID,
+				PackageID:     n.packageID,
+				ArtifactID:    n.artifactID,
+				Score:         n.score,
+				ScoreType:     n.scoreType,
+				Reviewer:      n.reviewer,
+				Justification: n.justification,
+				CreatedAt:     n.createdAt,
+				ExpiresAt:     n.expiresAt,
+				Retracted:     n.retracted,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *certifySignedStruct:
+			s.CertifySigneds = append(s.CertifySigneds, &snapshotCertifySigned{
+				ID:            n.id,
+				Artifact:      n.artifact,
+				Signer:        n.signer,
+				SignatureType: n.signatureType,
+				Status:        n.status,
+				VerifiedAt:    n.verifiedAt,
+				TrustRoot:     n.trustRoot,
+				Origin:        n.origin,
+				Collector:     n.collector,
+			})
+		case *supersededByLink:
+			s.SupersededBys = append(s.SupersededBys, &snapshotSupersededBy{
+				ID:          n.id,
+				PackageID:   n.packageID,
+				SuccessorID: n.successorID,
+				Reason:      n.reason,
+				Since:       n.since,
+				Origin:      n.origin,
+				Collector:   n.collector,
+			})
+		default:
+			return backends.Internalf("export :: unexpected node type %T for ID %s", n, id)
+		}
+	}
+
+	// positions of the evidence stored without an ID
+	evidence := map[any]int{}
+	addEvidence := func(node any, e *snapshotEvidence) {
+		evidence[node] = len(s.Evidence)
+		s.Evidence = append(s.Evidence, e)
+	}
+	for _, v := range c.hasSBOM {
+		addEvidence(v, &snapshotEvidence{HasSBOM: &snapshotHasSBOM{HasSbom: *v, Subject: newSnapshotUnion(v.Subject)}})
+	}
+	for _, v := range c.certifyPkg {
+		addEvidence(v, &snapshotEvidence{CertifyPkg: v})
+	}
+	for _, v := range c.certifyBad {
+		addEvidence(v, &snapshotEvidence{CertifyBad: &snapshotCertifyBad{CertifyBad: *v, Subject: newSnapshotUnion(v.Subject)}})
+	}
+	for _, v := range c.certifyGood {
+		addEvidence(v, &snapshotEvidence{CertifyGood: &snapshotCertifyGood{CertifyGood: *v, Subject: newSnapshotUnion(v.Subject)}})
+	}
+	for _, v := range c.certifyVEXStatement {
+		addEvidence(v, &snapshotEvidence{CertifyVEXStatement: &snapshotVEXStatement{
+			CertifyVEXStatement: *v,
+			Subject:             newSnapshotUnion(v.Subject),
+			Vulnerability:       newSnapshotUnion(v.Vulnerability),
+		}})
+	}
+	evidenceRef := func(node any) (*int, error) {
+		pos, ok := evidence[node]
+		if !ok {
+			return nil, backends.Internalf("export :: evidence %T is not stored", node)
+		}
+		return &pos, nil
+	}
+
+	s.Changes = snapshotChanges{Seq: c.changes.seq, Dropped: c.changes.dropped}
+	for _, e := range c.changes.entries {
+		change := &snapshotChange{Seq: e.seq, ID: e.id, NodeType: e.nodeType, Retracted: e.retracted}
+		if e.node != nil && !e.retracted {
+			ref, err := evidenceRef(e.node)
+			if err != nil {
+				return err
+			}
+			change.Evidence = ref
+		}
+		s.Changes.Entries = append(s.Changes.Entries, change)
+	}
+
+	s.Conflicts.Certifications = map[string][]int{}
+	for id, certifications := range c.conflicts.certifications {
+		refs := []int{}
+		for _, v := range certifications {
+			ref, err := evidenceRef(v)
+			if err != nil {
+				return err
+			}
+			refs = append(refs, *ref)
+		}
+		s.Conflicts.Certifications[id] = refs
+	}
+	for key, vexStatements := range c.conflicts.vexStatements {
+		vexKey := &snapshotVEXKey{PackageID: key.packageID, VulnerabilityID: key.vulnerabilityID, Evidence: []int{}}
+		for _, v := range vexStatements {
+			ref, err := evidenceRef(v)
+			if err != nil {
+				return err
+			}
+			vexKey.Evidence = append(vexKey.Evidence, *ref)
+		}
+		s.Conflicts.VEXStatements = append(s.Conflicts.VEXStatements, vexKey)
+	}
+	sort.Slice(s.Conflicts.VEXStatements, func(i, j int) bool {
+		a, b := s.Conflicts.VEXStatements[i], s.Conflicts.VEXStatements[j]
+		return a.PackageID < b.PackageID || (a.PackageID == b.PackageID && a.VulnerabilityID < b.VulnerabilityID)
+	})
+	conflictSide := func(side conflictSide) (snapshotConflictSide, error) {
+		if side.node == nil {
+			return snapshotConflictSide{ID: side.id}, nil
+		}
+		ref, err := evidenceRef(side.node)
+		return snapshotConflictSide{Evidence: ref}, err
+	}
+	for _, conflict := range c.conflicts.list {
+		first, err := conflictSide(conflict.first)
+		if err != nil {
+			return err
+		}
+		second, err := conflictSide(conflict.second)
+		if err != nil {
+			return err
+		}
+		s.Conflicts.List = append(s.Conflicts.List, &snapshotConflictLink{
+			Pattern:    conflict.pattern,
+			First:      first,
+			Second:     second,
+			DetectedAt: conflict.detectedAt,
+		})
+	}
+
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		return fmt.Errorf("export :: %w", err)
+	}
+	return nil
+}
+
+// Import
+
+// Import restores the state written by Export, keeping the IDs of all nodes,
+// so that the IDs handed out to clients before the export remain valid.
+//
+// The client must be empty: snapshots are not merged. The IDs of the snapshot
+// may already be in use in other namespaces, as when a snapshot is imported
+// into several of them, since lookups never cross namespaces. If the snapshot
+// can't be restored the client is left empty.
+//
+// The configuration of the client applies to the restored state: the change
+// log is truncated to its retention, and the conflict patterns that were not
+// detected before the export are not detected in the restored evidence.
+func (c *demoClient) Import(r io.Reader) error {
+	if !c.empty() {
+		return backends.InvalidInputf("import :: the namespace is not empty")
+	}
+	var s snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return backends.InvalidInputf("import :: invalid snapshot %s", err)
+	}
+	if s.Version != snapshotVersion {
+		return backends.InvalidInputf("import :: unsupported snapshot version %d", s.Version)
+	}
+	if err := c.restore(&s); err != nil {
+		c.reset()
+		return backends.InvalidInputf("import :: %v", err)
+	}
+	if err := c.claimIDs(&s); err != nil {
+		c.reset()
+		return backends.InvalidInputf("import :: %v", err)
+	}
+	return nil
+}
+
+func (c *demoClient) empty() bool {
+	return len(c.index) == 0 && c.changes.seq == 0 && len(c.hasSBOM) == 0 && len(c.certifyPkg) == 0 &&
+		len(c.certifyBad) == 0 && len(c.certifyGood) == 0 && len(c.certifyVEXStatement) == 0
+}
+
+// claimIDs moves the ID generator past the IDs of the snapshot. Only the
+// namespace being restored, which is empty, is checked for IDs in use: the
+// other namespaces never look up its nodes, so IDs handed out there don't
+// get in the way.
+func (c *demoClient) claimIDs(s *snapshot) error {
+	return c.ids.advance(s.LastID)
+}
+
+func (c *demoClient) restore(s *snapshot) error {
+	if _, err := parseID(s.LastID); err != nil {
+		return fmt.Errorf("last ID: %w", err)
+	}
+	add := func(n hasID) error {
+		id := n.getID()
+		if _, err := parseID(id); err != nil {
+			return err
+		}
+		if id > s.LastID {
+			return fmt.Errorf("ID %s out of range", id)
+		}
+		if _, ok := c.index[id]; ok {
+			return fmt.Errorf("duplicate ID %s", id)
+		}
+		c.index[id] = n
+		return nil
+	}
+	parentError := func(id, parent string) error {
+		return fmt.Errorf("node %s has no parent %s", id, parent)
+	}
+
+	for _, v := range s.PkgTypes {
+		n := &pkgNamespaceStruct{id: v.ID, typeKey: v.Key, namespaces: pkgNamespaceMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.packages[n.typeKey] = n
+	}
+	for _, v := range s.PkgNamespaces {
+		parent, ok := c.index[v.Parent].(*pkgNamespaceStruct)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &pkgNameStruct{id: v.ID, parent: v.Parent, namespace: v.Namespace, names: pkgNameMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.namespaces[n.namespace] = n
+	}
+	for _, v := range s.PkgNames {
+		parent, ok := c.index[v.Parent].(*pkgNameStruct)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &pkgVersionStruct{
+			id:               v.ID,
+			parent:           v.Parent,
+			name:             v.Name,
+			versions:         pkgVersionList{},
+			srcMapLink:       v.SrcMapLink,
+			isDependencyLink: v.IsDependencyLink,
+			supersededByLink: v.SupersededByLink,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.names[n.name] = n
+	}
+	for _, v := range s.PkgVersions {
+		parent, ok := c.index[v.Parent].(*pkgVersionStruct)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &pkgVersionNode{
+			id:                   v.ID,
+			parent:               v.Parent,
+			version:              v.Version,
+			subpath:              v.Subpath,
+			qualifiers:           v.Qualifiers,
+			srcMapLink:           v.SrcMapLink,
+			isDependencyLink:     v.IsDependencyLink,
+			occurrences:          v.Occurrences,
+			certifyVulnLink:      v.CertifyVulnLink,
+			severityOverrideLink: v.SeverityOverrideLink,
+			supersededByLink:     v.SupersededByLink,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.versions = append(parent.versions, n)
+	}
+
+	for _, v := range s.SrcTypes {
+		n := &srcNamespaceStruct{id: v.ID, typeKey: v.Key, namespaces: srcNamespaceMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.sources[n.typeKey] = n
+	}
+	for _, v := range s.SrcNamespaces {
+		parent, ok := c.index[v.Parent].(*srcNamespaceStruct)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &srcNameStruct{id: v.ID, parent: v.Parent, namespace: v.Namespace, names: srcNameList{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.namespaces[n.namespace] = n
+	}
+	for _, v := range s.SrcNames {
+		parent, ok := c.index[v.Parent].(*srcNameStruct)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &srcNameNode{
+			id:            v.ID,
+			parent:        v.Parent,
+			name:          v.Name,
+			tag:           v.Tag,
+			commit:        v.Commit,
+			srcMapLink:    v.SrcMapLink,
+			scorecardLink: v.ScorecardLink,
+			occurrences:   v.Occurrences,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.names = append(parent.names, n)
+	}
+
+	for _, v := range s.Artifacts {
+		n := &artStruct{
+			id:          v.ID,
+			algorithm:   v.Algorithm,
+			digest:      v.Digest,
+			hashEquals:  v.HashEquals,
+			occurrences: v.Occurrences,
+			hasSLSAs:    v.HasSLSAs,
+			overrides:   v.Overrides,
+			signatures:  v.Signatures,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.artifacts[strings.Join([]string{n.algorithm, n.digest}, ":")] = n
+	}
+	for _, v := range s.Builders {
+		n := &builderStruct{id: v.ID, uri: v.URI, hasSLSAs: v.HasSLSAs}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.builders[n.uri] = n
+	}
+
+	for _, v := range s.OsvTypes {
+		n := &osvNode{id: v.ID, typeKey: v.Key, osvIDs: osvIDMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.osvs[n.typeKey] = n
+	}
+	for _, v := range s.OsvIDs {
+		parent, ok := c.index[v.Parent].(*osvNode)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &osvIDNode{
+			id:                   v.ID,
+			parent:               v.Parent,
+			osvID:                v.VulnID,
+			certifyVulnLink:      v.CertifyVulnLink,
+			equalVulnLink:        v.EqualVulnLink,
+			severityOverrideLink: v.SeverityOverrideLink,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.osvIDs[n.osvID] = n
+	}
+	for _, v := range s.CveYears {
+		n := &cveNode{id: v.ID, year: v.Year, cveIDs: cveIDMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.cves[n.year] = n
+	}
+	for _, v := range s.CveIDs {
+		parent, ok := c.index[v.Parent].(*cveNode)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &cveIDNode{
+			id:                   v.ID,
+			parent:               v.Parent,
+			cveID:                v.VulnID,
+			certifyVulnLink:      v.CertifyVulnLink,
+			equalVulnLink:        v.EqualVulnLink,
+			severityOverrideLink: v.SeverityOverrideLink,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.cveIDs[n.cveID] = n
+	}
+	for _, v := range s.GhsaTypes {
+		n := &ghsaNode{id: v.ID, typeKey: v.Key, ghsaIDs: ghsaIDMap{}}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.ghsas[n.typeKey] = n
+	}
+	for _, v := range s.GhsaIDs {
+		parent, ok := c.index[v.Parent].(*ghsaNode)
+		if !ok {
+			return parentError(v.ID, v.Parent)
+		}
+		n := &ghsaIDNode{
+			id:                   v.ID,
+			parent:               v.Parent,
+			ghsaID:               v.VulnID,
+			certifyVulnLink:      v.CertifyVulnLink,
+			equalVulnLink:        v.EqualVulnLink,
+			severityOverrideLink: v.SeverityOverrideLink,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		parent.ghsaIDs[n.ghsaID] = n
+	}
+	if v := s.NoVuln; v != nil {
+		n := &noVulnNode{id: v.ID, certifyVulnLink: v.CertifyVulnLink}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.noVuln = n
+	}
+
+	for _, v := range s.HasSourceAts {
+		n := &srcMapLink{
+			id:            v.ID,
+			sourceID:      v.SourceID,
+			packageID:     v.PackageID,
+			knownSince:    v.KnownSince,
+			justification: v.Justification,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.hasSources = append(c.hasSources, n)
+		c.hasSourceKeys[n.key()] = n
+		if c.conflicts.patterns[model.ConflictPatternHasSourceAtRepository] {
+			c.indexHasSourceAt(n)
+		}
+	}
+	for _, v := range s.IsDependencies {
+		n := &isDependencyLink{
+			id:            v.ID,
+			packageID:     v.PackageID,
+			depPackageID:  v.DepPackageID,
+			versionRange:  v.VersionRange,
+			justification: v.Justification,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.isDependencies = append(c.isDependencies, n)
+		c.isDependencyKeys[n.key()] = n
+	}
+	for _, v := range s.Scorecards {
+		n := &scorecardLink{
+			id:               v.ID,
+			sourceID:         v.SourceID,
+			timeScanned:      v.TimeScanned,
+			aggregateScore:   v.AggregateScore,
+			checks:           v.Checks,
+			scorecardVersion: v.ScorecardVersion,
+			scorecardCommit:  v.ScorecardCommit,
+			origin:           v.Origin,
+			collector:        v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.scorecards = append(c.scorecards, n)
+		c.scorecardKeys[n.key()] = n
+	}
+	for _, v := range s.HashEquals {
+		n := &hashEqualStruct{
+			id:            v.ID,
+			artifacts:     v.Artifacts,
+			justification: v.Justification,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.hashEquals = append(c.hashEquals, n)
+		c.hashEqualKeys[n.key()] = n
+	}
+	for _, v := range s.Occurrences {
+		n := &isOccurrenceStruct{
+			id:            v.ID,
+			pkg:           v.Package,
+			source:        v.Source,
+			artifact:      v.Artifact,
+			justification: v.Justification,
+			origin:        v.Origin,
+			collector:     v.Collector,
+			ingestedAt:    v.IngestedAt,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.occurrences = append(c.occurrences, n)
+		c.occurrenceKeys[n.key()] = n
+	}
+	for _, v := range s.CertifyVulns {
+		n := &vulnerabilityLink{
+			id:             v.ID,
+			packageID:      v.PackageID,
+			osvID:          v.OsvID,
+			cveID:          v.CveID,
+			ghsaID:         v.GhsaID,
+			noVulnID:       v.NoVulnID,
+			timeScanned:    v.TimeScanned.UTC(),
+			dbURI:          v.DbURI,
+			dbVersion:      v.DbVersion,
+			scannerURI:     v.ScannerURI,
+			scannerVersion: v.ScannerVersion,
+			origin:         v.Origin,
+			collector:      v.Collector,
+			scannerScore:   v.ScannerScore,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.vulnerabilities = append(c.vulnerabilities, n)
+		c.certifyVulnKeys[n.key()] = n
+	}
+	for _, v := range s.IsVulnerabilities {
+		n := &equalVulnerabilityLink{
+			id:            v.ID,
+			osvID:         v.OsvID,
+			cveID:         v.CveID,
+			ghsaID:        v.GhsaID,
+			justification: v.Justification,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.equalVulnerabilities = append(c.equalVulnerabilities, n)
+		c.isVulnerabilityKeys[n.key()] = n
+	}
+	for _, v := range s.HasSLSAs {
+		n := &hasSLSAStruct{
+			id:         v.ID,
+			subject:    v.Subject,
+			builtFrom:  v.BuiltFrom,
+			builtBy:    v.BuiltBy,
+			buildType:  v.BuildType,
+			predicates: v.Predicates,
+			version:    v.Version,
+			start:      v.Start,
+			finish:     v.Finish,
+			origin:     v.Origin,
+			collector:  v.Collector,
+			docHash:    v.DocHash,
+			ingestedAt: v.IngestedAt,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.hasSLSAs = append(c.hasSLSAs, n)
+	}
+	for _, v := range s.SeverityOverrides {
+		n := &severityOverrideLink{
+			id:            v.ID,
+			osvID:         v.OsvID,
+			cveID:         v.CveID,
+			ghsaID:        v.GhsaID,
+			packageID:     v.PackageID,
+			artifactID:    v.ArtifactID,
+			score:         v.Score,
+			scoreType:     v.ScoreType,
+			reviewer:      v.Reviewer,
+			justification: v.Justification,
+			createdAt:     v.CreatedAt,
+			expiresAt:     v.ExpiresAt,
+			retracted:     v.Retracted,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.severityOverrides = append(c.severityOverrides, n)
+		c.severityOverrideKeys[n.key()] = n
+	}
+	for _, v := range s.CertifySigneds {
+		n := &certifySignedStruct{
+			id:            v.ID,
+			artifact:      v.Artifact,
+			signer:        v.Signer,
+			signatureType: v.SignatureType,
+			status:        v.Status,
+			verifiedAt:    v.VerifiedAt,
+			trustRoot:     v.TrustRoot,
+			origin:        v.Origin,
+			collector:     v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.certifySigneds = append(c.certifySigneds, n)
+		c.certifySignedKeys[n.key()] = n
+	}
+	for _, v := range s.SupersededBys {
+		n := &supersededByLink{
+			id:          v.ID,
+			packageID:   v.PackageID,
+			successorID: v.SuccessorID,
+			reason:      v.Reason,
+			since:       v.Since,
+			origin:      v.Origin,
+			collector:   v.Collector,
+		}
+		if err := add(n); err != nil {
+			return err
+		}
+		c.supersededBys = append(c.supersededBys, n)
+		c.supersededByKeys[n.key()] = n
+	}
+
+	// every edge must lead to a node, for the queries to follow it safely
+	for id, n := range c.index {
+		for _, neighbor := range n.neighbors() {
+			if _, ok := c.index[neighbor]; !ok {
+				return fmt.Errorf("node %s links to missing node %s", id, neighbor)
+			}
+		}
+	}
+
+	evidence := make([]model.Nodes, 0, len(s.Evidence))
+	for i, e := range s.Evidence {
+		node, err := c.restoreEvidence(e)
+		if err != nil {
+			return fmt.Errorf("evidence %d: %w", i, err)
+		}
+		evidence = append(evidence, node)
+	}
+	evidenceAt := func(pos int) (model.Nodes, error) {
+		if pos < 0 || pos >= len(evidence) {
+			return nil, fmt.Errorf("no evidence %d", pos)
+		}
+		return evidence[pos], nil
+	}
+
+	if s.Changes.Seq < s.Changes.Dropped || uint64(len(s.Changes.Entries)) != s.Changes.Seq-s.Changes.Dropped {
+		return fmt.Errorf("inconsistent change log")
+	}
+	c.changes.seq = s.Changes.Seq
+	c.changes.dropped = s.Changes.Dropped
+	for i, v := range s.Changes.Entries {
+		if v.Seq != s.Changes.Dropped+uint64(i)+1 {
+			return fmt.Errorf("inconsistent change log")
+		}
+		e := changeEntry{seq: v.Seq, id: v.ID, nodeType: v.NodeType, retracted: v.Retracted}
+		if v.Evidence != nil {
+			node, err := evidenceAt(*v.Evidence)
+			if err != nil {
+				return err
+			}
+			e.node = node
+		} else if _, ok := c.index[v.ID]; !ok && !v.Retracted {
+			return fmt.Errorf("change %d refers to missing node %s", v.Seq, v.ID)
+		}
+		c.changes.entries = append(c.changes.entries, e)
+	}
+	if extra := len(c.changes.entries) - c.changes.retention; extra > 0 {
+		c.changes.dropped = c.changes.entries[extra-1].seq
+		c.changes.entries = append([]changeEntry{}, c.changes.entries[extra:]...)
+	}
+
+	for id, refs := range s.Conflicts.Certifications {
+		certifications := []model.ConflictEvidence{}
+		for _, ref := range refs {
+			node, err := evidenceAt(ref)
+			if err != nil {
+				return err
+			}
+			certification, ok := node.(model.ConflictEvidence)
+			if !ok {
+				return fmt.Errorf("evidence %d is not a certification", ref)
+			}
+			certifications = append(certifications, certification)
+		}
+		c.conflicts.certifications[id] = certifications
+	}
+	for _, v := range s.Conflicts.VEXStatements {
+		vexStatements := []*model.CertifyVEXStatement{}
+		for _, ref := range v.Evidence {
+			node, err := evidenceAt(ref)
+			if err != nil {
+				return err
+			}
+			vex, ok := node.(*model.CertifyVEXStatement)
+			if !ok {
+				return fmt.Errorf("evidence %d is not a VEX statement", ref)
+			}
+			vexStatements = append(vexStatements, vex)
+		}
+		c.conflicts.vexStatements[vexKey{packageID: v.PackageID, vulnerabilityID: v.VulnerabilityID}] = vexStatements
+	}
+	conflictSide := func(v snapshotConflictSide) (conflictSide, error) {
+		if v.Evidence == nil {
+			if _, ok := c.index[v.ID]; !ok {
+				return conflictSide{}, fmt.Errorf("conflict refers to missing node %s", v.ID)
+			}
+			return conflictSide{id: v.ID}, nil
+		}
+		node, err := evidenceAt(*v.Evidence)
+		if err != nil {
+			return conflictSide{}, err
+		}
+		certification, ok := node.(model.ConflictEvidence)
+		if !ok {
+			return conflictSide{}, fmt.Errorf("evidence %d can't be in conflict", *v.Evidence)
+		}
+		return conflictSide{node: certification}, nil
+	}
+	for _, v := range s.Conflicts.List {
+		first, err := conflictSide(v.First)
+		if err != nil {
+			return err
+		}
+		second, err := conflictSide(v.Second)
+		if err != nil {
+			return err
+		}
+		c.conflicts.list = append(c.conflicts.list, &conflictLink{
+			pattern:    v.Pattern,
+			first:      first,
+			second:     second,
+			detectedAt: v.DetectedAt,
+		})
+	}
+	c.recount()
+	return nil
+}
+
+// restoreEvidence appends the evidence e to the list of its type and returns
+// it.
+func (c *demoClient) restoreEvidence(e *snapshotEvidence) (model.Nodes, error) {
+	var err error
+	switch {
+	case e.HasSBOM != nil:
+		v := e.HasSBOM.HasSbom
+		if v.Subject, err = unionValue[model.PackageOrSource](e.HasSBOM.Subject); err != nil {
+			return nil, err
+		}
+		c.hasSBOM = append(c.hasSBOM, &v)
+		return &v, nil
+	case e.CertifyPkg != nil:
+		c.certifyPkg = append(c.certifyPkg, e.CertifyPkg)
+		return e.CertifyPkg, nil
+	case e.CertifyBad != nil:
+		v := e.CertifyBad.CertifyBad
+		if v.Subject, err = unionValue[model.PackageSourceOrArtifact](e.CertifyBad.Subject); err != nil {
+			return nil, err
+		}
+		c.certifyBad = append(c.certifyBad, &v)
+		return &v, nil
+	case e.CertifyGood != nil:
+		v := e.CertifyGood.CertifyGood
+		if v.Subject, err = unionValue[model.PackageSourceOrArtifact](e.CertifyGood.Subject); err != nil {
+			return nil, err
+		}
+		c.certifyGood = append(c.certifyGood, &v)
+		return &v, nil
+	case e.CertifyVEXStatement != nil:
+		v := e.CertifyVEXStatement.CertifyVEXStatement
+		if v.Subject, err = unionValue[model.PackageOrArtifact](e.CertifyVEXStatement.Subject); err != nil {
+			return nil, err
+		}
+		if v.Vulnerability, err = unionValue[model.CveOrGhsa](e.CertifyVEXStatement.Vulnerability); err != nil {
+			return nil, err
+		}
+		if v.Status == "" {
+			// exported before VEX statements had a status
+			v.Status = model.VexStatusNotAffected
+		}
+		c.certifyVEXStatement = append(c.certifyVEXStatement, &v)
+		return &v, nil
+	}
+	return nil, fmt.Errorf("no evidence set")
+}
@@ -0,0 +1,357 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ingestSnapshotData ingests a bit of every kind of evidence, including some
+// that is retracted and some that is in conflict.
+func ingestSnapshotData(t *testing.T, ctx context.Context, b backends.Backend) {
+	t.Helper()
+	since := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	for _, p := range []*model.PkgInputSpec{p1, p2, p3, p4} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{s1, s2} {
+		if _, err := b.IngestSource(ctx, *s); err != nil {
+			t.Fatalf("Could not ingest source: %v", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{a1, a2} {
+		if _, err := b.IngestArtifact(ctx, a); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	if _, err := b.IngestGhsa(ctx, gh1); err != nil {
+		t.Fatalf("Could not ingest GHSA: %v", err)
+	}
+	specificVersion := model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	for _, origin := range []string{"sbom.json", "retracted.json"} {
+		if _, err := b.IngestHasSourceAt(ctx, *p2, specificVersion, *s1, model.HasSourceAtInputSpec{KnownSince: since, Origin: origin}); err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+	}
+	if _, err := b.IngestHasSourceAt(ctx, *p4, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, *s2, model.HasSourceAtInputSpec{Justification: "all versions"}); err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, *p2, *p4, model.IsDependencyInputSpec{VersionRange: ">=3.0"}); err != nil {
+		t.Fatalf("Could not ingest IsDependency: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest IsOccurrence: %v", err)
+	}
+	if _, err := b.IngestHashEqual(ctx, *a1, *a2, model.HashEqualInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest HashEqual: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{TimeScanned: since}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *p1, model.OsvCveOrGhsaInput{NoVuln: ptrfrom.Bool(true)}, model.VulnerabilityMetaDataInput{TimeScanned: since}); err != nil {
+		t.Fatalf("Could not ingest CertifyVuln: %v", err)
+	}
+	affected := model.VexStatusAffected
+	if _, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: p2}, model.CveOrGhsaInput{Cve: c1}, model.VexStatementInputSpec{Status: &affected, Statement: ptrfrom.String("upgrade"), KnownSince: since}); err != nil {
+		t.Fatalf("Could not ingest VEX statement: %v", err)
+	}
+	subject := model.PackageSourceOrArtifactInput{Package: p2}
+	for _, origin := range []string{"audit.json", "retracted.json"} {
+		if _, err := b.IngestCertifyBad(ctx, subject, &specificVersion, model.CertifyBadInputSpec{Justification: origin, Origin: origin}); err != nil {
+			t.Fatalf("Could not ingest CertifyBad: %v", err)
+		}
+	}
+	if _, err := b.IngestCertifyGood(ctx, subject, &specificVersion, model.CertifyGoodInputSpec{Justification: "vetted"}); err != nil {
+		t.Fatalf("Could not ingest CertifyGood: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrSourceInput{Source: s1}, model.HasSBOMInputSpec{URI: "https://example.com/sbom.json"}); err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+	if _, err := b.IngestCertifyPkg(ctx, *p2, *p3, model.CertifyPkgInputSpec{Justification: "same package"}); err != nil {
+		t.Fatalf("Could not ingest CertifyPkg: %v", err)
+	}
+	if _, err := b.RetractEvidence(ctx, "retracted.json"); err != nil {
+		t.Fatalf("Could not retract evidence: %v", err)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestSnapshotData(t, ctx, b)
+	var exported bytes.Buffer
+	if err := b.(inmem.Snapshotter).Export(ctx, &exported); err != nil {
+		t.Fatalf("Could not export: %v", err)
+	}
+
+	restored, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if err := restored.(inmem.Snapshotter).Import(ctx, bytes.NewReader(exported.Bytes())); err != nil {
+		t.Fatalf("Could not import: %v", err)
+	}
+
+	// the restored state is exported the same
+	var reexported bytes.Buffer
+	if err := restored.(inmem.Snapshotter).Export(ctx, &reexported); err != nil {
+		t.Fatalf("Could not export: %v", err)
+	}
+	if diff := cmp.Diff(exported.String(), reexported.String()); diff != "" {
+		t.Errorf("Unexpected snapshot after import (-want +got):\n%s", diff)
+	}
+
+	// queries return the same results, with the same IDs
+	queries := map[string]func(backends.Backend) (any, error){
+		"HasSourceAt": func(b backends.Backend) (any, error) {
+			return b.HasSourceAt(ctx, &model.HasSourceAtSpec{})
+		},
+		"HasSourceAt by source": func(b backends.Backend) (any, error) {
+			return b.HasSourceAt(ctx, &model.HasSourceAtSpec{Source: &model.SourceSpec{Name: &s1.Name}})
+		},
+		"Packages": func(b backends.Backend) (any, error) {
+			return b.Packages(ctx, &model.PkgSpec{Type: ptrfrom.String("pypi")})
+		},
+		"CertifyBad": func(b backends.Backend) (any, error) {
+			return b.CertifyBad(ctx, &model.CertifyBadSpec{})
+		},
+		"CertifyVuln": func(b backends.Backend) (any, error) {
+			return b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+		},
+		"CertifyVEXStatement": func(b backends.Backend) (any, error) {
+			return b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{})
+		},
+		"Changes": func(b backends.Backend) (any, error) {
+			return b.Changes(ctx, nil, nil, nil)
+		},
+		"Conflicts": func(b backends.Backend) (any, error) {
+			return b.Conflicts(ctx, nil)
+		},
+	}
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			want, err := query(b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got, err := query(restored)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Unexpected results after import (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// ingesting the same evidence again finds the restored one
+	hasSourceAts, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Origin: ptrfrom.String("sbom.json")})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hasSourceAt, err := restored.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1,
+		model.HasSourceAtInputSpec{KnownSince: time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC), Origin: "sbom.json"})
+	if err != nil {
+		t.Fatalf("Could not ingest HasSourceAt: %v", err)
+	}
+	if len(hasSourceAts) != 1 || hasSourceAt.ID != hasSourceAts[0].ID {
+		t.Errorf("expected to find HasSourceAt %+v, got %+v", hasSourceAts, hasSourceAt)
+	}
+
+	// new nodes get IDs that weren't handed out before the export
+	vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lastID := vulns[0].ID
+	pkg, err := restored.IngestPackage(ctx, *p5)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if id := pkg.Namespaces[0].Names[0].Versions[0].ID; id <= lastID {
+		t.Errorf("expected new package to get an ID after %s, got %s", lastID, id)
+	}
+}
+
+func TestSnapshotImportIntoNamespaces(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestSnapshotData(t, ctx, b)
+	var exported bytes.Buffer
+	if err := b.(inmem.Snapshotter).Export(ctx, &exported); err != nil {
+		t.Fatalf("Could not export: %v", err)
+	}
+
+	// IDs handed out in the millisecond of the export may sort before its
+	// IDs, and other namespaces keep handing out IDs after it
+	time.Sleep(2 * time.Millisecond)
+	if _, err := b.IngestArtifact(helper.WithNamespace(ctx, "team-a"), a3); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	// the same snapshot can be restored into several namespaces
+	for _, namespace := range []string{"team-b", "team-c"} {
+		nsCtx := helper.WithNamespace(ctx, namespace)
+		if err := b.(inmem.Snapshotter).Import(nsCtx, bytes.NewReader(exported.Bytes())); err != nil {
+			t.Fatalf("Could not import into %s: %v", namespace, err)
+		}
+		var reexported bytes.Buffer
+		if err := b.(inmem.Snapshotter).Export(nsCtx, &reexported); err != nil {
+			t.Fatalf("Could not export %s: %v", namespace, err)
+		}
+		// the last ID is the one of the backend, past the artifact of team-a
+		if diff := cmp.Diff(withoutLastID(t, exported.Bytes()), withoutLastID(t, reexported.Bytes())); diff != "" {
+			t.Errorf("Unexpected snapshot of %s (-want +got):\n%s", namespace, diff)
+		}
+	}
+
+	artifacts, err := b.Artifacts(helper.WithNamespace(ctx, "team-a"), &model.ArtifactSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pkg, err := b.IngestPackage(helper.WithNamespace(ctx, "team-b"), *p5)
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if id := pkg.Namespaces[0].Names[0].Versions[0].ID; id <= artifacts[0].ID {
+		t.Errorf("expected new package to get an ID after %s, got %s", artifacts[0].ID, id)
+	}
+}
+
+func withoutLastID(t *testing.T, snapshot []byte) map[string]any {
+	t.Helper()
+	var s map[string]any
+	if err := json.Unmarshal(snapshot, &s); err != nil {
+		t.Fatalf("Could not parse snapshot: %v", err)
+	}
+	delete(s, "lastID")
+	return s
+}
+
+func TestSnapshotImportErrors(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestSnapshotData(t, ctx, b)
+	var exported bytes.Buffer
+	if err := b.(inmem.Snapshotter).Export(ctx, &exported); err != nil {
+		t.Fatalf("Could not export: %v", err)
+	}
+
+	tests := []struct {
+		Name     string
+		Snapshot string
+		Prepare  func(context.Context, backends.Backend) error
+		ExpErr   string
+	}{
+		{
+			Name:     "Not empty",
+			Snapshot: exported.String(),
+			Prepare: func(ctx context.Context, b backends.Backend) error {
+				_, err := b.IngestArtifact(ctx, a3)
+				return err
+			},
+			ExpErr: "namespace is not empty",
+		},
+		{
+			Name:     "Malformed",
+			Snapshot: "{",
+			ExpErr:   "invalid snapshot",
+		},
+		{
+			Name:     "Unknown version",
+			Snapshot: `{"version": 1000}`,
+			ExpErr:   "unsupported snapshot version",
+		},
+		{
+			Name:     "Missing parent",
+			Snapshot: `{"version": 2, "lastID": "0000000000000000000000000A", "pkgNamespaces": [{"id": "00000000000000000000000002", "parent": "00000000000000000000000001", "namespace": ""}]}`,
+			ExpErr:   "node 00000000000000000000000002 has no parent 00000000000000000000000001",
+		},
+		{
+			Name:     "Missing link target",
+			Snapshot: `{"version": 2, "lastID": "0000000000000000000000000A", "isDependencies": [{"id": "00000000000000000000000003", "packageID": "00000000000000000000000001", "depPackageID": "00000000000000000000000002"}]}`,
+			ExpErr:   "node 00000000000000000000000003 links to missing node",
+		},
+		{
+			Name:     "Malformed ID",
+			Snapshot: `{"version": 2, "lastID": "0000000000000000000000000A", "pkgTypes": [{"id": "3", "key": "pypi"}]}`,
+			ExpErr:   "numeric IDs are no longer supported",
+		},
+		{
+			Name:     "ID out of range",
+			Snapshot: `{"version": 2, "lastID": "0000000000000000000000000A", "pkgTypes": [{"id": "0000000000000000000000000B", "key": "pypi"}]}`,
+			ExpErr:   "out of range",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			if test.Prepare != nil {
+				if err := test.Prepare(ctx, b); err != nil {
+					t.Fatalf("Could not prepare backend: %v", err)
+				}
+			}
+			err = b.(inmem.Snapshotter).Import(ctx, strings.NewReader(test.Snapshot))
+			if err == nil || !strings.Contains(err.Error(), test.ExpErr) {
+				t.Fatalf("expected error containing %q, got: %v", test.ExpErr, err)
+			}
+			// a failed import leaves nothing behind
+			if test.Prepare != nil {
+				return
+			}
+			pkgs, err := b.Packages(ctx, &model.PkgSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(pkgs)+len(deps) != 0 {
+				t.Errorf("failed import left %d packages and %d dependencies", len(pkgs), len(deps))
+			}
+		})
+	}
+}
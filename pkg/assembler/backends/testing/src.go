@@ -17,12 +17,11 @@ package testing
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
-	"strconv"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // TODO: move this into a unit test for this file
@@ -62,43 +61,63 @@ func registerAllSources(client *demoClient) {
 // Internal data: Sources
 type srcTypeMap map[string]*srcNamespaceStruct
 type srcNamespaceStruct struct {
-	id         uint32
+	id         string
 	typeKey    string
 	namespaces srcNamespaceMap
 }
 type srcNamespaceMap map[string]*srcNameStruct
 type srcNameStruct struct {
-	id        uint32
-	parent    uint32
+	id        string
+	parent    string
 	namespace string
 	names     srcNameList
 }
 type srcNameList []*srcNameNode
 type srcNameNode struct {
-	id            uint32
-	parent        uint32
+	id            string
+	parent        string
 	name          string
 	tag           string
 	commit        string
-	srcMapLink    []uint32
-	scorecardLink []uint32
-	occurrences   []uint32
+	srcMapLink    []string
+	scorecardLink []string
+	occurrences   []string
 }
 
-func (n *srcNamespaceStruct) getID() uint32 { return n.id }
-func (n *srcNameStruct) getID() uint32      { return n.id }
-func (n *srcNameNode) getID() uint32        { return n.id }
+func (n *srcNamespaceStruct) getID() string { return n.id }
+func (n *srcNameStruct) getID() string      { return n.id }
+func (n *srcNameNode) getID() string        { return n.id }
+
+func (n *srcNamespaceStruct) neighbors() []string {
+	ids := []string{}
+	for _, child := range n.namespaces {
+		ids = append(ids, child.id)
+	}
+	return sortedIDs(ids)
+}
+
+func (n *srcNameStruct) neighbors() []string {
+	ids := []string{n.parent}
+	for _, child := range n.names {
+		ids = append(ids, child.id)
+	}
+	return ids
+}
+
+func (n *srcNameNode) neighbors() []string {
+	return appendIDs([]string{n.parent}, n.srcMapLink, n.scorecardLink, n.occurrences)
+}
 
 // hasSourceAt back edges
-func (p *srcNameNode) setSrcMapLink(id uint32) { p.srcMapLink = append(p.srcMapLink, id) }
-func (p *srcNameNode) getSrcMapLink() []uint32 { return p.srcMapLink }
+func (p *srcNameNode) setSrcMapLink(id string) { p.srcMapLink = append(p.srcMapLink, id) }
+func (p *srcNameNode) getSrcMapLink() []string { return p.srcMapLink }
 
 // scorecard back edges
-func (p *srcNameNode) setScorecardLink(id uint32) { p.scorecardLink = append(p.scorecardLink, id) }
-func (p *srcNameNode) getScorecardLink() []uint32 { return p.scorecardLink }
+func (p *srcNameNode) setScorecardLink(id string) { p.scorecardLink = append(p.scorecardLink, id) }
+func (p *srcNameNode) getScorecardLink() []string { return p.scorecardLink }
 
-func (p *srcNameNode) setOccurrences(id uint32) { p.occurrences = append(p.occurrences, id) }
-func (p *srcNameNode) getOccurrences() []uint32 { return p.occurrences }
+func (p *srcNameNode) setOccurrences(id string) { p.occurrences = append(p.occurrences, id) }
+func (p *srcNameNode) getOccurrences() []string { return p.occurrences }
 
 // Ingest Source
 func (c *demoClient) IngestSource(ctx context.Context, input model.SourceInputSpec) (*model.Source, error) {
@@ -150,6 +169,7 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.SourceInputSp
 			name:   input.Name,
 		}
 		c.index[collectedSrcName.id] = &collectedSrcName
+		c.recordChange(collectedSrcName.id, model.NodeTypeSource)
 		if input.Tag != nil {
 			collectedSrcName.tag = nilToEmpty(input.Tag)
 		}
@@ -159,26 +179,45 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.SourceInputSp
 		namesStruct.names = append(names, &collectedSrcName)
 		namespaces[input.Namespace] = namesStruct
 		c.sources[input.Type] = namespacesStruct
+	} else {
+		c.countIngestion(model.NodeTypeSource, false)
 	}
 
 	// build return GraphQL type
 	return c.buildSourceResponse(collectedSrcName.id, nil)
 }
 
+// IngestSources ingests the sources at every index of sources. The result is in input
+// order, with duplicates within the batch returning the same node.
+func (c *demoClient) IngestSources(ctx context.Context, sources []*model.SourceInputSpec) ([]*model.Source, error) {
+	out := make([]*model.Source, 0, len(sources))
+	for i, source := range sources {
+		if source == nil {
+			return nil, backends.InvalidInputf("IngestSources :: index %d: missing source", i)
+		}
+		ingested, err := c.IngestSource(ctx, *source)
+		if err != nil {
+			return nil, fmt.Errorf("IngestSources :: index %d: %w", i, err)
+		}
+		out = append(out, ingested)
+	}
+	return out, nil
+}
+
 // Query Source
 
 func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*model.Source, error) {
 	if filter.Commit != nil && filter.Tag != nil {
 		if *filter.Commit != "" && *filter.Tag != "" {
-			return nil, gqlerror.Errorf("Passing both commit and tag selectors is an error")
+			return nil, backends.InvalidInputf("Passing both commit and tag selectors is an error")
 		}
 	}
 	if filter != nil && filter.ID != nil {
-		id, err := strconv.Atoi(*filter.ID)
+		id, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		s, err := c.buildSourceResponse(uint32(id), filter)
+		s, err := c.buildSourceResponse(id, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -192,7 +231,7 @@ func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 			sNamespaces := buildSourceNamespace(srcNamespaceStruct, filter)
 			if len(sNamespaces) > 0 {
 				out = append(out, &model.Source{
-					ID:         nodeID(srcNamespaceStruct.id),
+					ID:         srcNamespaceStruct.id,
 					Type:       srcNamespaceStruct.typeKey,
 					Namespaces: sNamespaces,
 				})
@@ -203,7 +242,7 @@ func (c *demoClient) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 			sNamespaces := buildSourceNamespace(srcNamespaceStruct, filter)
 			if len(sNamespaces) > 0 {
 				out = append(out, &model.Source{
-					ID:         nodeID(srcNamespaceStruct.id),
+					ID:         srcNamespaceStruct.id,
 					Type:       dbType,
 					Namespaces: sNamespaces,
 				})
@@ -221,7 +260,7 @@ func buildSourceNamespace(srcNamespaceStruct *srcNamespaceStruct, filter *model.
 			sns := buildSourceName(srcNameStruct, filter)
 			if len(sns) > 0 {
 				sNamespaces = append(sNamespaces, &model.SourceNamespace{
-					ID:        nodeID(srcNameStruct.id),
+					ID:        srcNameStruct.id,
 					Namespace: srcNameStruct.namespace,
 					Names:     sns,
 				})
@@ -232,7 +271,7 @@ func buildSourceNamespace(srcNamespaceStruct *srcNamespaceStruct, filter *model.
 			sns := buildSourceName(srcNameStruct, filter)
 			if len(sns) > 0 {
 				sNamespaces = append(sNamespaces, &model.SourceNamespace{
-					ID:        nodeID(srcNameStruct.id),
+					ID:        srcNameStruct.id,
 					Namespace: namespace,
 					Names:     sns,
 				})
@@ -255,7 +294,7 @@ func buildSourceName(srcNameStruct *srcNameStruct, filter *model.SourceSpec) []*
 			continue
 		}
 		sns = append(sns, &model.SourceName{
-			ID:     nodeID(s.id),
+			ID:     s.id,
 			Name:   s.name,
 			Tag:    &s.tag,
 			Commit: &s.commit,
@@ -266,20 +305,20 @@ func buildSourceName(srcNameStruct *srcNameStruct, filter *model.SourceSpec) []*
 
 // Builds a model.Source to send as GraphQL response, starting from id.
 // The optional filter allows restricting output (on selection operations).
-func (c *demoClient) buildSourceResponse(id uint32, filter *model.SourceSpec) (*model.Source, error) {
+func (c *demoClient) buildSourceResponse(id string, filter *model.SourceSpec) (*model.Source, error) {
 	if filter != nil && filter.ID != nil {
-		filteredID, err := strconv.Atoi(*filter.ID)
+		filteredID, err := parseID(*filter.ID)
 		if err != nil {
 			return nil, err
 		}
-		if uint32(filteredID) != id {
+		if filteredID != id {
 			return nil, nil
 		}
 	}
 
 	node, ok := c.index[id]
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match existing node")
+		return nil, backends.NotFoundf("ID does not match existing node")
 	}
 
 	snl := []*model.SourceName{}
@@ -296,7 +335,7 @@ func (c *demoClient) buildSourceResponse(id uint32, filter *model.SourceSpec) (*
 		snl = append(snl, &model.SourceName{
 			// IDs are generated as string even though we ask for integers
 			// See https://github.com/99designs/gqlgen/issues/2561
-			ID:     nodeID(nameNode.id),
+			ID:     nameNode.id,
 			Name:   nameNode.name,
 			Tag:    &nameNode.tag,
 			Commit: &nameNode.commit,
@@ -310,7 +349,7 @@ func (c *demoClient) buildSourceResponse(id uint32, filter *model.SourceSpec) (*
 			return nil, nil
 		}
 		snsl = append(snsl, &model.SourceNamespace{
-			ID:        nodeID(nameStruct.id),
+			ID:        nameStruct.id,
 			Namespace: nameStruct.namespace,
 			Names:     snl,
 		})
@@ -319,10 +358,10 @@ func (c *demoClient) buildSourceResponse(id uint32, filter *model.SourceSpec) (*
 
 	namespaceStruct, ok := node.(*srcNamespaceStruct)
 	if !ok {
-		return nil, gqlerror.Errorf("ID does not match expected node type for source namespace")
+		return nil, backends.InvalidInputf("ID does not match expected node type for source namespace")
 	}
 	s := model.Source{
-		ID:         nodeID(namespaceStruct.id),
+		ID:         namespaceStruct.id,
 		Type:       namespaceStruct.typeKey,
 		Namespaces: snsl,
 	}
@@ -332,17 +371,86 @@ func (c *demoClient) buildSourceResponse(id uint32, filter *model.SourceSpec) (*
 	return &s, nil
 }
 
-func getSourceIDFromInput(c *demoClient, input model.SourceInputSpec) (uint32, error) {
+// matchSource reports whether buildSourceResponse would return a source for id
+// and filter, without building it.
+func (c *demoClient) matchSource(id string, filter *model.SourceSpec) bool {
+	if filter == nil {
+		return true
+	}
+	if !filterMatchesID(filter.ID, id) {
+		return false
+	}
+	node := c.index[id]
+	if nameNode, ok := node.(*srcNameNode); ok {
+		if noMatch(filter.Name, nameNode.name) ||
+			noMatch(filter.Tag, nameNode.tag) ||
+			noMatch(filter.Commit, nameNode.commit) {
+			return false
+		}
+		node = c.index[nameNode.parent]
+	}
+	if nameStruct, ok := node.(*srcNameStruct); ok {
+		if noMatch(filter.Namespace, nameStruct.namespace) {
+			return false
+		}
+		node = c.index[nameStruct.parent]
+	}
+	namespaceStruct, ok := node.(*srcNamespaceStruct)
+	return ok && !noMatch(filter.Type, namespaceStruct.typeKey)
+}
+
+// sourceBackedges returns the links, stored on the source name nodes that can
+// match filter, that links selects. They are in ingestion order. It returns
+// nil if filter doesn't narrow down the sources, for the caller to search all
+// its links instead.
+func (c *demoClient) sourceBackedges(filter *model.SourceSpec, links func(*srcNameNode) []string) []string {
+	if filter == nil || (filter.ID == nil && filter.Type == nil && filter.Namespace == nil && filter.Name == nil) {
+		return nil
+	}
+	ids := []string{}
+	if filter.ID != nil {
+		id, err := parseID(*filter.ID)
+		if err != nil {
+			// let the search report the invalid ID
+			return nil
+		}
+		if node, ok := c.index[id].(*srcNameNode); ok {
+			ids = append(ids, links(node)...)
+		}
+		return sortedBackedges(ids)
+	}
+	for typeKey, namespaces := range c.sources {
+		if noMatch(filter.Type, typeKey) {
+			continue
+		}
+		for namespace, names := range namespaces.namespaces {
+			if noMatch(filter.Namespace, namespace) {
+				continue
+			}
+			for _, name := range names.names {
+				if noMatch(filter.Name, name.name) ||
+					noMatch(filter.Tag, name.tag) ||
+					noMatch(filter.Commit, name.commit) {
+					continue
+				}
+				ids = append(ids, links(name)...)
+			}
+		}
+	}
+	return sortedBackedges(ids)
+}
+
+func getSourceIDFromInput(c *demoClient, input model.SourceInputSpec) (string, error) {
 	srcNamespace, srcHasNamespace := c.sources[input.Type]
 	if !srcHasNamespace {
-		return 0, gqlerror.Errorf("Source type \"%s\" not found", input.Type)
+		return "", backends.NotFoundf("Source type \"%s\" not found", input.Type)
 	}
 	srcName, srcHasName := srcNamespace.namespaces[input.Namespace]
 	if !srcHasName {
-		return 0, gqlerror.Errorf("Source namespace \"%s\" not found", input.Namespace)
+		return "", backends.NotFoundf("Source namespace \"%s\" not found", input.Namespace)
 	}
 	found := false
-	var sourceID uint32
+	var sourceID string
 	for _, src := range srcName.names {
 		if src.name != input.Name {
 			continue
@@ -354,13 +462,13 @@ func getSourceIDFromInput(c *demoClient, input model.SourceInputSpec) (uint32, e
 			continue
 		}
 		if found {
-			return 0, gqlerror.Errorf("More than one source matches input")
+			return "", backends.InvalidInputf("More than one source matches input")
 		}
 		sourceID = src.id
 		found = true
 	}
 	if !found {
-		return 0, gqlerror.Errorf("No source matches input")
+		return "", backends.NotFoundf("No source matches input")
 	}
 	return sourceID, nil
 }
@@ -415,7 +523,7 @@ func filterSourceName(ns *model.SourceNamespace, sourceSpec *model.SourceSpec) (
 func filterSourceTagCommit(n *model.SourceName, sourceSpec *model.SourceSpec) (*model.SourceName, error) {
 	if sourceSpec.Commit != nil && sourceSpec.Tag != nil {
 		if *sourceSpec.Commit != "" && *sourceSpec.Tag != "" {
-			return nil, gqlerror.Errorf("Passing both commit and tag selectors is an error")
+			return nil, backends.InvalidInputf("Passing both commit and tag selectors is an error")
 		}
 	}
 
@@ -443,14 +551,14 @@ func matchInputSpecWithDBField(spec *string, dbField *string) bool {
 	return (dbField != nil && *dbField == *spec)
 }
 
-func (c *demoClient) sourceByID(id uint32) (*srcNameNode, error) {
+func (c *demoClient) sourceByID(id string) (*srcNameNode, error) {
 	o, ok := c.index[id]
 	if !ok {
-		return nil, errors.New("could not find source")
+		return nil, backends.NotFoundf("could not find source")
 	}
 	a, ok := o.(*srcNameNode)
 	if !ok {
-		return nil, errors.New("not a source")
+		return nil, backends.InvalidInputf("not a source")
 	}
 	return a, nil
 }
@@ -0,0 +1,56 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestSources(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+
+	got, err := b.IngestSources(ctx, []*model.SourceInputSpec{s1, s2, s1})
+	if err != nil {
+		t.Fatalf("Could not ingest sources: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 sources, got %d", len(got))
+	}
+	for i, name := range []string{"tensorflow", "numpy", "tensorflow"} {
+		if gotName := got[i].Namespaces[0].Names[0].Name; gotName != name {
+			t.Errorf("expected source %d to be %s, got %s", i, name, gotName)
+		}
+	}
+	if got[0].Namespaces[0].Names[0].ID != got[2].Namespaces[0].Names[0].ID {
+		t.Errorf("expected duplicates within the batch to be the same node")
+	}
+
+	_, err = b.IngestSources(ctx, []*model.SourceInputSpec{s1, s2, nil})
+	if !errors.Is(err, backends.ErrInvalidInput) || !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("expected an invalid input error naming index 2, got: %v", err)
+	}
+}
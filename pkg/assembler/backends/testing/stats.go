@@ -0,0 +1,137 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: the number of nodes of each type and of their edges, as
+// reported by Stats. They are counted when the nodes are recorded in the
+// change log and when they are retracted, so that Stats doesn't walk the
+// index while holding the lock of the namespace. RetractEvidence is the only
+// way nodes leave a namespace short of PurgeNamespace, which drops the
+// demoClient with its counts, and Import recounts the restored nodes once.
+type nodeCount struct {
+	nodes, edges int
+}
+
+// countNode adds a node of nodeType to the counts, n is 1 when the node is
+// created and -1 when it is retracted. node is the node of the index or the
+// evidence kept out of it.
+func (c *demoClient) countNode(nodeType model.NodeType, node any, n int) {
+	count := c.counts[nodeType]
+	count.nodes += n
+	count.edges += n * nodeEdges(node)
+	c.counts[nodeType] = count
+}
+
+// nodeEdges returns the number of edges of the evidence, each linking its
+// subject and, for CertifyPkg and CertifyVEXStatement, a package or
+// vulnerability. The nodes of the software trees and the vulnerabilities
+// have none.
+func nodeEdges(node any) int {
+	switch n := node.(type) {
+	case *model.CertifyBad, *model.CertifyGood, *model.HasSbom:
+		return 1
+	case *model.CertifyVEXStatement:
+		return 2
+	case *model.CertifyPkg:
+		return len(n.Packages)
+	case *pkgVersionNode, *srcNameNode, *artStruct, *builderStruct, *osvIDNode, *cveIDNode, *ghsaIDNode:
+		return 0
+	case hasID:
+		return len(n.neighbors())
+	}
+	return 0
+}
+
+// recount counts the nodes of a restored snapshot, which are not recorded
+// one by one. The namespace and name nodes of the software trees and the
+// NoVuln node aren't counted, as the changes query doesn't report them.
+func (c *demoClient) recount() {
+	c.counts = map[model.NodeType]nodeCount{}
+	for _, node := range c.index {
+		switch node.(type) {
+		case *pkgVersionNode:
+			c.countNode(model.NodeTypePackage, node, 1)
+		case *srcNameNode:
+			c.countNode(model.NodeTypeSource, node, 1)
+		case *artStruct:
+			c.countNode(model.NodeTypeArtifact, node, 1)
+		case *builderStruct:
+			c.countNode(model.NodeTypeBuilder, node, 1)
+		case *osvIDNode:
+			c.countNode(model.NodeTypeOsv, node, 1)
+		case *cveIDNode:
+			c.countNode(model.NodeTypeCve, node, 1)
+		case *ghsaIDNode:
+			c.countNode(model.NodeTypeGhsa, node, 1)
+		case *scorecardLink:
+			c.countNode(model.NodeTypeCertifyScorecard, node, 1)
+		case *certifySignedStruct:
+			c.countNode(model.NodeTypeCertifySigned, node, 1)
+		case *vulnerabilityLink:
+			c.countNode(model.NodeTypeCertifyVuln, node, 1)
+		case *hasSLSAStruct:
+			c.countNode(model.NodeTypeHasSlsa, node, 1)
+		case *srcMapLink:
+			c.countNode(model.NodeTypeHasSourceAt, node, 1)
+		case *hashEqualStruct:
+			c.countNode(model.NodeTypeHashEqual, node, 1)
+		case *isDependencyLink:
+			c.countNode(model.NodeTypeIsDependency, node, 1)
+		case *isOccurrenceStruct:
+			c.countNode(model.NodeTypeIsOccurrence, node, 1)
+		case *equalVulnerabilityLink:
+			c.countNode(model.NodeTypeIsVulnerability, node, 1)
+		case *severityOverrideLink:
+			c.countNode(model.NodeTypeSeverityOverride, node, 1)
+		case *supersededByLink:
+			c.countNode(model.NodeTypeSupersededBy, node, 1)
+		}
+	}
+	for _, v := range c.certifyBad {
+		c.countNode(model.NodeTypeCertifyBad, v, 1)
+	}
+	for _, v := range c.certifyGood {
+		c.countNode(model.NodeTypeCertifyGood, v, 1)
+	}
+	for _, v := range c.hasSBOM {
+		c.countNode(model.NodeTypeHasSbom, v, 1)
+	}
+	for _, v := range c.certifyVEXStatement {
+		c.countNode(model.NodeTypeCertifyVexStatement, v, 1)
+	}
+	for _, v := range c.certifyPkg {
+		c.countNode(model.NodeTypeCertifyPkg, v, 1)
+	}
+}
+
+// Query Stats
+
+func (c *demoClient) Stats(ctx context.Context) (*model.BackendStats, error) {
+	out := &model.BackendStats{Types: []*model.NodeTypeStats{}}
+	for _, nodeType := range model.AllNodeType {
+		count := c.counts[nodeType]
+		out.Nodes += count.nodes
+		out.Edges += count.edges
+		out.Types = append(out.Types, &model.NodeTypeStats{Type: nodeType, Nodes: count.nodes, Edges: count.edges})
+	}
+	return out, nil
+}
@@ -0,0 +1,208 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// StitchedByGuac is the justification of the HashEqual edges proposed by
+// StitchingProposals.
+const StitchedByGuac = "stitched-by-guac"
+
+const defaultStitchingWindow = time.Hour
+
+var (
+	// imageTag matches valid OCI tags.
+	imageTag = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	// digestTag matches the tags derived from a digest that cosign uses to
+	// attach signatures, attestations and SBOMs to an image.
+	digestTag = regexp.MustCompile(`^sha256-[a-f0-9]{64}(\.[a-z]+)?$`)
+)
+
+// stitchEvidence is an IsOccurrence or HasSLSA node referencing an artifact,
+// from a document whose origin references a tagged container image.
+type stitchEvidence struct {
+	id         string
+	artifact   string
+	imageRef   string
+	ingestedAt time.Time
+}
+
+// Query StitchingProposals
+//
+// For each piece of evidence on the artifact, the evidence on other artifacts
+// with the same image repository and tag in its origin that was ingested
+// within the window is collected. If it all references a single other
+// artifact that isn't already HashEqual to the queried one, that artifact is
+// proposed. Repository and tag combinations pointing to more than one other
+// artifact in the window (e.g. a moving tag) are ambiguous and skipped.
+func (c *demoClient) StitchingProposals(ctx context.Context, artifactSpec model.ArtifactSpec, windowSeconds *int) ([]*model.StitchProposal, error) {
+	window := defaultStitchingWindow
+	if windowSeconds != nil {
+		if *windowSeconds < 0 {
+			return nil, backends.InvalidInputf("stitchingProposals :: window must not be negative")
+		}
+		window = time.Duration(*windowSeconds) * time.Second
+	}
+	a, err := c.artifactBySpec(artifactSpec)
+	if err != nil {
+		return nil, fmt.Errorf("stitchingProposals :: %w", err)
+	}
+
+	byImageRef := map[string][]stitchEvidence{}
+	var own []stitchEvidence
+	for _, e := range c.stitchEvidence() {
+		byImageRef[e.imageRef] = append(byImageRef[e.imageRef], e)
+		if e.artifact == a.id {
+			own = append(own, e)
+		}
+	}
+
+	proposals := map[string]*model.StitchProposal{}
+	evidence := map[string]map[string]bool{}
+	for _, e := range own {
+		var candidates []stitchEvidence
+		others := map[string]bool{}
+		for _, o := range byImageRef[e.imageRef] {
+			if o.artifact == a.id || !withinWindow(e.ingestedAt, o.ingestedAt, window) {
+				continue
+			}
+			candidates = append(candidates, o)
+			others[o.artifact] = true
+		}
+		if len(others) != 1 {
+			continue
+		}
+		other := candidates[0].artifact
+		if c.hashEqualLinked(a, other) {
+			continue
+		}
+		if _, ok := proposals[other]; !ok {
+			otherArtifact, err := c.artifactByID(other)
+			if err != nil {
+				return nil, fmt.Errorf("stitchingProposals :: %w", err)
+			}
+			proposals[other] = &model.StitchProposal{
+				Artifact:      convArtifact(a),
+				EqualArtifact: convArtifact(otherArtifact),
+				ImageRef:      e.imageRef,
+				Justification: StitchedByGuac,
+			}
+			evidence[other] = map[string]bool{}
+		}
+		evidence[other][e.id] = true
+		for _, o := range candidates {
+			evidence[other][o.id] = true
+		}
+	}
+
+	others := make([]string, 0, len(proposals))
+	for other := range proposals {
+		others = append(others, other)
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	out := make([]*model.StitchProposal, 0, len(proposals))
+	for _, other := range others {
+		p := proposals[other]
+		p.Evidence = sortedNodeIDs(evidence[other])
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// stitchEvidence returns the IsOccurrence and HasSLSA nodes whose origin
+// references a tagged container image.
+func (c *demoClient) stitchEvidence() []stitchEvidence {
+	var out []stitchEvidence
+	for _, o := range c.occurrences {
+		if ref := imageRefFromOrigin(o.origin); ref != "" {
+			out = append(out, stitchEvidence{id: o.id, artifact: o.artifact, imageRef: ref, ingestedAt: o.ingestedAt})
+		}
+	}
+	for _, s := range c.hasSLSAs {
+		if ref := imageRefFromOrigin(s.origin); ref != "" {
+			out = append(out, stitchEvidence{id: s.id, artifact: s.subject, imageRef: ref, ingestedAt: s.ingestedAt})
+		}
+	}
+	return out
+}
+
+func (c *demoClient) hashEqualLinked(a *artStruct, other string) bool {
+	for _, id := range a.hashEquals {
+		he, err := c.hashEqualByID(id)
+		if err != nil {
+			continue
+		}
+		for _, linked := range he.artifacts {
+			if linked == other {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// imageRefFromOrigin returns the repository and tag of the container image
+// referenced by origin (e.g. "ghcr.io/guacsec/guac:v0.1.0"), or "" if origin
+// is not a reference to a tagged image. Tags derived from digests, which
+// cosign uses for attached signatures, attestations and SBOMs, are ignored.
+func imageRefFromOrigin(origin string) string {
+	ref := strings.TrimPrefix(strings.TrimPrefix(origin, "docker://"), "oci://")
+	if strings.Contains(ref, "://") || strings.ContainsAny(ref, " \t") {
+		return ""
+	}
+	ref, _, _ = strings.Cut(ref, "@")
+	slash := strings.LastIndex(ref, "/")
+	colon := strings.LastIndex(ref, ":")
+	if slash <= 0 || colon < slash {
+		return ""
+	}
+	tag := ref[colon+1:]
+	if !imageTag.MatchString(tag) || digestTag.MatchString(tag) {
+		return ""
+	}
+	return ref
+}
+
+func withinWindow(t1, t2 time.Time, window time.Duration) bool {
+	d := t1.Sub(t2)
+	if d < 0 {
+		d = -d
+	}
+	return d <= window
+}
+
+func sortedNodeIDs(ids map[string]bool) []string {
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	out := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		out = append(out, id)
+	}
+	return out
+}
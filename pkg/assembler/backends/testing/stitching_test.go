@@ -0,0 +1,278 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var (
+	manifest = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("a", 64)}
+	imageID  = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("b", 64)}
+	oldBuild = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("c", 64)}
+	sibling  = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("d", 64)}
+	retag    = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("e", 64)}
+)
+
+// stitchingFixture is a graph in which the SBOM identifies an image by its
+// manifest digest while the provenance and the scan use its image ID.
+type stitchingFixture struct {
+	b        backends.Backend
+	now      time.Time
+	sbom     string
+	slsa     string
+	scan     string
+	imageRef string
+}
+
+func (f *stitchingFixture) occurrence(t *testing.T, p *model.PkgInputSpec, a *model.ArtifactInputSpec, origin string) string {
+	t.Helper()
+	o, err := f.b.IngestOccurrence(context.Background(), model.PackageOrSourceInput{Package: p}, *a,
+		model.IsOccurrenceInputSpec{Justification: "test", Origin: origin, Collector: "test"})
+	if err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	return o.ID
+}
+
+func newStitchingFixture(t *testing.T) *stitchingFixture {
+	t.Helper()
+	ctx := context.Background()
+	f := &stitchingFixture{
+		now:      time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+		imageRef: "ghcr.io/example/app:v1.2.0",
+	}
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{Clock: func() time.Time { return f.now }})
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	f.b = b
+	for _, p := range []*model.PkgInputSpec{p2, p4} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{manifest, imageID, oldBuild, sibling, retag} {
+		if _, err := b.IngestArtifact(ctx, a); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+	}
+	builder := model.BuilderInputSpec{URI: "https://github.com/Attestations/GitHubHostedActions@v1"}
+	if _, err := b.IngestBuilder(ctx, &builder); err != nil {
+		t.Fatalf("Could not ingest builder: %v", err)
+	}
+
+	// An older build of the same tag, outside of the window.
+	f.now = f.now.Add(-48 * time.Hour)
+	f.occurrence(t, p2, oldBuild, f.imageRef)
+	f.now = f.now.Add(48 * time.Hour)
+
+	f.sbom = f.occurrence(t, p2, manifest, f.imageRef)
+	// Documents that don't reference the tagged image.
+	f.occurrence(t, p2, sibling, "ghcr.io/example/other:v1.2.0")
+	f.occurrence(t, p2, sibling, "ghcr.io/example/app:sha256-"+strings.Repeat("a", 64)+".sbom")
+	f.occurrence(t, p2, sibling, "file:///tmp/ghcr.io/example/app:v1.2.0")
+
+	f.now = f.now.Add(10 * time.Minute)
+	slsa, err := b.IngestSLSA(ctx, *imageID, []*model.ArtifactInputSpec{sibling}, builder,
+		model.SLSAInputSpec{BuildType: "test", Origin: "docker://" + f.imageRef, Collector: "test"})
+	if err != nil {
+		t.Fatalf("Could not ingest slsa: %v", err)
+	}
+	f.slsa = slsa.ID
+
+	f.now = f.now.Add(10 * time.Minute)
+	f.scan = f.occurrence(t, p4, imageID, f.imageRef+"@sha256:"+strings.Repeat("b", 64))
+	return f
+}
+
+func TestStitchingProposals(t *testing.T) {
+	ctx := context.Background()
+	artifactSpec := func(a *model.ArtifactInputSpec) model.ArtifactSpec {
+		return model.ArtifactSpec{Algorithm: &a.Algorithm, Digest: &a.Digest}
+	}
+	artifact := func(a *model.ArtifactInputSpec) *model.Artifact {
+		return &model.Artifact{Algorithm: a.Algorithm, Digest: a.Digest}
+	}
+	tests := []struct {
+		Name   string
+		Setup  func(t *testing.T, f *stitchingFixture)
+		Query  *model.ArtifactInputSpec
+		Window *int
+		Exp    func(f *stitchingFixture) []*model.StitchProposal
+		ExpErr bool
+	}{
+		{
+			Name:  "Manifest digest is stitched to image ID",
+			Query: manifest,
+			Exp: func(f *stitchingFixture) []*model.StitchProposal {
+				return []*model.StitchProposal{{
+					Artifact:      artifact(manifest),
+					EqualArtifact: artifact(imageID),
+					ImageRef:      f.imageRef,
+					Justification: inmem.StitchedByGuac,
+					Evidence:      []string{f.sbom, f.slsa, f.scan},
+				}}
+			},
+		},
+		{
+			Name:  "Image ID is stitched to manifest digest",
+			Query: imageID,
+			Exp: func(f *stitchingFixture) []*model.StitchProposal {
+				return []*model.StitchProposal{{
+					Artifact:      artifact(imageID),
+					EqualArtifact: artifact(manifest),
+					ImageRef:      f.imageRef,
+					Justification: inmem.StitchedByGuac,
+					Evidence:      []string{f.sbom, f.slsa, f.scan},
+				}}
+			},
+		},
+		{
+			Name:   "Evidence outside of window",
+			Query:  manifest,
+			Window: ptrfrom.Int(5 * 60),
+			Exp:    func(f *stitchingFixture) []*model.StitchProposal { return nil },
+		},
+		{
+			Name:   "Partial evidence inside of window",
+			Query:  manifest,
+			Window: ptrfrom.Int(15 * 60),
+			Exp: func(f *stitchingFixture) []*model.StitchProposal {
+				return []*model.StitchProposal{{
+					Artifact:      artifact(manifest),
+					EqualArtifact: artifact(imageID),
+					ImageRef:      f.imageRef,
+					Justification: inmem.StitchedByGuac,
+					Evidence:      []string{f.sbom, f.slsa},
+				}}
+			},
+		},
+		{
+			Name:  "Ambiguous tag",
+			Query: manifest,
+			Setup: func(t *testing.T, f *stitchingFixture) {
+				f.occurrence(t, p2, retag, f.imageRef)
+			},
+			Exp: func(f *stitchingFixture) []*model.StitchProposal { return nil },
+		},
+		{
+			Name:  "Unrelated image",
+			Query: sibling,
+			Exp:   func(f *stitchingFixture) []*model.StitchProposal { return nil },
+		},
+		{
+			Name:  "Already HashEqual",
+			Query: manifest,
+			Setup: func(t *testing.T, f *stitchingFixture) {
+				if _, err := f.b.IngestHashEqual(ctx, *manifest, *imageID, model.HashEqualInputSpec{Justification: "test"}); err != nil {
+					t.Fatalf("Could not ingest hash equal: %v", err)
+				}
+			},
+			Exp: func(f *stitchingFixture) []*model.StitchProposal { return nil },
+		},
+		{
+			Name:   "Negative window",
+			Query:  manifest,
+			Window: ptrfrom.Int(-1),
+			ExpErr: true,
+		},
+		{
+			Name:   "Unknown artifact",
+			Query:  &model.ArtifactInputSpec{Algorithm: "sha256", Digest: strings.Repeat("f", 64)},
+			ExpErr: true,
+		},
+	}
+	ignoreID := cmp.FilterPath(func(p cmp.Path) bool {
+		return p.Last().String() == ".ID"
+	}, cmp.Ignore())
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			f := newStitchingFixture(t)
+			if test.Setup != nil {
+				test.Setup(t, f)
+			}
+			got, err := f.b.StitchingProposals(ctx, artifactSpec(test.Query), test.Window)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.Exp(f), got, ignoreID, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStitchingProposalsApply(t *testing.T) {
+	ctx := context.Background()
+	f := newStitchingFixture(t)
+	proposals, err := f.b.StitchingProposals(ctx, model.ArtifactSpec{Algorithm: &manifest.Algorithm, Digest: &manifest.Digest}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(proposals) != 1 {
+		t.Fatalf("Unexpected number of proposals, want: 1, got: %d", len(proposals))
+	}
+	for _, p := range proposals {
+		if _, err := f.b.IngestHashEqual(ctx,
+			model.ArtifactInputSpec{Algorithm: p.Artifact.Algorithm, Digest: p.Artifact.Digest},
+			model.ArtifactInputSpec{Algorithm: p.EqualArtifact.Algorithm, Digest: p.EqualArtifact.Digest},
+			model.HashEqualInputSpec{Justification: p.Justification, Origin: "test", Collector: "test"}); err != nil {
+			t.Fatalf("Could not ingest hash equal: %v", err)
+		}
+	}
+
+	// The image ID is now reachable from the manifest digest used by the SBOM.
+	equal, err := f.b.HashEqual(ctx, &model.HashEqualSpec{
+		Artifacts:     []*model.ArtifactSpec{{Algorithm: &manifest.Algorithm, Digest: &manifest.Digest}},
+		Justification: ptrfrom.String(inmem.StitchedByGuac),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(equal) != 1 {
+		t.Fatalf("Unexpected number of HashEqual, want: 1, got: %d", len(equal))
+	}
+	var digests []string
+	for _, a := range equal[0].Artifacts {
+		digests = append(digests, a.Digest)
+	}
+	if diff := cmp.Diff([]string{manifest.Digest, imageID.Digest}, digests, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected HashEqual artifacts. (-want +got):\n%s", diff)
+	}
+
+	// And there is nothing left to stitch.
+	proposals, err = f.b.StitchingProposals(ctx, model.ArtifactSpec{Algorithm: &imageID.Algorithm, Digest: &imageID.Digest}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(proposals) != 0 {
+		t.Errorf("Unexpected proposals after applying: %v", proposals)
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var matchOrigins = []string{
+	"file:///sboms/team-a/foo.spdx.json",
+	"file:///sboms/team-a/bar.spdx.json",
+	"file:///sboms/team-ab/foo.spdx.json",
+	"file:///sboms/team-b/foo.cdx.json",
+	"file:///sboms/team-*/foo.spdx.json",
+}
+
+func TestStringMatch(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, *p2); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	for _, origin := range matchOrigins {
+		_, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1,
+			model.HasSourceAtInputSpec{Origin: origin})
+		if err != nil {
+			t.Fatalf("Could not ingest HasSourceAt: %v", err)
+		}
+		_, err = b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Source: s1}, nil,
+			model.CertifyBadInputSpec{Origin: origin})
+		if err != nil {
+			t.Fatalf("Could not ingest CertifyBad: %v", err)
+		}
+	}
+
+	mode := func(m model.StringMatchMode) *model.StringMatchMode { return &m }
+	tests := []struct {
+		Name   string
+		Mode   *model.StringMatchMode
+		Origin string
+		Exp    []int
+		ExpErr bool
+	}{
+		{
+			Name:   "Exact by default",
+			Origin: "file:///sboms/team-a/foo.spdx.json",
+			Exp:    []int{0},
+		},
+		{
+			Name:   "Exact does not expand wildcards",
+			Mode:   mode(model.StringMatchModeExact),
+			Origin: "file:///sboms/team-*/foo.spdx.json",
+			Exp:    []int{4},
+		},
+		{
+			Name:   "Prefix",
+			Mode:   mode(model.StringMatchModePrefix),
+			Origin: "file:///sboms/team-a",
+			Exp:    []int{0, 1, 2},
+		},
+		{
+			Name:   "Prefix of a directory",
+			Mode:   mode(model.StringMatchModePrefix),
+			Origin: "file:///sboms/team-a/",
+			Exp:    []int{0, 1},
+		},
+		{
+			Name:   "Glob star",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: "file:///sboms/team-a/*",
+			Exp:    []int{0, 1},
+		},
+		{
+			Name:   "Glob star across slashes",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: "*foo.spdx.json",
+			Exp:    []int{0, 2, 4},
+		},
+		{
+			Name:   "Glob question mark",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: "file:///sboms/team-?/foo.*.json",
+			Exp:    []int{0, 3, 4},
+		},
+		{
+			Name:   "Glob must match the whole value",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: "file:///sboms/team-a",
+			Exp:    []int{},
+		},
+		{
+			Name:   "Glob escaped asterisk",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: `file:///sboms/team-\*/*`,
+			Exp:    []int{4},
+		},
+		{
+			Name:   "Glob escaped question mark",
+			Mode:   mode(model.StringMatchModeGlob),
+			Origin: `file:///sboms/team-\?/*`,
+			Exp:    []int{},
+		},
+		{
+			Name:   "Invalid mode",
+			Mode:   mode("FUZZY"),
+			Origin: "file:///sboms/team-a",
+			ExpErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			exp := []string{}
+			for _, i := range test.Exp {
+				exp = append(exp, matchOrigins[i])
+			}
+			sort.Strings(exp)
+
+			hasSourceAts, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Origin: &test.Origin, StringMatch: test.Mode})
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected HasSourceAt error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err == nil {
+				got := []string{}
+				for _, h := range hasSourceAts {
+					got = append(got, h.Origin)
+				}
+				sort.Strings(got)
+				if diff := cmp.Diff(exp, got); diff != "" {
+					t.Errorf("Unexpected HasSourceAt origins (-want +got):\n%s", diff)
+				}
+			}
+
+			// the same semantics on evidence stored differently
+			certifyBads, err := b.CertifyBad(ctx, &model.CertifyBadSpec{Origin: &test.Origin, StringMatch: test.Mode})
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected CertifyBad error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err == nil {
+				got := []string{}
+				for _, h := range certifyBads {
+					got = append(got, h.Origin)
+				}
+				sort.Strings(got)
+				if diff := cmp.Diff(exp, got); diff != "" {
+					t.Errorf("Unexpected CertifyBad origins (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// TestGlobToRegex checks that the regular expressions given to the query
+// languages of other backends match as the testing backend does.
+func TestGlobToRegex(t *testing.T) {
+	glob := model.StringMatchModeGlob
+	patterns := []string{
+		"file:///sboms/team-a/*",
+		"*foo.spdx.json",
+		"file:///sboms/team-?/foo.*.json",
+		`file:///sboms/team-\*/*`,
+		`file:///sboms/team-\?/*`,
+		"file:///sboms/team-(a|b)/*",
+		"file:///sboms/team-[ab]/*",
+		`trailing\`,
+		"**",
+		"",
+	}
+	values := append([]string{"", "trailing\\", "file:///sboms/team-(a|b)/x", "file:///sboms/team-[ab]/x"}, matchOrigins...)
+	for _, pattern := range patterns {
+		re := regexp.MustCompile("^" + helper.GlobToRegex(pattern) + "$")
+		for _, value := range values {
+			if got, want := re.MatchString(value), helper.MatchString(&glob, pattern, value); got != want {
+				t.Errorf("GlobToRegex(%q) matches %q: %v, MatchString: %v", pattern, value, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,334 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Internal data: link between a package and the package superseding it (SupersededBy)
+type supersededByList []*supersededByLink
+type supersededByLink struct {
+	id          string
+	packageID   string
+	successorID string
+	reason      string
+	since       time.Time
+	origin      string
+	collector   string
+}
+
+func (n *supersededByLink) getID() string { return n.id }
+
+func (n *supersededByLink) neighbors() []string { return []string{n.packageID, n.successorID} }
+
+// supersededByLinkKey is the identity of a SupersededBy: ingesting a link
+// with the same key returns the existing one.
+type supersededByLinkKey struct {
+	packageID   string
+	successorID string
+	reason      string
+	since       time.Time
+	origin      string
+	collector   string
+}
+
+func (n *supersededByLink) key() supersededByLinkKey {
+	return supersededByLinkKey{
+		packageID:   n.packageID,
+		successorID: n.successorID,
+		reason:      n.reason,
+		since:       n.since.UTC(),
+		origin:      n.origin,
+		collector:   n.collector,
+	}
+}
+
+// Ingest SupersededBy
+func (c *demoClient) IngestSupersededBy(ctx context.Context, packageArg model.PkgInputSpec, successorArg model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) (*model.SupersededBy, error) {
+	if err := c.ingestSubjects(ctx, []*model.PkgInputSpec{&packageArg, &successorArg}, nil); err != nil {
+		return nil, err
+	}
+	packageID, err := getPackageIDFromInput(c, packageArg, pkgMatchType)
+	if err != nil {
+		return nil, err
+	}
+	successorID, err := getPackageIDFromInput(c, successorArg, pkgMatchType)
+	if err != nil {
+		return nil, err
+	}
+	if packageID == successorID {
+		return nil, backends.InvalidInputf("IngestSupersededBy :: a package cannot supersede itself")
+	}
+
+	collectedSupersededByLink := &supersededByLink{
+		packageID:   packageID,
+		successorID: successorID,
+		reason:      supersededBy.Reason,
+		since:       supersededBy.Since.UTC(),
+		origin:      supersededBy.Origin,
+		collector:   supersededBy.Collector,
+	}
+
+	// Don't insert duplicates
+	if existing, ok := c.supersededByKeys[collectedSupersededByLink.key()]; ok {
+		collectedSupersededByLink = existing
+		c.countIngestion(model.NodeTypeSupersededBy, false)
+	} else {
+		// store the link
+		collectedSupersededByLink.id = c.getNextID()
+		c.index[collectedSupersededByLink.id] = collectedSupersededByLink
+		c.supersededByKeys[collectedSupersededByLink.key()] = collectedSupersededByLink
+		c.recordChange(collectedSupersededByLink.id, model.NodeTypeSupersededBy)
+		c.supersededBys = append(c.supersededBys, collectedSupersededByLink)
+		// set the backlinks
+		c.index[packageID].(pkgNameOrVersion).setSupersededByLink(collectedSupersededByLink.id)
+		c.index[successorID].(pkgNameOrVersion).setSupersededByLink(collectedSupersededByLink.id)
+	}
+
+	// build return GraphQL type
+	return buildSupersededBy(c, collectedSupersededByLink, nil, true)
+}
+
+// Query SupersededBy
+func (c *demoClient) SupersededBy(ctx context.Context, filter *model.SupersededBySpec) ([]*model.SupersededBy, error) {
+	if filter != nil {
+		if err := helper.ValidateStringMatch(filter.StringMatch); err != nil {
+			return nil, err
+		}
+	}
+	if filter != nil && filter.ID != nil {
+		id, err := parseID(*filter.ID)
+		if err != nil {
+			return nil, err
+		}
+		link, err := c.supersededByByID(id)
+		if err != nil {
+			return nil, backends.InvalidInputf("SupersededBy :: ID does not match expected node type for supersededBy")
+		}
+		found, err := buildSupersededBy(c, link, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		return []*model.SupersededBy{found}, nil
+	}
+
+	out := []*model.SupersededBy{}
+	for i, link := range c.supersededBys {
+		if err := checkCanceled(ctx, i); err != nil {
+			return nil, err
+		}
+		if filter != nil && noMatch(filter.Reason, link.reason) {
+			continue
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Origin, link.origin) {
+			continue
+		}
+		if filter != nil && noMatchString(filter.StringMatch, filter.Collector, link.collector) {
+			continue
+		}
+		if filter != nil && filter.Since != nil && !filter.Since.UTC().Equal(link.since) {
+			continue
+		}
+		found, err := buildSupersededBy(c, link, filter, false)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			continue
+		}
+		out = append(out, found)
+	}
+	return out, nil
+}
+
+// Query successors
+//
+// The chain is followed breadth first from all package names and versions
+// matching the spec. Every package node and edge is only visited once, so
+// cycles in the SupersededBy edges terminate.
+func (c *demoClient) Successors(ctx context.Context, pkg model.PkgSpec) ([]*model.SupersededBy, error) {
+	startIDs, err := c.matchingPkgNameOrVersionIDs(&pkg)
+	if err != nil {
+		return nil, err
+	}
+	return c.buildSuccessors(startIDs...)
+}
+
+// buildSuccessors returns the SupersededBy chain starting at the given
+// package names or versions, in the order the edges are reached.
+func (c *demoClient) buildSuccessors(startIDs ...string) ([]*model.SupersededBy, error) {
+	out := []*model.SupersededBy{}
+	visited := map[string]bool{}
+	seenLinks := map[string]bool{}
+	queue := append([]string{}, startIDs...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		for _, link := range c.outgoingSupersededBy(id) {
+			if seenLinks[link.id] {
+				continue
+			}
+			seenLinks[link.id] = true
+			found, err := buildSupersededBy(c, link, nil, true)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, found)
+			queue = append(queue, link.successorID)
+		}
+	}
+	return out, nil
+}
+
+// outgoingSupersededBy returns the SupersededBy edges that apply to the
+// package name or version. Edges on a package name also apply to all of its
+// versions.
+func (c *demoClient) outgoingSupersededBy(id string) []*supersededByLink {
+	ids := []string{id}
+	if v, ok := c.index[id].(*pkgVersionNode); ok {
+		ids = append(ids, v.parent)
+	}
+	var out []*supersededByLink
+	for _, nodeID := range ids {
+		node, ok := c.index[nodeID].(pkgNameOrVersion)
+		if !ok {
+			continue
+		}
+		for _, linkID := range node.getSupersededByLink() {
+			link, err := c.supersededByByID(linkID)
+			if err == nil && link.packageID == nodeID {
+				out = append(out, link)
+			}
+		}
+	}
+	return out
+}
+
+// matchingPkgNameOrVersionIDs returns the IDs of the package versions matching
+// the spec and of their package names, sorted.
+func (c *demoClient) matchingPkgNameOrVersionIDs(filter *model.PkgSpec) ([]string, error) {
+	if filter.ID != nil {
+		id, err := parseID(*filter.ID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := c.index[id].(pkgNameOrVersion); !ok {
+			return nil, backends.InvalidInputf("successors :: ID does not match a package name or version")
+		}
+		return []string{id}, nil
+	}
+	versionFilter := filter.Version != nil || filter.Subpath != nil || len(filter.Qualifiers) > 0 ||
+		(filter.MatchOnlyEmptyQualifiers != nil && *filter.MatchOnlyEmptyQualifiers)
+	out := []string{}
+	for _, namespaces := range c.packages {
+		if noMatch(filter.Type, namespaces.typeKey) {
+			continue
+		}
+		for _, names := range namespaces.namespaces {
+			if noMatch(filter.Namespace, names.namespace) {
+				continue
+			}
+			for _, versions := range names.names {
+				if noMatch(filter.Name, versions.name) {
+					continue
+				}
+				matched := !versionFilter
+				for _, v := range versions.versions {
+					if noMatch(filter.Version, v.version) || noMatch(filter.Subpath, v.subpath) || noMatchQualifiers(filter, v.qualifiers) {
+						continue
+					}
+					out = append(out, v.id)
+					matched = true
+				}
+				if matched {
+					out = append(out, versions.id)
+				}
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+func buildSupersededBy(c *demoClient, link *supersededByLink, filter *model.SupersededBySpec, ingestOrIDProvided bool) (*model.SupersededBy, error) {
+	var p *model.Package
+	var successor *model.Package
+	var err error
+	if filter != nil {
+		p, err = c.buildPackageResponse(link.packageID, filter.Package)
+		if err != nil {
+			return nil, err
+		}
+		successor, err = c.buildPackageResponse(link.successorID, filter.Successor)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		p, err = c.buildPackageResponse(link.packageID, nil)
+		if err != nil {
+			return nil, err
+		}
+		successor, err = c.buildPackageResponse(link.successorID, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// if package not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
+	if p == nil && ingestOrIDProvided {
+		return nil, backends.Internalf("failed to retrieve package via packageID")
+	} else if p == nil && !ingestOrIDProvided {
+		return nil, nil
+	}
+	// if successor not found during ingestion or if ID is provided in filter, send error. On query do not send error to continue search
+	if successor == nil && ingestOrIDProvided {
+		return nil, backends.Internalf("failed to retrieve successor package via successorID")
+	} else if successor == nil && !ingestOrIDProvided {
+		return nil, nil
+	}
+
+	return &model.SupersededBy{
+		ID:        link.id,
+		Package:   p,
+		Successor: successor,
+		Reason:    link.reason,
+		Since:     link.since,
+		Origin:    link.origin,
+		Collector: link.collector,
+	}, nil
+}
+
+func (c *demoClient) supersededByByID(id string) (*supersededByLink, error) {
+	node, ok := c.index[id]
+	if !ok {
+		return nil, backends.NotFoundf("could not find supersededByLink")
+	}
+	link, ok := node.(*supersededByLink)
+	if !ok {
+		return nil, backends.InvalidInputf("not a supersededByLink")
+	}
+	return link, nil
+}
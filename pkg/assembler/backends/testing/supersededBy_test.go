@@ -0,0 +1,272 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var g1 = &model.PkgInputSpec{
+	Type:      "golang",
+	Namespace: ptrfrom.String("github.com/golang"),
+	Name:      "lint",
+	Version:   ptrfrom.String("v0.0.0-20210508222113-6edffad5e616"),
+}
+var g2 = &model.PkgInputSpec{
+	Type:      "golang",
+	Namespace: ptrfrom.String("golang.org/x"),
+	Name:      "lint",
+	Version:   ptrfrom.String("v0.0.0-20210508222113-6edffad5e616"),
+}
+var g3 = &model.PkgInputSpec{
+	Type:      "golang",
+	Namespace: ptrfrom.String("honnef.co/go"),
+	Name:      "tools",
+	Version:   ptrfrom.String("v0.4.3"),
+}
+
+var allVersions = model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}
+
+// seedSupersededBy ingests the two-hop chain
+// github.com/golang/lint -> golang.org/x/lint -> honnef.co/go/tools at the
+// package name level.
+func seedSupersededBy(t *testing.T, ctx context.Context, b backends.Backend) {
+	t.Helper()
+	since := time.Date(2021, 5, 8, 0, 0, 0, 0, time.UTC)
+	for _, p := range []*model.PkgInputSpec{g1, g2, g3} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestSupersededBy(ctx, *g1, *g2, allVersions, model.SupersededByInputSpec{
+		Reason: "module path changed", Since: since, Origin: "deps.dev", Collector: "deps.dev",
+	}); err != nil {
+		t.Fatalf("Could not ingest SupersededBy: %v", err)
+	}
+	if _, err := b.IngestSupersededBy(ctx, *g2, *g3, allVersions, model.SupersededByInputSpec{
+		Reason: "Deprecated: use staticcheck instead", Since: since, Origin: "deps.dev", Collector: "deps.dev",
+	}); err != nil {
+		t.Fatalf("Could not ingest SupersededBy: %v", err)
+	}
+}
+
+// chain summarizes SupersededBy edges as "package -> successor" names
+func chain(edges []*model.SupersededBy) []string {
+	out := []string{}
+	for _, e := range edges {
+		out = append(out, pkgName(e.Package)+" -> "+pkgName(e.Successor))
+	}
+	return out
+}
+
+func pkgName(p *model.Package) string {
+	ns := p.Namespaces[0]
+	name := ns.Names[0]
+	out := ns.Namespace + "/" + name.Name
+	if len(name.Versions) > 0 {
+		out += "@" + name.Versions[0].Version
+	}
+	return out
+}
+
+func TestSuccessors(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Extra func(t *testing.T, ctx context.Context, b backends.Backend)
+		Query model.PkgSpec
+		Exp   []string
+	}{
+		{
+			Name:  "Two hops from the package name",
+			Query: model.PkgSpec{Namespace: ptrfrom.String("github.com/golang"), Name: ptrfrom.String("lint")},
+			Exp: []string{
+				"github.com/golang/lint -> golang.org/x/lint",
+				"golang.org/x/lint -> honnef.co/go/tools",
+			},
+		},
+		{
+			Name:  "Name level edges apply to versions",
+			Query: model.PkgSpec{Name: ptrfrom.String("lint"), Version: ptrfrom.String("v0.0.0-20210508222113-6edffad5e616")},
+			Exp: []string{
+				"github.com/golang/lint -> golang.org/x/lint",
+				"golang.org/x/lint -> honnef.co/go/tools",
+			},
+		},
+		{
+			Name:  "Middle of the chain",
+			Query: model.PkgSpec{Namespace: ptrfrom.String("golang.org/x")},
+			Exp:   []string{"golang.org/x/lint -> honnef.co/go/tools"},
+		},
+		{
+			Name:  "End of the chain",
+			Query: model.PkgSpec{Name: ptrfrom.String("tools")},
+			Exp:   []string{},
+		},
+		{
+			Name:  "Unknown version",
+			Query: model.PkgSpec{Namespace: ptrfrom.String("github.com/golang"), Version: ptrfrom.String("v1.0.0")},
+			Exp:   []string{},
+		},
+		{
+			Name: "Cycle",
+			Extra: func(t *testing.T, ctx context.Context, b backends.Backend) {
+				if _, err := b.IngestSupersededBy(ctx, *g3, *g1, allVersions, model.SupersededByInputSpec{Reason: "cycle"}); err != nil {
+					t.Fatalf("Could not ingest SupersededBy: %v", err)
+				}
+			},
+			Query: model.PkgSpec{Namespace: ptrfrom.String("github.com/golang")},
+			Exp: []string{
+				"github.com/golang/lint -> golang.org/x/lint",
+				"golang.org/x/lint -> honnef.co/go/tools",
+				"honnef.co/go/tools -> github.com/golang/lint",
+			},
+		},
+		{
+			Name: "Version level edge",
+			Extra: func(t *testing.T, ctx context.Context, b backends.Backend) {
+				if _, err := b.IngestSupersededBy(ctx, *g3, *g2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+					model.SupersededByInputSpec{Reason: "retracted"}); err != nil {
+					t.Fatalf("Could not ingest SupersededBy: %v", err)
+				}
+			},
+			Query: model.PkgSpec{Name: ptrfrom.String("tools"), Version: ptrfrom.String("v0.4.3")},
+			Exp: []string{
+				"honnef.co/go/tools@v0.4.3 -> golang.org/x/lint@v0.0.0-20210508222113-6edffad5e616",
+				"golang.org/x/lint -> honnef.co/go/tools",
+			},
+		},
+	}
+	ctx := context.Background()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			seedSupersededBy(t, ctx, b)
+			if test.Extra != nil {
+				test.Extra(t, ctx, b)
+			}
+			got, err := b.Successors(ctx, test.Query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Exp, chain(got)); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSupersededBy(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedSupersededBy(t, ctx, b)
+
+	// re-ingesting is deduplicated
+	if _, err := b.IngestSupersededBy(ctx, *g1, *g2, allVersions, model.SupersededByInputSpec{
+		Reason: "module path changed", Since: time.Date(2021, 5, 8, 0, 0, 0, 0, time.UTC), Origin: "deps.dev", Collector: "deps.dev",
+	}); err != nil {
+		t.Fatalf("Could not ingest SupersededBy: %v", err)
+	}
+	all, err := b.SupersededBy(ctx, &model.SupersededBySpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("expected 2 SupersededBy, got %d", len(all))
+	}
+
+	got, err := b.SupersededBy(ctx, &model.SupersededBySpec{Successor: &model.PkgSpec{Name: ptrfrom.String("tools")}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"golang.org/x/lint -> honnef.co/go/tools"}, chain(got)); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	if _, err := b.IngestSupersededBy(ctx, *g1, *g1, allVersions, model.SupersededByInputSpec{}); err == nil {
+		t.Errorf("expected an error when a package supersedes itself")
+	}
+	if _, err := b.IngestSupersededBy(ctx, *g1, *p5, allVersions, model.SupersededByInputSpec{}); err == nil {
+		t.Errorf("expected an error when the successor was not ingested")
+	}
+}
+
+func TestSuccessorAnnotations(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	seedSupersededBy(t, ctx, b)
+	if _, err := b.IngestPackage(ctx, *p5); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest cve: %v", err)
+	}
+	if _, err := b.IngestVulnerability(ctx, *g1, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	if _, err := b.IngestDependency(ctx, *p5, *g1, model.IsDependencyInputSpec{}); err != nil {
+		t.Fatalf("Could not ingest dependency: %v", err)
+	}
+	exp := []string{
+		"github.com/golang/lint -> golang.org/x/lint",
+		"golang.org/x/lint -> honnef.co/go/tools",
+	}
+
+	vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(vulns) != 1 || vulns[0].Successors != nil {
+		t.Errorf("expected one CertifyVuln without successors, got %v", vulns)
+	}
+	vulns, err = b.CertifyVuln(ctx, &model.CertifyVulnSpec{IncludeSuccessors: ptrfrom.Bool(true)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("expected one CertifyVuln, got %d", len(vulns))
+	}
+	if diff := cmp.Diff(exp, chain(vulns[0].Successors)); diff != "" {
+		t.Errorf("Unexpected CertifyVuln successors. (-want +got):\n%s", diff)
+	}
+
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{IncludeSuccessors: ptrfrom.Bool(true)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected one IsDependency, got %d", len(deps))
+	}
+	if diff := cmp.Diff(exp, chain(deps[0].Successors)); diff != "" {
+		t.Errorf("Unexpected IsDependency successors. (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,424 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Visualize
+//
+// The graph drawn by Visualize has a node for every node of the index: the
+// software tree nodes, linked to their parent, and the evidence, linked to
+// the nodes it refers to. Evidence kept out of the index has no ID and isn't
+// drawn, as for Neighbors.
+//
+// IDs are random, so nodes are ordered by their content, the path of their
+// software tree or the kind and subjects of the evidence, and named by their
+// position in that order. Ingesting the same evidence, in any order, draws the
+// same graph.
+
+// The formats written by Visualize.
+const (
+	VisualizeDOT     = "dot"
+	VisualizeGraphML = "graphml"
+)
+
+// VisualizeOptions restricts the graph written by Visualize.
+type VisualizeOptions struct {
+	// Node, when set, restricts the graph to the nodes at most Hops edges
+	// away from the node with this ID, as followed by Neighbors.
+	Node string
+	Hops int
+	// IDs adds the IDs of the nodes, which differ from one run to the next.
+	IDs bool
+}
+
+type visualNode struct {
+	id    string
+	kind  string
+	label string
+	props []visualProp
+	// parent is the parent of a software tree node, targets the nodes an
+	// evidence node refers to
+	parent   string
+	evidence bool
+	targets  []string
+	// path identifies the node by its content, position is its rank by path
+	// among the drawn nodes
+	path     string
+	position int
+}
+
+type visualProp struct {
+	name, value string
+}
+
+// Visualize writes the graph of the client to w in format, VisualizeDOT or
+// VisualizeGraphML, restricted by options if not nil.
+func (c *demoClient) Visualize(w io.Writer, format string, options *VisualizeOptions) error {
+	if format != VisualizeDOT && format != VisualizeGraphML {
+		return backends.InvalidInputf("visualize :: unknown format %q, expected %s or %s", format, VisualizeDOT, VisualizeGraphML)
+	}
+	ids, err := c.visualIDs(options)
+	if err != nil {
+		return fmt.Errorf("visualize :: %w", err)
+	}
+	// the paths of the nodes outside of a neighborhood order their neighbors
+	all := map[string]*visualNode{}
+	for id := range c.index {
+		all[id] = c.visualNode(id)
+	}
+	nodes := map[string]*visualNode{}
+	for _, id := range ids {
+		nodes[id] = all[id]
+	}
+	sorted := sortVisualNodes(all, nodes)
+	if format == VisualizeDOT {
+		err = writeDOT(w, sorted, nodes, options != nil && options.IDs)
+	} else {
+		err = writeGraphML(w, sorted, nodes, options != nil && options.IDs)
+	}
+	if err != nil {
+		return fmt.Errorf("visualize :: %w", err)
+	}
+	return nil
+}
+
+// visualIDs returns the IDs of the nodes to draw: all of them, or the ones
+// around options.Node.
+func (c *demoClient) visualIDs(options *VisualizeOptions) ([]string, error) {
+	if options == nil || options.Node == "" {
+		return maps.Keys(c.index), nil
+	}
+	if options.Hops < 0 {
+		return nil, backends.InvalidInputf("hops must not be negative")
+	}
+	start, err := parseID(options.Node)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := c.index[start]; !ok {
+		return nil, backends.NotFoundf("ID %s does not match existing node", start)
+	}
+	seen := map[string]bool{start: true}
+	frontier := []string{start}
+	for hop := 0; hop < options.Hops && len(frontier) > 0; hop++ {
+		var next []string
+		for _, id := range frontier {
+			for _, neighbor := range c.index[id].neighbors() {
+				if !seen[neighbor] {
+					seen[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		frontier = next
+	}
+	return maps.Keys(seen), nil
+}
+
+// visualNode describes the node with the given ID, without its name and path.
+func (c *demoClient) visualNode(id string) *visualNode {
+	v := &visualNode{id: id}
+	// evidence refers to its subjects, as listed by neighbors
+	provenance := func(justification, origin, collector string) {
+		v.props = appendProps(v.props, "justification", justification, "origin", origin, "collector", collector)
+		v.evidence = true
+		v.targets = c.index[id].neighbors()
+	}
+	switch n := c.index[id].(type) {
+	case *pkgNamespaceStruct:
+		v.kind, v.label = "PackageType", n.typeKey
+	case *pkgNameStruct:
+		v.kind, v.label, v.parent = "PackageNamespace", n.namespace, n.parent
+	case *pkgVersionStruct:
+		v.kind, v.label, v.parent = "PackageName", n.name, n.parent
+	case *pkgVersionNode:
+		v.kind, v.label, v.parent = "PackageVersion", n.version, n.parent
+		qualifiers := []string{}
+		for _, k := range sortedIDs(maps.Keys(n.qualifiers)) {
+			qualifiers = append(qualifiers, k+"="+n.qualifiers[k])
+		}
+		v.props = appendProps(v.props, "subpath", n.subpath, "qualifiers", strings.Join(qualifiers, ","))
+	case *srcNamespaceStruct:
+		v.kind, v.label = "SourceType", n.typeKey
+	case *srcNameStruct:
+		v.kind, v.label, v.parent = "SourceNamespace", n.namespace, n.parent
+	case *srcNameNode:
+		v.kind, v.label, v.parent = "SourceName", n.name, n.parent
+		v.props = appendProps(v.props, "tag", n.tag, "commit", n.commit)
+	case *artStruct:
+		v.kind, v.label = "Artifact", n.algorithm+":"+n.digest
+	case *builderStruct:
+		v.kind, v.label = "Builder", n.uri
+	case *osvNode:
+		v.kind, v.label = "OSVType", n.typeKey
+	case *osvIDNode:
+		v.kind, v.label, v.parent = "OSV", n.osvID, n.parent
+	case *cveNode:
+		v.kind, v.label = "CVEYear", strconv.Itoa(n.year)
+	case *cveIDNode:
+		v.kind, v.label, v.parent = "CVE", n.cveID, n.parent
+	case *ghsaNode:
+		v.kind, v.label = "GHSAType", n.typeKey
+	case *ghsaIDNode:
+		v.kind, v.label, v.parent = "GHSA", n.ghsaID, n.parent
+	case *noVulnNode:
+		v.kind = "NoVuln"
+	case *srcMapLink:
+		v.kind = "HasSourceAt"
+		provenance(n.justification, n.origin, n.collector)
+	case *isDependencyLink:
+		v.kind = "IsDependency"
+		v.props = appendProps(v.props, "versionRange", n.versionRange)
+		provenance(n.justification, n.origin, n.collector)
+	case *scorecardLink:
+		v.kind = "CertifyScorecard"
+		provenance("", n.origin, n.collector)
+	case *hashEqualStruct:
+		v.kind = "HashEqual"
+		provenance(n.justification, n.origin, n.collector)
+	case *isOccurrenceStruct:
+		v.kind = "IsOccurrence"
+		provenance(n.justification, n.origin, n.collector)
+	case *vulnerabilityLink:
+		v.kind = "CertifyVuln"
+		provenance("", n.origin, n.collector)
+	case *equalVulnerabilityLink:
+		v.kind = "IsVulnerability"
+		provenance(n.justification, n.origin, n.collector)
+	case *hasSLSAStruct:
+		v.kind = "HasSLSA"
+		provenance("", n.origin, n.collector)
+	case *severityOverrideLink:
+		v.kind = "SeverityOverride"
+		provenance(n.justification, n.origin, n.collector)
+	case *certifySignedStruct:
+		v.kind = "CertifySigned"
+		provenance("", n.origin, n.collector)
+	case *supersededByLink:
+		v.kind = "SupersededBy"
+		v.props = appendProps(v.props, "reason", n.reason)
+		provenance("", n.origin, n.collector)
+	}
+	return v
+}
+
+// appendProps appends the properties given as name and value pairs, skipping
+// the empty values.
+func appendProps(props []visualProp, pairs ...string) []visualProp {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			props = append(props, visualProp{pairs[i], pairs[i+1]})
+		}
+	}
+	return props
+}
+
+// sortVisualNodes sets the paths of all the nodes, and returns the ones to
+// draw in path order, setting their positions. Nodes with the same path keep
+// the order of their IDs.
+func sortVisualNodes(all, nodes map[string]*visualNode) []*visualNode {
+	var pathOf func(id string) string
+	pathOf = func(id string) string {
+		v := all[id]
+		if v.path != "" {
+			return v.path
+		}
+		var sb strings.Builder
+		if v.parent != "" {
+			sb.WriteString(pathOf(v.parent))
+			sb.WriteString("/")
+		}
+		sb.WriteString(v.kind)
+		sb.WriteString(":")
+		sb.WriteString(v.label)
+		if len(v.targets) > 0 {
+			targets := []string{}
+			for _, target := range v.targets {
+				targets = append(targets, pathOf(target))
+			}
+			sort.Strings(targets)
+			sb.WriteString("(")
+			sb.WriteString(strings.Join(targets, ","))
+			sb.WriteString(")")
+		}
+		for _, p := range v.props {
+			sb.WriteString(" ")
+			sb.WriteString(p.name)
+			sb.WriteString("=")
+			sb.WriteString(p.value)
+		}
+		v.path = sb.String()
+		return v.path
+	}
+	sorted := []*visualNode{}
+	for _, id := range sortedIDs(maps.Keys(nodes)) {
+		pathOf(id)
+		sorted = append(sorted, nodes[id])
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].path < sorted[j].path })
+	for i, v := range sorted {
+		v.position = i
+	}
+	return sorted
+}
+
+// visualEdges returns the edges between the nodes, from the parent of a
+// software tree node and from evidence to its subjects, in node order.
+func visualEdges(sorted []*visualNode, nodes map[string]*visualNode) [][2]*visualNode {
+	edges := [][2]*visualNode{}
+	for _, v := range sorted {
+		if parent, ok := nodes[v.parent]; ok {
+			edges = append(edges, [2]*visualNode{parent, v})
+		}
+		for _, id := range v.targets {
+			if target, ok := nodes[id]; ok {
+				edges = append(edges, [2]*visualNode{v, target})
+			}
+		}
+	}
+	slices.SortStableFunc(edges, func(a, b [2]*visualNode) bool {
+		if a[0] != b[0] {
+			return a[0].position < b[0].position
+		}
+		return a[1].position < b[1].position
+	})
+	return edges
+}
+
+// name returns the name of a drawn node.
+func (v *visualNode) name() string {
+	return "n" + strconv.Itoa(v.position)
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeDOT(w io.Writer, sorted []*visualNode, nodes map[string]*visualNode, ids bool) error {
+	var sb strings.Builder
+	sb.WriteString("digraph guac {\n\tnode [shape=box];\n")
+	for _, v := range sorted {
+		lines := []string{v.kind}
+		if v.label != "" {
+			lines = append(lines, v.label)
+		}
+		for _, p := range v.props {
+			lines = append(lines, p.name+": "+p.value)
+		}
+		for i, line := range lines {
+			lines[i] = dotEscaper.Replace(line)
+		}
+		fmt.Fprintf(&sb, "\t%s [label=\"%s\"", v.name(), strings.Join(lines, `\n`))
+		if v.evidence {
+			sb.WriteString(", shape=ellipse")
+		}
+		if ids {
+			fmt.Fprintf(&sb, ", id=\"%s\"", v.id)
+		}
+		sb.WriteString("];\n")
+	}
+	for _, e := range visualEdges(sorted, nodes) {
+		fmt.Fprintf(&sb, "\t%s -> %s;\n", e[0].name(), e[1].name())
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLEdge struct {
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+func writeGraphML(w io.Writer, sorted []*visualNode, nodes map[string]*visualNode, ids bool) error {
+	g := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{ID: "guac", EdgeDefault: "directed"},
+	}
+	names := map[string]bool{}
+	for _, v := range sorted {
+		n := graphMLNode{ID: v.name(), Data: []graphMLData{{Key: "kind", Value: v.kind}}}
+		if v.label != "" {
+			n.Data = append(n.Data, graphMLData{Key: "label", Value: v.label})
+		}
+		if ids {
+			n.Data = append(n.Data, graphMLData{Key: "id", Value: v.id})
+		}
+		for _, p := range v.props {
+			n.Data = append(n.Data, graphMLData{Key: p.name, Value: p.value})
+			names[p.name] = true
+		}
+		g.Graph.Nodes = append(g.Graph.Nodes, n)
+	}
+	keys := []string{"kind", "label"}
+	if ids {
+		keys = append(keys, "id")
+	}
+	keys = append(keys, sortedIDs(maps.Keys(names))...)
+	for _, key := range keys {
+		g.Keys = append(g.Keys, graphMLKey{ID: key, For: "node", Name: key, Type: "string"})
+	}
+	for _, e := range visualEdges(sorted, nodes) {
+		g.Graph.Edges = append(g.Graph.Edges, graphMLEdge{Source: e[0].name(), Target: e[1].name()})
+	}
+	out, err := xml.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, out)
+	return err
+}
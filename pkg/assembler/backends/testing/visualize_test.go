@@ -0,0 +1,196 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of testdata")
+
+// ingestVisualizeFixture ingests a small graph, in the given order of its
+// evidence, and returns the ID of its HasSourceAt.
+func ingestVisualizeFixture(t *testing.T, b backends.Backend, reversed bool) string {
+	t.Helper()
+	ctx := context.Background()
+	for _, p := range []*model.PkgInputSpec{p2, p4} {
+		if _, err := b.IngestPackage(ctx, *p); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestSource(ctx, *s1); err != nil {
+		t.Fatalf("Could not ingest source: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, a1); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, c1); err != nil {
+		t.Fatalf("Could not ingest CVE: %v", err)
+	}
+	var hasSourceAtID string
+	ingest := []func(){
+		func() {
+			hasSourceAt, err := b.IngestHasSourceAt(ctx, *p2, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, *s1,
+				model.HasSourceAtInputSpec{Justification: "source \"main\"", Origin: "origin-a", Collector: "collector-a"})
+			if err != nil {
+				t.Fatalf("Could not ingest HasSourceAt: %v", err)
+			}
+			hasSourceAtID = hasSourceAt.ID
+		},
+		func() {
+			if _, err := b.IngestDependency(ctx, *p2, *p4, model.IsDependencyInputSpec{VersionRange: "^1.0.0", Justification: "direct", Origin: "origin-b", Collector: "collector-b"}); err != nil {
+				t.Fatalf("Could not ingest IsDependency: %v", err)
+			}
+		},
+		func() {
+			if _, err := b.IngestVulnerability(ctx, *p2, model.OsvCveOrGhsaInput{Cve: c1}, model.VulnerabilityMetaDataInput{Origin: "origin-a", Collector: "collector-c"}); err != nil {
+				t.Fatalf("Could not ingest CertifyVuln: %v", err)
+			}
+		},
+		func() {
+			if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: p2}, *a1, model.IsOccurrenceInputSpec{Justification: "built"}); err != nil {
+				t.Fatalf("Could not ingest IsOccurrence: %v", err)
+			}
+		},
+	}
+	for i := range ingest {
+		if reversed {
+			ingest[len(ingest)-1-i]()
+		} else {
+			ingest[i]()
+		}
+	}
+	return hasSourceAtID
+}
+
+func TestVisualize(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	hasSourceAtID := ingestVisualizeFixture(t, b, false)
+
+	tests := []struct {
+		Name    string
+		Format  string
+		Options *inmem.VisualizeOptions
+		Golden  string
+		ExpErr  error
+	}{
+		{
+			Name:   "DOT",
+			Format: inmem.VisualizeDOT,
+			Golden: "visualize.dot",
+		},
+		{
+			Name:   "GraphML",
+			Format: inmem.VisualizeGraphML,
+			Golden: "visualize.graphml",
+		},
+		{
+			Name:    "Neighborhood",
+			Format:  inmem.VisualizeDOT,
+			Options: &inmem.VisualizeOptions{Node: hasSourceAtID, Hops: 2},
+			Golden:  "visualize_neighborhood.dot",
+		},
+		{
+			Name:    "Single node",
+			Format:  inmem.VisualizeDOT,
+			Options: &inmem.VisualizeOptions{Node: hasSourceAtID},
+			Golden:  "visualize_node.dot",
+		},
+		{
+			Name:   "Unknown format",
+			Format: "svg",
+			ExpErr: backends.ErrInvalidInput,
+		},
+		{
+			Name:    "Unknown node",
+			Format:  inmem.VisualizeDOT,
+			Options: &inmem.VisualizeOptions{Node: unknownID, Hops: 1},
+			ExpErr:  backends.ErrNotFound,
+		},
+		{
+			Name:    "Negative hops",
+			Format:  inmem.VisualizeDOT,
+			Options: &inmem.VisualizeOptions{Node: hasSourceAtID, Hops: -1},
+			ExpErr:  backends.ErrInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got bytes.Buffer
+			err := b.(inmem.Visualizer).Visualize(ctx, &got, test.Format, test.Options)
+			if !errors.Is(err, test.ExpErr) {
+				t.Fatalf("did not get expected error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			golden := filepath.Join("testdata", test.Golden)
+			if *updateGolden {
+				if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+					t.Fatalf("Could not update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Could not read golden file: %v", err)
+			}
+			if diff := cmp.Diff(string(want), got.String()); diff != "" {
+				t.Errorf("Unexpected graph (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// the same evidence ingested in another order draws the same graph
+	other, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	ingestVisualizeFixture(t, other, true)
+	var first, second bytes.Buffer
+	if err := b.(inmem.Visualizer).Visualize(ctx, &first, inmem.VisualizeDOT, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := other.(inmem.Visualizer).Visualize(ctx, &second, inmem.VisualizeDOT, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(first.String(), second.String()); diff != "" {
+		t.Errorf("Graph depends on the ingestion order (-first +second):\n%s", diff)
+	}
+
+	var withIDs bytes.Buffer
+	if err := b.(inmem.Visualizer).Visualize(ctx, &withIDs, inmem.VisualizeGraphML, &inmem.VisualizeOptions{IDs: true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(withIDs.String(), hasSourceAtID) {
+		t.Errorf("expected the graph to contain the ID %s, got:\n%s", hasSourceAtID, withIDs.String())
+	}
+}
@@ -25,6 +25,24 @@ func (v *ArtifactInputSpec) GetAlgorithm() string { return v.Algorithm }
 // GetDigest returns ArtifactInputSpec.Digest, and is useful for accessing the field via an interface.
 func (v *ArtifactInputSpec) GetDigest() string { return v.Digest }
 
+// ArtifactSpec allows filtering the list of artifacts to return.
+//
+// Both arguments will be canonicalized to lowercase.
+type ArtifactSpec struct {
+	Id        *string `json:"id"`
+	Algorithm *string `json:"algorithm"`
+	Digest    *string `json:"digest"`
+}
+
+// GetId returns ArtifactSpec.Id, and is useful for accessing the field via an interface.
+func (v *ArtifactSpec) GetId() *string { return v.Id }
+
+// GetAlgorithm returns ArtifactSpec.Algorithm, and is useful for accessing the field via an interface.
+func (v *ArtifactSpec) GetAlgorithm() *string { return v.Algorithm }
+
+// GetDigest returns ArtifactSpec.Digest, and is useful for accessing the field via an interface.
+func (v *ArtifactSpec) GetDigest() *string { return v.Digest }
+
 // BuilderInputSpec is the same as Builder, but used for mutation ingestion.
 type BuilderInputSpec struct {
 	Uri string `json:"uri"`
@@ -34,6 +52,8 @@ type BuilderInputSpec struct {
 func (v *BuilderInputSpec) GetUri() string { return v.Uri }
 
 // CVEInputSpec is the same as CVESpec, but used for mutation ingestion.
+//
+// The year must be the one of the ID, e.g. 2021 for CVE-2021-44228.
 type CVEInputSpec struct {
 	Year  int    `json:"year"`
 	CveId string `json:"cveId"`
@@ -127,6 +147,8 @@ func (v *CertifyBadArtifactIngestArtifact) __premarshalJSON() (*__premarshalCert
 // justification (property) - string value representing why the subject is considered bad
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+// certifications ingested without one
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type CertifyBadArtifactIngestCertifyBad struct {
@@ -221,11 +243,12 @@ func (v *CertifyBadArtifactResponse) GetIngestCertifyBad() CertifyBadArtifactIng
 
 // CertifyBadInputSpec is the same as CertifyBad but for mutation input.
 //
-// All fields are required.
+// All fields are required, except knownSince which defaults to the zero time.
 type CertifyBadInputSpec struct {
-	Justification string `json:"justification"`
-	Origin        string `json:"origin"`
-	Collector     string `json:"collector"`
+	Justification string     `json:"justification"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+	KnownSince    *time.Time `json:"knownSince"`
 }
 
 // GetJustification returns CertifyBadInputSpec.Justification, and is useful for accessing the field via an interface.
@@ -237,6 +260,9 @@ func (v *CertifyBadInputSpec) GetOrigin() string { return v.Origin }
 // GetCollector returns CertifyBadInputSpec.Collector, and is useful for accessing the field via an interface.
 func (v *CertifyBadInputSpec) GetCollector() string { return v.Collector }
 
+// GetKnownSince returns CertifyBadInputSpec.KnownSince, and is useful for accessing the field via an interface.
+func (v *CertifyBadInputSpec) GetKnownSince() *time.Time { return v.KnownSince }
+
 // CertifyBadPkgIngestCertifyBad includes the requested fields of the GraphQL type CertifyBad.
 // The GraphQL type's documentation follows.
 //
@@ -246,6 +272,8 @@ func (v *CertifyBadInputSpec) GetCollector() string { return v.Collector }
 // justification (property) - string value representing why the subject is considered bad
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+// certifications ingested without one
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type CertifyBadPkgIngestCertifyBad struct {
@@ -425,6 +453,8 @@ func (v *CertifyBadPkgResponse) GetIngestCertifyBad() CertifyBadPkgIngestCertify
 // justification (property) - string value representing why the subject is considered bad
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+// certifications ingested without one
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type CertifyBadSrcIngestCertifyBad struct {
@@ -1643,6 +1673,95 @@ func (v *CertifyPkgResponse) GetIngestCertifyPkg() CertifyPkgIngestCertifyPkg {
 	return v.IngestCertifyPkg
 }
 
+// CertifySignedIngestArtifact includes the requested fields of the GraphQL type Artifact.
+// The GraphQL type's documentation follows.
+//
+// # Artifact represents the artifact and contains a digest field
+//
+// Both field are mandatory and canonicalized to be lowercase.
+//
+// If having a `checksum` Go object, `algorithm` can be
+// `strings.ToLower(string(checksum.Algorithm))` and `digest` can be
+// `checksum.Value`.
+type CertifySignedIngestArtifact struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CertifySignedIngestArtifact.Id, and is useful for accessing the field via an interface.
+func (v *CertifySignedIngestArtifact) GetId() string { return v.Id }
+
+// CertifySignedIngestCertifySigned includes the requested fields of the GraphQL type CertifySigned.
+// The GraphQL type's documentation follows.
+//
+// CertifySigned is an attestation that represents a signature found on an artifact.
+//
+// artifact (subject) - the artifact that is signed
+// signer (property) - the identity of the signer (key name, certificate subject or email)
+// signatureType (property) - the kind of signature (cosign, notation, gpg)
+// status (property) - whether the signature was verified
+// verifiedAt (property) - the time the signature was last checked
+// trustRoot (property) - the key or root certificate the signature was checked against, empty if none applied
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+type CertifySignedIngestCertifySigned struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CertifySignedIngestCertifySigned.Id, and is useful for accessing the field via an interface.
+func (v *CertifySignedIngestCertifySigned) GetId() string { return v.Id }
+
+// CertifySignedInputSpec is the same as CertifySigned but for mutation input.
+//
+// All fields are required.
+type CertifySignedInputSpec struct {
+	Signer        string          `json:"signer"`
+	SignatureType SignatureType   `json:"signatureType"`
+	Status        SignatureStatus `json:"status"`
+	VerifiedAt    time.Time       `json:"verifiedAt"`
+	TrustRoot     string          `json:"trustRoot"`
+	Origin        string          `json:"origin"`
+	Collector     string          `json:"collector"`
+}
+
+// GetSigner returns CertifySignedInputSpec.Signer, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetSigner() string { return v.Signer }
+
+// GetSignatureType returns CertifySignedInputSpec.SignatureType, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetSignatureType() SignatureType { return v.SignatureType }
+
+// GetStatus returns CertifySignedInputSpec.Status, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetStatus() SignatureStatus { return v.Status }
+
+// GetVerifiedAt returns CertifySignedInputSpec.VerifiedAt, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetVerifiedAt() time.Time { return v.VerifiedAt }
+
+// GetTrustRoot returns CertifySignedInputSpec.TrustRoot, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetTrustRoot() string { return v.TrustRoot }
+
+// GetOrigin returns CertifySignedInputSpec.Origin, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetOrigin() string { return v.Origin }
+
+// GetCollector returns CertifySignedInputSpec.Collector, and is useful for accessing the field via an interface.
+func (v *CertifySignedInputSpec) GetCollector() string { return v.Collector }
+
+// CertifySignedResponse is returned by CertifySigned on success.
+type CertifySignedResponse struct {
+	// Ingest a new artifact. Returns the ingested artifact
+	IngestArtifact CertifySignedIngestArtifact `json:"ingestArtifact"`
+	// certify that an artifact is signed
+	IngestCertifySigned CertifySignedIngestCertifySigned `json:"ingestCertifySigned"`
+}
+
+// GetIngestArtifact returns CertifySignedResponse.IngestArtifact, and is useful for accessing the field via an interface.
+func (v *CertifySignedResponse) GetIngestArtifact() CertifySignedIngestArtifact {
+	return v.IngestArtifact
+}
+
+// GetIngestCertifySigned returns CertifySignedResponse.IngestCertifySigned, and is useful for accessing the field via an interface.
+func (v *CertifySignedResponse) GetIngestCertifySigned() CertifySignedIngestCertifySigned {
+	return v.IngestCertifySigned
+}
+
 // GHSAInputSpec is the same as GHSASpec, but used for mutation ingestion.
 type GHSAInputSpec struct {
 	GhsaId string `json:"ghsaId"`
@@ -1651,13 +1770,96 @@ type GHSAInputSpec struct {
 // GetGhsaId returns GHSAInputSpec.GhsaId, and is useful for accessing the field via an interface.
 func (v *GHSAInputSpec) GetGhsaId() string { return v.GhsaId }
 
+// GoModulesPackagesPackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Package represents a package.
+//
+// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
+// The `type` field matches the pURL types but we might also use `"guac"` for the
+// cases where the pURL representation is not complete or when we have custom
+// rules.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Package`, not `PackageType`. This is only to make
+// queries more readable.
+type GoModulesPackagesPackage struct {
+	Namespaces []GoModulesPackagesPackageNamespacesPackageNamespace `json:"namespaces"`
+}
+
+// GetNamespaces returns GoModulesPackagesPackage.Namespaces, and is useful for accessing the field via an interface.
+func (v *GoModulesPackagesPackage) GetNamespaces() []GoModulesPackagesPackageNamespacesPackageNamespace {
+	return v.Namespaces
+}
+
+// GoModulesPackagesPackageNamespacesPackageNamespace includes the requested fields of the GraphQL type PackageNamespace.
+// The GraphQL type's documentation follows.
+//
+// PackageNamespace is a namespace for packages.
+//
+// In the pURL representation, each PackageNamespace matches the
+// `pkg:<type>/<namespace>/` partial pURL.
+//
+// Namespaces are optional and type specific. Because they are optional, we use
+// empty string to denote missing namespaces.
+type GoModulesPackagesPackageNamespacesPackageNamespace struct {
+	Namespace string                                                               `json:"namespace"`
+	Names     []GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName `json:"names"`
+}
+
+// GetNamespace returns GoModulesPackagesPackageNamespacesPackageNamespace.Namespace, and is useful for accessing the field via an interface.
+func (v *GoModulesPackagesPackageNamespacesPackageNamespace) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetNames returns GoModulesPackagesPackageNamespacesPackageNamespace.Names, and is useful for accessing the field via an interface.
+func (v *GoModulesPackagesPackageNamespacesPackageNamespace) GetNames() []GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName {
+	return v.Names
+}
+
+// GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName includes the requested fields of the GraphQL type PackageName.
+// The GraphQL type's documentation follows.
+//
+// PackageName is a name for packages.
+//
+// In the pURL representation, each PackageName matches the
+// `pkg:<type>/<namespace>/<name>` pURL.
+//
+// Names are always mandatory.
+//
+// This is the first node in the trie that can be referred to by other parts of
+// GUAC.
+type GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName struct {
+	Name string `json:"name"`
+}
+
+// GetName returns GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName.Name, and is useful for accessing the field via an interface.
+func (v *GoModulesPackagesPackageNamespacesPackageNamespaceNamesPackageName) GetName() string {
+	return v.Name
+}
+
+// GoModulesResponse is returned by GoModules on success.
+type GoModulesResponse struct {
+	// Returns all packages
+	Packages []GoModulesPackagesPackage `json:"packages"`
+}
+
+// GetPackages returns GoModulesResponse.Packages, and is useful for accessing the field via an interface.
+func (v *GoModulesResponse) GetPackages() []GoModulesPackagesPackage { return v.Packages }
+
 // HasSBOMInputSpec is the same as HasSBOM but for mutation input.
 //
-// All fields are required.
+// All fields are required, except documentHash which is only set when the
+// original document was stored, and fileMode which is only set by parsers that
+// can leave out the file level entries.
 type HasSBOMInputSpec struct {
-	Uri       string `json:"uri"`
-	Origin    string `json:"origin"`
-	Collector string `json:"collector"`
+	Uri          string  `json:"uri"`
+	Origin       string  `json:"origin"`
+	Collector    string  `json:"collector"`
+	DocumentHash *string `json:"documentHash"`
+	FileMode     *string `json:"fileMode"`
 }
 
 // GetUri returns HasSBOMInputSpec.Uri, and is useful for accessing the field via an interface.
@@ -1669,6 +1871,12 @@ func (v *HasSBOMInputSpec) GetOrigin() string { return v.Origin }
 // GetCollector returns HasSBOMInputSpec.Collector, and is useful for accessing the field via an interface.
 func (v *HasSBOMInputSpec) GetCollector() string { return v.Collector }
 
+// GetDocumentHash returns HasSBOMInputSpec.DocumentHash, and is useful for accessing the field via an interface.
+func (v *HasSBOMInputSpec) GetDocumentHash() *string { return v.DocumentHash }
+
+// GetFileMode returns HasSBOMInputSpec.FileMode, and is useful for accessing the field via an interface.
+func (v *HasSBOMInputSpec) GetFileMode() *string { return v.FileMode }
+
 // HasSBOMPkgIngestHasSBOM includes the requested fields of the GraphQL type HasSBOM.
 // The GraphQL type's documentation follows.
 //
@@ -1678,6 +1886,8 @@ func (v *HasSBOMInputSpec) GetCollector() string { return v.Collector }
 // uri (property) - identifier string for the SBOM
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// documentHash (property) - content hash of the original document in the blob store, if it was stored
+// fileMode (property) - how the file level entries of the SBOM were ingested (full, checksums-only or skip), if the parser supports choosing
 //
 // Note: Only package object or source object can be defined. Not both.
 type HasSBOMPkgIngestHasSBOM struct {
@@ -1865,6 +2075,8 @@ func (v *HasSBOMPkgResponse) GetIngestHasSBOM() HasSBOMPkgIngestHasSBOM { return
 // uri (property) - identifier string for the SBOM
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// documentHash (property) - content hash of the original document in the blob store, if it was stored
+// fileMode (property) - how the file level entries of the SBOM were ingested (full, checksums-only or skip), if the parser supports choosing
 //
 // Note: Only package object or source object can be defined. Not both.
 type HasSBOMSrcIngestHasSBOM struct {
@@ -2613,47 +2825,43 @@ func (v *HashEqualResponse) GetEqualArtifact() HashEqualEqualArtifact { return v
 // GetIngestHashEqual returns HashEqualResponse.IngestHashEqual, and is useful for accessing the field via an interface.
 func (v *HashEqualResponse) GetIngestHashEqual() HashEqualIngestHashEqual { return v.IngestHashEqual }
 
-// IsDependencyDependentPkgPackage includes the requested fields of the GraphQL type Package.
+// HealthcheckCertifyVulnCertifyVuln includes the requested fields of the GraphQL type CertifyVuln.
 // The GraphQL type's documentation follows.
 //
-// Package represents a package.
-//
-// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
-// The `type` field matches the pURL types but we might also use `"guac"` for the
-// cases where the pURL representation is not complete or when we have custom
-// rules.
-//
-// This node is a singleton: backends guarantee that there is exactly one node
-// with the same `type` value.
-//
-// Also note that this is named `Package`, not `PackageType`. This is only to make
-// queries more readable.
-type IsDependencyDependentPkgPackage struct {
-	allPkgTree `json:"-"`
+// CertifyVuln is an attestation that represents when a package has a vulnerability
+type HealthcheckCertifyVulnCertifyVuln struct {
+	allCertifyVuln `json:"-"`
 }
 
-// GetId returns IsDependencyDependentPkgPackage.Id, and is useful for accessing the field via an interface.
-func (v *IsDependencyDependentPkgPackage) GetId() string { return v.allPkgTree.Id }
+// GetId returns HealthcheckCertifyVulnCertifyVuln.Id, and is useful for accessing the field via an interface.
+func (v *HealthcheckCertifyVulnCertifyVuln) GetId() string { return v.allCertifyVuln.Id }
 
-// GetType returns IsDependencyDependentPkgPackage.Type, and is useful for accessing the field via an interface.
-func (v *IsDependencyDependentPkgPackage) GetType() string { return v.allPkgTree.Type }
+// GetPackage returns HealthcheckCertifyVulnCertifyVuln.Package, and is useful for accessing the field via an interface.
+func (v *HealthcheckCertifyVulnCertifyVuln) GetPackage() allCertifyVulnPackage {
+	return v.allCertifyVuln.Package
+}
 
-// GetNamespaces returns IsDependencyDependentPkgPackage.Namespaces, and is useful for accessing the field via an interface.
-func (v *IsDependencyDependentPkgPackage) GetNamespaces() []allPkgTreeNamespacesPackageNamespace {
-	return v.allPkgTree.Namespaces
+// GetVulnerability returns HealthcheckCertifyVulnCertifyVuln.Vulnerability, and is useful for accessing the field via an interface.
+func (v *HealthcheckCertifyVulnCertifyVuln) GetVulnerability() allCertifyVulnVulnerabilityOsvCveOrGhsa {
+	return v.allCertifyVuln.Vulnerability
 }
 
-func (v *IsDependencyDependentPkgPackage) UnmarshalJSON(b []byte) error {
+// GetMetadata returns HealthcheckCertifyVulnCertifyVuln.Metadata, and is useful for accessing the field via an interface.
+func (v *HealthcheckCertifyVulnCertifyVuln) GetMetadata() allCertifyVulnMetadataVulnerabilityMetaData {
+	return v.allCertifyVuln.Metadata
+}
+
+func (v *HealthcheckCertifyVulnCertifyVuln) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*IsDependencyDependentPkgPackage
+		*HealthcheckCertifyVulnCertifyVuln
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.IsDependencyDependentPkgPackage = v
+	firstPass.HealthcheckCertifyVulnCertifyVuln = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2661,22 +2869,24 @@ func (v *IsDependencyDependentPkgPackage) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.allPkgTree)
+		b, &v.allCertifyVuln)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalIsDependencyDependentPkgPackage struct {
+type __premarshalHealthcheckCertifyVulnCertifyVuln struct {
 	Id string `json:"id"`
 
-	Type string `json:"type"`
+	Package allCertifyVulnPackage `json:"package"`
 
-	Namespaces []allPkgTreeNamespacesPackageNamespace `json:"namespaces"`
+	Vulnerability json.RawMessage `json:"vulnerability"`
+
+	Metadata allCertifyVulnMetadataVulnerabilityMetaData `json:"metadata"`
 }
 
-func (v *IsDependencyDependentPkgPackage) MarshalJSON() ([]byte, error) {
+func (v *HealthcheckCertifyVulnCertifyVuln) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2684,74 +2894,93 @@ func (v *IsDependencyDependentPkgPackage) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *IsDependencyDependentPkgPackage) __premarshalJSON() (*__premarshalIsDependencyDependentPkgPackage, error) {
-	var retval __premarshalIsDependencyDependentPkgPackage
+func (v *HealthcheckCertifyVulnCertifyVuln) __premarshalJSON() (*__premarshalHealthcheckCertifyVulnCertifyVuln, error) {
+	var retval __premarshalHealthcheckCertifyVulnCertifyVuln
 
-	retval.Id = v.allPkgTree.Id
-	retval.Type = v.allPkgTree.Type
-	retval.Namespaces = v.allPkgTree.Namespaces
+	retval.Id = v.allCertifyVuln.Id
+	retval.Package = v.allCertifyVuln.Package
+	{
+
+		dst := &retval.Vulnerability
+		src := v.allCertifyVuln.Vulnerability
+		var err error
+		*dst, err = __marshalallCertifyVulnVulnerabilityOsvCveOrGhsa(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal HealthcheckCertifyVulnCertifyVuln.allCertifyVuln.Vulnerability: %w", err)
+		}
+	}
+	retval.Metadata = v.allCertifyVuln.Metadata
 	return &retval, nil
 }
 
-// IsDependencyIngestDependencyIsDependency includes the requested fields of the GraphQL type IsDependency.
+// HealthcheckCertifyVulnResponse is returned by HealthcheckCertifyVuln on success.
+type HealthcheckCertifyVulnResponse struct {
+	// Returns all CertifyVuln
+	CertifyVuln []HealthcheckCertifyVulnCertifyVuln `json:"CertifyVuln"`
+}
+
+// GetCertifyVuln returns HealthcheckCertifyVulnResponse.CertifyVuln, and is useful for accessing the field via an interface.
+func (v *HealthcheckCertifyVulnResponse) GetCertifyVuln() []HealthcheckCertifyVulnCertifyVuln {
+	return v.CertifyVuln
+}
+
+// HealthcheckHasSourceAtHasSourceAt includes the requested fields of the GraphQL type HasSourceAt.
 // The GraphQL type's documentation follows.
 //
-// # IsDependency is an attestation that represents when a package is dependent on another package
+// # HasSourceAt is an attestation represents that a package object has a source object since a timestamp
 //
 // package (subject) - the package object type that represents the package
-// dependentPackage (object) - the package object type that represents the packageName (cannot be to the packageVersion)
-// versionRange (property) - string value for version range that applies to the dependent package
-// justification (property) - string value representing why the artifacts are the equal
+// source (object) - the source object type that represents the source
+// knownSince (property) - timestamp when this was last checked (exact time)
+// justification (property) - string value representing why the package has a source specified
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
-type IsDependencyIngestDependencyIsDependency struct {
-	allIsDependencyTree `json:"-"`
+type HealthcheckHasSourceAtHasSourceAt struct {
+	allHasSourceAt `json:"-"`
 }
 
-// GetId returns IsDependencyIngestDependencyIsDependency.Id, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetId() string { return v.allIsDependencyTree.Id }
+// GetId returns HealthcheckHasSourceAtHasSourceAt.Id, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetId() string { return v.allHasSourceAt.Id }
 
-// GetJustification returns IsDependencyIngestDependencyIsDependency.Justification, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetJustification() string {
-	return v.allIsDependencyTree.Justification
+// GetJustification returns HealthcheckHasSourceAtHasSourceAt.Justification, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetJustification() string {
+	return v.allHasSourceAt.Justification
 }
 
-// GetPackage returns IsDependencyIngestDependencyIsDependency.Package, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetPackage() allIsDependencyTreePackage {
-	return v.allIsDependencyTree.Package
+// GetKnownSince returns HealthcheckHasSourceAtHasSourceAt.KnownSince, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetKnownSince() time.Time {
+	return v.allHasSourceAt.KnownSince
 }
 
-// GetDependentPackage returns IsDependencyIngestDependencyIsDependency.DependentPackage, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetDependentPackage() allIsDependencyTreeDependentPackage {
-	return v.allIsDependencyTree.DependentPackage
+// GetPackage returns HealthcheckHasSourceAtHasSourceAt.Package, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetPackage() allHasSourceAtPackage {
+	return v.allHasSourceAt.Package
 }
 
-// GetVersionRange returns IsDependencyIngestDependencyIsDependency.VersionRange, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetVersionRange() string {
-	return v.allIsDependencyTree.VersionRange
+// GetSource returns HealthcheckHasSourceAtHasSourceAt.Source, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetSource() allHasSourceAtSource {
+	return v.allHasSourceAt.Source
 }
 
-// GetOrigin returns IsDependencyIngestDependencyIsDependency.Origin, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetOrigin() string {
-	return v.allIsDependencyTree.Origin
-}
+// GetOrigin returns HealthcheckHasSourceAtHasSourceAt.Origin, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetOrigin() string { return v.allHasSourceAt.Origin }
 
-// GetCollector returns IsDependencyIngestDependencyIsDependency.Collector, and is useful for accessing the field via an interface.
-func (v *IsDependencyIngestDependencyIsDependency) GetCollector() string {
-	return v.allIsDependencyTree.Collector
-}
+// GetCollector returns HealthcheckHasSourceAtHasSourceAt.Collector, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtHasSourceAt) GetCollector() string { return v.allHasSourceAt.Collector }
 
-func (v *IsDependencyIngestDependencyIsDependency) UnmarshalJSON(b []byte) error {
+func (v *HealthcheckHasSourceAtHasSourceAt) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*IsDependencyIngestDependencyIsDependency
+		*HealthcheckHasSourceAtHasSourceAt
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.IsDependencyIngestDependencyIsDependency = v
+	firstPass.HealthcheckHasSourceAtHasSourceAt = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2759,30 +2988,30 @@ func (v *IsDependencyIngestDependencyIsDependency) UnmarshalJSON(b []byte) error
 	}
 
 	err = json.Unmarshal(
-		b, &v.allIsDependencyTree)
+		b, &v.allHasSourceAt)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalIsDependencyIngestDependencyIsDependency struct {
+type __premarshalHealthcheckHasSourceAtHasSourceAt struct {
 	Id string `json:"id"`
 
 	Justification string `json:"justification"`
 
-	Package allIsDependencyTreePackage `json:"package"`
+	KnownSince time.Time `json:"knownSince"`
 
-	DependentPackage allIsDependencyTreeDependentPackage `json:"dependentPackage"`
+	Package allHasSourceAtPackage `json:"package"`
 
-	VersionRange string `json:"versionRange"`
+	Source allHasSourceAtSource `json:"source"`
 
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
 }
 
-func (v *IsDependencyIngestDependencyIsDependency) MarshalJSON() ([]byte, error) {
+func (v *HealthcheckHasSourceAtHasSourceAt) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2790,34 +3019,236 @@ func (v *IsDependencyIngestDependencyIsDependency) MarshalJSON() ([]byte, error)
 	return json.Marshal(premarshaled)
 }
 
-func (v *IsDependencyIngestDependencyIsDependency) __premarshalJSON() (*__premarshalIsDependencyIngestDependencyIsDependency, error) {
-	var retval __premarshalIsDependencyIngestDependencyIsDependency
+func (v *HealthcheckHasSourceAtHasSourceAt) __premarshalJSON() (*__premarshalHealthcheckHasSourceAtHasSourceAt, error) {
+	var retval __premarshalHealthcheckHasSourceAtHasSourceAt
 
-	retval.Id = v.allIsDependencyTree.Id
-	retval.Justification = v.allIsDependencyTree.Justification
-	retval.Package = v.allIsDependencyTree.Package
-	retval.DependentPackage = v.allIsDependencyTree.DependentPackage
-	retval.VersionRange = v.allIsDependencyTree.VersionRange
-	retval.Origin = v.allIsDependencyTree.Origin
-	retval.Collector = v.allIsDependencyTree.Collector
+	retval.Id = v.allHasSourceAt.Id
+	retval.Justification = v.allHasSourceAt.Justification
+	retval.KnownSince = v.allHasSourceAt.KnownSince
+	retval.Package = v.allHasSourceAt.Package
+	retval.Source = v.allHasSourceAt.Source
+	retval.Origin = v.allHasSourceAt.Origin
+	retval.Collector = v.allHasSourceAt.Collector
 	return &retval, nil
 }
 
-// IsDependencyInputSpec is the same as IsDependency but for mutation input.
-//
-// All fields are required.
-type IsDependencyInputSpec struct {
-	VersionRange  string `json:"versionRange"`
-	Justification string `json:"justification"`
-	Origin        string `json:"origin"`
-	Collector     string `json:"collector"`
+// HealthcheckHasSourceAtResponse is returned by HealthcheckHasSourceAt on success.
+type HealthcheckHasSourceAtResponse struct {
+	// Returns all HasSourceAt
+	HasSourceAt []HealthcheckHasSourceAtHasSourceAt `json:"HasSourceAt"`
 }
 
-// GetVersionRange returns IsDependencyInputSpec.VersionRange, and is useful for accessing the field via an interface.
-func (v *IsDependencyInputSpec) GetVersionRange() string { return v.VersionRange }
-
-// GetJustification returns IsDependencyInputSpec.Justification, and is useful for accessing the field via an interface.
-func (v *IsDependencyInputSpec) GetJustification() string { return v.Justification }
+// GetHasSourceAt returns HealthcheckHasSourceAtResponse.HasSourceAt, and is useful for accessing the field via an interface.
+func (v *HealthcheckHasSourceAtResponse) GetHasSourceAt() []HealthcheckHasSourceAtHasSourceAt {
+	return v.HasSourceAt
+}
+
+// IsDependencyDependentPkgPackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Package represents a package.
+//
+// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
+// The `type` field matches the pURL types but we might also use `"guac"` for the
+// cases where the pURL representation is not complete or when we have custom
+// rules.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Package`, not `PackageType`. This is only to make
+// queries more readable.
+type IsDependencyDependentPkgPackage struct {
+	allPkgTree `json:"-"`
+}
+
+// GetId returns IsDependencyDependentPkgPackage.Id, and is useful for accessing the field via an interface.
+func (v *IsDependencyDependentPkgPackage) GetId() string { return v.allPkgTree.Id }
+
+// GetType returns IsDependencyDependentPkgPackage.Type, and is useful for accessing the field via an interface.
+func (v *IsDependencyDependentPkgPackage) GetType() string { return v.allPkgTree.Type }
+
+// GetNamespaces returns IsDependencyDependentPkgPackage.Namespaces, and is useful for accessing the field via an interface.
+func (v *IsDependencyDependentPkgPackage) GetNamespaces() []allPkgTreeNamespacesPackageNamespace {
+	return v.allPkgTree.Namespaces
+}
+
+func (v *IsDependencyDependentPkgPackage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IsDependencyDependentPkgPackage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IsDependencyDependentPkgPackage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.allPkgTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIsDependencyDependentPkgPackage struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Namespaces []allPkgTreeNamespacesPackageNamespace `json:"namespaces"`
+}
+
+func (v *IsDependencyDependentPkgPackage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IsDependencyDependentPkgPackage) __premarshalJSON() (*__premarshalIsDependencyDependentPkgPackage, error) {
+	var retval __premarshalIsDependencyDependentPkgPackage
+
+	retval.Id = v.allPkgTree.Id
+	retval.Type = v.allPkgTree.Type
+	retval.Namespaces = v.allPkgTree.Namespaces
+	return &retval, nil
+}
+
+// IsDependencyIngestDependencyIsDependency includes the requested fields of the GraphQL type IsDependency.
+// The GraphQL type's documentation follows.
+//
+// # IsDependency is an attestation that represents when a package is dependent on another package
+//
+// package (subject) - the package object type that represents the package
+// dependentPackage (object) - the package object type that represents the packageName (cannot be to the packageVersion)
+// versionRange (property) - string value for version range that applies to the dependent package
+// justification (property) - string value representing why the artifacts are the equal
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+// successors - the SupersededBy chain of the dependent package, only set if includeSuccessors is set in the spec
+type IsDependencyIngestDependencyIsDependency struct {
+	allIsDependencyTree `json:"-"`
+}
+
+// GetId returns IsDependencyIngestDependencyIsDependency.Id, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetId() string { return v.allIsDependencyTree.Id }
+
+// GetJustification returns IsDependencyIngestDependencyIsDependency.Justification, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetJustification() string {
+	return v.allIsDependencyTree.Justification
+}
+
+// GetPackage returns IsDependencyIngestDependencyIsDependency.Package, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetPackage() allIsDependencyTreePackage {
+	return v.allIsDependencyTree.Package
+}
+
+// GetDependentPackage returns IsDependencyIngestDependencyIsDependency.DependentPackage, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetDependentPackage() allIsDependencyTreeDependentPackage {
+	return v.allIsDependencyTree.DependentPackage
+}
+
+// GetVersionRange returns IsDependencyIngestDependencyIsDependency.VersionRange, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetVersionRange() string {
+	return v.allIsDependencyTree.VersionRange
+}
+
+// GetOrigin returns IsDependencyIngestDependencyIsDependency.Origin, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetOrigin() string {
+	return v.allIsDependencyTree.Origin
+}
+
+// GetCollector returns IsDependencyIngestDependencyIsDependency.Collector, and is useful for accessing the field via an interface.
+func (v *IsDependencyIngestDependencyIsDependency) GetCollector() string {
+	return v.allIsDependencyTree.Collector
+}
+
+func (v *IsDependencyIngestDependencyIsDependency) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*IsDependencyIngestDependencyIsDependency
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.IsDependencyIngestDependencyIsDependency = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.allIsDependencyTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalIsDependencyIngestDependencyIsDependency struct {
+	Id string `json:"id"`
+
+	Justification string `json:"justification"`
+
+	Package allIsDependencyTreePackage `json:"package"`
+
+	DependentPackage allIsDependencyTreeDependentPackage `json:"dependentPackage"`
+
+	VersionRange string `json:"versionRange"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+}
+
+func (v *IsDependencyIngestDependencyIsDependency) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *IsDependencyIngestDependencyIsDependency) __premarshalJSON() (*__premarshalIsDependencyIngestDependencyIsDependency, error) {
+	var retval __premarshalIsDependencyIngestDependencyIsDependency
+
+	retval.Id = v.allIsDependencyTree.Id
+	retval.Justification = v.allIsDependencyTree.Justification
+	retval.Package = v.allIsDependencyTree.Package
+	retval.DependentPackage = v.allIsDependencyTree.DependentPackage
+	retval.VersionRange = v.allIsDependencyTree.VersionRange
+	retval.Origin = v.allIsDependencyTree.Origin
+	retval.Collector = v.allIsDependencyTree.Collector
+	return &retval, nil
+}
+
+// IsDependencyInputSpec is the same as IsDependency but for mutation input.
+//
+// All fields are required.
+type IsDependencyInputSpec struct {
+	VersionRange  string `json:"versionRange"`
+	Justification string `json:"justification"`
+	Origin        string `json:"origin"`
+	Collector     string `json:"collector"`
+}
+
+// GetVersionRange returns IsDependencyInputSpec.VersionRange, and is useful for accessing the field via an interface.
+func (v *IsDependencyInputSpec) GetVersionRange() string { return v.VersionRange }
+
+// GetJustification returns IsDependencyInputSpec.Justification, and is useful for accessing the field via an interface.
+func (v *IsDependencyInputSpec) GetJustification() string { return v.Justification }
 
 // GetOrigin returns IsDependencyInputSpec.Origin, and is useful for accessing the field via an interface.
 func (v *IsDependencyInputSpec) GetOrigin() string { return v.Origin }
@@ -4148,6 +4579,16 @@ const (
 	PkgMatchTypeSpecificVersion PkgMatchType = "SPECIFIC_VERSION"
 )
 
+// PurgeNamespaceResponse is returned by PurgeNamespace on success.
+type PurgeNamespaceResponse struct {
+	// Deletes all the data in the namespace of the request. Returns false if the
+	// namespace was not in use. The default namespace cannot be purged.
+	PurgeNamespace bool `json:"purgeNamespace"`
+}
+
+// GetPurgeNamespace returns PurgeNamespaceResponse.PurgeNamespace, and is useful for accessing the field via an interface.
+func (v *PurgeNamespaceResponse) GetPurgeNamespace() bool { return v.PurgeNamespace }
+
 // SLSAForArtifactIngestArtifact includes the requested fields of the GraphQL type Artifact.
 // The GraphQL type's documentation follows.
 //
@@ -4414,7 +4855,8 @@ func (v *SLSAForArtifactResponse) GetIngestSLSA() SLSAForArtifactIngestSLSAHasSL
 
 // SLSAInputSpec is the same as SLSA but for mutation input.
 //
-// All fields are required.
+// All fields are required, except documentHash which is only set when the
+// original document was stored.
 type SLSAInputSpec struct {
 	BuildType     string                   `json:"buildType"`
 	SlsaPredicate []SLSAPredicateInputSpec `json:"slsaPredicate"`
@@ -4423,6 +4865,7 @@ type SLSAInputSpec struct {
 	FinishedOn    time.Time                `json:"finishedOn"`
 	Origin        string                   `json:"origin"`
 	Collector     string                   `json:"collector"`
+	DocumentHash  *string                  `json:"documentHash"`
 }
 
 // GetBuildType returns SLSAInputSpec.BuildType, and is useful for accessing the field via an interface.
@@ -4446,6 +4889,9 @@ func (v *SLSAInputSpec) GetOrigin() string { return v.Origin }
 // GetCollector returns SLSAInputSpec.Collector, and is useful for accessing the field via an interface.
 func (v *SLSAInputSpec) GetCollector() string { return v.Collector }
 
+// GetDocumentHash returns SLSAInputSpec.DocumentHash, and is useful for accessing the field via an interface.
+func (v *SLSAInputSpec) GetDocumentHash() *string { return v.DocumentHash }
+
 // SLSAPredicateInputSpec is the same as SLSAPredicateSpec, but for mutation
 // input.
 type SLSAPredicateInputSpec struct {
@@ -4670,6 +5116,26 @@ func (v *ScorecardResponse) GetCertifyScorecard() ScorecardCertifyScorecard {
 	return v.CertifyScorecard
 }
 
+// SignatureStatus records the outcome of verifying a signature.
+//
+// VERIFIED means the signature was checked against the trust root. FAILED means
+// the signature was found but could not be verified.
+type SignatureStatus string
+
+const (
+	SignatureStatusVerified SignatureStatus = "VERIFIED"
+	SignatureStatusFailed   SignatureStatus = "FAILED"
+)
+
+// SignatureType is the kind of signature found on an artifact.
+type SignatureType string
+
+const (
+	SignatureTypeCosign   SignatureType = "COSIGN"
+	SignatureTypeNotation SignatureType = "NOTATION"
+	SignatureTypeGpg      SignatureType = "GPG"
+)
+
 // SourceInputSpec specifies a source for a mutation.
 //
 // This is different than SourceSpec because we want to encode that all fields
@@ -4701,45 +5167,370 @@ func (v *SourceInputSpec) GetTag() *string { return v.Tag }
 // GetCommit returns SourceInputSpec.Commit, and is useful for accessing the field via an interface.
 func (v *SourceInputSpec) GetCommit() *string { return v.Commit }
 
-// VEXPackageAndGhsaIngestGHSA includes the requested fields of the GraphQL type GHSA.
+// StitchingProposalsResponse is returned by StitchingProposals on success.
+type StitchingProposalsResponse struct {
+	// Proposes HashEqual edges for digests that identify the same image as
+	// artifact. The time window is in seconds and defaults to one hour.
+	StitchingProposals []StitchingProposalsStitchingProposalsStitchProposal `json:"stitchingProposals"`
+}
+
+// GetStitchingProposals returns StitchingProposalsResponse.StitchingProposals, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsResponse) GetStitchingProposals() []StitchingProposalsStitchingProposalsStitchProposal {
+	return v.StitchingProposals
+}
+
+// StitchingProposalsStitchingProposalsStitchProposal includes the requested fields of the GraphQL type StitchProposal.
 // The GraphQL type's documentation follows.
 //
-// GHSA represents GitHub security advisories.
+// StitchProposal proposes a HashEqual between an artifact and another digest of
+// the same container image, e.g. the manifest digest in an SBOM and the image ID
+// in a provenance document.
 //
-// We create a separate node to allow retrieving all GHSAs.
-type VEXPackageAndGhsaIngestGHSA struct {
-	allGHSATree `json:"-"`
+// Proposals are conservative: the supporting IsOccurrence and HasSLSA evidence
+// must reference the same image repository and tag in their origin, must have
+// been ingested within the time window of each other, and the repository and tag
+// must point to exactly one other digest in that window.
+type StitchingProposalsStitchingProposalsStitchProposal struct {
+	// The queried artifact
+	Artifact StitchingProposalsStitchingProposalsStitchProposalArtifact `json:"artifact"`
+	// The digest proposed to be equal to the queried artifact
+	EqualArtifact StitchingProposalsStitchingProposalsStitchProposalEqualArtifact `json:"equalArtifact"`
+	// Image repository and tag shared by the origins of the evidence
+	ImageRef string `json:"imageRef"`
+	// Justification to use when ingesting the HashEqual (stitched-by-guac)
+	Justification string `json:"justification"`
+	// IDs of the IsOccurrence and HasSLSA nodes supporting the proposal
+	Evidence []string `json:"evidence"`
 }
 
-// GetId returns VEXPackageAndGhsaIngestGHSA.Id, and is useful for accessing the field via an interface.
-func (v *VEXPackageAndGhsaIngestGHSA) GetId() string { return v.allGHSATree.Id }
-
-// GetGhsaIds returns VEXPackageAndGhsaIngestGHSA.GhsaIds, and is useful for accessing the field via an interface.
-func (v *VEXPackageAndGhsaIngestGHSA) GetGhsaIds() []allGHSATreeGhsaIdsGHSAId {
-	return v.allGHSATree.GhsaIds
+// GetArtifact returns StitchingProposalsStitchingProposalsStitchProposal.Artifact, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposal) GetArtifact() StitchingProposalsStitchingProposalsStitchProposalArtifact {
+	return v.Artifact
 }
 
-func (v *VEXPackageAndGhsaIngestGHSA) UnmarshalJSON(b []byte) error {
+// GetEqualArtifact returns StitchingProposalsStitchingProposalsStitchProposal.EqualArtifact, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposal) GetEqualArtifact() StitchingProposalsStitchingProposalsStitchProposalEqualArtifact {
+	return v.EqualArtifact
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetImageRef returns StitchingProposalsStitchingProposalsStitchProposal.ImageRef, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposal) GetImageRef() string { return v.ImageRef }
 
-	var firstPass struct {
-		*VEXPackageAndGhsaIngestGHSA
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.VEXPackageAndGhsaIngestGHSA = v
+// GetJustification returns StitchingProposalsStitchingProposalsStitchProposal.Justification, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposal) GetJustification() string {
+	return v.Justification
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetEvidence returns StitchingProposalsStitchingProposalsStitchProposal.Evidence, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposal) GetEvidence() []string {
+	return v.Evidence
+}
 
-	err = json.Unmarshal(
-		b, &v.allGHSATree)
-	if err != nil {
-		return err
+// StitchingProposalsStitchingProposalsStitchProposalArtifact includes the requested fields of the GraphQL type Artifact.
+// The GraphQL type's documentation follows.
+//
+// # Artifact represents the artifact and contains a digest field
+//
+// Both field are mandatory and canonicalized to be lowercase.
+//
+// If having a `checksum` Go object, `algorithm` can be
+// `strings.ToLower(string(checksum.Algorithm))` and `digest` can be
+// `checksum.Value`.
+type StitchingProposalsStitchingProposalsStitchProposalArtifact struct {
+	allArtifactTree `json:"-"`
+}
+
+// GetId returns StitchingProposalsStitchingProposalsStitchProposalArtifact.Id, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) GetId() string {
+	return v.allArtifactTree.Id
+}
+
+// GetAlgorithm returns StitchingProposalsStitchingProposalsStitchProposalArtifact.Algorithm, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) GetAlgorithm() string {
+	return v.allArtifactTree.Algorithm
+}
+
+// GetDigest returns StitchingProposalsStitchingProposalsStitchProposalArtifact.Digest, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) GetDigest() string {
+	return v.allArtifactTree.Digest
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*StitchingProposalsStitchingProposalsStitchProposalArtifact
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.StitchingProposalsStitchingProposalsStitchProposalArtifact = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.allArtifactTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalStitchingProposalsStitchingProposalsStitchProposalArtifact struct {
+	Id string `json:"id"`
+
+	Algorithm string `json:"algorithm"`
+
+	Digest string `json:"digest"`
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalArtifact) __premarshalJSON() (*__premarshalStitchingProposalsStitchingProposalsStitchProposalArtifact, error) {
+	var retval __premarshalStitchingProposalsStitchingProposalsStitchProposalArtifact
+
+	retval.Id = v.allArtifactTree.Id
+	retval.Algorithm = v.allArtifactTree.Algorithm
+	retval.Digest = v.allArtifactTree.Digest
+	return &retval, nil
+}
+
+// StitchingProposalsStitchingProposalsStitchProposalEqualArtifact includes the requested fields of the GraphQL type Artifact.
+// The GraphQL type's documentation follows.
+//
+// # Artifact represents the artifact and contains a digest field
+//
+// Both field are mandatory and canonicalized to be lowercase.
+//
+// If having a `checksum` Go object, `algorithm` can be
+// `strings.ToLower(string(checksum.Algorithm))` and `digest` can be
+// `checksum.Value`.
+type StitchingProposalsStitchingProposalsStitchProposalEqualArtifact struct {
+	allArtifactTree `json:"-"`
+}
+
+// GetId returns StitchingProposalsStitchingProposalsStitchProposalEqualArtifact.Id, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) GetId() string {
+	return v.allArtifactTree.Id
+}
+
+// GetAlgorithm returns StitchingProposalsStitchingProposalsStitchProposalEqualArtifact.Algorithm, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) GetAlgorithm() string {
+	return v.allArtifactTree.Algorithm
+}
+
+// GetDigest returns StitchingProposalsStitchingProposalsStitchProposalEqualArtifact.Digest, and is useful for accessing the field via an interface.
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) GetDigest() string {
+	return v.allArtifactTree.Digest
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*StitchingProposalsStitchingProposalsStitchProposalEqualArtifact
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.StitchingProposalsStitchingProposalsStitchProposalEqualArtifact = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.allArtifactTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalStitchingProposalsStitchingProposalsStitchProposalEqualArtifact struct {
+	Id string `json:"id"`
+
+	Algorithm string `json:"algorithm"`
+
+	Digest string `json:"digest"`
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *StitchingProposalsStitchingProposalsStitchProposalEqualArtifact) __premarshalJSON() (*__premarshalStitchingProposalsStitchingProposalsStitchProposalEqualArtifact, error) {
+	var retval __premarshalStitchingProposalsStitchingProposalsStitchProposalEqualArtifact
+
+	retval.Id = v.allArtifactTree.Id
+	retval.Algorithm = v.allArtifactTree.Algorithm
+	retval.Digest = v.allArtifactTree.Digest
+	return &retval, nil
+}
+
+// SupersededByIngestSupersededBy includes the requested fields of the GraphQL type SupersededBy.
+// The GraphQL type's documentation follows.
+//
+// SupersededBy is an attestation that represents that a package has been renamed or deprecated upstream in favor
+// of another package
+//
+// package (subject) - the package object type that represents the superseded package
+// successor (object) - the package object type that represents the package replacing it
+// reason (property) - string value representing why the package was superseded (e.g. the deprecation message)
+// since (property) - timestamp since when the package is superseded
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+//
+// The package and successor are either both at the version level or both at the package name level.
+type SupersededByIngestSupersededBy struct {
+	Id string `json:"id"`
+}
+
+// GetId returns SupersededByIngestSupersededBy.Id, and is useful for accessing the field via an interface.
+func (v *SupersededByIngestSupersededBy) GetId() string { return v.Id }
+
+// SupersededByInputSpec is the same as SupersededBy but for mutation input.
+//
+// All fields are required.
+type SupersededByInputSpec struct {
+	Reason    string    `json:"reason"`
+	Since     time.Time `json:"since"`
+	Origin    string    `json:"origin"`
+	Collector string    `json:"collector"`
+}
+
+// GetReason returns SupersededByInputSpec.Reason, and is useful for accessing the field via an interface.
+func (v *SupersededByInputSpec) GetReason() string { return v.Reason }
+
+// GetSince returns SupersededByInputSpec.Since, and is useful for accessing the field via an interface.
+func (v *SupersededByInputSpec) GetSince() time.Time { return v.Since }
+
+// GetOrigin returns SupersededByInputSpec.Origin, and is useful for accessing the field via an interface.
+func (v *SupersededByInputSpec) GetOrigin() string { return v.Origin }
+
+// GetCollector returns SupersededByInputSpec.Collector, and is useful for accessing the field via an interface.
+func (v *SupersededByInputSpec) GetCollector() string { return v.Collector }
+
+// SupersededByPkgPackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Package represents a package.
+//
+// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
+// The `type` field matches the pURL types but we might also use `"guac"` for the
+// cases where the pURL representation is not complete or when we have custom
+// rules.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Package`, not `PackageType`. This is only to make
+// queries more readable.
+type SupersededByPkgPackage struct {
+	Id string `json:"id"`
+}
+
+// GetId returns SupersededByPkgPackage.Id, and is useful for accessing the field via an interface.
+func (v *SupersededByPkgPackage) GetId() string { return v.Id }
+
+// SupersededByResponse is returned by SupersededBy on success.
+type SupersededByResponse struct {
+	// Ingest a new package. Returns the ingested package trie
+	Pkg SupersededByPkgPackage `json:"pkg"`
+	// Ingest a new package. Returns the ingested package trie
+	Successor SupersededBySuccessorPackage `json:"successor"`
+	// Adds a certification that a package (either at the version level or package name level) is superseded by another package
+	IngestSupersededBy SupersededByIngestSupersededBy `json:"ingestSupersededBy"`
+}
+
+// GetPkg returns SupersededByResponse.Pkg, and is useful for accessing the field via an interface.
+func (v *SupersededByResponse) GetPkg() SupersededByPkgPackage { return v.Pkg }
+
+// GetSuccessor returns SupersededByResponse.Successor, and is useful for accessing the field via an interface.
+func (v *SupersededByResponse) GetSuccessor() SupersededBySuccessorPackage { return v.Successor }
+
+// GetIngestSupersededBy returns SupersededByResponse.IngestSupersededBy, and is useful for accessing the field via an interface.
+func (v *SupersededByResponse) GetIngestSupersededBy() SupersededByIngestSupersededBy {
+	return v.IngestSupersededBy
+}
+
+// SupersededBySuccessorPackage includes the requested fields of the GraphQL type Package.
+// The GraphQL type's documentation follows.
+//
+// Package represents a package.
+//
+// In the pURL representation, each Package matches a `pkg:<type>` partial pURL.
+// The `type` field matches the pURL types but we might also use `"guac"` for the
+// cases where the pURL representation is not complete or when we have custom
+// rules.
+//
+// This node is a singleton: backends guarantee that there is exactly one node
+// with the same `type` value.
+//
+// Also note that this is named `Package`, not `PackageType`. This is only to make
+// queries more readable.
+type SupersededBySuccessorPackage struct {
+	Id string `json:"id"`
+}
+
+// GetId returns SupersededBySuccessorPackage.Id, and is useful for accessing the field via an interface.
+func (v *SupersededBySuccessorPackage) GetId() string { return v.Id }
+
+// VEXPackageAndGhsaIngestGHSA includes the requested fields of the GraphQL type GHSA.
+// The GraphQL type's documentation follows.
+//
+// GHSA represents GitHub security advisories.
+//
+// We create a separate node to allow retrieving all GHSAs.
+type VEXPackageAndGhsaIngestGHSA struct {
+	allGHSATree `json:"-"`
+}
+
+// GetId returns VEXPackageAndGhsaIngestGHSA.Id, and is useful for accessing the field via an interface.
+func (v *VEXPackageAndGhsaIngestGHSA) GetId() string { return v.allGHSATree.Id }
+
+// GetGhsaIds returns VEXPackageAndGhsaIngestGHSA.GhsaIds, and is useful for accessing the field via an interface.
+func (v *VEXPackageAndGhsaIngestGHSA) GetGhsaIds() []allGHSATreeGhsaIdsGHSAId {
+	return v.allGHSATree.GhsaIds
+}
+
+func (v *VEXPackageAndGhsaIngestGHSA) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*VEXPackageAndGhsaIngestGHSA
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.VEXPackageAndGhsaIngestGHSA = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.allGHSATree)
+	if err != nil {
+		return err
 	}
 	return nil
 }
@@ -4853,7 +5644,9 @@ func (v *VEXPackageAndGhsaIngestPackage) __premarshalJSON() (*__premarshalVEXPac
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -5148,7 +5941,9 @@ func (v *VexArtifactAndCveIngestCVE) __premarshalJSON() (*__premarshalVexArtifac
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -5435,7 +6230,9 @@ func (v *VexArtifactAndGhsaIngestGHSA) __premarshalJSON() (*__premarshalVexArtif
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -5739,7 +6536,9 @@ func (v *VexPackageAndCveIngestPackage) __premarshalJSON() (*__premarshalVexPack
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -5883,14 +6682,25 @@ func (v *VexPackageAndCveResponse) GetIngestVEXStatement() VexPackageAndCveInges
 
 // VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
 //
-// All fields are required.
+// The justification is required if and only if the status is NOT_AFFECTED, and
+// the statement is required if the status is AFFECTED. knownSince can't be the
+// zero time.
 type VexStatementInputSpec struct {
-	Justification string    `json:"justification"`
-	KnownSince    time.Time `json:"knownSince"`
-	Origin        string    `json:"origin"`
-	Collector     string    `json:"collector"`
+	// NOT_AFFECTED if not set, the status of the VEX statements ingested before it existed
+	Status        *VexStatus `json:"status"`
+	Statement     *string    `json:"statement"`
+	Justification string     `json:"justification"`
+	KnownSince    time.Time  `json:"knownSince"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
 }
 
+// GetStatus returns VexStatementInputSpec.Status, and is useful for accessing the field via an interface.
+func (v *VexStatementInputSpec) GetStatus() *VexStatus { return v.Status }
+
+// GetStatement returns VexStatementInputSpec.Statement, and is useful for accessing the field via an interface.
+func (v *VexStatementInputSpec) GetStatement() *string { return v.Statement }
+
 // GetJustification returns VexStatementInputSpec.Justification, and is useful for accessing the field via an interface.
 func (v *VexStatementInputSpec) GetJustification() string { return v.Justification }
 
@@ -5903,9 +6713,26 @@ func (v *VexStatementInputSpec) GetOrigin() string { return v.Origin }
 // GetCollector returns VexStatementInputSpec.Collector, and is useful for accessing the field via an interface.
 func (v *VexStatementInputSpec) GetCollector() string { return v.Collector }
 
+// VexStatus is the status of a package or artifact with regard to a
+// vulnerability, as stated by a VEX.
+//
+// NOT_AFFECTED - the vulnerability can't be exploited, for the reason given in the justification
+// AFFECTED - the vulnerability can be exploited, the statement gives the action to take
+// FIXED - the vulnerability was fixed
+// UNDER_INVESTIGATION - it is not known yet whether the vulnerability can be exploited
+type VexStatus string
+
+const (
+	VexStatusNotAffected        VexStatus = "NOT_AFFECTED"
+	VexStatusAffected           VexStatus = "AFFECTED"
+	VexStatusFixed              VexStatus = "FIXED"
+	VexStatusUnderInvestigation VexStatus = "UNDER_INVESTIGATION"
+)
+
 // VulnerabilityInputSpec is the same as VulnerabilityMetaData but for mutation input.
 //
-// All fields are required.
+// All fields are required, except scannerScore which is only set by scanners
+// that score the vulnerabilities they find.
 type VulnerabilityMetaDataInput struct {
 	TimeScanned    time.Time `json:"timeScanned"`
 	DbUri          string    `json:"dbUri"`
@@ -5914,6 +6741,7 @@ type VulnerabilityMetaDataInput struct {
 	ScannerVersion string    `json:"scannerVersion"`
 	Origin         string    `json:"origin"`
 	Collector      string    `json:"collector"`
+	ScannerScore   *float64  `json:"scannerScore"`
 }
 
 // GetTimeScanned returns VulnerabilityMetaDataInput.TimeScanned, and is useful for accessing the field via an interface.
@@ -5937,6 +6765,9 @@ func (v *VulnerabilityMetaDataInput) GetOrigin() string { return v.Origin }
 // GetCollector returns VulnerabilityMetaDataInput.Collector, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetaDataInput) GetCollector() string { return v.Collector }
 
+// GetScannerScore returns VulnerabilityMetaDataInput.ScannerScore, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetaDataInput) GetScannerScore() *float64 { return v.ScannerScore }
+
 // __CertifyBadArtifactInput is used internally by genqlient
 type __CertifyBadArtifactInput struct {
 	Artifact   ArtifactInputSpec   `json:"artifact"`
@@ -6041,6 +6872,18 @@ func (v *__CertifyPkgInput) GetDepPkg() PkgInputSpec { return v.DepPkg }
 // GetCertifyPkg returns __CertifyPkgInput.CertifyPkg, and is useful for accessing the field via an interface.
 func (v *__CertifyPkgInput) GetCertifyPkg() CertifyPkgInputSpec { return v.CertifyPkg }
 
+// __CertifySignedInput is used internally by genqlient
+type __CertifySignedInput struct {
+	Artifact      ArtifactInputSpec      `json:"artifact"`
+	CertifySigned CertifySignedInputSpec `json:"certifySigned"`
+}
+
+// GetArtifact returns __CertifySignedInput.Artifact, and is useful for accessing the field via an interface.
+func (v *__CertifySignedInput) GetArtifact() ArtifactInputSpec { return v.Artifact }
+
+// GetCertifySigned returns __CertifySignedInput.CertifySigned, and is useful for accessing the field via an interface.
+func (v *__CertifySignedInput) GetCertifySigned() CertifySignedInputSpec { return v.CertifySigned }
+
 // __HasSBOMPkgInput is used internally by genqlient
 type __HasSBOMPkgInput struct {
 	Pkg     PkgInputSpec     `json:"pkg"`
@@ -6101,6 +6944,22 @@ func (v *__HashEqualInput) GetEqualArtifact() ArtifactInputSpec { return v.Equal
 // GetHashEqual returns __HashEqualInput.HashEqual, and is useful for accessing the field via an interface.
 func (v *__HashEqualInput) GetHashEqual() HashEqualInputSpec { return v.HashEqual }
 
+// __HealthcheckCertifyVulnInput is used internally by genqlient
+type __HealthcheckCertifyVulnInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __HealthcheckCertifyVulnInput.Id, and is useful for accessing the field via an interface.
+func (v *__HealthcheckCertifyVulnInput) GetId() string { return v.Id }
+
+// __HealthcheckHasSourceAtInput is used internally by genqlient
+type __HealthcheckHasSourceAtInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __HealthcheckHasSourceAtInput.Id, and is useful for accessing the field via an interface.
+func (v *__HealthcheckHasSourceAtInput) GetId() string { return v.Id }
+
 // __IsDependencyInput is used internally by genqlient
 type __IsDependencyInput struct {
 	Pkg        PkgInputSpec          `json:"pkg"`
@@ -6217,11 +7076,43 @@ func (v *__ScorecardInput) GetSource() SourceInputSpec { return v.Source }
 // GetScorecard returns __ScorecardInput.Scorecard, and is useful for accessing the field via an interface.
 func (v *__ScorecardInput) GetScorecard() ScorecardInputSpec { return v.Scorecard }
 
-// __VEXPackageAndGhsaInput is used internally by genqlient
-type __VEXPackageAndGhsaInput struct {
-	Pkg          PkgInputSpec          `json:"pkg"`
-	Ghsa         GHSAInputSpec         `json:"ghsa"`
-	VexStatement VexStatementInputSpec `json:"vexStatement"`
+// __StitchingProposalsInput is used internally by genqlient
+type __StitchingProposalsInput struct {
+	Artifact      ArtifactSpec `json:"artifact"`
+	WindowSeconds *int         `json:"windowSeconds"`
+}
+
+// GetArtifact returns __StitchingProposalsInput.Artifact, and is useful for accessing the field via an interface.
+func (v *__StitchingProposalsInput) GetArtifact() ArtifactSpec { return v.Artifact }
+
+// GetWindowSeconds returns __StitchingProposalsInput.WindowSeconds, and is useful for accessing the field via an interface.
+func (v *__StitchingProposalsInput) GetWindowSeconds() *int { return v.WindowSeconds }
+
+// __SupersededByInput is used internally by genqlient
+type __SupersededByInput struct {
+	Pkg          PkgInputSpec          `json:"pkg"`
+	Successor    PkgInputSpec          `json:"successor"`
+	PkgMatchType MatchFlags            `json:"pkgMatchType"`
+	SupersededBy SupersededByInputSpec `json:"supersededBy"`
+}
+
+// GetPkg returns __SupersededByInput.Pkg, and is useful for accessing the field via an interface.
+func (v *__SupersededByInput) GetPkg() PkgInputSpec { return v.Pkg }
+
+// GetSuccessor returns __SupersededByInput.Successor, and is useful for accessing the field via an interface.
+func (v *__SupersededByInput) GetSuccessor() PkgInputSpec { return v.Successor }
+
+// GetPkgMatchType returns __SupersededByInput.PkgMatchType, and is useful for accessing the field via an interface.
+func (v *__SupersededByInput) GetPkgMatchType() MatchFlags { return v.PkgMatchType }
+
+// GetSupersededBy returns __SupersededByInput.SupersededBy, and is useful for accessing the field via an interface.
+func (v *__SupersededByInput) GetSupersededBy() SupersededByInputSpec { return v.SupersededBy }
+
+// __VEXPackageAndGhsaInput is used internally by genqlient
+type __VEXPackageAndGhsaInput struct {
+	Pkg          PkgInputSpec          `json:"pkg"`
+	Ghsa         GHSAInputSpec         `json:"ghsa"`
+	VexStatement VexStatementInputSpec `json:"vexStatement"`
 }
 
 // GetPkg returns __VEXPackageAndGhsaInput.Pkg, and is useful for accessing the field via an interface.
@@ -6315,6 +7206,8 @@ func (v *allArtifactTree) GetDigest() string { return v.Digest }
 // justification (property) - string value representing why the subject is considered bad
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+// certifications ingested without one
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type allCertifyBad struct {
@@ -7037,7 +7930,9 @@ func (v *allCertifyScorecardSource) __premarshalJSON() (*__premarshalallCertifyS
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -7674,7 +8569,7 @@ type allCertifyVuln struct {
 	Id string `json:"id"`
 	// package (subject) - the package object type that represents the package
 	Package allCertifyVulnPackage `json:"package"`
-	// vulnerability (object) - union type that consists of osv, cve or ghsa
+	// vulnerability (object) - union type that consists of osv, cve, ghsa or noVuln
 	Vulnerability allCertifyVulnVulnerabilityOsvCveOrGhsa `json:"-"`
 	// metadata (property) - contains all the vulnerability metadata
 	Metadata allCertifyVulnMetadataVulnerabilityMetaData `json:"metadata"`
@@ -8043,6 +8938,18 @@ func (v *allCertifyVulnVulnerabilityGHSA) __premarshalJSON() (*__premarshalallCe
 	return &retval, nil
 }
 
+// allCertifyVulnVulnerabilityNoVuln includes the requested fields of the GraphQL type NoVuln.
+// The GraphQL type's documentation follows.
+//
+// NoVuln is a special vulnerability node to attest that no vulnerability was
+// found when scanning a package, to tell it apart from a package never scanned.
+type allCertifyVulnVulnerabilityNoVuln struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns allCertifyVulnVulnerabilityNoVuln.Typename, and is useful for accessing the field via an interface.
+func (v *allCertifyVulnVulnerabilityNoVuln) GetTypename() *string { return v.Typename }
+
 // allCertifyVulnVulnerabilityOSV includes the requested fields of the GraphQL type OSV.
 // The GraphQL type's documentation follows.
 //
@@ -8121,9 +9028,10 @@ func (v *allCertifyVulnVulnerabilityOSV) __premarshalJSON() (*__premarshalallCer
 // allCertifyVulnVulnerabilityOSV
 // allCertifyVulnVulnerabilityCVE
 // allCertifyVulnVulnerabilityGHSA
+// allCertifyVulnVulnerabilityNoVuln
 // The GraphQL type's documentation follows.
 //
-// OsvCveGhsaObject is a union of OSV, CVE and GHSA. Any of these objects can be specified for vulnerability
+// OsvCveGhsaObject is a union of OSV, CVE, GHSA and NoVuln. Any of these objects can be specified for vulnerability
 type allCertifyVulnVulnerabilityOsvCveOrGhsa interface {
 	implementsGraphQLInterfaceallCertifyVulnVulnerabilityOsvCveOrGhsa()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
@@ -8136,6 +9044,8 @@ func (v *allCertifyVulnVulnerabilityCVE) implementsGraphQLInterfaceallCertifyVul
 }
 func (v *allCertifyVulnVulnerabilityGHSA) implementsGraphQLInterfaceallCertifyVulnVulnerabilityOsvCveOrGhsa() {
 }
+func (v *allCertifyVulnVulnerabilityNoVuln) implementsGraphQLInterfaceallCertifyVulnVulnerabilityOsvCveOrGhsa() {
+}
 
 func __unmarshalallCertifyVulnVulnerabilityOsvCveOrGhsa(b []byte, v *allCertifyVulnVulnerabilityOsvCveOrGhsa) error {
 	if string(b) == "null" {
@@ -8160,6 +9070,9 @@ func __unmarshalallCertifyVulnVulnerabilityOsvCveOrGhsa(b []byte, v *allCertifyV
 	case "GHSA":
 		*v = new(allCertifyVulnVulnerabilityGHSA)
 		return json.Unmarshal(b, *v)
+	case "NoVuln":
+		*v = new(allCertifyVulnVulnerabilityNoVuln)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing OsvCveOrGhsa.__typename")
@@ -8209,6 +9122,14 @@ func __marshalallCertifyVulnVulnerabilityOsvCveOrGhsa(v *allCertifyVulnVulnerabi
 			*__premarshalallCertifyVulnVulnerabilityGHSA
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *allCertifyVulnVulnerabilityNoVuln:
+		typename = "NoVuln"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*allCertifyVulnVulnerabilityNoVuln
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -8247,7 +9168,8 @@ func (v *allCveTree) GetCveIds() []allCveTreeCveIdsCVEId { return v.CveIds }
 //
 // # CVEId is the actual ID that is given to a specific vulnerability
 //
-// The `id` field is mandatory and canonicalized to be lowercase.
+// The `id` field is mandatory, must be of the form CVE-YYYY-NNNN and is
+// canonicalized to be lowercase.
 //
 // This node can be referred to by other parts of GUAC.
 type allCveTreeCveIdsCVEId struct {
@@ -8283,7 +9205,8 @@ func (v *allGHSATree) GetGhsaIds() []allGHSATreeGhsaIdsGHSAId { return v.GhsaIds
 //
 // # GHSAId is the actual ID that is given to a specific vulnerability on GitHub
 //
-// The `id` field is mandatory and canonicalized to be lowercase.
+// The `id` field is mandatory, must be of the form GHSA-xxxx-xxxx-xxxx and is
+// canonicalized to be lowercase.
 //
 // This node can be referred to by other parts of GUAC.
 type allGHSATreeGhsaIdsGHSAId struct {
@@ -8306,6 +9229,8 @@ func (v *allGHSATreeGhsaIdsGHSAId) GetGhsaId() string { return v.GhsaId }
 // uri (property) - identifier string for the SBOM
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// documentHash (property) - content hash of the original document in the blob store, if it was stored
+// fileMode (property) - how the file level entries of the SBOM were ingested (full, checksums-only or skip), if the parser supports choosing
 //
 // Note: Only package object or source object can be defined. Not both.
 type allHasSBOMTree struct {
@@ -8969,6 +9894,7 @@ func (v *allHashEqualTreeArtifactsArtifact) __premarshalJSON() (*__premarshalall
 // justification (property) - string value representing why the artifacts are the equal
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// successors - the SupersededBy chain of the dependent package, only set if includeSuccessors is set in the spec
 type allIsDependencyTree struct {
 	Id               string                              `json:"id"`
 	Justification    string                              `json:"justification"`
@@ -11278,6 +12204,76 @@ fragment allCertifyPkg on CertifyPkg {
 	return &data, err
 }
 
+func CertifySigned(
+	ctx context.Context,
+	client graphql.Client,
+	artifact ArtifactInputSpec,
+	certifySigned CertifySignedInputSpec,
+) (*CertifySignedResponse, error) {
+	req := &graphql.Request{
+		OpName: "CertifySigned",
+		Query: `
+mutation CertifySigned ($artifact: ArtifactInputSpec!, $certifySigned: CertifySignedInputSpec!) {
+	ingestArtifact(artifact: $artifact) {
+		id
+	}
+	ingestCertifySigned(artifact: $artifact, certifySigned: $certifySigned) {
+		id
+	}
+}
+`,
+		Variables: &__CertifySignedInput{
+			Artifact:      artifact,
+			CertifySigned: certifySigned,
+		},
+	}
+	var err error
+
+	var data CertifySignedResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GoModules(
+	ctx context.Context,
+	client graphql.Client,
+) (*GoModulesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GoModules",
+		Query: `
+query GoModules {
+	packages(pkgSpec: {type:"golang"}) {
+		namespaces {
+			namespace
+			names {
+				name
+			}
+		}
+	}
+}
+`,
+	}
+	var err error
+
+	var data GoModulesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func HasSBOMPkg(
 	ctx context.Context,
 	client graphql.Client,
@@ -11598,6 +12594,188 @@ fragment allHashEqualTree on HashEqual {
 	return &data, err
 }
 
+func HealthcheckCertifyVuln(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*HealthcheckCertifyVulnResponse, error) {
+	req := &graphql.Request{
+		OpName: "HealthcheckCertifyVuln",
+		Query: `
+query HealthcheckCertifyVuln ($id: ID!) {
+	CertifyVuln(certifyVulnSpec: {id:$id}) {
+		... allCertifyVuln
+	}
+}
+fragment allCertifyVuln on CertifyVuln {
+	id
+	package {
+		... allPkgTree
+	}
+	vulnerability {
+		__typename
+		... on CVE {
+			... allCveTree
+		}
+		... on OSV {
+			... allOSVTree
+		}
+		... on GHSA {
+			... allGHSATree
+		}
+	}
+	metadata {
+		dbUri
+		dbVersion
+		scannerUri
+		scannerVersion
+		timeScanned
+		origin
+		collector
+	}
+}
+fragment allPkgTree on Package {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			versions {
+				id
+				version
+				qualifiers {
+					key
+					value
+				}
+				subpath
+			}
+		}
+	}
+}
+fragment allCveTree on CVE {
+	id
+	year
+	cveIds {
+		id
+		cveId
+	}
+}
+fragment allOSVTree on OSV {
+	id
+	osvIds {
+		id
+		osvId
+	}
+}
+fragment allGHSATree on GHSA {
+	id
+	ghsaIds {
+		id
+		ghsaId
+	}
+}
+`,
+		Variables: &__HealthcheckCertifyVulnInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data HealthcheckCertifyVulnResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func HealthcheckHasSourceAt(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*HealthcheckHasSourceAtResponse, error) {
+	req := &graphql.Request{
+		OpName: "HealthcheckHasSourceAt",
+		Query: `
+query HealthcheckHasSourceAt ($id: ID!) {
+	HasSourceAt(hasSourceAtSpec: {id:$id}) {
+		... allHasSourceAt
+	}
+}
+fragment allHasSourceAt on HasSourceAt {
+	id
+	justification
+	knownSince
+	package {
+		... allPkgTree
+	}
+	source {
+		... allSourceTree
+	}
+	origin
+	collector
+}
+fragment allPkgTree on Package {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			versions {
+				id
+				version
+				qualifiers {
+					key
+					value
+				}
+				subpath
+			}
+		}
+	}
+}
+fragment allSourceTree on Source {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			tag
+			commit
+		}
+	}
+}
+`,
+		Variables: &__HealthcheckHasSourceAtInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data HealthcheckHasSourceAtResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func IsDependency(
 	ctx context.Context,
 	client graphql.Client,
@@ -12038,6 +13216,32 @@ fragment allCveTree on CVE {
 	return &data, err
 }
 
+func PurgeNamespace(
+	ctx context.Context,
+	client graphql.Client,
+) (*PurgeNamespaceResponse, error) {
+	req := &graphql.Request{
+		OpName: "PurgeNamespace",
+		Query: `
+mutation PurgeNamespace {
+	purgeNamespace
+}
+`,
+	}
+	var err error
+
+	var data PurgeNamespaceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func SLSAForArtifact(
 	ctx context.Context,
 	client graphql.Client,
@@ -12183,6 +13387,97 @@ fragment allCertifyScorecard on CertifyScorecard {
 	return &data, err
 }
 
+func StitchingProposals(
+	ctx context.Context,
+	client graphql.Client,
+	artifact ArtifactSpec,
+	windowSeconds *int,
+) (*StitchingProposalsResponse, error) {
+	req := &graphql.Request{
+		OpName: "StitchingProposals",
+		Query: `
+query StitchingProposals ($artifact: ArtifactSpec!, $windowSeconds: Int) {
+	stitchingProposals(artifact: $artifact, windowSeconds: $windowSeconds) {
+		artifact {
+			... allArtifactTree
+		}
+		equalArtifact {
+			... allArtifactTree
+		}
+		imageRef
+		justification
+		evidence
+	}
+}
+fragment allArtifactTree on Artifact {
+	id
+	algorithm
+	digest
+}
+`,
+		Variables: &__StitchingProposalsInput{
+			Artifact:      artifact,
+			WindowSeconds: windowSeconds,
+		},
+	}
+	var err error
+
+	var data StitchingProposalsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func SupersededBy(
+	ctx context.Context,
+	client graphql.Client,
+	pkg PkgInputSpec,
+	successor PkgInputSpec,
+	pkgMatchType MatchFlags,
+	supersededBy SupersededByInputSpec,
+) (*SupersededByResponse, error) {
+	req := &graphql.Request{
+		OpName: "SupersededBy",
+		Query: `
+mutation SupersededBy ($pkg: PkgInputSpec!, $successor: PkgInputSpec!, $pkgMatchType: MatchFlags!, $supersededBy: SupersededByInputSpec!) {
+	pkg: ingestPackage(pkg: $pkg) {
+		id
+	}
+	successor: ingestPackage(pkg: $successor) {
+		id
+	}
+	ingestSupersededBy(pkg: $pkg, successor: $successor, pkgMatchType: $pkgMatchType, supersededBy: $supersededBy) {
+		id
+	}
+}
+`,
+		Variables: &__SupersededByInput{
+			Pkg:          pkg,
+			Successor:    successor,
+			PkgMatchType: pkgMatchType,
+			SupersededBy: supersededBy,
+		},
+	}
+	var err error
+
+	var data SupersededByResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func VEXPackageAndGhsa(
 	ctx context.Context,
 	client graphql.Client,
@@ -50,6 +50,11 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 				return err
 			}
 
+			logger.Infof("assembling HasSBOM: %v", len(p.HasSBOM))
+			if err := ingestHasSBOM(ctx, gqlclient, p.HasSBOM); err != nil {
+				return err
+			}
+
 			logger.Infof("assembling CertifyVuln: %v", len(p.CertifyVuln))
 			if err := ingestCertifyVuln(ctx, gqlclient, p.CertifyVuln); err != nil {
 				return err
@@ -60,6 +65,21 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 				return err
 			}
 
+			logger.Infof("assembling CertifySigned: %v", len(p.CertifySigned))
+			if err := ingestCertifySigned(ctx, gqlclient, p.CertifySigned); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling SupersededBy: %v", len(p.SupersededBy))
+			if err := ingestSupersededBy(ctx, gqlclient, p.SupersededBy); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling HasSourceAt: %v", len(p.HasSourceAt))
+			if err := ingestHasSourceAt(ctx, gqlclient, p.HasSourceAt); err != nil {
+				return err
+			}
+
 		}
 		return nil
 	}
@@ -122,6 +142,23 @@ func ingestHasSlsa(ctx context.Context, client graphql.Client, vs []assembler.Ha
 	return nil
 }
 
+func ingestHasSBOM(ctx context.Context, client graphql.Client, vs []assembler.HasSBOMIngest) error {
+	for _, v := range vs {
+		if v.Pkg != nil {
+			_, err := model.HasSBOMPkg(ctx, client, *v.Pkg, *v.HasSBOM)
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err := model.HasSBOMSrc(ctx, client, *v.Src, *v.HasSBOM)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func ingestCertifyVuln(ctx context.Context, client graphql.Client, cvs []assembler.CertifyVulnIngest) error {
 	for _, cv := range cvs {
 		_, err := model.CertifyOSV(ctx, client, *cv.Pkg, *cv.OSV, *cv.VulnData)
@@ -159,4 +196,34 @@ func ingestIsVuln(ctx context.Context, client graphql.Client, ivs []assembler.Is
 	return nil
 }
 
+func ingestCertifySigned(ctx context.Context, client graphql.Client, css []assembler.CertifySignedIngest) error {
+	for _, cs := range css {
+		_, err := model.CertifySigned(ctx, client, *cs.Artifact, *cs.CertifySigned)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestSupersededBy(ctx context.Context, client graphql.Client, sbs []assembler.SupersededByIngest) error {
+	for _, sb := range sbs {
+		_, err := model.SupersededBy(ctx, client, *sb.Pkg, *sb.Successor, sb.PkgMatchFlag, *sb.SupersededBy)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestHasSourceAt(ctx context.Context, client graphql.Client, hsas []assembler.HasSourceAtIngest) error {
+	for _, hsa := range hsas {
+		_, err := model.HasSourceAt(ctx, client, *hsa.Pkg, hsa.PkgMatchFlag, *hsa.Src, *hsa.HasSourceAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TODO(lumjjb): add more ingestion verbs as they come up
@@ -0,0 +1,47 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"errors"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorKind returns the kind of the error that the GraphQL server reported in
+// err, the error of a query, so that clients can branch on it:
+//
+//	if errors.Is(helpers.ErrorKind(err), backends.ErrNotFound) {
+//	  ...
+//	}
+//
+// It returns nil if the server didn't report the kind of any of the errors of
+// the response.
+func ErrorKind(err error) error {
+	var errs gqlerror.List
+	if !errors.As(err, &errs) {
+		return nil
+	}
+	for _, e := range errs {
+		if code, ok := e.Extensions[backends.ErrorCodeExtension].(string); ok {
+			if kind := backends.ErrorKind(code); kind != nil {
+				return kind
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestErrorKind(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	config := generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}
+	gqlSrv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	gqlSrv.SetErrorPresenter(resolvers.ErrorPresenter)
+	srv := httptest.NewServer(gqlSrv)
+	defer srv.Close()
+	gqlclient := graphql.NewClient(srv.URL, srv.Client())
+
+	tests := []struct {
+		Name    string
+		ID      string
+		ExpKind error
+	}{
+		{
+			Name:    "Unknown ID",
+			ID:      "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			ExpKind: backends.ErrNotFound,
+		},
+		{
+			Name:    "Malformed ID",
+			ID:      "tensorflow",
+			ExpKind: backends.ErrInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			req := &graphql.Request{
+				OpName:    "HasSourceAtByID",
+				Query:     `query HasSourceAtByID($id: ID!) { HasSourceAt(hasSourceAtSpec: {id: $id}) { id } }`,
+				Variables: map[string]interface{}{"id": test.ID},
+			}
+			err := gqlclient.MakeRequest(ctx, req, &graphql.Response{})
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if kind := helpers.ErrorKind(err); !errors.Is(kind, test.ExpKind) {
+				t.Errorf("expected error of kind %v, got %v: %v", test.ExpKind, kind, err)
+			}
+		})
+	}
+
+	// errors of the client have no kind
+	if kind := helpers.ErrorKind(errors.New("connection refused")); kind != nil {
+		t.Errorf("expected no error kind, got: %v", kind)
+	}
+}
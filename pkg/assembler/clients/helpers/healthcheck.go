@@ -0,0 +1,226 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// HealthcheckNamespace is the namespace the healthcheck ingests its data into.
+// It is purged before and after every run, so nothing else should use it.
+const HealthcheckNamespace = "guac-healthcheck"
+
+// healthcheckTag is the origin and collector of all the evidence ingested by
+// the healthcheck, so that it stands out if it ever ends up elsewhere.
+const healthcheckTag = "guac-healthcheck"
+
+// HealthcheckStep is the outcome of one step of the healthcheck.
+type HealthcheckStep struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+type healthcheck struct {
+	gqlclient graphql.Client
+	steps     []HealthcheckStep
+}
+
+// Healthcheck checks that the GraphQL server behind gqlclient can ingest
+// evidence and return it unchanged. It ingests a synthetic package, source,
+// HasSourceAt and CertifyVuln, queries them back by ID and compares every
+// field, including the IDs and timestamps.
+//
+// gqlclient must send its requests to HealthcheckNamespace. The namespace is
+// purged before ingesting anything, and purged again at the end even if a step
+// failed or panicked. If the server can't purge it, nothing is ingested.
+//
+// All the steps run are returned, with the error of the first step that
+// failed, if any.
+func Healthcheck(ctx context.Context, gqlclient graphql.Client) (steps []HealthcheckStep, err error) {
+	h := &healthcheck{gqlclient: gqlclient}
+	if err := h.run("purge leftovers", func() error { return h.purge(ctx) }); err != nil {
+		return h.steps, err
+	}
+	defer func() {
+		cleanupErr := h.run("cleanup", func() error { return h.purge(ctx) })
+		if err == nil {
+			err = cleanupErr
+		}
+		steps = h.steps
+	}()
+	return nil, h.roundTrip(ctx)
+}
+
+func (h *healthcheck) run(name string, step func() error) error {
+	start := time.Now()
+	err := step()
+	h.steps = append(h.steps, HealthcheckStep{Name: name, Duration: time.Since(start), Err: err})
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func (h *healthcheck) purge(ctx context.Context) error {
+	_, err := model.PurgeNamespace(ctx, h.gqlclient)
+	return err
+}
+
+func (h *healthcheck) roundTrip(ctx context.Context) error {
+	pkgNamespace := "healthcheck"
+	version := "0.0.0"
+	pkg := model.PkgInputSpec{
+		Type:      "guac",
+		Namespace: &pkgNamespace,
+		Name:      "guac-healthcheck",
+		Version:   &version,
+	}
+	tag := "v0.0.0"
+	source := model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "healthcheck.guac.sh",
+		Name:      "guac-healthcheck",
+		Tag:       &tag,
+	}
+	cve := model.CVEInputSpec{Year: 1970, CveId: "cve-1970-0000"}
+	// the server may not keep more than millisecond precision
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	hasSourceAt := model.HasSourceAtInputSpec{
+		KnownSince:    now,
+		Justification: "guac healthcheck",
+		Origin:        healthcheckTag,
+		Collector:     healthcheckTag,
+	}
+	certifyVuln := model.VulnerabilityMetaDataInput{
+		TimeScanned:    now,
+		DbUri:          "https://guac.sh/healthcheck",
+		DbVersion:      "0.0.0",
+		ScannerUri:     "https://guac.sh/healthcheck",
+		ScannerVersion: "0.0.0",
+		Origin:         healthcheckTag,
+		Collector:      healthcheckTag,
+	}
+
+	var pkgID, sourceID, cveID, hasSourceAtID, certifyVulnID string
+	err := h.run("ingest HasSourceAt", func() error {
+		resp, err := model.HasSourceAt(ctx, h.gqlclient, pkg, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, source, hasSourceAt)
+		if err != nil {
+			return err
+		}
+		pkgID = resp.IngestPackage.Namespaces[0].Names[0].Versions[0].Id
+		sourceID = resp.IngestSource.Namespaces[0].Names[0].Id
+		hasSourceAtID = resp.IngestHasSourceAt.Id
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = h.run("ingest CertifyVuln", func() error {
+		resp, err := model.CertifyCVE(ctx, h.gqlclient, pkg, cve, certifyVuln)
+		if err != nil {
+			return err
+		}
+		cveID = resp.IngestCVE.Id
+		certifyVulnID = resp.IngestVulnerability.Id
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.run("query HasSourceAt", func() error {
+		resp, err := model.HealthcheckHasSourceAt(ctx, h.gqlclient, hasSourceAtID)
+		if err != nil {
+			return err
+		}
+		if len(resp.HasSourceAt) != 1 {
+			return fmt.Errorf("expected 1 HasSourceAt with ID %s, got %d", hasSourceAtID, len(resp.HasSourceAt))
+		}
+		got := resp.HasSourceAt[0]
+		return checkFields(
+			field("ID", got.Id, hasSourceAtID),
+			field("package ID", got.Package.Namespaces[0].Names[0].Versions[0].Id, pkgID),
+			field("source ID", got.Source.Namespaces[0].Names[0].Id, sourceID),
+			timeField("knownSince", got.KnownSince, now),
+			field("justification", got.Justification, hasSourceAt.Justification),
+			field("origin", got.Origin, hasSourceAt.Origin),
+			field("collector", got.Collector, hasSourceAt.Collector),
+		)
+	})
+	if err != nil {
+		return err
+	}
+	return h.run("query CertifyVuln", func() error {
+		resp, err := model.HealthcheckCertifyVuln(ctx, h.gqlclient, certifyVulnID)
+		if err != nil {
+			return err
+		}
+		if len(resp.CertifyVuln) != 1 {
+			return fmt.Errorf("expected 1 CertifyVuln with ID %s, got %d", certifyVulnID, len(resp.CertifyVuln))
+		}
+		got := resp.CertifyVuln[0]
+		if typename := got.Vulnerability.GetTypename(); typename == nil || *typename != "CVE" {
+			return fmt.Errorf("expected a CVE vulnerability, got %v", typename)
+		}
+		gotCVE, ok := got.Vulnerability.(interface{ GetId() string })
+		if !ok {
+			return fmt.Errorf("unexpected vulnerability type %T", got.Vulnerability)
+		}
+		return checkFields(
+			field("ID", got.Id, certifyVulnID),
+			field("package ID", got.Package.Namespaces[0].Names[0].Versions[0].Id, pkgID),
+			field("CVE ID", gotCVE.GetId(), cveID),
+			timeField("timeScanned", got.Metadata.TimeScanned, now),
+			field("dbUri", got.Metadata.DbUri, certifyVuln.DbUri),
+			field("dbVersion", got.Metadata.DbVersion, certifyVuln.DbVersion),
+			field("scannerUri", got.Metadata.ScannerUri, certifyVuln.ScannerUri),
+			field("scannerVersion", got.Metadata.ScannerVersion, certifyVuln.ScannerVersion),
+			field("origin", got.Metadata.Origin, certifyVuln.Origin),
+			field("collector", got.Metadata.Collector, certifyVuln.Collector),
+		)
+	})
+}
+
+// field returns an error if the value of a field read back from the server
+// is not the one ingested.
+func field[T comparable](name string, got, want T) error {
+	if got != want {
+		return fmt.Errorf("%s: got %v, want %v", name, got, want)
+	}
+	return nil
+}
+
+func timeField(name string, got, want time.Time) error {
+	if !got.Equal(want) {
+		return fmt.Errorf("%s: got %v, want %v", name, got, want)
+	}
+	return nil
+}
+
+func checkFields(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+// faultyBackend fails the purge or the CertifyVuln query on request.
+type faultyBackend struct {
+	backends.Backend
+	failPurge       bool
+	failCertifyVuln bool
+}
+
+func (b *faultyBackend) PurgeNamespace(ctx context.Context) (bool, error) {
+	if b.failPurge {
+		return false, fmt.Errorf("purge not supported")
+	}
+	return b.Backend.PurgeNamespace(ctx)
+}
+
+func (b *faultyBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	if b.failCertifyVuln {
+		return nil, fmt.Errorf("query failed")
+	}
+	return b.Backend.CertifyVuln(ctx, certifyVulnSpec)
+}
+
+func TestHealthcheck(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Backend   faultyBackend
+		ExpSteps  []string
+		ExpFailed string
+	}{
+		{
+			Name: "Round trip",
+			ExpSteps: []string{
+				"purge leftovers",
+				"ingest HasSourceAt",
+				"ingest CertifyVuln",
+				"query HasSourceAt",
+				"query CertifyVuln",
+				"cleanup",
+			},
+		},
+		{
+			Name:      "Purge not supported",
+			Backend:   faultyBackend{failPurge: true},
+			ExpSteps:  []string{"purge leftovers"},
+			ExpFailed: "purge leftovers",
+		},
+		{
+			Name:    "Cleanup after failure",
+			Backend: faultyBackend{failCertifyVuln: true},
+			ExpSteps: []string{
+				"purge leftovers",
+				"ingest HasSourceAt",
+				"ingest CertifyVuln",
+				"query HasSourceAt",
+				"query CertifyVuln",
+				"cleanup",
+			},
+			ExpFailed: "query CertifyVuln",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			b, err := inmem.GetEmptyBackend(nil)
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			test.Backend.Backend = b
+			config := generated.Config{Resolvers: &resolvers.Resolver{Backend: &test.Backend}}
+			srv := httptest.NewServer(helper.NamespaceHandler(handler.NewDefaultServer(generated.NewExecutableSchema(config)), nil))
+			defer srv.Close()
+			gqlclient := graphql.NewClient(srv.URL, helpers.NewHTTPClient(helpers.HealthcheckNamespace, ""))
+
+			steps, err := helpers.Healthcheck(ctx, gqlclient)
+			if (err != nil) != (test.ExpFailed != "") {
+				t.Errorf("did not get expected healthcheck error, want: %v, got: %v", test.ExpFailed, err)
+			}
+			var gotSteps []string
+			for _, step := range steps {
+				gotSteps = append(gotSteps, step.Name)
+				if (step.Err != nil) != (step.Name == test.ExpFailed) {
+					t.Errorf("unexpected result of step %s: %v", step.Name, step.Err)
+				}
+			}
+			if fmt.Sprint(gotSteps) != fmt.Sprint(test.ExpSteps) {
+				t.Errorf("unexpected steps, want: %v, got: %v", test.ExpSteps, gotSteps)
+			}
+
+			// no residue of the healthcheck is left in its namespace
+			nsCtx := helper.WithNamespace(ctx, helpers.HealthcheckNamespace)
+			pkgs, err := b.Packages(nsCtx, &model.PkgSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			sources, err := b.Sources(nsCtx, &model.SourceSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			hasSourceAts, err := b.HasSourceAt(nsCtx, &model.HasSourceAtSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			certifyVulns, err := b.CertifyVuln(nsCtx, &model.CertifyVulnSpec{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(pkgs)+len(sources)+len(hasSourceAts)+len(certifyVulns) != 0 {
+				t.Errorf("Healthcheck left data behind: %v packages, %v sources, %v HasSourceAt, %v CertifyVuln",
+					len(pkgs), len(sources), len(hasSourceAts), len(certifyVulns))
+			}
+		})
+	}
+}
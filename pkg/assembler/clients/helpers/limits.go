@@ -0,0 +1,78 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/logging"
+	"go.uber.org/zap"
+)
+
+// resultLimitsExtension is the response extension in which the GraphQL server
+// reports the queries it truncated because they were not paginated.
+const resultLimitsExtension = "resultLimits"
+
+type resultLimit struct {
+	Returned       int  `json:"returned"`
+	TotalAvailable int  `json:"totalAvailable"`
+	Truncated      bool `json:"truncated"`
+}
+
+type truncationWarningClient struct {
+	client graphql.Client
+	logger *zap.SugaredLogger
+}
+
+// WarnOnTruncation wraps gqlclient so that a warning is logged whenever the
+// server returns only part of the results of a query because the query was
+// not paginated and hit the server's default result cap.
+func WarnOnTruncation(ctx context.Context, gqlclient graphql.Client) graphql.Client {
+	return &truncationWarningClient{client: gqlclient, logger: logging.FromContext(ctx)}
+}
+
+func (c *truncationWarningClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	err := c.client.MakeRequest(ctx, req, resp)
+	if resp == nil || resp.Extensions[resultLimitsExtension] == nil {
+		return err
+	}
+	raw, jsonErr := json.Marshal(resp.Extensions[resultLimitsExtension])
+	if jsonErr != nil {
+		return err
+	}
+	var limits map[string]resultLimit
+	if jsonErr := json.Unmarshal(raw, &limits); jsonErr != nil {
+		c.logger.Warnf("unable to decode %s response extension: %v", resultLimitsExtension, jsonErr)
+		return err
+	}
+	queries := make([]string, 0, len(limits))
+	for query := range limits {
+		queries = append(queries, query)
+	}
+	sort.Strings(queries)
+	for _, query := range queries {
+		limit := limits[query]
+		if !limit.Truncated {
+			continue
+		}
+		c.logger.Warnf("%s: results of %s truncated to %d of %d, paginate with first/after if the query supports it or raise the server cap with --gql-result-limits %s=<n>",
+			req.OpName, query, limit.Returned, limit.TotalAvailable, query)
+	}
+	return err
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWarnOnTruncation(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Limit   int
+		ExpWarn string
+	}{
+		{
+			Name:    "Truncated",
+			Limit:   1,
+			ExpWarn: "Query: results of CertifyVuln truncated to 1 of 2",
+		},
+		{
+			Name:  "Not truncated",
+			Limit: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{DefaultResultLimit: test.Limit, AutoIngestSubjects: true})
+			if err != nil {
+				t.Fatalf("Could not instantiate testing backend: %v", err)
+			}
+			cve := &model.CVEInputSpec{Year: 2023, CveID: "CVE-2023-1234"}
+			if _, err := b.IngestCve(ctx, cve); err != nil {
+				t.Fatalf("Could not ingest CVE: %v", err)
+			}
+			for _, name := range []string{"a", "b"} {
+				pkg := model.PkgInputSpec{Type: "pypi", Name: name, Version: ptrfrom.String("1.0.0")}
+				if _, err := b.IngestVulnerability(ctx, pkg, model.OsvCveOrGhsaInput{Cve: cve}, model.VulnerabilityMetaDataInput{}); err != nil {
+					t.Fatalf("Could not ingest CertifyVuln: %v", err)
+				}
+			}
+			srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{Backend: b}}))
+			srv.AroundResponses(resolvers.ResultLimits)
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+			gqlclient := &truncationWarningClient{client: graphql.NewClient(ts.URL, nil), logger: zap.New(core).Sugar()}
+			var data map[string]any
+			req := &graphql.Request{OpName: "Query", Query: "query Query { CertifyVuln(certifyVulnSpec: {}) { id } }"}
+			if err := gqlclient.MakeRequest(ctx, req, &graphql.Response{Data: &data}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var warnings []string
+			for _, entry := range logs.All() {
+				warnings = append(warnings, entry.Message)
+			}
+			if test.ExpWarn == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warning, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.HasPrefix(warnings[0], test.ExpWarn) {
+				t.Errorf("expected a warning starting with %q, got %v", test.ExpWarn, warnings)
+			}
+		})
+	}
+}
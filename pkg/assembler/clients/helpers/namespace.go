@@ -0,0 +1,55 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"net/http"
+)
+
+// namespaceHeader is the header from which the GraphQL server reads the
+// namespace of a request.
+const namespaceHeader = "X-Guac-Namespace"
+
+type namespaceTransport struct {
+	namespace string
+	token     string
+	next      http.RoundTripper
+}
+
+// NewHTTPClient returns the HTTP client for the GraphQL server, sending the
+// requests to namespace and authenticating them with the bearer token. Empty
+// values are not sent, in which case the server picks the namespace from the
+// token, or uses its default namespace.
+func NewHTTPClient(namespace, token string) *http.Client {
+	return &http.Client{
+		Transport: &namespaceTransport{
+			namespace: namespace,
+			token:     token,
+			next:      http.DefaultTransport,
+		},
+	}
+}
+
+func (t *namespaceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.namespace != "" {
+		req.Header.Set(namespaceHeader, t.namespace)
+	}
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return t.next.RoundTrip(req)
+}
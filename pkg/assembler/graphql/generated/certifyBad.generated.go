@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -204,6 +205,50 @@ func (ec *executionContext) fieldContext_CertifyBad_collector(ctx context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _CertifyBad_knownSince(ctx context.Context, field graphql.CollectedField, obj *model.CertifyBad) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CertifyBad_knownSince(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.KnownSince, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CertifyBad_knownSince(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CertifyBad",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -215,7 +260,7 @@ func (ec *executionContext) unmarshalInputCertifyBadInputSpec(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"justification", "origin", "collector", "knownSince"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -246,6 +291,14 @@ func (ec *executionContext) unmarshalInputCertifyBadInputSpec(ctx context.Contex
 			if err != nil {
 				return it, err
 			}
+		case "knownSince":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSince"))
+			it.KnownSince, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -259,7 +312,7 @@ func (ec *executionContext) unmarshalInputCertifyBadSpec(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"subject", "justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"subject", "justification", "origin", "collector", "knownSince", "knownSinceAfter", "knownSinceBefore", "stringMatch"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -298,6 +351,38 @@ func (ec *executionContext) unmarshalInputCertifyBadSpec(ctx context.Context, ob
 			if err != nil {
 				return it, err
 			}
+		case "knownSince":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSince"))
+			it.KnownSince, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "knownSinceAfter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSinceAfter"))
+			it.KnownSinceAfter, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "knownSinceBefore":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSinceBefore"))
+			it.KnownSinceBefore, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "stringMatch":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stringMatch"))
+			it.StringMatch, err = ec.unmarshalOStringMatchMode2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐStringMatchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -458,7 +543,7 @@ func (ec *executionContext) _PackageSourceOrArtifact(ctx context.Context, sel as
 
 // region    **************************** object.gotpl ****************************
 
-var certifyBadImplementors = []string{"CertifyBad", "Nodes"}
+var certifyBadImplementors = []string{"CertifyBad", "ConflictEvidence", "Nodes"}
 
 func (ec *executionContext) _CertifyBad(ctx context.Context, sel ast.SelectionSet, obj *model.CertifyBad) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, certifyBadImplementors)
@@ -493,6 +578,13 @@ func (ec *executionContext) _CertifyBad(ctx context.Context, sel ast.SelectionSe
 
 			out.Values[i] = ec._CertifyBad_collector(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "knownSince":
+
+			out.Values[i] = ec._CertifyBad_knownSince(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -589,6 +681,50 @@ func (ec *executionContext) marshalNPackageSourceOrArtifact2githubᚗcomᚋguacs
 	return ec._PackageSourceOrArtifact(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPackageSourceOrArtifact2ᚕgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageSourceOrArtifactᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PackageSourceOrArtifact) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPackageSourceOrArtifact2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageSourceOrArtifact(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNPackageSourceOrArtifactInput2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageSourceOrArtifactInput(ctx context.Context, v interface{}) (model.PackageSourceOrArtifactInput, error) {
 	res, err := ec.unmarshalInputPackageSourceOrArtifactInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -267,7 +267,7 @@ func (ec *executionContext) unmarshalInputCertifyPkgSpec(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"packages", "justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"packages", "justification", "origin", "collector", "stringMatch"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -306,6 +306,14 @@ func (ec *executionContext) unmarshalInputCertifyPkgSpec(ctx context.Context, ob
 			if err != nil {
 				return it, err
 			}
+		case "stringMatch":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stringMatch"))
+			it.StringMatch, err = ec.unmarshalOStringMatchMode2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐStringMatchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -353,6 +353,195 @@ func (ec *executionContext) fieldContext_HasSourceAt_collector(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _HasSourceAtConnection_hasSourceAts(ctx context.Context, field graphql.CollectedField, obj *model.HasSourceAtConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HasSourceAtConnection_hasSourceAts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasSourceAts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.HasSourceAt)
+	fc.Result = res
+	return ec.marshalNHasSourceAt2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HasSourceAtConnection_hasSourceAts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HasSourceAtConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_HasSourceAt_id(ctx, field)
+			case "package":
+				return ec.fieldContext_HasSourceAt_package(ctx, field)
+			case "source":
+				return ec.fieldContext_HasSourceAt_source(ctx, field)
+			case "knownSince":
+				return ec.fieldContext_HasSourceAt_knownSince(ctx, field)
+			case "justification":
+				return ec.fieldContext_HasSourceAt_justification(ctx, field)
+			case "origin":
+				return ec.fieldContext_HasSourceAt_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_HasSourceAt_collector(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HasSourceAt", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HasSourceAtConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.HasSourceAtConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HasSourceAtConnection_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HasSourceAtConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HasSourceAtConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HasSourceAtConnection_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.HasSourceAtConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HasSourceAtConnection_endCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HasSourceAtConnection_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HasSourceAtConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HasSourceAtConnection_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.HasSourceAtConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HasSourceAtConnection_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HasSourceAtConnection_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HasSourceAtConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -416,7 +605,7 @@ func (ec *executionContext) unmarshalInputHasSourceAtSpec(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "package", "source", "knownSince", "justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"id", "package", "source", "knownSince", "knownSinceAfter", "knownSinceBefore", "justification", "origin", "collector", "origins", "collectors", "stringMatch", "orderByKnownSince", "latest"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -455,6 +644,22 @@ func (ec *executionContext) unmarshalInputHasSourceAtSpec(ctx context.Context, o
 			if err != nil {
 				return it, err
 			}
+		case "knownSinceAfter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSinceAfter"))
+			it.KnownSinceAfter, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "knownSinceBefore":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("knownSinceBefore"))
+			it.KnownSinceBefore, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		case "justification":
 			var err error
 
@@ -479,6 +684,46 @@ func (ec *executionContext) unmarshalInputHasSourceAtSpec(ctx context.Context, o
 			if err != nil {
 				return it, err
 			}
+		case "origins":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("origins"))
+			it.Origins, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "collectors":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("collectors"))
+			it.Collectors, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "stringMatch":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stringMatch"))
+			it.StringMatch, err = ec.unmarshalOStringMatchMode2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐStringMatchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "orderByKnownSince":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderByKnownSince"))
+			it.OrderByKnownSince, err = ec.unmarshalOSortDirection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "latest":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("latest"))
+			it.Latest, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -493,7 +738,7 @@ func (ec *executionContext) unmarshalInputHasSourceAtSpec(ctx context.Context, o
 
 // region    **************************** object.gotpl ****************************
 
-var hasSourceAtImplementors = []string{"HasSourceAt", "Nodes"}
+var hasSourceAtImplementors = []string{"HasSourceAt", "ConflictEvidence", "Nodes"}
 
 func (ec *executionContext) _HasSourceAt(ctx context.Context, sel ast.SelectionSet, obj *model.HasSourceAt) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, hasSourceAtImplementors)
@@ -563,6 +808,52 @@ func (ec *executionContext) _HasSourceAt(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var hasSourceAtConnectionImplementors = []string{"HasSourceAtConnection"}
+
+func (ec *executionContext) _HasSourceAtConnection(ctx context.Context, sel ast.SelectionSet, obj *model.HasSourceAtConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hasSourceAtConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HasSourceAtConnection")
+		case "hasSourceAts":
+
+			out.Values[i] = ec._HasSourceAtConnection_hasSourceAts(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totalCount":
+
+			out.Values[i] = ec._HasSourceAtConnection_totalCount(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endCursor":
+
+			out.Values[i] = ec._HasSourceAtConnection_endCursor(ctx, field, obj)
+
+		case "hasNextPage":
+
+			out.Values[i] = ec._HasSourceAtConnection_hasNextPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 // endregion **************************** object.gotpl ****************************
 
 // region    ***************************** type.gotpl *****************************
@@ -625,11 +916,47 @@ func (ec *executionContext) marshalNHasSourceAt2ᚖgithubᚗcomᚋguacsecᚋguac
 	return ec._HasSourceAt(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNHasSourceAtConnection2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtConnection(ctx context.Context, sel ast.SelectionSet, v model.HasSourceAtConnection) graphql.Marshaler {
+	return ec._HasSourceAtConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNHasSourceAtConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtConnection(ctx context.Context, sel ast.SelectionSet, v *model.HasSourceAtConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._HasSourceAtConnection(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNHasSourceAtInputSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtInputSpec(ctx context.Context, v interface{}) (model.HasSourceAtInputSpec, error) {
 	res, err := ec.unmarshalInputHasSourceAtInputSpec(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNHasSourceAtInputSpec2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtInputSpecᚄ(ctx context.Context, v interface{}) ([]*model.HasSourceAtInputSpec, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.HasSourceAtInputSpec, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNHasSourceAtInputSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtInputSpec(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNHasSourceAtInputSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtInputSpec(ctx context.Context, v interface{}) (*model.HasSourceAtInputSpec, error) {
+	res, err := ec.unmarshalInputHasSourceAtInputSpec(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOHasSourceAtSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSourceAtSpec(ctx context.Context, v interface{}) (*model.HasSourceAtSpec, error) {
 	if v == nil {
 		return nil, nil
@@ -311,7 +311,7 @@ func (ec *executionContext) unmarshalInputHashEqualSpec(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "artifacts", "justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"id", "artifacts", "justification", "origin", "collector", "stringMatch"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -358,6 +358,14 @@ func (ec *executionContext) unmarshalInputHashEqualSpec(ctx context.Context, obj
 			if err != nil {
 				return it, err
 			}
+		case "stringMatch":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stringMatch"))
+			it.StringMatch, err = ec.unmarshalOStringMatchMode2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐStringMatchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -425,7 +425,7 @@ func (ec *executionContext) unmarshalInputIsVulnerabilitySpec(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "osv", "vulnerability", "justification", "origin", "collector"}
+	fieldsInOrder := [...]string{"id", "osv", "vulnerability", "justification", "origin", "collector", "stringMatch"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -480,6 +480,14 @@ func (ec *executionContext) unmarshalInputIsVulnerabilitySpec(ctx context.Contex
 			if err != nil {
 				return it, err
 			}
+		case "stringMatch":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stringMatch"))
+			it.StringMatch, err = ec.unmarshalOStringMatchMode2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐStringMatchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -30,82 +30,6 @@ import (
 
 // region    **************************** input.gotpl *****************************
 
-func (ec *executionContext) unmarshalInputPackageSourceArtifactBuilderOsvCveOrGhsaFilter(ctx context.Context, obj interface{}) (model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, error) {
-	var it model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"package", "source", "artifact", "builder", "osv", "cve", "ghsa"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "package":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("package"))
-			it.Package, err = ec.unmarshalOPkgSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPkgSpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "source":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("source"))
-			it.Source, err = ec.unmarshalOSourceSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐSourceSpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "artifact":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("artifact"))
-			it.Artifact, err = ec.unmarshalOArtifactSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐArtifactSpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "builder":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("builder"))
-			it.Builder, err = ec.unmarshalOBuilderSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐBuilderSpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "osv":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("osv"))
-			it.Osv, err = ec.unmarshalOOSVSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐOSVSpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "cve":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cve"))
-			it.Cve, err = ec.unmarshalOCVESpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCVESpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "ghsa":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ghsa"))
-			it.Ghsa, err = ec.unmarshalOGHSASpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐGHSASpec(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		}
-	}
-
-	return it, nil
-}
-
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -205,6 +129,13 @@ func (ec *executionContext) _Nodes(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._CertifyBad(ctx, sel, obj)
+	case model.CertifyGood:
+		return ec._CertifyGood(ctx, sel, &obj)
+	case *model.CertifyGood:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CertifyGood(ctx, sel, obj)
 	case model.CertifyPkg:
 		return ec._CertifyPkg(ctx, sel, &obj)
 	case *model.CertifyPkg:
@@ -247,6 +178,34 @@ func (ec *executionContext) _Nodes(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._HasSLSA(ctx, sel, obj)
+	case model.CertifySigned:
+		return ec._CertifySigned(ctx, sel, &obj)
+	case *model.CertifySigned:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CertifySigned(ctx, sel, obj)
+	case model.SeverityOverride:
+		return ec._SeverityOverride(ctx, sel, &obj)
+	case *model.SeverityOverride:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeverityOverride(ctx, sel, obj)
+	case model.SupersededBy:
+		return ec._SupersededBy(ctx, sel, &obj)
+	case *model.SupersededBy:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SupersededBy(ctx, sel, obj)
+	case model.NoVuln:
+		return ec._NoVuln(ctx, sel, &obj)
+	case *model.NoVuln:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._NoVuln(ctx, sel, obj)
 	default:
 		panic(fmt.Errorf("unexpected type %T", obj))
 	}
@@ -314,9 +273,4 @@ func (ec *executionContext) marshalNNodes2ᚕgithubᚗcomᚋguacsecᚋguacᚋpkg
 	return ret
 }
 
-func (ec *executionContext) unmarshalNPackageSourceArtifactBuilderOsvCveOrGhsaFilter2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageSourceArtifactBuilderOsvCveOrGhsaFilter(ctx context.Context, v interface{}) (model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, error) {
-	res, err := ec.unmarshalInputPackageSourceArtifactBuilderOsvCveOrGhsaFilter(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
 // endregion ***************************** type.gotpl *****************************
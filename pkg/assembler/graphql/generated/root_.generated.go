@@ -44,6 +44,12 @@ type ComplexityRoot struct {
 		ID        func(childComplexity int) int
 	}
 
+	BackendStats struct {
+		Edges func(childComplexity int) int
+		Nodes func(childComplexity int) int
+		Types func(childComplexity int) int
+	}
+
 	Builder struct {
 		ID  func(childComplexity int) int
 		URI func(childComplexity int) int
@@ -63,6 +69,15 @@ type ComplexityRoot struct {
 	CertifyBad struct {
 		Collector     func(childComplexity int) int
 		Justification func(childComplexity int) int
+		KnownSince    func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Subject       func(childComplexity int) int
+	}
+
+	CertifyGood struct {
+		Collector     func(childComplexity int) int
+		Justification func(childComplexity int) int
+		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Subject       func(childComplexity int) int
 	}
@@ -80,19 +95,87 @@ type ComplexityRoot struct {
 		Source    func(childComplexity int) int
 	}
 
+	CertifySigned struct {
+		Artifact      func(childComplexity int) int
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		SignatureType func(childComplexity int) int
+		Signer        func(childComplexity int) int
+		Status        func(childComplexity int) int
+		TrustRoot     func(childComplexity int) int
+		VerifiedAt    func(childComplexity int) int
+	}
+
 	CertifyVEXStatement struct {
 		Collector     func(childComplexity int) int
 		Justification func(childComplexity int) int
 		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
+		Statement     func(childComplexity int) int
+		Status        func(childComplexity int) int
 		Subject       func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
 	CertifyVuln struct {
-		ID            func(childComplexity int) int
-		Metadata      func(childComplexity int) int
-		Package       func(childComplexity int) int
+		EffectiveSeverity func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Metadata          func(childComplexity int) int
+		Package           func(childComplexity int) int
+		Successors        func(childComplexity int) int
+		Vulnerability     func(childComplexity int) int
+	}
+
+	CertifyVulnConnection struct {
+		CertifyVulns func(childComplexity int) int
+		EndCursor    func(childComplexity int) int
+		HasNextPage  func(childComplexity int) int
+		TotalCount   func(childComplexity int) int
+	}
+
+	Change struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+		Type   func(childComplexity int) int
+	}
+
+	ChangeConnection struct {
+		Changes     func(childComplexity int) int
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
+	CollectorDiff struct {
+		Both  func(childComplexity int) int
+		OnlyA func(childComplexity int) int
+		OnlyB func(childComplexity int) int
+	}
+
+	CollectorDiffPartition struct {
+		Count  func(childComplexity int) int
+		Sample func(childComplexity int) int
+	}
+
+	Conflict struct {
+		DetectedAt func(childComplexity int) int
+		First      func(childComplexity int) int
+		Pattern    func(childComplexity int) int
+		Second     func(childComplexity int) int
+	}
+
+	DependencyTree struct {
+		Dependencies func(childComplexity int) int
+		Occurrences  func(childComplexity int) int
+		Packages     func(childComplexity int) int
+		Truncated    func(childComplexity int) int
+	}
+
+	EffectiveSeverity struct {
+		Bucket        func(childComplexity int) int
+		Override      func(childComplexity int) int
+		Score         func(childComplexity int) int
+		Source        func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
@@ -107,10 +190,12 @@ type ComplexityRoot struct {
 	}
 
 	HasSBOM struct {
-		Collector func(childComplexity int) int
-		Origin    func(childComplexity int) int
-		Subject   func(childComplexity int) int
-		URI       func(childComplexity int) int
+		Collector    func(childComplexity int) int
+		DocumentHash func(childComplexity int) int
+		FileMode     func(childComplexity int) int
+		Origin       func(childComplexity int) int
+		Subject      func(childComplexity int) int
+		URI          func(childComplexity int) int
 	}
 
 	HasSLSA struct {
@@ -129,6 +214,13 @@ type ComplexityRoot struct {
 		Source        func(childComplexity int) int
 	}
 
+	HasSourceAtConnection struct {
+		EndCursor    func(childComplexity int) int
+		HasNextPage  func(childComplexity int) int
+		HasSourceAts func(childComplexity int) int
+		TotalCount   func(childComplexity int) int
+	}
+
 	HashEqual struct {
 		Artifacts     func(childComplexity int) int
 		Collector     func(childComplexity int) int
@@ -144,9 +236,17 @@ type ComplexityRoot struct {
 		Justification    func(childComplexity int) int
 		Origin           func(childComplexity int) int
 		Package          func(childComplexity int) int
+		Successors       func(childComplexity int) int
 		VersionRange     func(childComplexity int) int
 	}
 
+	IsDependencyConnection struct {
+		EndCursor      func(childComplexity int) int
+		HasNextPage    func(childComplexity int) int
+		IsDependencies func(childComplexity int) int
+		TotalCount     func(childComplexity int) int
+	}
+
 	IsOccurrence struct {
 		Artifact      func(childComplexity int) int
 		Collector     func(childComplexity int) int
@@ -166,26 +266,46 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		CertifyScorecard      func(childComplexity int, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) int
-		IngestArtifact        func(childComplexity int, artifact *model.ArtifactInputSpec) int
-		IngestBuilder         func(childComplexity int, builder *model.BuilderInputSpec) int
-		IngestCertifyBad      func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) int
-		IngestCertifyPkg      func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) int
-		IngestCve             func(childComplexity int, cve *model.CVEInputSpec) int
-		IngestDependency      func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, dependency model.IsDependencyInputSpec) int
-		IngestGhsa            func(childComplexity int, ghsa *model.GHSAInputSpec) int
-		IngestHasSbom         func(childComplexity int, subject model.PackageOrSourceInput, hasSbom model.HasSBOMInputSpec) int
-		IngestHasSourceAt     func(childComplexity int, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) int
-		IngestHashEqual       func(childComplexity int, artifact model.ArtifactInputSpec, equalArtifact model.ArtifactInputSpec, hashEqual model.HashEqualInputSpec) int
-		IngestIsVulnerability func(childComplexity int, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) int
-		IngestMaterials       func(childComplexity int, materials []*model.ArtifactInputSpec) int
-		IngestOccurrence      func(childComplexity int, subject model.PackageOrSourceInput, artifact model.ArtifactInputSpec, occurrence model.IsOccurrenceInputSpec) int
-		IngestOsv             func(childComplexity int, osv *model.OSVInputSpec) int
-		IngestPackage         func(childComplexity int, pkg model.PkgInputSpec) int
-		IngestSlsa            func(childComplexity int, subject model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) int
-		IngestSource          func(childComplexity int, source model.SourceInputSpec) int
-		IngestVEXStatement    func(childComplexity int, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) int
-		IngestVulnerability   func(childComplexity int, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) int
+		CertifyScorecard       func(childComplexity int, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) int
+		IngestArtifact         func(childComplexity int, artifact *model.ArtifactInputSpec) int
+		IngestArtifacts        func(childComplexity int, artifacts []*model.ArtifactInputSpec) int
+		IngestBuilder          func(childComplexity int, builder *model.BuilderInputSpec) int
+		IngestCertifyBad       func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) int
+		IngestCertifyGood      func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) int
+		IngestCertifyPkg       func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) int
+		IngestCertifySigned    func(childComplexity int, artifact model.ArtifactInputSpec, certifySigned model.CertifySignedInputSpec) int
+		IngestCve              func(childComplexity int, cve *model.CVEInputSpec) int
+		IngestDependency       func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, dependency model.IsDependencyInputSpec) int
+		IngestGhsa             func(childComplexity int, ghsa *model.GHSAInputSpec) int
+		IngestHasSbom          func(childComplexity int, subject model.PackageOrSourceInput, hasSbom model.HasSBOMInputSpec) int
+		IngestHasSourceAt      func(childComplexity int, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) int
+		IngestHasSourceAts     func(childComplexity int, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) int
+		IngestHashEqual        func(childComplexity int, artifact model.ArtifactInputSpec, equalArtifact model.ArtifactInputSpec, hashEqual model.HashEqualInputSpec) int
+		IngestIsVulnerability  func(childComplexity int, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) int
+		IngestMaterials        func(childComplexity int, materials []*model.ArtifactInputSpec) int
+		IngestOccurrence       func(childComplexity int, subject model.PackageOrSourceInput, artifact model.ArtifactInputSpec, occurrence model.IsOccurrenceInputSpec) int
+		IngestOsv              func(childComplexity int, osv *model.OSVInputSpec) int
+		IngestPackage          func(childComplexity int, pkg model.PkgInputSpec) int
+		IngestPackages         func(childComplexity int, pkgs []*model.PkgInputSpec) int
+		IngestSeverityOverride func(childComplexity int, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) int
+		IngestSlsa             func(childComplexity int, subject model.ArtifactInputSpec, builtFrom []*model.ArtifactInputSpec, builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) int
+		IngestSource           func(childComplexity int, source model.SourceInputSpec) int
+		IngestSources          func(childComplexity int, sources []*model.SourceInputSpec) int
+		IngestSupersededBy     func(childComplexity int, pkg model.PkgInputSpec, successor model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) int
+		IngestVEXStatement     func(childComplexity int, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) int
+		IngestVulnerability    func(childComplexity int, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) int
+		PurgeNamespace         func(childComplexity int) int
+		RetractEvidence        func(childComplexity int, origin string) int
+	}
+
+	NoVuln struct {
+		ID func(childComplexity int) int
+	}
+
+	NodeTypeStats struct {
+		Edges func(childComplexity int) int
+		Nodes func(childComplexity int) int
+		Type  func(childComplexity int) int
 	}
 
 	OSV struct {
@@ -232,23 +352,61 @@ type ComplexityRoot struct {
 		Artifacts           func(childComplexity int, artifactSpec *model.ArtifactSpec) int
 		Builders            func(childComplexity int, builderSpec *model.BuilderSpec) int
 		CertifyBad          func(childComplexity int, certifyBadSpec *model.CertifyBadSpec) int
+		CertifyGood         func(childComplexity int, certifyGoodSpec *model.CertifyGoodSpec) int
 		CertifyPkg          func(childComplexity int, certifyPkgSpec *model.CertifyPkgSpec) int
+		CertifySigned       func(childComplexity int, certifySignedSpec *model.CertifySignedSpec) int
 		CertifyVEXStatement func(childComplexity int, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) int
 		CertifyVuln         func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
+		CertifyVulnCount    func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
+		CertifyVulnList     func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec, first *int, after *string) int
+		Changes             func(childComplexity int, after *string, types []model.NodeType, first *int) int
+		CollectorDiff       func(childComplexity int, verb model.Verb, collectorA string, collectorB string, subject model.PkgSpec, sampleSize *int) int
+		Conflicts           func(childComplexity int, patterns []model.ConflictPattern) int
 		Cve                 func(childComplexity int, cveSpec *model.CVESpec) int
+		Dependencies        func(childComplexity int, pkg model.PkgSpec, maxDepth int) int
+		EffectiveSeverity   func(childComplexity int, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) int
+		EqualArtifacts      func(childComplexity int, artifact model.ArtifactSpec) int
+		FindSoftware        func(childComplexity int, searchText string) int
 		Ghsa                func(childComplexity int, ghsaSpec *model.GHSASpec) int
 		HasSbom             func(childComplexity int, hasSBOMSpec *model.HasSBOMSpec) int
 		HasSlsa             func(childComplexity int, hasSLSASpec *model.HasSLSASpec) int
 		HasSourceAt         func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec) int
+		HasSourceAtCount    func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec) int
+		HasSourceAtList     func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec, first *int, after *string) int
 		HashEqual           func(childComplexity int, hashEqualSpec *model.HashEqualSpec) int
 		IsDependency        func(childComplexity int, isDependencySpec *model.IsDependencySpec) int
+		IsDependencyCount   func(childComplexity int, isDependencySpec *model.IsDependencySpec) int
+		IsDependencyList    func(childComplexity int, isDependencySpec *model.IsDependencySpec, first *int, after *string) int
 		IsOccurrence        func(childComplexity int, isOccurrenceSpec *model.IsOccurrenceSpec) int
 		IsVulnerability     func(childComplexity int, isVulnerabilitySpec *model.IsVulnerabilitySpec) int
+		Neighbors           func(childComplexity int, node string) int
+		Node                func(childComplexity int, node string) int
+		Nodes               func(childComplexity int, nodes []string) int
 		Osv                 func(childComplexity int, osvSpec *model.OSVSpec) int
 		Packages            func(childComplexity int, pkgSpec *model.PkgSpec) int
-		Path                func(childComplexity int, subject model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, target model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, maxPathLength int) int
+		Path                func(childComplexity int, subject string, target string, maxPathLength int) int
+		RiskyPackages       func(childComplexity int, conditions model.RiskyPackageConditions, first *int, after *string) int
 		Scorecards          func(childComplexity int, scorecardSpec *model.CertifyScorecardSpec) int
+		SeverityOverride    func(childComplexity int, severityOverrideSpec *model.SeverityOverrideSpec) int
 		Sources             func(childComplexity int, sourceSpec *model.SourceSpec) int
+		Stats               func(childComplexity int) int
+		StitchingProposals  func(childComplexity int, artifact model.ArtifactSpec, windowSeconds *int) int
+		Successors          func(childComplexity int, pkg model.PkgSpec) int
+		SupersededBy        func(childComplexity int, supersededBySpec *model.SupersededBySpec) int
+	}
+
+	RiskyPackage struct {
+		HasSbom                func(childComplexity int) int
+		Package                func(childComplexity int) int
+		Scorecards             func(childComplexity int) int
+		UnprovenancedArtifacts func(childComplexity int) int
+		Vulnerabilities        func(childComplexity int) int
+	}
+
+	RiskyPackageConnection struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+		Packages    func(childComplexity int) int
 	}
 
 	SLSA struct {
@@ -256,6 +414,7 @@ type ComplexityRoot struct {
 		BuiltBy       func(childComplexity int) int
 		BuiltFrom     func(childComplexity int) int
 		Collector     func(childComplexity int) int
+		DocumentHash  func(childComplexity int) int
 		FinishedOn    func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		SlsaPredicate func(childComplexity int) int
@@ -283,6 +442,21 @@ type ComplexityRoot struct {
 		Score func(childComplexity int) int
 	}
 
+	SeverityOverride struct {
+		Collector     func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		ExpiresAt     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Justification func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Retracted     func(childComplexity int) int
+		Reviewer      func(childComplexity int) int
+		Score         func(childComplexity int) int
+		ScoreType     func(childComplexity int) int
+		Subject       func(childComplexity int) int
+		Vulnerability func(childComplexity int) int
+	}
+
 	Source struct {
 		ID         func(childComplexity int) int
 		Namespaces func(childComplexity int) int
@@ -302,11 +476,30 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	StitchProposal struct {
+		Artifact      func(childComplexity int) int
+		EqualArtifact func(childComplexity int) int
+		Evidence      func(childComplexity int) int
+		ImageRef      func(childComplexity int) int
+		Justification func(childComplexity int) int
+	}
+
+	SupersededBy struct {
+		Collector func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Origin    func(childComplexity int) int
+		Package   func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Since     func(childComplexity int) int
+		Successor func(childComplexity int) int
+	}
+
 	VulnerabilityMetaData struct {
 		Collector      func(childComplexity int) int
 		DbURI          func(childComplexity int) int
 		DbVersion      func(childComplexity int) int
 		Origin         func(childComplexity int) int
+		ScannerScore   func(childComplexity int) int
 		ScannerURI     func(childComplexity int) int
 		ScannerVersion func(childComplexity int) int
 		TimeScanned    func(childComplexity int) int
@@ -349,6 +542,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Artifact.ID(childComplexity), true
 
+	case "BackendStats.edges":
+		if e.complexity.BackendStats.Edges == nil {
+			break
+		}
+
+		return e.complexity.BackendStats.Edges(childComplexity), true
+
+	case "BackendStats.nodes":
+		if e.complexity.BackendStats.Nodes == nil {
+			break
+		}
+
+		return e.complexity.BackendStats.Nodes(childComplexity), true
+
+	case "BackendStats.types":
+		if e.complexity.BackendStats.Types == nil {
+			break
+		}
+
+		return e.complexity.BackendStats.Types(childComplexity), true
+
 	case "Builder.id":
 		if e.complexity.Builder.ID == nil {
 			break
@@ -412,6 +626,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyBad.Justification(childComplexity), true
 
+	case "CertifyBad.knownSince":
+		if e.complexity.CertifyBad.KnownSince == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.KnownSince(childComplexity), true
+
 	case "CertifyBad.origin":
 		if e.complexity.CertifyBad.Origin == nil {
 			break
@@ -426,6 +647,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyBad.Subject(childComplexity), true
 
+	case "CertifyGood.collector":
+		if e.complexity.CertifyGood.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Collector(childComplexity), true
+
+	case "CertifyGood.justification":
+		if e.complexity.CertifyGood.Justification == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Justification(childComplexity), true
+
+	case "CertifyGood.knownSince":
+		if e.complexity.CertifyGood.KnownSince == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.KnownSince(childComplexity), true
+
+	case "CertifyGood.origin":
+		if e.complexity.CertifyGood.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Origin(childComplexity), true
+
+	case "CertifyGood.subject":
+		if e.complexity.CertifyGood.Subject == nil {
+			break
+		}
+
+		return e.complexity.CertifyGood.Subject(childComplexity), true
+
 	case "CertifyPkg.collector":
 		if e.complexity.CertifyPkg.Collector == nil {
 			break
@@ -475,6 +731,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyScorecard.Source(childComplexity), true
 
+	case "CertifySigned.artifact":
+		if e.complexity.CertifySigned.Artifact == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.Artifact(childComplexity), true
+
+	case "CertifySigned.collector":
+		if e.complexity.CertifySigned.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.Collector(childComplexity), true
+
+	case "CertifySigned.id":
+		if e.complexity.CertifySigned.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.ID(childComplexity), true
+
+	case "CertifySigned.origin":
+		if e.complexity.CertifySigned.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.Origin(childComplexity), true
+
+	case "CertifySigned.signatureType":
+		if e.complexity.CertifySigned.SignatureType == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.SignatureType(childComplexity), true
+
+	case "CertifySigned.signer":
+		if e.complexity.CertifySigned.Signer == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.Signer(childComplexity), true
+
+	case "CertifySigned.status":
+		if e.complexity.CertifySigned.Status == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.Status(childComplexity), true
+
+	case "CertifySigned.trustRoot":
+		if e.complexity.CertifySigned.TrustRoot == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.TrustRoot(childComplexity), true
+
+	case "CertifySigned.verifiedAt":
+		if e.complexity.CertifySigned.VerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.CertifySigned.VerifiedAt(childComplexity), true
+
 	case "CertifyVEXStatement.collector":
 		if e.complexity.CertifyVEXStatement.Collector == nil {
 			break
@@ -503,6 +822,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Origin(childComplexity), true
 
+	case "CertifyVEXStatement.statement":
+		if e.complexity.CertifyVEXStatement.Statement == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Statement(childComplexity), true
+
+	case "CertifyVEXStatement.status":
+		if e.complexity.CertifyVEXStatement.Status == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Status(childComplexity), true
+
 	case "CertifyVEXStatement.subject":
 		if e.complexity.CertifyVEXStatement.Subject == nil {
 			break
@@ -517,6 +850,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
 
+	case "CertifyVuln.effectiveSeverity":
+		if e.complexity.CertifyVuln.EffectiveSeverity == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.EffectiveSeverity(childComplexity), true
+
 	case "CertifyVuln.id":
 		if e.complexity.CertifyVuln.ID == nil {
 			break
@@ -538,6 +878,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVuln.Package(childComplexity), true
 
+	case "CertifyVuln.successors":
+		if e.complexity.CertifyVuln.Successors == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Successors(childComplexity), true
+
 	case "CertifyVuln.vulnerability":
 		if e.complexity.CertifyVuln.Vulnerability == nil {
 			break
@@ -545,222 +892,495 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
 
-	case "GHSA.ghsaIds":
-		if e.complexity.GHSA.GhsaIds == nil {
+	case "CertifyVulnConnection.certifyVulns":
+		if e.complexity.CertifyVulnConnection.CertifyVulns == nil {
 			break
 		}
 
-		return e.complexity.GHSA.GhsaIds(childComplexity), true
+		return e.complexity.CertifyVulnConnection.CertifyVulns(childComplexity), true
 
-	case "GHSA.id":
-		if e.complexity.GHSA.ID == nil {
+	case "CertifyVulnConnection.endCursor":
+		if e.complexity.CertifyVulnConnection.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.GHSA.ID(childComplexity), true
+		return e.complexity.CertifyVulnConnection.EndCursor(childComplexity), true
 
-	case "GHSAId.ghsaId":
-		if e.complexity.GHSAId.GhsaID == nil {
+	case "CertifyVulnConnection.hasNextPage":
+		if e.complexity.CertifyVulnConnection.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.GHSAId.GhsaID(childComplexity), true
+		return e.complexity.CertifyVulnConnection.HasNextPage(childComplexity), true
 
-	case "GHSAId.id":
-		if e.complexity.GHSAId.ID == nil {
+	case "CertifyVulnConnection.totalCount":
+		if e.complexity.CertifyVulnConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.GHSAId.ID(childComplexity), true
+		return e.complexity.CertifyVulnConnection.TotalCount(childComplexity), true
 
-	case "HasSBOM.collector":
-		if e.complexity.HasSBOM.Collector == nil {
+	case "Change.cursor":
+		if e.complexity.Change.Cursor == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Collector(childComplexity), true
+		return e.complexity.Change.Cursor(childComplexity), true
 
-	case "HasSBOM.origin":
-		if e.complexity.HasSBOM.Origin == nil {
+	case "Change.node":
+		if e.complexity.Change.Node == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Origin(childComplexity), true
+		return e.complexity.Change.Node(childComplexity), true
 
-	case "HasSBOM.subject":
-		if e.complexity.HasSBOM.Subject == nil {
+	case "Change.type":
+		if e.complexity.Change.Type == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Subject(childComplexity), true
+		return e.complexity.Change.Type(childComplexity), true
 
-	case "HasSBOM.uri":
-		if e.complexity.HasSBOM.URI == nil {
+	case "ChangeConnection.changes":
+		if e.complexity.ChangeConnection.Changes == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.URI(childComplexity), true
+		return e.complexity.ChangeConnection.Changes(childComplexity), true
 
-	case "HasSLSA.id":
-		if e.complexity.HasSLSA.ID == nil {
+	case "ChangeConnection.endCursor":
+		if e.complexity.ChangeConnection.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.HasSLSA.ID(childComplexity), true
+		return e.complexity.ChangeConnection.EndCursor(childComplexity), true
 
-	case "HasSLSA.slsa":
-		if e.complexity.HasSLSA.Slsa == nil {
+	case "ChangeConnection.hasNextPage":
+		if e.complexity.ChangeConnection.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.HasSLSA.Slsa(childComplexity), true
+		return e.complexity.ChangeConnection.HasNextPage(childComplexity), true
 
-	case "HasSLSA.subject":
-		if e.complexity.HasSLSA.Subject == nil {
+	case "CollectorDiff.both":
+		if e.complexity.CollectorDiff.Both == nil {
 			break
 		}
 
-		return e.complexity.HasSLSA.Subject(childComplexity), true
+		return e.complexity.CollectorDiff.Both(childComplexity), true
 
-	case "HasSourceAt.collector":
-		if e.complexity.HasSourceAt.Collector == nil {
+	case "CollectorDiff.onlyA":
+		if e.complexity.CollectorDiff.OnlyA == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Collector(childComplexity), true
+		return e.complexity.CollectorDiff.OnlyA(childComplexity), true
 
-	case "HasSourceAt.id":
-		if e.complexity.HasSourceAt.ID == nil {
+	case "CollectorDiff.onlyB":
+		if e.complexity.CollectorDiff.OnlyB == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.ID(childComplexity), true
+		return e.complexity.CollectorDiff.OnlyB(childComplexity), true
 
-	case "HasSourceAt.justification":
-		if e.complexity.HasSourceAt.Justification == nil {
+	case "CollectorDiffPartition.count":
+		if e.complexity.CollectorDiffPartition.Count == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Justification(childComplexity), true
+		return e.complexity.CollectorDiffPartition.Count(childComplexity), true
 
-	case "HasSourceAt.knownSince":
-		if e.complexity.HasSourceAt.KnownSince == nil {
+	case "CollectorDiffPartition.sample":
+		if e.complexity.CollectorDiffPartition.Sample == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.KnownSince(childComplexity), true
+		return e.complexity.CollectorDiffPartition.Sample(childComplexity), true
 
-	case "HasSourceAt.origin":
-		if e.complexity.HasSourceAt.Origin == nil {
+	case "Conflict.detectedAt":
+		if e.complexity.Conflict.DetectedAt == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Origin(childComplexity), true
+		return e.complexity.Conflict.DetectedAt(childComplexity), true
 
-	case "HasSourceAt.package":
-		if e.complexity.HasSourceAt.Package == nil {
+	case "Conflict.first":
+		if e.complexity.Conflict.First == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Package(childComplexity), true
+		return e.complexity.Conflict.First(childComplexity), true
 
-	case "HasSourceAt.source":
-		if e.complexity.HasSourceAt.Source == nil {
+	case "Conflict.pattern":
+		if e.complexity.Conflict.Pattern == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Source(childComplexity), true
+		return e.complexity.Conflict.Pattern(childComplexity), true
 
-	case "HashEqual.artifacts":
-		if e.complexity.HashEqual.Artifacts == nil {
+	case "Conflict.second":
+		if e.complexity.Conflict.Second == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Artifacts(childComplexity), true
+		return e.complexity.Conflict.Second(childComplexity), true
 
-	case "HashEqual.collector":
-		if e.complexity.HashEqual.Collector == nil {
+	case "DependencyTree.dependencies":
+		if e.complexity.DependencyTree.Dependencies == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Collector(childComplexity), true
+		return e.complexity.DependencyTree.Dependencies(childComplexity), true
 
-	case "HashEqual.id":
-		if e.complexity.HashEqual.ID == nil {
+	case "DependencyTree.occurrences":
+		if e.complexity.DependencyTree.Occurrences == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.ID(childComplexity), true
+		return e.complexity.DependencyTree.Occurrences(childComplexity), true
 
-	case "HashEqual.justification":
-		if e.complexity.HashEqual.Justification == nil {
+	case "DependencyTree.packages":
+		if e.complexity.DependencyTree.Packages == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Justification(childComplexity), true
+		return e.complexity.DependencyTree.Packages(childComplexity), true
 
-	case "HashEqual.origin":
-		if e.complexity.HashEqual.Origin == nil {
+	case "DependencyTree.truncated":
+		if e.complexity.DependencyTree.Truncated == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Origin(childComplexity), true
+		return e.complexity.DependencyTree.Truncated(childComplexity), true
 
-	case "IsDependency.collector":
-		if e.complexity.IsDependency.Collector == nil {
+	case "EffectiveSeverity.bucket":
+		if e.complexity.EffectiveSeverity.Bucket == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Collector(childComplexity), true
+		return e.complexity.EffectiveSeverity.Bucket(childComplexity), true
 
-	case "IsDependency.dependentPackage":
-		if e.complexity.IsDependency.DependentPackage == nil {
+	case "EffectiveSeverity.override":
+		if e.complexity.EffectiveSeverity.Override == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.DependentPackage(childComplexity), true
+		return e.complexity.EffectiveSeverity.Override(childComplexity), true
 
-	case "IsDependency.id":
-		if e.complexity.IsDependency.ID == nil {
+	case "EffectiveSeverity.score":
+		if e.complexity.EffectiveSeverity.Score == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.ID(childComplexity), true
+		return e.complexity.EffectiveSeverity.Score(childComplexity), true
 
-	case "IsDependency.justification":
-		if e.complexity.IsDependency.Justification == nil {
+	case "EffectiveSeverity.source":
+		if e.complexity.EffectiveSeverity.Source == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Justification(childComplexity), true
+		return e.complexity.EffectiveSeverity.Source(childComplexity), true
 
-	case "IsDependency.origin":
-		if e.complexity.IsDependency.Origin == nil {
+	case "EffectiveSeverity.vulnerability":
+		if e.complexity.EffectiveSeverity.Vulnerability == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Origin(childComplexity), true
+		return e.complexity.EffectiveSeverity.Vulnerability(childComplexity), true
 
-	case "IsDependency.package":
-		if e.complexity.IsDependency.Package == nil {
+	case "GHSA.ghsaIds":
+		if e.complexity.GHSA.GhsaIds == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Package(childComplexity), true
+		return e.complexity.GHSA.GhsaIds(childComplexity), true
 
-	case "IsDependency.versionRange":
-		if e.complexity.IsDependency.VersionRange == nil {
+	case "GHSA.id":
+		if e.complexity.GHSA.ID == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.VersionRange(childComplexity), true
+		return e.complexity.GHSA.ID(childComplexity), true
 
-	case "IsOccurrence.artifact":
-		if e.complexity.IsOccurrence.Artifact == nil {
+	case "GHSAId.ghsaId":
+		if e.complexity.GHSAId.GhsaID == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+		return e.complexity.GHSAId.GhsaID(childComplexity), true
+
+	case "GHSAId.id":
+		if e.complexity.GHSAId.ID == nil {
+			break
+		}
+
+		return e.complexity.GHSAId.ID(childComplexity), true
+
+	case "HasSBOM.collector":
+		if e.complexity.HasSBOM.Collector == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Collector(childComplexity), true
+
+	case "HasSBOM.documentHash":
+		if e.complexity.HasSBOM.DocumentHash == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.DocumentHash(childComplexity), true
+
+	case "HasSBOM.fileMode":
+		if e.complexity.HasSBOM.FileMode == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.FileMode(childComplexity), true
+
+	case "HasSBOM.origin":
+		if e.complexity.HasSBOM.Origin == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Origin(childComplexity), true
+
+	case "HasSBOM.subject":
+		if e.complexity.HasSBOM.Subject == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Subject(childComplexity), true
+
+	case "HasSBOM.uri":
+		if e.complexity.HasSBOM.URI == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.URI(childComplexity), true
+
+	case "HasSLSA.id":
+		if e.complexity.HasSLSA.ID == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.ID(childComplexity), true
+
+	case "HasSLSA.slsa":
+		if e.complexity.HasSLSA.Slsa == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.Slsa(childComplexity), true
+
+	case "HasSLSA.subject":
+		if e.complexity.HasSLSA.Subject == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.Subject(childComplexity), true
+
+	case "HasSourceAt.collector":
+		if e.complexity.HasSourceAt.Collector == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Collector(childComplexity), true
+
+	case "HasSourceAt.id":
+		if e.complexity.HasSourceAt.ID == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.ID(childComplexity), true
+
+	case "HasSourceAt.justification":
+		if e.complexity.HasSourceAt.Justification == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Justification(childComplexity), true
+
+	case "HasSourceAt.knownSince":
+		if e.complexity.HasSourceAt.KnownSince == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.KnownSince(childComplexity), true
+
+	case "HasSourceAt.origin":
+		if e.complexity.HasSourceAt.Origin == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Origin(childComplexity), true
+
+	case "HasSourceAt.package":
+		if e.complexity.HasSourceAt.Package == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Package(childComplexity), true
+
+	case "HasSourceAt.source":
+		if e.complexity.HasSourceAt.Source == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Source(childComplexity), true
+
+	case "HasSourceAtConnection.endCursor":
+		if e.complexity.HasSourceAtConnection.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.EndCursor(childComplexity), true
+
+	case "HasSourceAtConnection.hasNextPage":
+		if e.complexity.HasSourceAtConnection.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.HasNextPage(childComplexity), true
+
+	case "HasSourceAtConnection.hasSourceAts":
+		if e.complexity.HasSourceAtConnection.HasSourceAts == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.HasSourceAts(childComplexity), true
+
+	case "HasSourceAtConnection.totalCount":
+		if e.complexity.HasSourceAtConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.TotalCount(childComplexity), true
+
+	case "HashEqual.artifacts":
+		if e.complexity.HashEqual.Artifacts == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Artifacts(childComplexity), true
+
+	case "HashEqual.collector":
+		if e.complexity.HashEqual.Collector == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Collector(childComplexity), true
+
+	case "HashEqual.id":
+		if e.complexity.HashEqual.ID == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.ID(childComplexity), true
+
+	case "HashEqual.justification":
+		if e.complexity.HashEqual.Justification == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Justification(childComplexity), true
+
+	case "HashEqual.origin":
+		if e.complexity.HashEqual.Origin == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Origin(childComplexity), true
+
+	case "IsDependency.collector":
+		if e.complexity.IsDependency.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Collector(childComplexity), true
+
+	case "IsDependency.dependentPackage":
+		if e.complexity.IsDependency.DependentPackage == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.DependentPackage(childComplexity), true
+
+	case "IsDependency.id":
+		if e.complexity.IsDependency.ID == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.ID(childComplexity), true
+
+	case "IsDependency.justification":
+		if e.complexity.IsDependency.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Justification(childComplexity), true
+
+	case "IsDependency.origin":
+		if e.complexity.IsDependency.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Origin(childComplexity), true
+
+	case "IsDependency.package":
+		if e.complexity.IsDependency.Package == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Package(childComplexity), true
+
+	case "IsDependency.successors":
+		if e.complexity.IsDependency.Successors == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Successors(childComplexity), true
+
+	case "IsDependency.versionRange":
+		if e.complexity.IsDependency.VersionRange == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.VersionRange(childComplexity), true
+
+	case "IsDependencyConnection.endCursor":
+		if e.complexity.IsDependencyConnection.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.EndCursor(childComplexity), true
+
+	case "IsDependencyConnection.hasNextPage":
+		if e.complexity.IsDependencyConnection.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.HasNextPage(childComplexity), true
+
+	case "IsDependencyConnection.isDependencies":
+		if e.complexity.IsDependencyConnection.IsDependencies == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.IsDependencies(childComplexity), true
+
+	case "IsDependencyConnection.totalCount":
+		if e.complexity.IsDependencyConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.TotalCount(childComplexity), true
+
+	case "IsOccurrence.artifact":
+		if e.complexity.IsOccurrence.Artifact == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Artifact(childComplexity), true
 
 	case "IsOccurrence.collector":
 		if e.complexity.IsOccurrence.Collector == nil {
@@ -863,6 +1483,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestArtifact(childComplexity, args["artifact"].(*model.ArtifactInputSpec)), true
 
+	case "Mutation.ingestArtifacts":
+		if e.complexity.Mutation.IngestArtifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestArtifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestArtifacts(childComplexity, args["artifacts"].([]*model.ArtifactInputSpec)), true
+
 	case "Mutation.ingestBuilder":
 		if e.complexity.Mutation.IngestBuilder == nil {
 			break
@@ -887,6 +1519,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestCertifyBad(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.MatchFlags), args["certifyBad"].(model.CertifyBadInputSpec)), true
 
+	case "Mutation.ingestCertifyGood":
+		if e.complexity.Mutation.IngestCertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyGood(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.MatchFlags), args["certifyGood"].(model.CertifyGoodInputSpec)), true
+
 	case "Mutation.ingestCertifyPkg":
 		if e.complexity.Mutation.IngestCertifyPkg == nil {
 			break
@@ -899,6 +1543,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestCertifyPkg(childComplexity, args["pkg"].(model.PkgInputSpec), args["depPkg"].(model.PkgInputSpec), args["certifyPkg"].(model.CertifyPkgInputSpec)), true
 
+	case "Mutation.ingestCertifySigned":
+		if e.complexity.Mutation.IngestCertifySigned == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifySigned_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifySigned(childComplexity, args["artifact"].(model.ArtifactInputSpec), args["certifySigned"].(model.CertifySignedInputSpec)), true
+
 	case "Mutation.ingestCVE":
 		if e.complexity.Mutation.IngestCve == nil {
 			break
@@ -959,6 +1615,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestHasSourceAt(childComplexity, args["pkg"].(model.PkgInputSpec), args["pkgMatchType"].(model.MatchFlags), args["source"].(model.SourceInputSpec), args["hasSourceAt"].(model.HasSourceAtInputSpec)), true
 
+	case "Mutation.ingestHasSourceAts":
+		if e.complexity.Mutation.IngestHasSourceAts == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasSourceAts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasSourceAts(childComplexity, args["pkgs"].([]*model.PkgInputSpec), args["pkgMatchType"].(model.MatchFlags), args["sources"].([]*model.SourceInputSpec), args["hasSourceAts"].([]*model.HasSourceAtInputSpec)), true
+
 	case "Mutation.ingestHashEqual":
 		if e.complexity.Mutation.IngestHashEqual == nil {
 			break
@@ -1031,6 +1699,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestPackage(childComplexity, args["pkg"].(model.PkgInputSpec)), true
 
+	case "Mutation.ingestPackages":
+		if e.complexity.Mutation.IngestPackages == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPackages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPackages(childComplexity, args["pkgs"].([]*model.PkgInputSpec)), true
+
+	case "Mutation.ingestSeverityOverride":
+		if e.complexity.Mutation.IngestSeverityOverride == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSeverityOverride_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSeverityOverride(childComplexity, args["vulnerability"].(model.OsvCveOrGhsaInput), args["subject"].(*model.PackageOrArtifactInput), args["severityOverride"].(model.SeverityOverrideInputSpec)), true
+
 	case "Mutation.ingestSLSA":
 		if e.complexity.Mutation.IngestSlsa == nil {
 			break
@@ -1055,6 +1747,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestSource(childComplexity, args["source"].(model.SourceInputSpec)), true
 
+	case "Mutation.ingestSources":
+		if e.complexity.Mutation.IngestSources == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSources_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSources(childComplexity, args["sources"].([]*model.SourceInputSpec)), true
+
+	case "Mutation.ingestSupersededBy":
+		if e.complexity.Mutation.IngestSupersededBy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSupersededBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestSupersededBy(childComplexity, args["pkg"].(model.PkgInputSpec), args["successor"].(model.PkgInputSpec), args["pkgMatchType"].(model.MatchFlags), args["supersededBy"].(model.SupersededByInputSpec)), true
+
 	case "Mutation.ingestVEXStatement":
 		if e.complexity.Mutation.IngestVEXStatement == nil {
 			break
@@ -1065,19 +1781,66 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestVEXStatement(childComplexity, args["subject"].(model.PackageOrArtifactInput), args["vulnerability"].(model.CveOrGhsaInput), args["vexStatement"].(model.VexStatementInputSpec)), true
+		return e.complexity.Mutation.IngestVEXStatement(childComplexity, args["subject"].(model.PackageOrArtifactInput), args["vulnerability"].(model.CveOrGhsaInput), args["vexStatement"].(model.VexStatementInputSpec)), true
+
+	case "Mutation.ingestVulnerability":
+		if e.complexity.Mutation.IngestVulnerability == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVulnerability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVulnerability(childComplexity, args["pkg"].(model.PkgInputSpec), args["vulnerability"].(model.OsvCveOrGhsaInput), args["certifyVuln"].(model.VulnerabilityMetaDataInput)), true
+
+	case "Mutation.purgeNamespace":
+		if e.complexity.Mutation.PurgeNamespace == nil {
+			break
+		}
+
+		return e.complexity.Mutation.PurgeNamespace(childComplexity), true
+
+	case "Mutation.retractEvidence":
+		if e.complexity.Mutation.RetractEvidence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retractEvidence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RetractEvidence(childComplexity, args["origin"].(string)), true
+
+	case "NoVuln.id":
+		if e.complexity.NoVuln.ID == nil {
+			break
+		}
+
+		return e.complexity.NoVuln.ID(childComplexity), true
+
+	case "NodeTypeStats.edges":
+		if e.complexity.NodeTypeStats.Edges == nil {
+			break
+		}
+
+		return e.complexity.NodeTypeStats.Edges(childComplexity), true
 
-	case "Mutation.ingestVulnerability":
-		if e.complexity.Mutation.IngestVulnerability == nil {
+	case "NodeTypeStats.nodes":
+		if e.complexity.NodeTypeStats.Nodes == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestVulnerability_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.NodeTypeStats.Nodes(childComplexity), true
+
+	case "NodeTypeStats.type":
+		if e.complexity.NodeTypeStats.Type == nil {
+			break
 		}
 
-		return e.complexity.Mutation.IngestVulnerability(childComplexity, args["pkg"].(model.PkgInputSpec), args["vulnerability"].(model.OsvCveOrGhsaInput), args["certifyVuln"].(model.VulnerabilityMetaDataInput)), true
+		return e.complexity.NodeTypeStats.Type(childComplexity), true
 
 	case "OSV.id":
 		if e.complexity.OSV.ID == nil {
@@ -1248,6 +2011,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(*model.CertifyBadSpec)), true
 
+	case "Query.CertifyGood":
+		if e.complexity.Query.CertifyGood == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyGood_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyGood(childComplexity, args["certifyGoodSpec"].(*model.CertifyGoodSpec)), true
+
 	case "Query.CertifyPkg":
 		if e.complexity.Query.CertifyPkg == nil {
 			break
@@ -1260,6 +2035,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyPkg(childComplexity, args["certifyPkgSpec"].(*model.CertifyPkgSpec)), true
 
+	case "Query.CertifySigned":
+		if e.complexity.Query.CertifySigned == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifySigned_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifySigned(childComplexity, args["certifySignedSpec"].(*model.CertifySignedSpec)), true
+
 	case "Query.CertifyVEXStatement":
 		if e.complexity.Query.CertifyVEXStatement == nil {
 			break
@@ -1284,6 +2071,66 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
 
+	case "Query.CertifyVulnCount":
+		if e.complexity.Query.CertifyVulnCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnCount(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
+
+	case "Query.CertifyVulnList":
+		if e.complexity.Query.CertifyVulnList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyVulnList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.changes":
+		if e.complexity.Query.Changes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_changes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Changes(childComplexity, args["after"].(*string), args["types"].([]model.NodeType), args["first"].(*int)), true
+
+	case "Query.collectorDiff":
+		if e.complexity.Query.CollectorDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collectorDiff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CollectorDiff(childComplexity, args["verb"].(model.Verb), args["collectorA"].(string), args["collectorB"].(string), args["subject"].(model.PkgSpec), args["sampleSize"].(*int)), true
+
+	case "Query.conflicts":
+		if e.complexity.Query.Conflicts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_conflicts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Conflicts(childComplexity, args["patterns"].([]model.ConflictPattern)), true
+
 	case "Query.cve":
 		if e.complexity.Query.Cve == nil {
 			break
@@ -1296,6 +2143,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Cve(childComplexity, args["cveSpec"].(*model.CVESpec)), true
 
+	case "Query.dependencies":
+		if e.complexity.Query.Dependencies == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dependencies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Dependencies(childComplexity, args["pkg"].(model.PkgSpec), args["maxDepth"].(int)), true
+
+	case "Query.effectiveSeverity":
+		if e.complexity.Query.EffectiveSeverity == nil {
+			break
+		}
+
+		args, err := ec.field_Query_effectiveSeverity_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EffectiveSeverity(childComplexity, args["vulnerability"].(model.OsvCveOrGhsaInput), args["subject"].(*model.PackageOrArtifactInput), args["scannerScore"].(float64)), true
+
+	case "Query.equalArtifacts":
+		if e.complexity.Query.EqualArtifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_equalArtifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EqualArtifacts(childComplexity, args["artifact"].(model.ArtifactSpec)), true
+
+	case "Query.findSoftware":
+		if e.complexity.Query.FindSoftware == nil {
+			break
+		}
+
+		args, err := ec.field_Query_findSoftware_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FindSoftware(childComplexity, args["searchText"].(string)), true
+
 	case "Query.ghsa":
 		if e.complexity.Query.Ghsa == nil {
 			break
@@ -1342,115 +2237,322 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
+		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
+
+	case "Query.HasSourceAtCount":
+		if e.complexity.Query.HasSourceAtCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSourceAtCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSourceAtCount(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
+
+	case "Query.HasSourceAtList":
+		if e.complexity.Query.HasSourceAtList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasSourceAtList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasSourceAtList(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.HashEqual":
+		if e.complexity.Query.HashEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(*model.HashEqualSpec)), true
+
+	case "Query.IsDependency":
+		if e.complexity.Query.IsDependency == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
+
+	case "Query.IsDependencyCount":
+		if e.complexity.Query.IsDependencyCount == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependencyCount_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependencyCount(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
+
+	case "Query.IsDependencyList":
+		if e.complexity.Query.IsDependencyList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsDependencyList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsDependencyList(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.IsOccurrence":
+		if e.complexity.Query.IsOccurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(*model.IsOccurrenceSpec)), true
+
+	case "Query.IsVulnerability":
+		if e.complexity.Query.IsVulnerability == nil {
+			break
+		}
+
+		args, err := ec.field_Query_IsVulnerability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IsVulnerability(childComplexity, args["isVulnerabilitySpec"].(*model.IsVulnerabilitySpec)), true
+
+	case "Query.neighbors":
+		if e.complexity.Query.Neighbors == nil {
+			break
+		}
+
+		args, err := ec.field_Query_neighbors_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Neighbors(childComplexity, args["node"].(string)), true
+
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
+			break
+		}
+
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Node(childComplexity, args["node"].(string)), true
+
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Nodes(childComplexity, args["nodes"].([]string)), true
+
+	case "Query.osv":
+		if e.complexity.Query.Osv == nil {
+			break
+		}
+
+		args, err := ec.field_Query_osv_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Osv(childComplexity, args["osvSpec"].(*model.OSVSpec)), true
+
+	case "Query.packages":
+		if e.complexity.Query.Packages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+
+	case "Query.path":
+		if e.complexity.Query.Path == nil {
+			break
+		}
+
+		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Path(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int)), true
+
+	case "Query.riskyPackages":
+		if e.complexity.Query.RiskyPackages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_riskyPackages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RiskyPackages(childComplexity, args["conditions"].(model.RiskyPackageConditions), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.scorecards":
+		if e.complexity.Query.Scorecards == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(*model.CertifyScorecardSpec)), true
 
-	case "Query.HashEqual":
-		if e.complexity.Query.HashEqual == nil {
+	case "Query.SeverityOverride":
+		if e.complexity.Query.SeverityOverride == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HashEqual_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_SeverityOverride_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(*model.HashEqualSpec)), true
+		return e.complexity.Query.SeverityOverride(childComplexity, args["severityOverrideSpec"].(*model.SeverityOverrideSpec)), true
 
-	case "Query.IsDependency":
-		if e.complexity.Query.IsDependency == nil {
+	case "Query.sources":
+		if e.complexity.Query.Sources == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsDependency_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
+		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
 
-	case "Query.IsOccurrence":
-		if e.complexity.Query.IsOccurrence == nil {
+	case "Query.stats":
+		if e.complexity.Query.Stats == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsOccurrence_args(context.TODO(), rawArgs)
+		return e.complexity.Query.Stats(childComplexity), true
+
+	case "Query.stitchingProposals":
+		if e.complexity.Query.StitchingProposals == nil {
+			break
+		}
+
+		args, err := ec.field_Query_stitchingProposals_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(*model.IsOccurrenceSpec)), true
+		return e.complexity.Query.StitchingProposals(childComplexity, args["artifact"].(model.ArtifactSpec), args["windowSeconds"].(*int)), true
 
-	case "Query.IsVulnerability":
-		if e.complexity.Query.IsVulnerability == nil {
+	case "Query.successors":
+		if e.complexity.Query.Successors == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsVulnerability_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_successors_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsVulnerability(childComplexity, args["isVulnerabilitySpec"].(*model.IsVulnerabilitySpec)), true
+		return e.complexity.Query.Successors(childComplexity, args["pkg"].(model.PkgSpec)), true
 
-	case "Query.osv":
-		if e.complexity.Query.Osv == nil {
+	case "Query.SupersededBy":
+		if e.complexity.Query.SupersededBy == nil {
 			break
 		}
 
-		args, err := ec.field_Query_osv_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_SupersededBy_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Osv(childComplexity, args["osvSpec"].(*model.OSVSpec)), true
+		return e.complexity.Query.SupersededBy(childComplexity, args["supersededBySpec"].(*model.SupersededBySpec)), true
 
-	case "Query.packages":
-		if e.complexity.Query.Packages == nil {
+	case "RiskyPackage.hasSBOM":
+		if e.complexity.RiskyPackage.HasSbom == nil {
 			break
 		}
 
-		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RiskyPackage.HasSbom(childComplexity), true
+
+	case "RiskyPackage.package":
+		if e.complexity.RiskyPackage.Package == nil {
+			break
 		}
 
-		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+		return e.complexity.RiskyPackage.Package(childComplexity), true
 
-	case "Query.path":
-		if e.complexity.Query.Path == nil {
+	case "RiskyPackage.scorecards":
+		if e.complexity.RiskyPackage.Scorecards == nil {
 			break
 		}
 
-		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RiskyPackage.Scorecards(childComplexity), true
+
+	case "RiskyPackage.unprovenancedArtifacts":
+		if e.complexity.RiskyPackage.UnprovenancedArtifacts == nil {
+			break
 		}
 
-		return e.complexity.Query.Path(childComplexity, args["subject"].(model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter), args["target"].(model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter), args["maxPathLength"].(int)), true
+		return e.complexity.RiskyPackage.UnprovenancedArtifacts(childComplexity), true
 
-	case "Query.scorecards":
-		if e.complexity.Query.Scorecards == nil {
+	case "RiskyPackage.vulnerabilities":
+		if e.complexity.RiskyPackage.Vulnerabilities == nil {
 			break
 		}
 
-		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RiskyPackage.Vulnerabilities(childComplexity), true
+
+	case "RiskyPackageConnection.endCursor":
+		if e.complexity.RiskyPackageConnection.EndCursor == nil {
+			break
 		}
 
-		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(*model.CertifyScorecardSpec)), true
+		return e.complexity.RiskyPackageConnection.EndCursor(childComplexity), true
 
-	case "Query.sources":
-		if e.complexity.Query.Sources == nil {
+	case "RiskyPackageConnection.hasNextPage":
+		if e.complexity.RiskyPackageConnection.HasNextPage == nil {
 			break
 		}
 
-		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RiskyPackageConnection.HasNextPage(childComplexity), true
+
+	case "RiskyPackageConnection.packages":
+		if e.complexity.RiskyPackageConnection.Packages == nil {
+			break
 		}
 
-		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
+		return e.complexity.RiskyPackageConnection.Packages(childComplexity), true
 
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
@@ -1480,6 +2582,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SLSA.Collector(childComplexity), true
 
+	case "SLSA.documentHash":
+		if e.complexity.SLSA.DocumentHash == nil {
+			break
+		}
+
+		return e.complexity.SLSA.DocumentHash(childComplexity), true
+
 	case "SLSA.finishedOn":
 		if e.complexity.SLSA.FinishedOn == nil {
 			break
@@ -1592,6 +2701,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScorecardCheck.Score(childComplexity), true
 
+	case "SeverityOverride.collector":
+		if e.complexity.SeverityOverride.Collector == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Collector(childComplexity), true
+
+	case "SeverityOverride.createdAt":
+		if e.complexity.SeverityOverride.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.CreatedAt(childComplexity), true
+
+	case "SeverityOverride.expiresAt":
+		if e.complexity.SeverityOverride.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.ExpiresAt(childComplexity), true
+
+	case "SeverityOverride.id":
+		if e.complexity.SeverityOverride.ID == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.ID(childComplexity), true
+
+	case "SeverityOverride.justification":
+		if e.complexity.SeverityOverride.Justification == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Justification(childComplexity), true
+
+	case "SeverityOverride.origin":
+		if e.complexity.SeverityOverride.Origin == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Origin(childComplexity), true
+
+	case "SeverityOverride.retracted":
+		if e.complexity.SeverityOverride.Retracted == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Retracted(childComplexity), true
+
+	case "SeverityOverride.reviewer":
+		if e.complexity.SeverityOverride.Reviewer == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Reviewer(childComplexity), true
+
+	case "SeverityOverride.score":
+		if e.complexity.SeverityOverride.Score == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Score(childComplexity), true
+
+	case "SeverityOverride.scoreType":
+		if e.complexity.SeverityOverride.ScoreType == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.ScoreType(childComplexity), true
+
+	case "SeverityOverride.subject":
+		if e.complexity.SeverityOverride.Subject == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Subject(childComplexity), true
+
+	case "SeverityOverride.vulnerability":
+		if e.complexity.SeverityOverride.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.SeverityOverride.Vulnerability(childComplexity), true
+
 	case "Source.id":
 		if e.complexity.Source.ID == nil {
 			break
@@ -1662,6 +2855,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "StitchProposal.artifact":
+		if e.complexity.StitchProposal.Artifact == nil {
+			break
+		}
+
+		return e.complexity.StitchProposal.Artifact(childComplexity), true
+
+	case "StitchProposal.equalArtifact":
+		if e.complexity.StitchProposal.EqualArtifact == nil {
+			break
+		}
+
+		return e.complexity.StitchProposal.EqualArtifact(childComplexity), true
+
+	case "StitchProposal.evidence":
+		if e.complexity.StitchProposal.Evidence == nil {
+			break
+		}
+
+		return e.complexity.StitchProposal.Evidence(childComplexity), true
+
+	case "StitchProposal.imageRef":
+		if e.complexity.StitchProposal.ImageRef == nil {
+			break
+		}
+
+		return e.complexity.StitchProposal.ImageRef(childComplexity), true
+
+	case "StitchProposal.justification":
+		if e.complexity.StitchProposal.Justification == nil {
+			break
+		}
+
+		return e.complexity.StitchProposal.Justification(childComplexity), true
+
+	case "SupersededBy.collector":
+		if e.complexity.SupersededBy.Collector == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Collector(childComplexity), true
+
+	case "SupersededBy.id":
+		if e.complexity.SupersededBy.ID == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.ID(childComplexity), true
+
+	case "SupersededBy.origin":
+		if e.complexity.SupersededBy.Origin == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Origin(childComplexity), true
+
+	case "SupersededBy.package":
+		if e.complexity.SupersededBy.Package == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Package(childComplexity), true
+
+	case "SupersededBy.reason":
+		if e.complexity.SupersededBy.Reason == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Reason(childComplexity), true
+
+	case "SupersededBy.since":
+		if e.complexity.SupersededBy.Since == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Since(childComplexity), true
+
+	case "SupersededBy.successor":
+		if e.complexity.SupersededBy.Successor == nil {
+			break
+		}
+
+		return e.complexity.SupersededBy.Successor(childComplexity), true
+
 	case "VulnerabilityMetaData.collector":
 		if e.complexity.VulnerabilityMetaData.Collector == nil {
 			break
@@ -1690,6 +2967,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetaData.Origin(childComplexity), true
 
+	case "VulnerabilityMetaData.scannerScore":
+		if e.complexity.VulnerabilityMetaData.ScannerScore == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetaData.ScannerScore(childComplexity), true
+
 	case "VulnerabilityMetaData.scannerUri":
 		if e.complexity.VulnerabilityMetaData.ScannerURI == nil {
 			break
@@ -1727,9 +3011,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCVESpec,
 		ec.unmarshalInputCertifyBadInputSpec,
 		ec.unmarshalInputCertifyBadSpec,
+		ec.unmarshalInputCertifyGoodInputSpec,
+		ec.unmarshalInputCertifyGoodSpec,
 		ec.unmarshalInputCertifyPkgInputSpec,
 		ec.unmarshalInputCertifyPkgSpec,
 		ec.unmarshalInputCertifyScorecardSpec,
+		ec.unmarshalInputCertifySignedInputSpec,
+		ec.unmarshalInputCertifySignedSpec,
 		ec.unmarshalInputCertifyVEXStatementSpec,
 		ec.unmarshalInputCertifyVulnSpec,
 		ec.unmarshalInputCveOrGhsaInput,
@@ -1760,20 +3048,24 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputPackageOrSourceSpec,
 		ec.unmarshalInputPackageQualifierInputSpec,
 		ec.unmarshalInputPackageQualifierSpec,
-		ec.unmarshalInputPackageSourceArtifactBuilderOsvCveOrGhsaFilter,
 		ec.unmarshalInputPackageSourceOrArtifactInput,
 		ec.unmarshalInputPackageSourceOrArtifactSpec,
 		ec.unmarshalInputPkgInputSpec,
 		ec.unmarshalInputPkgNameSpec,
 		ec.unmarshalInputPkgSpec,
+		ec.unmarshalInputRiskyPackageConditions,
 		ec.unmarshalInputSLSAInputSpec,
 		ec.unmarshalInputSLSAPredicateInputSpec,
 		ec.unmarshalInputSLSAPredicateSpec,
 		ec.unmarshalInputScorecardCheckInputSpec,
 		ec.unmarshalInputScorecardCheckSpec,
 		ec.unmarshalInputScorecardInputSpec,
+		ec.unmarshalInputSeverityOverrideInputSpec,
+		ec.unmarshalInputSeverityOverrideSpec,
 		ec.unmarshalInputSourceInputSpec,
 		ec.unmarshalInputSourceSpec,
+		ec.unmarshalInputSupersededByInputSpec,
+		ec.unmarshalInputSupersededBySpec,
 		ec.unmarshalInputVexStatementInputSpec,
 		ec.unmarshalInputVulnerabilityMetaDataInput,
 	)
@@ -1900,6 +3192,8 @@ extend type Query {
 extend type Mutation {
   "Ingest a new artifact. Returns the ingested artifact"
   ingestArtifact(artifact: ArtifactInputSpec): Artifact!
+  "Bulk ingest artifacts. Returns the ingested artifacts, in input order"
+  ingestArtifacts(artifacts: [ArtifactInputSpec!]!): [Artifact!]!
 }
 `, BuiltIn: false},
 	{Name: "../schema/builder.graphql", Input: `#
@@ -1990,81 +3284,178 @@ input PackageSourceOrArtifactSpec {
   artifact: ArtifactSpec
 }
 
-"""
-PackageSourceOrArtifactInput allows using PackageSourceOrArtifact union as
-input type to be used in mutations.
+"""
+PackageSourceOrArtifactInput allows using PackageSourceOrArtifact union as
+input type to be used in mutations.
+
+Exactly one of the value must be set to non-nil.
+"""
+input PackageSourceOrArtifactInput {
+  package: PkgInputSpec
+  source: SourceInputSpec
+  artifact: ArtifactInputSpec
+}
+
+"""
+CertifyBad is an attestation represents when a package, source or artifact is considered bad
+
+subject - union type that can be either a package, source or artifact object type
+justification (property) - string value representing why the subject is considered bad
+origin (property) - where this attestation was generated from (based on which document)
+collector (property) - the GUAC collector that collected the document that generated this attestation
+knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+certifications ingested without one
+
+Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
+"""
+type CertifyBad {
+  subject: PackageSourceOrArtifact!
+  justification: String!
+  origin: String!
+  collector: String!
+  knownSince: Time!
+}
+
+"""
+CertifyBadSpec allows filtering the list of CertifyBad to return.
+Note: Package, Source or artifact must be specified but not at the same time
+For package - a PackageName or PackageVersion must be specified (name or name, version, qualifiers and subpath)
+For source - a SourceName must be specified (name, tag or commit)
+
+knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+so that consecutive ranges don't overlap. Timestamps are compared in UTC.
+"""
+input CertifyBadSpec {
+  subject: PackageSourceOrArtifactSpec
+  justification: String
+  origin: String
+  collector: String
+  knownSince: Time
+  knownSinceAfter: Time
+  knownSinceBefore: Time
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+}
+
+"""
+CertifyBadInputSpec is the same as CertifyBad but for mutation input.
+
+All fields are required, except knownSince which defaults to the zero time.
+"""
+input CertifyBadInputSpec {
+  justification: String!
+  origin: String!
+  collector: String!
+  knownSince: Time
+}
+
+"""
+PkgMatchType is an enum to determine if the attestation should be done at the
+specific version or package name
+"""
+enum PkgMatchType {
+  ALL_VERSIONS
+  SPECIFIC_VERSION
+}
+
+"MatchFlags is used to input the PkgMatchType enum."
+input MatchFlags {
+  pkg: PkgMatchType!
+}
+
+extend type Query {
+  "Returns all CertifyBad"
+  CertifyBad(certifyBadSpec: CertifyBadSpec): [CertifyBad!]!
+}
+
+extend type Mutation {
+  "Adds a certification that two packages are similar"
+  ingestCertifyBad(subject: PackageSourceOrArtifactInput!, pkgMatchType: MatchFlags, certifyBad: CertifyBadInputSpec!): CertifyBad!
+}
+`, BuiltIn: false},
+	{Name: "../schema/certifyGood.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
 
-Exactly one of the value must be set to non-nil.
-"""
-input PackageSourceOrArtifactInput {
-  package: PkgInputSpec
-  source: SourceInputSpec
-  artifact: ArtifactInputSpec
-}
+# Defines a GraphQL schema for the CertifyGood. It contains the subject (which can be either a package, source or artifact),
+#  justification, origin of the attestation, and collector
 
 """
-CertifyBad is an attestation represents when a package, source or artifact is considered bad
+CertifyGood is an attestation represents when a package, source or artifact is considered good
 
 subject - union type that can be either a package, source or artifact object type
-justification (property) - string value representing why the subject is considered bad
+justification (property) - string value representing why the subject is considered good
 origin (property) - where this attestation was generated from (based on which document)
 collector (property) - the GUAC collector that collected the document that generated this attestation
+knownSince (property) - timestamp since when the subject is considered good, the zero time for the
+certifications ingested without one
 
 Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 """
-type CertifyBad {
+type CertifyGood {
   subject: PackageSourceOrArtifact!
   justification: String!
   origin: String!
   collector: String!
+  knownSince: Time!
 }
 
 """
-CertifyBadSpec allows filtering the list of CertifyBad to return.
+CertifyGoodSpec allows filtering the list of CertifyGood to return.
 Note: Package, Source or artifact must be specified but not at the same time
 For package - a PackageName or PackageVersion must be specified (name or name, version, qualifiers and subpath)
 For source - a SourceName must be specified (name, tag or commit)
+
+knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+so that consecutive ranges don't overlap. Timestamps are compared in UTC.
 """
-input CertifyBadSpec {
+input CertifyGoodSpec {
   subject: PackageSourceOrArtifactSpec
   justification: String
   origin: String
   collector: String
+  knownSince: Time
+  knownSinceAfter: Time
+  knownSinceBefore: Time
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
-CertifyBadInputSpec is the same as CertifyBad but for mutation input.
+CertifyGoodInputSpec is the same as CertifyGood but for mutation input.
 
-All fields are required.
+All fields are required, except knownSince which defaults to the zero time.
 """
-input CertifyBadInputSpec {
+input CertifyGoodInputSpec {
   justification: String!
   origin: String!
   collector: String!
-}
-
-"""
-PkgMatchType is an enum to determine if the attestation should be done at the
-specific version or package name
-"""
-enum PkgMatchType {
-  ALL_VERSIONS
-  SPECIFIC_VERSION
-}
-
-"MatchFlags is used to input the PkgMatchType enum."
-input MatchFlags {
-  pkg: PkgMatchType!
+  knownSince: Time
 }
 
 extend type Query {
-  "Returns all CertifyBad"
-  CertifyBad(certifyBadSpec: CertifyBadSpec): [CertifyBad!]!
+  "Returns all CertifyGood"
+  CertifyGood(certifyGoodSpec: CertifyGoodSpec): [CertifyGood!]!
 }
 
 extend type Mutation {
-  "Adds a certification that two packages are similar"
-  ingestCertifyBad(subject: PackageSourceOrArtifactInput!, pkgMatchType: MatchFlags, certifyBad: CertifyBadInputSpec!): CertifyBad!
+  "Adds a certification that a package, source or artifact is considered good"
+  ingestCertifyGood(subject: PackageSourceOrArtifactInput!, pkgMatchType: MatchFlags, certifyGood: CertifyGoodInputSpec!): CertifyGood!
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyPkg.graphql", Input: `#
@@ -2111,6 +3502,8 @@ input CertifyPkgSpec {
   justification: String
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
@@ -2231,6 +3624,14 @@ input CertifyScorecardSpec {
   scorecardCommit: String
   origin: String
   collector: String
+  "match any of these origins, in addition to origin"
+  origins: [String!]
+  "match any of these collectors, in addition to collector"
+  collectors: [String!]
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  "order the results by aggregateScore, applied after filtering"
+  orderByScore: SortDirection
 }
 
 "ScorecardCheckSpec is the same as ScorecardCheck, but usable as query input."
@@ -2271,6 +3672,110 @@ extend type Mutation {
   "Certifies the Scorecard scanning of a source repository"
   certifyScorecard(source: SourceInputSpec!, scorecard: ScorecardInputSpec!): CertifyScorecard!
 }
+`, BuiltIn: false},
+	{Name: "../schema/certifySigned.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifySigned. It contains the artifact, signer, signature type, status, verification time, trust root, origin and collector.
+"""
+SignatureType is the kind of signature found on an artifact.
+"""
+enum SignatureType {
+  COSIGN
+  NOTATION
+  GPG
+}
+
+"""
+SignatureStatus records the outcome of verifying a signature.
+
+VERIFIED means the signature was checked against the trust root. FAILED means
+the signature was found but could not be verified.
+"""
+enum SignatureStatus {
+  VERIFIED
+  FAILED
+}
+
+"""
+CertifySigned is an attestation that represents a signature found on an artifact.
+
+artifact (subject) - the artifact that is signed
+signer (property) - the identity of the signer (key name, certificate subject or email)
+signatureType (property) - the kind of signature (cosign, notation, gpg)
+status (property) - whether the signature was verified
+verifiedAt (property) - the time the signature was last checked
+trustRoot (property) - the key or root certificate the signature was checked against, empty if none applied
+origin (property) - where this attestation was generated from (based on which document)
+collector (property) - the GUAC collector that collected the document that generated this attestation
+"""
+type CertifySigned {
+  id: ID!
+  artifact: Artifact!
+  signer: String!
+  signatureType: SignatureType!
+  status: SignatureStatus!
+  verifiedAt: Time!
+  trustRoot: String!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifySignedSpec allows filtering the list of CertifySigned to return.
+"""
+input CertifySignedSpec {
+  id: ID
+  artifact: ArtifactSpec
+  signer: String
+  signatureType: SignatureType
+  status: SignatureStatus
+  verifiedAt: Time
+  trustRoot: String
+  origin: String
+  collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+}
+
+"""
+CertifySignedInputSpec is the same as CertifySigned but for mutation input.
+
+All fields are required.
+"""
+input CertifySignedInputSpec {
+  signer: String!
+  signatureType: SignatureType!
+  status: SignatureStatus!
+  verifiedAt: Time!
+  trustRoot: String!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all CertifySigned"
+  CertifySigned(certifySignedSpec: CertifySignedSpec): [CertifySigned!]!
+}
+
+extend type Mutation {
+  "certify that an artifact is signed"
+  ingestCertifySigned(artifact: ArtifactInputSpec!, certifySigned: CertifySignedInputSpec!): CertifySigned!
+}
 `, BuiltIn: false},
 	{Name: "../schema/certifyVEXStatement.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -2307,12 +3812,30 @@ input PackageOrArtifactSpec {
   artifact: ArtifactSpec
 }
 
+"""
+VexStatus is the status of a package or artifact with regard to a
+vulnerability, as stated by a VEX.
+
+NOT_AFFECTED - the vulnerability can't be exploited, for the reason given in the justification
+AFFECTED - the vulnerability can be exploited, the statement gives the action to take
+FIXED - the vulnerability was fixed
+UNDER_INVESTIGATION - it is not known yet whether the vulnerability can be exploited
+"""
+enum VexStatus {
+  NOT_AFFECTED
+  AFFECTED
+  FIXED
+  UNDER_INVESTIGATION
+}
+
 """
 CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (CVE or GHSA)
 
 subject - union type that represents a package or artifact
 vulnerability (object) - union type that consists of cve or ghsa
-justification (property) - justification for VEX
+status (property) - status of the subject with regard to the vulnerability
+statement (property) - action to take when the subject is affected, empty if not given
+justification (property) - justification for VEX, only set when the subject is not affected
 knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 origin (property) - where this attestation was generated from (based on which document)
 collector (property) - the GUAC collector that collected the document that generated this attestation
@@ -2320,6 +3843,8 @@ collector (property) - the GUAC collector that collected the document that gener
 type CertifyVEXStatement {
   subject: PackageOrArtifact!
   vulnerability: CveOrGhsa!
+  status: VexStatus!
+  statement: String!
   justification: String!
   knownSince: Time!
   origin: String!
@@ -2337,14 +3862,21 @@ input CertifyVEXStatementSpec {
   knownSince: Time
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
 VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
 
-All fields are required.
+The justification is required if and only if the status is NOT_AFFECTED, and
+the statement is required if the status is AFFECTED. knownSince can't be the
+zero time.
 """
 input VexStatementInputSpec {
+  "NOT_AFFECTED if not set, the status of the VEX statements ingested before it existed"
+  status: VexStatus
+  statement: String
   justification: String!
   knownSince: Time!
   origin: String!
@@ -2389,7 +3921,7 @@ extend type Mutation {
 # NOTE: This is experimental and might change in the future!
 
 # Defines a GraphQL schema for the CertifyVuln. It contains a package, vulnerability that can be of type
-# cve, ghsa or osv, time scanned, db uri, db version, scanner uri, scanner version, origin and collector
+# cve, ghsa or osv (or noVuln when none was found), time scanned, db uri, db version, scanner uri, scanner version, origin and collector
 """
 CertifyVuln is an attestation that represents when a package has a vulnerability
 
@@ -2398,10 +3930,14 @@ type CertifyVuln {
   id: ID!
   "package (subject) - the package object type that represents the package"
   package: Package!
-  "vulnerability (object) - union type that consists of osv, cve or ghsa"
+  "vulnerability (object) - union type that consists of osv, cve, ghsa or noVuln"
   vulnerability: OsvCveOrGhsa!
   "metadata (property) - contains all the vulnerability metadata "
   metadata: VulnerabilityMetaData!
+  "successors - the SupersededBy chain of the package, only set if includeSuccessors is set in the spec"
+  successors: [SupersededBy!]
+  "effectiveSeverity - the scanner score after applying the SeverityOverride precedence, null if neither the scanner nor an override scored the vulnerability"
+  effectiveSeverity: EffectiveSeverity
 }
 
 type VulnerabilityMetaData {
@@ -2419,47 +3955,79 @@ type VulnerabilityMetaData {
   origin: String!
   "collector (property) - the GUAC collector that collected the document that generated this attestation"
   collector: String!
+  "scannerScore (property) - score the scanner reported for the vulnerability, if any"
+  scannerScore: Float
+}
+
+"""
+NoVuln is a special vulnerability node to attest that no vulnerability was
+found when scanning a package, to tell it apart from a package never scanned.
+"""
+type NoVuln {
+  id: ID!
 }
 
 """
-OsvCveGhsaObject is a union of OSV, CVE and GHSA. Any of these objects can be specified for vulnerability
+OsvCveGhsaObject is a union of OSV, CVE, GHSA and NoVuln. Any of these objects can be specified for vulnerability
 """
-union OsvCveOrGhsa = OSV | CVE | GHSA
+union OsvCveOrGhsa = OSV | CVE | GHSA | NoVuln
 
 """
 OsvCveOrGhsaSpec allows using OsvCveOrGhsa union as
 input type to be used in read queries.
 Exactly one of the value must be set to non-nil.
+
+Setting noVuln to true only returns the results attesting that no
+vulnerability was found, while setting it to false only returns the results
+with an actual vulnerability.
 """
 input OsvCveOrGhsaSpec {
   osv: OSVSpec
   cve: CVESpec
   ghsa: GHSASpec
+  noVuln: Boolean
 }
 
 """
 CertifyVulnSpec allows filtering the list of CertifyVuln to return.
 
 Specifying just the package allows to query for all vulnerabilities associated with the package.
-Only OSV, CVE or GHSA can be specified at once
+Only OSV, CVE, GHSA or noVuln can be specified at once
+
+timeScanned matches the exact timestamp. timeScannedAfter and
+timeScannedBefore select a range, both bounds being inclusive. Timestamps are
+compared in UTC.
 """
 input CertifyVulnSpec {
   id: ID
   package: PkgSpec
   vulnerability: OsvCveOrGhsaSpec
   timeScanned: Time
+  timeScannedAfter: Time
+  timeScannedBefore: Time
   dbUri: String
   dbVersion: String
   scannerUri: String
   scannerVersion: String
   origin: String
   collector: String
+  "match any of these origins, in addition to origin"
+  origins: [String!]
+  "match any of these collectors, in addition to collector"
+  collectors: [String!]
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  "annotate the results with the SupersededBy chain of the package"
+  includeSuccessors: Boolean
+  "order the results by timeScanned, applied after filtering"
+  orderByTimeScanned: SortDirection
 }
 
 """
 VulnerabilityInputSpec is the same as VulnerabilityMetaData but for mutation input.
 
-All fields are required.
+All fields are required, except scannerScore which is only set by scanners
+that score the vulnerabilities they find.
 """
 input VulnerabilityMetaDataInput {
   timeScanned: Time!
@@ -2469,28 +4037,293 @@ input VulnerabilityMetaDataInput {
   scannerVersion: String!
   origin: String!
   collector: String!
+  scannerScore: Float
 }
 
 """
 OsvCveOrGhsaInput allows using OsvCveOrGhsa union as
 input type to be used in mutations.
-Exactly one of the value must be set to non-nil.
+Exactly one of the value must be set to non-nil, noVuln counting as set only
+when true.
+
+Setting noVuln to true certifies that scanning the package found no
+vulnerability. It is only valid when ingesting a CertifyVuln.
 """
 input OsvCveOrGhsaInput {
   osv: OSVInputSpec
   cve: CVEInputSpec
   ghsa: GHSAInputSpec
+  noVuln: Boolean
+}
+
+"""
+CertifyVulnConnection is a page of CertifyVulnList results.
+
+totalCount - the number of CertifyVuln matching the filter, across all pages
+endCursor - pass as ` + "`" + `after` + "`" + ` to get the next page
+hasNextPage - true if there are more results after this page
+"""
+type CertifyVulnConnection {
+  certifyVulns: [CertifyVuln!]!
+  totalCount: Int!
+  endCursor: ID
+  hasNextPage: Boolean!
 }
 
 extend type Query {
   "Returns all CertifyVuln"
   CertifyVuln(certifyVulnSpec: CertifyVulnSpec): [CertifyVuln!]!
+  "Returns a page of the CertifyVuln matching the filter, in ingestion order"
+  CertifyVulnList(certifyVulnSpec: CertifyVulnSpec, first: Int, after: ID): CertifyVulnConnection!
+  "Returns the number of CertifyVuln matching the filter, as the length of CertifyVuln would be"
+  CertifyVulnCount(certifyVulnSpec: CertifyVulnSpec): Int!
 }
 
 extend type Mutation {
   "certify that a package is vulnerable to a vulnerability (OSV, CVE or GHSA)"
   ingestVulnerability(pkg: PkgInputSpec!, vulnerability: OsvCveOrGhsaInput!, certifyVuln: VulnerabilityMetaDataInput!): CertifyVuln!
 }
+`, BuiltIn: false},
+	{Name: "../schema/changes.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the changes query. It lets downstream consumers
+# tail the nodes ingested into GUAC, in ingestion order, and resume from where
+# they stopped.
+
+"""
+Cursor is an opaque position in the log of ingested nodes. It is returned by
+changes and must be passed back unmodified.
+"""
+scalar Cursor
+
+"NodeType is the kind of a node returned by changes."
+enum NodeType {
+  PACKAGE
+  SOURCE
+  ARTIFACT
+  BUILDER
+  OSV
+  CVE
+  GHSA
+  CERTIFY_BAD
+  CERTIFY_GOOD
+  CERTIFY_PKG
+  CERTIFY_SCORECARD
+  CERTIFY_SIGNED
+  CERTIFY_VEX_STATEMENT
+  CERTIFY_VULN
+  HAS_SBOM
+  HAS_SLSA
+  HAS_SOURCE_AT
+  HASH_EQUAL
+  IS_DEPENDENCY
+  IS_OCCURRENCE
+  IS_VULNERABILITY
+  SEVERITY_OVERRIDE
+  SUPERSEDED_BY
+}
+
+"""
+Change is a node that was ingested, as returned by changes.
+
+cursor - pass as ` + "`" + `after` + "`" + ` to get the changes following this one
+type - the kind of node
+node - the node, in its current state
+"""
+type Change {
+  cursor: Cursor!
+  type: NodeType!
+  node: Nodes!
+}
+
+"""
+ChangeConnection is a page of changes results.
+
+endCursor - pass as ` + "`" + `after` + "`" + ` to get the next page. It is always set, even if
+the page is empty, so that consumers can keep polling from it.
+hasNextPage - true if there are more changes after this page
+"""
+type ChangeConnection {
+  changes: [Change!]!
+  endCursor: Cursor!
+  hasNextPage: Boolean!
+}
+
+extend type Query {
+  """
+  Returns the nodes ingested after the cursor, in ingestion order, optionally
+  restricted to the given types. Without a cursor, changes start at the oldest
+  change still retained.
+
+  Only a bounded number of changes is retained. If changes following the cursor
+  were already dropped, the query fails with a "cursor expired, full resync
+  required" error and the consumer must resynchronize using the other queries
+  before tailing again without a cursor.
+  """
+  changes(after: Cursor, types: [NodeType!], first: Int): ChangeConnection!
+}
+`, BuiltIn: false},
+	{Name: "../schema/collectorDiff.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the collectorDiff query. It compares the evidence
+# two collectors (e.g. two vulnerability scanners) reported for the same packages.
+
+"Verb is the kind of evidence compared by collectorDiff."
+enum Verb {
+  CERTIFY_VULN
+  IS_DEPENDENCY
+  HAS_SOURCE_AT
+  IS_OCCURRENCE
+}
+
+"""
+CollectorDiffPartition is one part of a collectorDiff result.
+
+count - the number of distinct findings in the partition
+sample - up to sampleSize findings of the partition, in ingestion order
+"""
+type CollectorDiffPartition {
+  count: Int!
+  sample: [Nodes!]!
+}
+
+"""
+CollectorDiff is the three-way partition of the findings of two collectors.
+
+onlyA - findings only reported by collectorA
+onlyB - findings only reported by collectorB
+both - findings reported by both collectors, sampled from the evidence of collectorA
+"""
+type CollectorDiff {
+  onlyA: CollectorDiffPartition!
+  onlyB: CollectorDiffPartition!
+  both: CollectorDiffPartition!
+}
+
+extend type Query {
+  """
+  Compares the evidence of the given verb that collectorA and collectorB
+  reported for the packages matching subject. Setting only the type and
+  namespace of subject compares all the packages in that namespace.
+
+  Findings are matched by what they state, ignoring origin, collector,
+  timestamps and scanner metadata:
+  CERTIFY_VULN - package and vulnerability, where an OSV matches the CVE and
+  GHSA it is linked to by IsVulnerability
+  IS_DEPENDENCY - package, dependent package and version range
+  HAS_SOURCE_AT - package and source
+  IS_OCCURRENCE - package and artifact
+  """
+  collectorDiff(verb: Verb!, collectorA: String!, collectorB: String!, subject: PkgSpec!, sampleSize: Int = 10): CollectorDiff!
+}
+`, BuiltIn: false},
+	{Name: "../schema/conflicts.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the conflicts query. It returns the pairs of
+# evidence that contradict each other, as detected when they are ingested.
+
+"""
+ConflictPattern is a kind of contradiction between two pieces of evidence.
+
+CERTIFY_BAD_GOOD - a CertifyBad and a CertifyGood on the same subject whose
+validity windows overlap. A certification holds from its knownSince until the
+next certification of the subject from the same collector, good or bad, so a
+collector changing its verdict is not a conflict, while certifications from
+different collectors conflict unless one is superseded before the other holds
+VEX_CERTIFY_VULN - a CertifyVEXStatement stating that a package is not affected
+by a vulnerability and a CertifyVuln reporting that it is (VEX statements don't
+carry a status yet, so all of them are taken as not affected)
+HAS_SOURCE_AT_REPOSITORY - two HasSourceAt mapping the same package to
+different repositories (source type, namespace and name, ignoring tags and
+commits). A single conflict is returned per pair of repositories, between the
+first HasSourceAt to each that is not retracted
+"""
+enum ConflictPattern {
+  CERTIFY_BAD_GOOD
+  VEX_CERTIFY_VULN
+  HAS_SOURCE_AT_REPOSITORY
+}
+
+"ConflictEvidence is a union of the evidence that can be in conflict."
+union ConflictEvidence = CertifyBad | CertifyGood | CertifyVEXStatement | CertifyVuln | HasSourceAt
+
+"""
+Conflict is a pair of evidence that contradict each other.
+
+pattern - the kind of contradiction
+first - the evidence that was ingested first
+second - the evidence whose ingestion raised the conflict
+detectedAt - when the conflict was detected
+"""
+type Conflict {
+  pattern: ConflictPattern!
+  first: ConflictEvidence!
+  second: ConflictEvidence!
+  detectedAt: Time!
+}
+
+extend type Query {
+  """
+  Returns the open conflicts, in the order they were detected, optionally
+  restricted to some patterns. A conflict is resolved, and no longer
+  returned, once either side is retracted.
+  """
+  conflicts(patterns: [ConflictPattern!]): [Conflict!]!
+}
+
+extend type Mutation {
+  """
+  Retracts the CertifyBad, CertifyGood, CertifyVEXStatement, CertifyVuln and
+  HasSourceAt evidence ingested from a document (origin), resolving the
+  conflicts it was part of. Returns the number of evidence removed.
+  """
+  retractEvidence(origin: String!): Int!
+}
 `, BuiltIn: false},
 	{Name: "../schema/cve.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -2530,7 +4363,8 @@ type CVE {
 """
 CVEId is the actual ID that is given to a specific vulnerability
 
-The ` + "`" + `id` + "`" + ` field is mandatory and canonicalized to be lowercase.
+The ` + "`" + `id` + "`" + ` field is mandatory, must be of the form CVE-YYYY-NNNN and is
+canonicalized to be lowercase.
 
 This node can be referred to by other parts of GUAC.
 """
@@ -2550,6 +4384,8 @@ input CVESpec {
 
 """
 CVEInputSpec is the same as CVESpec, but used for mutation ingestion.
+
+The year must be the one of the ID, e.g. 2021 for CVE-2021-44228.
 """
 input CVEInputSpec {
   year: Int!
@@ -2600,7 +4436,8 @@ type GHSA {
 """
 GHSAId is the actual ID that is given to a specific vulnerability on GitHub
 
-The ` + "`" + `id` + "`" + ` field is mandatory and canonicalized to be lowercase.
+The ` + "`" + `id` + "`" + ` field is mandatory, must be of the form GHSA-xxxx-xxxx-xxxx and is
+canonicalized to be lowercase.
 
 This node can be referred to by other parts of GUAC.
 """
@@ -2661,6 +4498,8 @@ subject - union type that can be either a package or source object type
 uri (property) - identifier string for the SBOM
 origin (property) - where this attestation was generated from (based on which document)
 collector (property) - the GUAC collector that collected the document that generated this attestation
+documentHash (property) - content hash of the original document in the blob store, if it was stored
+fileMode (property) - how the file level entries of the SBOM were ingested (full, checksums-only or skip), if the parser supports choosing
 
 Note: Only package object or source object can be defined. Not both.
 """
@@ -2669,6 +4508,8 @@ type HasSBOM {
   uri: String!
   origin: String!
   collector: String!
+  documentHash: String
+  fileMode: String
 }
 
 """
@@ -2682,17 +4523,25 @@ input HasSBOMSpec {
   uri: String
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  documentHash: String
+  fileMode: String
 }
 
 """
 HasSBOMInputSpec is the same as HasSBOM but for mutation input.
 
-All fields are required.
+All fields are required, except documentHash which is only set when the
+original document was stored, and fileMode which is only set by parsers that
+can leave out the file level entries.
 """
 input HasSBOMInputSpec {
   uri: String!
   origin: String!
   collector: String!
+  documentHash: String
+  fileMode: String
 }
 
 extend type Query {
@@ -2763,6 +4612,8 @@ type SLSA {
   origin: String!
   "GUAC collector for the document"
   collector: String!
+  "Content hash of the original document in the blob store, if it was stored"
+  documentHash: String
 }
 
 """
@@ -2812,6 +4663,9 @@ input HasSLSASpec {
   finishedOn: Time
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  documentHash: String
 }
 
 "SLSAPredicateSpec is the same as SLSAPredicate, but usable as query input."
@@ -2823,7 +4677,8 @@ input SLSAPredicateSpec {
 """
 SLSAInputSpec is the same as SLSA but for mutation input.
 
-All fields are required.
+All fields are required, except documentHash which is only set when the
+original document was stored.
 """
 input SLSAInputSpec {
   buildType: String!
@@ -2833,6 +4688,7 @@ input SLSAInputSpec {
   finishedOn: Time!
   origin: String!
   collector: String!
+  documentHash: String
 }
 
 """
@@ -2909,15 +4765,36 @@ type HasSourceAt {
 
 """
 HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+
+knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+so that consecutive ranges don't overlap. Timestamps are compared in UTC.
+
+latest keeps, among the HasSourceAt matching the other filters, only the one
+with the latest knownSince for each package. A HasSourceAt attached to a
+package name and one attached to a version of it are for different packages.
+On a tie on knownSince, the one with the highest ID, ingested last, is kept.
 """
 input HasSourceAtSpec {
   id: ID
   package: PkgSpec
   source: SourceSpec
   knownSince: Time
+  knownSinceAfter: Time
+  knownSinceBefore: Time
   justification: String
   origin: String
   collector: String
+  "match any of these origins, in addition to origin"
+  origins: [String!]
+  "match any of these collectors, in addition to collector"
+  collectors: [String!]
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  "order the results by knownSince, applied after filtering"
+  orderByKnownSince: SortDirection
+  "only return the latest HasSourceAt of every package"
+  latest: Boolean
 }
 
 """
@@ -2932,15 +4809,34 @@ input HasSourceAtInputSpec {
   collector: String!
 }
 
+"""
+HasSourceAtConnection is a page of HasSourceAtList results.
+
+totalCount - the number of HasSourceAt matching the filter, across all pages
+endCursor - pass as ` + "`" + `after` + "`" + ` to get the next page
+hasNextPage - true if there are more results after this page
+"""
+type HasSourceAtConnection {
+  hasSourceAts: [HasSourceAt!]!
+  totalCount: Int!
+  endCursor: ID
+  hasNextPage: Boolean!
+}
 
 extend type Query {
   "Returns all HasSourceAt"
   HasSourceAt(hasSourceAtSpec: HasSourceAtSpec): [HasSourceAt!]!
+  "Returns a page of the HasSourceAt matching the filter, in ingestion order"
+  HasSourceAtList(hasSourceAtSpec: HasSourceAtSpec, first: Int, after: ID): HasSourceAtConnection!
+  "Returns the number of HasSourceAt matching the filter, as the length of HasSourceAt would be"
+  HasSourceAtCount(hasSourceAtSpec: HasSourceAtSpec): Int!
 }
 
 extend type Mutation {
   "Adds a certification that a package (either at the version level or package name level) is associated with the source"
   ingestHasSourceAt(pkg: PkgInputSpec!, pkgMatchType: MatchFlags!, source: SourceInputSpec!, hasSourceAt: HasSourceAtInputSpec!): HasSourceAt!
+  "Bulk ingestion of HasSourceAt, the package, source and hasSourceAt at the same index form one certification. Returns the certifications in input order"
+  ingestHasSourceAts(pkgs: [PkgInputSpec!]!, pkgMatchType: MatchFlags!, sources: [SourceInputSpec!]!, hasSourceAts: [HasSourceAtInputSpec!]!): [HasSourceAt!]!
 }
 `, BuiltIn: false},
 	{Name: "../schema/hashEqual.graphql", Input: `#
@@ -2988,6 +4884,8 @@ input HashEqualSpec {
   justification: String
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 
@@ -3006,6 +4904,13 @@ input HashEqualInputSpec {
 extend type Query {
   "Returns all HashEqual"
   HashEqual(hashEqualSpec: HashEqualSpec): [HashEqual!]!
+  """
+  Returns the artifacts transitively linked to artifact by HashEqual, including
+  artifact itself, sorted by algorithm and digest. The artifact must be
+  specified by ID or by algorithm and digest. An artifact without any HashEqual
+  has no equal artifacts, so the list is empty.
+  """
+  equalArtifacts(artifact: ArtifactSpec!): [Artifact!]!
 }
 
 extend type Mutation {
@@ -3041,6 +4946,7 @@ versionRange (property) - string value for version range that applies to the dep
 justification (property) - string value representing why the artifacts are the equal
 origin (property) - where this attestation was generated from (based on which document)
 collector (property) - the GUAC collector that collected the document that generated this attestation
+successors - the SupersededBy chain of the dependent package, only set if includeSuccessors is set in the spec
 """
 type IsDependency {
   id: ID!
@@ -3050,6 +4956,7 @@ type IsDependency {
   justification: String!
   origin: String!
   collector: String!
+  successors: [SupersededBy!]
 }
 
 """
@@ -3057,15 +4964,25 @@ IsDependencySpec allows filtering the list of IsDependency to return.
 
 Note: the package object must be defined to return its dependent packages.
 Dependent Packages must represent the packageName (cannot be the packageVersion)
+
+dependentVersion selects the IsDependency whose versionRange contains this
+version of the dependent package, versionRange being a semver range in the npm
+syntax, as ^1.2.0 or >=1.2.0 <2.0.0. Ranges and versions that are not semver,
+as those of deb packages, only match when they are equal.
 """
 input IsDependencySpec {
   id: ID
   package: PkgSpec
   dependentPackage: PkgNameSpec
   versionRange: String
+  dependentVersion: String
   justification: String
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+  "annotate the results with the SupersededBy chain of the dependent package"
+  includeSuccessors: Boolean
 }
 
 """
@@ -3092,9 +5009,49 @@ input IsDependencyInputSpec {
   collector: String!
 }
 
+"""
+IsDependencyConnection is a page of IsDependencyList results.
+
+totalCount - the number of IsDependency matching the filter, across all pages
+endCursor - pass as ` + "`" + `after` + "`" + ` to get the next page
+hasNextPage - true if there are more results after this page
+"""
+type IsDependencyConnection {
+  isDependencies: [IsDependency!]!
+  totalCount: Int!
+  endCursor: ID
+  hasNextPage: Boolean!
+}
+
+"""
+DependencyTree is the transitive closure of the dependencies of a package,
+returned by dependencies.
+
+packages - the dependent packages reached, as package names, in breadth-first order
+dependencies - the IsDependency followed, including those between packages reached already
+occurrences - the IsOccurrence of the versions of the packages reached
+truncated - true if maxDepth stopped the walk before all dependencies were reached
+"""
+type DependencyTree {
+  packages: [Package!]!
+  dependencies: [IsDependency!]!
+  occurrences: [IsOccurrence!]!
+  truncated: Boolean!
+}
+
 extend type Query {
   "Returns all IsDependency"
   IsDependency(isDependencySpec: IsDependencySpec): [IsDependency!]!
+  "Returns a page of the IsDependency matching the filter, in ingestion order"
+  IsDependencyList(isDependencySpec: IsDependencySpec, first: Int, after: ID): IsDependencyConnection!
+  "Returns the number of IsDependency matching the filter, as the length of IsDependency would be"
+  IsDependencyCount(isDependencySpec: IsDependencySpec): Int!
+  """
+  Follows IsDependency from the package versions matching the spec to their dependent packages, transitively and
+  breadth-first, at most maxDepth edges away. A dependent package is a package name, its dependencies are those of
+  all of its versions, whatever the versionRange. Packages are only visited once, so cycles end the walk.
+  """
+  dependencies(pkg: PkgSpec!, maxDepth: Int!): DependencyTree!
 }
 
 extend type Mutation {
@@ -3161,6 +5118,12 @@ input IsOccurrenceSpec {
   justification: String
   origin: String
   collector: String
+  "match any of these origins, in addition to origin"
+  origins: [String!]
+  "match any of these collectors, in addition to collector"
+  collectors: [String!]
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
@@ -3268,6 +5231,8 @@ input IsVulnerabilitySpec {
   justification: String
   origin: String
   collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
@@ -3301,6 +5266,33 @@ extend type Mutation {
   "certify that a OSV is associated with either a CVE or GHSA"
   ingestIsVulnerability(osv: OSVInputSpec!, vulnerability: CveOrGhsaInput!, isVulnerability: IsVulnerabilityInputSpec!): IsVulnerability!
 }
+`, BuiltIn: false},
+	{Name: "../schema/namespace.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the lifecycle operations of a namespace.
+
+extend type Mutation {
+  """
+  Deletes all the data in the namespace of the request. Returns false if the
+  namespace was not in use. The default namespace cannot be purged.
+  """
+  purgeNamespace: Boolean!
+}
 `, BuiltIn: false},
 	{Name: "../schema/osv.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -3447,132 +5439,434 @@ type PackageName {
   versions: [PackageVersion!]!
 }
 
-"""
-PackageVersion is a package version.
+"""
+PackageVersion is a package version.
+
+In the pURL representation, each PackageName matches the
+` + "`" + `pkg:<type>/<namespace>/<name>@<version>` + "`" + ` pURL.
+
+Versions are optional and each Package type defines own rules for handling them.
+For this level of GUAC, these are just opaque strings.
+
+This node can be referred to by other parts of GUAC.
+
+Subpath and qualifiers are optional. Lack of qualifiers is represented by an
+empty list and lack of subpath by empty string (to be consistent with
+optionality of namespace and version). Two nodes that have different qualifiers
+and/or subpath but the same version mean two different packages in the trie
+(they are different). Two nodes that have same version but qualifiers of one are
+a subset of the qualifier of the other also mean two different packages in the
+trie.
+"""
+type PackageVersion {
+  id: ID!
+  version: String!
+  qualifiers: [PackageQualifier!]!
+  subpath: String!
+}
+
+"""
+PackageQualifier is a qualifier for a package, a key-value pair.
+
+In the pURL representation, it is a part of the ` + "`" + `<qualifiers>` + "`" + ` part of the
+` + "`" + `pkg:<type>/<namespace>/<name>@<version>?<qualifiers>` + "`" + ` pURL.
+
+Qualifiers are optional, each Package type defines own rules for handling them,
+and multiple qualifiers could be attached to the same package.
+
+This node cannot be directly referred by other parts of GUAC.
+"""
+type PackageQualifier {
+  key: String!
+  value: String!
+}
+
+"""
+PkgSpec allows filtering the list of packages to return.
+
+Each field matches a qualifier from pURL. Use ` + "`" + `null` + "`" + ` to match on all values at
+that level. For example, to get all packages in GUAC backend, use a PkgSpec
+where every field is ` + "`" + `null` + "`" + `.
+
+Empty string at a field means matching with the empty string. If passing in
+qualifiers, all of the values in the list must match. Since we want to return
+nodes with any number of qualifiers if no qualifiers are passed in the input, we
+must also return the same set of nodes it the qualifiers list is empty. To match
+on nodes that don't contain any qualifier, set ` + "`" + `matchOnlyEmptyQualifiers` + "`" + ` to
+true. If this field is true, then the qualifiers argument is ignored.
+"""
+input PkgSpec {
+  id: ID
+  type: String
+  namespace: String
+  name: String
+  version: String
+  qualifiers: [PackageQualifierSpec!] = []
+  matchOnlyEmptyQualifiers: Boolean = false
+  subpath: String
+}
+
+"""
+PackageQualifierSpec is the same as PackageQualifier, but usable as query
+input.
+
+GraphQL does not allow input types to contain composite types and does not allow
+composite types to contain input types. So, although in this case these two
+types are semantically the same, we have to duplicate the definition.
+
+Keys are mandatory, but values could also be ` + "`" + `null` + "`" + ` if we want to match all
+values for a specific key.
+
+TODO(mihaimaruseac): Formalize empty vs null when the schema is fully done
+"""
+input PackageQualifierSpec {
+  key: String!
+  value: String
+}
+
+"""
+PkgInputSpec specifies a package for a mutation.
+
+This is different than PkgSpec because we want to encode mandatory fields:
+` + "`" + `type` + "`" + ` and ` + "`" + `name` + "`" + `. All optional fields are given empty default values.
+"""
+input PkgInputSpec {
+  type: String!
+  namespace: String = ""
+  name: String!
+  version: String = ""
+  qualifiers: [PackageQualifierInputSpec!] = []
+  subpath: String = ""
+}
+
+"""
+PackageQualifierInputSpec is the same as PackageQualifier, but usable as
+mutation input.
+
+GraphQL does not allow input types to contain composite types and does not allow
+composite types to contain input types. So, although in this case these two
+types are semantically the same, we have to duplicate the definition.
+
+Both fields are mandatory.
+"""
+input PackageQualifierInputSpec {
+  key: String!
+  value: String!
+}
+
+extend type Query {
+  "Returns all packages"
+  packages(pkgSpec: PkgSpec): [Package!]!
+}
+
+extend type Mutation {
+  "Ingest a new package. Returns the ingested package trie"
+  ingestPackage(pkg: PkgInputSpec!): Package!
+  "Bulk ingest packages. Returns the ingested package tries, in input order"
+  ingestPackages(pkgs: [PkgInputSpec!]!): [Package!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/path.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the artifact. It contains the algorithm and
+# digest fields
+
+"""
+Nodes is a union type of all the possible nodes. It encapsulates the software tree nodes along with the evidence nodes.
+In a path query, all connecting evidence nodes along with their intermediate subject nodes need to be returned
+in order to create a complete graph.
+"""
+union Nodes = Package | Source | Artifact | Builder | OSV | CVE | GHSA | IsOccurrence | IsDependency | IsVulnerability| CertifyVEXStatement | HashEqual | CertifyBad | CertifyGood | CertifyPkg | CertifyScorecard | CertifyVuln | HasSourceAt | HasSBOM | HasSLSA | CertifySigned | SeverityOverride | SupersededBy | NoVuln
+
+extend type Query {
+  """
+  path query is used to determine reachability between the nodes with the
+  subject and target IDs. It returns a shortest path of at most maxPathLength
+  edges, as the list of nodes from the subject to the target, including the
+  evidence nodes linking them. The list is empty if there is no such path.
+  """
+  path(subject: ID!, target: ID!, maxPathLength: Int!): [Nodes!]!
+  """
+  neighbors returns the nodes adjacent to the node with the given ID: the parent
+  and children of a software tree node, the evidence linked to it, or the nodes
+  linked by an evidence node. Use it to walk the graph one hop at a time.
+  """
+  neighbors(node: ID!): [Nodes!]!
+  "node returns the node with the given ID, whatever its type."
+  node(node: ID!): Nodes!
+  """
+  nodes returns the nodes with the given IDs, in the same order. It fails if
+  any of the IDs doesn't match a node.
+  """
+  nodes(nodes: [ID!]!): [Nodes!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/riskyPackages.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the riskyPackages query. It returns the package versions
+# that match all the given risk conditions, along with the evidence for each condition.
+
+"""
+RiskyPackageConditions are the conditions a package version must match to be
+returned by riskyPackages. All conditions that are set must hold. At least one
+condition must be set.
+
+hasVulnAboveSeverity - the package has a CertifyVuln whose effective severity
+(the scanner score after applying SeverityOverride) is at or above the value.
+Vulnerabilities without a known severity never match.
+lacksProvenance - if true, none of the artifacts the package occurs as is the
+subject of a HasSLSA
+lacksSBOM - if true, the package has no HasSBOM
+scorecardBelow - the package has a source (via HasSourceAt) with a
+CertifyScorecard whose aggregate score is below the value
+"""
+input RiskyPackageConditions {
+  hasVulnAboveSeverity: Float
+  lacksProvenance: Boolean
+  lacksSBOM: Boolean
+  scorecardBelow: Float
+}
+
+"""
+RiskyPackage is a package version returned by riskyPackages along with the
+evidence for each condition that was requested.
+
+package - the package version
+vulnerabilities - the CertifyVuln above the requested severity
+unprovenancedArtifacts - the artifacts of the package without provenance
+hasSBOM - whether the package has a HasSBOM
+scorecards - the scorecards below the requested score
+"""
+type RiskyPackage {
+  package: Package!
+  vulnerabilities: [CertifyVuln!]!
+  unprovenancedArtifacts: [Artifact!]!
+  hasSBOM: Boolean!
+  scorecards: [CertifyScorecard!]!
+}
+
+"""
+RiskyPackageConnection is a page of riskyPackages results.
+
+endCursor - pass as ` + "`" + `after` + "`" + ` to get the next page
+hasNextPage - true if there are more results after this page
+"""
+type RiskyPackageConnection {
+  packages: [RiskyPackage!]!
+  endCursor: ID
+  hasNextPage: Boolean!
+}
+
+extend type Query {
+  "Returns the package versions matching all the given risk conditions, ordered by ID"
+  riskyPackages(conditions: RiskyPackageConditions!, first: Int, after: ID): RiskyPackageConnection!
+}
+`, BuiltIn: false},
+	{Name: "../schema/search.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for searching the software trees without knowing
+# the exact type, namespace and name of the software.
+
+extend type Query {
+  """
+  findSoftware returns the packages, sources and artifacts whose names,
+  namespaces or digests contain searchText, ignoring case. Packages are
+  returned down to their name and sources down to their tag or commit. The
+  search text must not be empty, and the results are capped at 1000.
+  """
+  findSoftware(searchText: String!): [PackageSourceOrArtifact!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/severityOverride.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
 
-In the pURL representation, each PackageName matches the
-` + "`" + `pkg:<type>/<namespace>/<name>@<version>` + "`" + ` pURL.
+# Defines a GraphQL schema for the SeverityOverride. It contains a vulnerability that can be of type
+# cve, ghsa or osv, an optional subject which can be a package or artifact, the overridden score,
+# score type, reviewer, justification, timestamps, retraction flag, origin and collector
 
-Versions are optional and each Package type defines own rules for handling them.
-For this level of GUAC, these are just opaque strings.
+"""
+SeverityOverride is an assertion that re-scores a vulnerability, either globally
+or for a specific package or artifact.
 
-This node can be referred to by other parts of GUAC.
+vulnerability (object) - union type that consists of osv, cve or ghsa
+subject (object) - optional package or artifact the override is scoped to (global if null)
+score (property) - the new score for the vulnerability
+scoreType (property) - the scoring system used for the score (e.g., CVSSv3)
+reviewer (property) - who reviewed and set the new score
+justification (property) - why the score was changed
+createdAt (property) - timestamp of when the override was created
+expiresAt (property) - optional timestamp after which the override no longer applies
+retracted (property) - true if the override has been withdrawn
+origin (property) - where this attestation was generated from (based on which document)
+collector (property) - the GUAC collector that collected the document that generated this attestation
 
-Subpath and qualifiers are optional. Lack of qualifiers is represented by an
-empty list and lack of subpath by empty string (to be consistent with
-optionality of namespace and version). Two nodes that have different qualifiers
-and/or subpath but the same version mean two different packages in the trie
-(they are different). Two nodes that have same version but qualifiers of one are
-a subset of the qualifier of the other also mean two different packages in the
-trie.
+Scoped overrides take precedence over global overrides which take precedence
+over the score reported by scanners. Expired or retracted overrides are ignored.
 """
-type PackageVersion {
+type SeverityOverride {
   id: ID!
-  version: String!
-  qualifiers: [PackageQualifier!]!
-  subpath: String!
+  vulnerability: OsvCveOrGhsa!
+  subject: PackageOrArtifact
+  score: Float!
+  scoreType: String!
+  reviewer: String!
+  justification: String!
+  createdAt: Time!
+  expiresAt: Time
+  retracted: Boolean!
+  origin: String!
+  collector: String!
 }
 
 """
-PackageQualifier is a qualifier for a package, a key-value pair.
-
-In the pURL representation, it is a part of the ` + "`" + `<qualifiers>` + "`" + ` part of the
-` + "`" + `pkg:<type>/<namespace>/<name>@<version>?<qualifiers>` + "`" + ` pURL.
-
-Qualifiers are optional, each Package type defines own rules for handling them,
-and multiple qualifiers could be attached to the same package.
+SeverityOverrideSpec allows filtering the list of SeverityOverride to return.
 
-This node cannot be directly referred by other parts of GUAC.
+Only one of OSV, CVE or GHSA and only one of package or artifact can be specified at once.
 """
-type PackageQualifier {
-  key: String!
-  value: String!
+input SeverityOverrideSpec {
+  id: ID
+  vulnerability: OsvCveOrGhsaSpec
+  subject: PackageOrArtifactSpec
+  scoreType: String
+  reviewer: String
+  retracted: Boolean
+  origin: String
+  collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
 }
 
 """
-PkgSpec allows filtering the list of packages to return.
+SeverityOverrideInputSpec is the same as SeverityOverride but for mutation input.
 
-Each field matches a qualifier from pURL. Use ` + "`" + `null` + "`" + ` to match on all values at
-that level. For example, to get all packages in GUAC backend, use a PkgSpec
-where every field is ` + "`" + `null` + "`" + `.
+All fields except expiresAt are required.
 
-Empty string at a field means matching with the empty string. If passing in
-qualifiers, all of the values in the list must match. Since we want to return
-nodes with any number of qualifiers if no qualifiers are passed in the input, we
-must also return the same set of nodes it the qualifiers list is empty. To match
-on nodes that don't contain any qualifier, set ` + "`" + `matchOnlyEmptyQualifiers` + "`" + ` to
-true. If this field is true, then the qualifiers argument is ignored.
+An override is identified by its vulnerability, subject, scoreType, reviewer and
+createdAt. Ingesting an override with the same identity updates the existing
+one, which is how an override gets retracted or has its expiry changed.
 """
-input PkgSpec {
-  id: ID
-  type: String
-  namespace: String
-  name: String
-  version: String
-  qualifiers: [PackageQualifierSpec!] = []
-  matchOnlyEmptyQualifiers: Boolean = false
-  subpath: String
+input SeverityOverrideInputSpec {
+  score: Float!
+  scoreType: String!
+  reviewer: String!
+  justification: String!
+  createdAt: Time!
+  expiresAt: Time
+  retracted: Boolean!
+  origin: String!
+  collector: String!
 }
 
 """
-PackageQualifierSpec is the same as PackageQualifier, but usable as query
-input.
-
-GraphQL does not allow input types to contain composite types and does not allow
-composite types to contain input types. So, although in this case these two
-types are semantically the same, we have to duplicate the definition.
-
-Keys are mandatory, but values could also be ` + "`" + `null` + "`" + ` if we want to match all
-values for a specific key.
-
-TODO(mihaimaruseac): Formalize empty vs null when the schema is fully done
+SeveritySource records where an effective severity score came from.
 """
-input PackageQualifierSpec {
-  key: String!
-  value: String
+enum SeveritySource {
+  SCOPED_OVERRIDE
+  GLOBAL_OVERRIDE
+  SCANNER
 }
 
 """
-PkgInputSpec specifies a package for a mutation.
-
-This is different than PkgSpec because we want to encode mandatory fields:
-` + "`" + `type` + "`" + ` and ` + "`" + `name` + "`" + `. All optional fields are given empty default values.
+SeverityBucket is the qualitative rating of a score, following the CVSS v3
+severity rating scale.
 """
-input PkgInputSpec {
-  type: String!
-  namespace: String = ""
-  name: String!
-  version: String = ""
-  qualifiers: [PackageQualifierInputSpec!] = []
-  subpath: String = ""
+enum SeverityBucket {
+  NONE
+  LOW
+  MEDIUM
+  HIGH
+  CRITICAL
 }
 
 """
-PackageQualifierInputSpec is the same as PackageQualifier, but usable as
-mutation input.
-
-GraphQL does not allow input types to contain composite types and does not allow
-composite types to contain input types. So, although in this case these two
-types are semantically the same, we have to duplicate the definition.
+EffectiveSeverity is the severity of a vulnerability after applying the
+override precedence rules.
 
-Both fields are mandatory.
+score (property) - the effective score
+bucket (property) - the qualitative rating of the effective score
+source (property) - whether the score came from an override or the scanner
+override (object) - the override that was applied, if any
 """
-input PackageQualifierInputSpec {
-  key: String!
-  value: String!
+type EffectiveSeverity {
+  vulnerability: OsvCveOrGhsa!
+  score: Float!
+  bucket: SeverityBucket!
+  source: SeveritySource!
+  override: SeverityOverride
 }
 
 extend type Query {
-  "Returns all packages"
-  packages(pkgSpec: PkgSpec): [Package!]!
+  "Returns all SeverityOverride"
+  SeverityOverride(severityOverrideSpec: SeverityOverrideSpec): [SeverityOverride!]!
+  "Returns the effective severity of a vulnerability for an optional subject, falling back to the scanner score"
+  effectiveSeverity(vulnerability: OsvCveOrGhsaInput!, subject: PackageOrArtifactInput, scannerScore: Float!): EffectiveSeverity!
 }
 
 extend type Mutation {
-  "Ingest a new package. Returns the ingested package trie"
-  ingestPackage(pkg: PkgInputSpec!): Package!
+  "Adds an override of the severity of a vulnerability, optionally scoped to a package or artifact"
+  ingestSeverityOverride(vulnerability: OsvCveOrGhsaInput!, subject: PackageOrArtifactInput, severityOverride: SeverityOverrideInputSpec!): SeverityOverride!
 }
 `, BuiltIn: false},
-	{Name: "../schema/path.graphql", Input: `#
+	{Name: "../schema/sort.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
 # Licensed under the Apache License, Version 2.0 (the "License");
@@ -3589,36 +5883,18 @@ extend type Mutation {
 
 # NOTE: This is experimental and might change in the future!
 
-# Defines a GraphQL schema for the artifact. It contains the algorithm and
-# digest fields
-
-"""
-Nodes is a union type of all the possible nodes. It encapsulates the software tree nodes along with the evidence nodes.
-In a path query, all connecting evidence nodes along with their intermediate subject nodes need to be returned
-in order to create a complete graph.
-"""
-union Nodes = Package | Source | Artifact | Builder | OSV | CVE | GHSA | IsOccurrence | IsDependency | IsVulnerability| CertifyVEXStatement | HashEqual | CertifyBad | CertifyPkg | CertifyScorecard | CertifyVuln | HasSourceAt | HasSBOM | HasSLSA
-
+# Defines the sort direction shared by the queries that can order their
+# results.
 
 """
-PackageSourceArtifactBuilderOsvCveOrGhsaFilter allows for all the software tree node types to be
-specified for the subject or the end target in a path query.
+SortDirection orders the results of a query by one of their fields.
 
-Exactly one of the value must be set to non-nil.
+Results with equal values are ordered by ID, so that the order is stable
+across pages.
 """
-input PackageSourceArtifactBuilderOsvCveOrGhsaFilter {
-  package: PkgSpec
-  source: SourceSpec
-  artifact: ArtifactSpec
-  builder: BuilderSpec
-  osv: OSVSpec
-  cve: CVESpec
-  ghsa: GHSASpec
-}
-
-extend type Query {
-  "path query is used to determine reachability between the subject and target. It returns the path to the target via a list of nodes"
-  path(subject: PackageSourceArtifactBuilderOsvCveOrGhsaFilter!, target: PackageSourceArtifactBuilderOsvCveOrGhsaFilter!, maxPathLength: Int!): [Nodes!]!
+enum SortDirection {
+  ASC
+  DESC
 }
 `, BuiltIn: false},
 	{Name: "../schema/source.graphql", Input: `#
@@ -3733,6 +6009,231 @@ extend type Query {
 extend type Mutation {
   "Ingest a new source. Returns the ingested source trie"
   ingestSource(source: SourceInputSpec!): Source!
+  "Bulk ingest sources. Returns the ingested source tries, in input order"
+  ingestSources(sources: [SourceInputSpec!]!): [Source!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/stats.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the size of the graph, for dashboards to poll.
+
+"""
+NodeTypeStats counts the nodes of a type and the edges they make.
+
+Packages are counted by version, sources by name and vulnerabilities by ID, as
+the changes query reports them. Edges link evidence to the nodes it is about,
+so packages, sources, artifacts, builders and vulnerabilities have none.
+"""
+type NodeTypeStats {
+  "The node type counted"
+  type: NodeType!
+  "Number of nodes of the type"
+  nodes: Int!
+  "Number of edges from the nodes of the type to the nodes they link"
+  edges: Int!
+}
+
+"""
+BackendStats is the size of the graph of the namespace.
+"""
+type BackendStats {
+  "Number of nodes, of all types"
+  nodes: Int!
+  "Number of edges, of all types"
+  edges: Int!
+  "The counts of every node type, in the order of NodeType"
+  types: [NodeTypeStats!]!
+}
+
+extend type Query {
+  "Returns the number of nodes and edges of each type"
+  stats: BackendStats!
+}
+`, BuiltIn: false},
+	{Name: "../schema/stitching.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for proposing HashEqual edges between digests that
+# identify the same container image but were never linked.
+
+"""
+StitchProposal proposes a HashEqual between an artifact and another digest of
+the same container image, e.g. the manifest digest in an SBOM and the image ID
+in a provenance document.
+
+Proposals are conservative: the supporting IsOccurrence and HasSLSA evidence
+must reference the same image repository and tag in their origin, must have
+been ingested within the time window of each other, and the repository and tag
+must point to exactly one other digest in that window.
+"""
+type StitchProposal {
+  "The queried artifact"
+  artifact: Artifact!
+  "The digest proposed to be equal to the queried artifact"
+  equalArtifact: Artifact!
+  "Image repository and tag shared by the origins of the evidence"
+  imageRef: String!
+  "Justification to use when ingesting the HashEqual (stitched-by-guac)"
+  justification: String!
+  "IDs of the IsOccurrence and HasSLSA nodes supporting the proposal"
+  evidence: [ID!]!
+}
+
+extend type Query {
+  """
+  Proposes HashEqual edges for digests that identify the same image as
+  artifact. The time window is in seconds and defaults to one hour.
+  """
+  stitchingProposals(artifact: ArtifactSpec!, windowSeconds: Int): [StitchProposal!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/stringMatch.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines how the free-form string filters of the evidence specs are matched.
+# Every backend must implement the same semantics.
+
+"""
+StringMatchMode is how the justification, origin and collector filters of a
+query are compared with the values of the evidence.
+
+EXACT requires the values to be equal. PREFIX requires the value to start with
+the filter. GLOB matches the value against the filter, where * matches any
+sequence of characters, including none and /, and ? any single character; a
+backslash makes the next character of the filter literal, as in \* for an
+asterisk. All modes are case sensitive.
+"""
+enum StringMatchMode {
+  EXACT
+  PREFIX
+  GLOB
+}
+`, BuiltIn: false},
+	{Name: "../schema/supersededBy.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the SupersededBy. It contains the package object, successor package object,
+# reason, since (timestamp), origin and collector.
+"""
+SupersededBy is an attestation that represents that a package has been renamed or deprecated upstream in favor
+of another package
+
+package (subject) - the package object type that represents the superseded package
+successor (object) - the package object type that represents the package replacing it
+reason (property) - string value representing why the package was superseded (e.g. the deprecation message)
+since (property) - timestamp since when the package is superseded
+origin (property) - where this attestation was generated from (based on which document)
+collector (property) - the GUAC collector that collected the document that generated this attestation
+
+The package and successor are either both at the version level or both at the package name level.
+"""
+type SupersededBy {
+  id: ID!
+  package: Package!
+  successor: Package!
+  reason: String!
+  since: Time!
+  origin: String!
+  collector: String!
+}
+
+"""
+SupersededBySpec allows filtering the list of SupersededBy to return.
+"""
+input SupersededBySpec {
+  id: ID
+  package: PkgSpec
+  successor: PkgSpec
+  reason: String
+  since: Time
+  origin: String
+  collector: String
+  "how justification, origin and collector are matched, EXACT if not set"
+  stringMatch: StringMatchMode
+}
+
+"""
+SupersededByInputSpec is the same as SupersededBy but for mutation input.
+
+All fields are required.
+"""
+input SupersededByInputSpec {
+  reason: String!
+  since: Time!
+  origin: String!
+  collector: String!
+}
+
+extend type Query {
+  "Returns all SupersededBy"
+  SupersededBy(supersededBySpec: SupersededBySpec): [SupersededBy!]!
+  """
+  Follows SupersededBy from the packages matching the spec to their successors, transitively. The edges are returned
+  in the order they are reached. A SupersededBy on a package name also applies to all of its versions.
+  """
+  successors(pkg: PkgSpec!): [SupersededBy!]!
+}
+
+extend type Mutation {
+  "Adds a certification that a package (either at the version level or package name level) is superseded by another package"
+  ingestSupersededBy(pkg: PkgInputSpec!, successor: PkgInputSpec!, pkgMatchType: MatchFlags!, supersededBy: SupersededByInputSpec!): SupersededBy!
 }
 `, BuiltIn: false},
 }
@@ -9,6 +9,11 @@ import (
 	"time"
 )
 
+// ConflictEvidence is a union of the evidence that can be in conflict.
+type ConflictEvidence interface {
+	IsConflictEvidence()
+}
+
 // CveOrGhsa is a union of CVE and GHSA.
 type CveOrGhsa interface {
 	IsCveOrGhsa()
@@ -21,7 +26,7 @@ type Nodes interface {
 	IsNodes()
 }
 
-// OsvCveGhsaObject is a union of OSV, CVE and GHSA. Any of these objects can be specified for vulnerability
+// OsvCveGhsaObject is a union of OSV, CVE, GHSA and NoVuln. Any of these objects can be specified for vulnerability
 type OsvCveOrGhsa interface {
 	IsOsvCveOrGhsa()
 }
@@ -77,6 +82,16 @@ type ArtifactSpec struct {
 	Digest    *string `json:"digest,omitempty"`
 }
 
+// BackendStats is the size of the graph of the namespace.
+type BackendStats struct {
+	// Number of nodes, of all types
+	Nodes int `json:"nodes"`
+	// Number of edges, of all types
+	Edges int `json:"edges"`
+	// The counts of every node type, in the order of NodeType
+	Types []*NodeTypeStats `json:"types"`
+}
+
 // Builder represents the builder such as (FRSCA or github actions).
 //
 // Currently builders are identified by the `uri` field, which is mandatory.
@@ -119,7 +134,8 @@ func (Cve) IsNodes() {}
 
 // CVEId is the actual ID that is given to a specific vulnerability
 //
-// The `id` field is mandatory and canonicalized to be lowercase.
+// The `id` field is mandatory, must be of the form CVE-YYYY-NNNN and is
+// canonicalized to be lowercase.
 //
 // This node can be referred to by other parts of GUAC.
 type CVEId struct {
@@ -128,6 +144,8 @@ type CVEId struct {
 }
 
 // CVEInputSpec is the same as CVESpec, but used for mutation ingestion.
+//
+// The year must be the one of the ID, e.g. 2021 for CVE-2021-44228.
 type CVEInputSpec struct {
 	Year  int    `json:"year"`
 	CveID string `json:"cveId"`
@@ -146,6 +164,8 @@ type CVESpec struct {
 // justification (property) - string value representing why the subject is considered bad
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered bad, the zero time for the
+// certifications ingested without one
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type CertifyBad struct {
@@ -153,28 +173,93 @@ type CertifyBad struct {
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
+	KnownSince    time.Time               `json:"knownSince"`
 }
 
+func (CertifyBad) IsConflictEvidence() {}
+
 func (CertifyBad) IsNodes() {}
 
 // CertifyBadInputSpec is the same as CertifyBad but for mutation input.
 //
-// All fields are required.
+// All fields are required, except knownSince which defaults to the zero time.
 type CertifyBadInputSpec struct {
-	Justification string `json:"justification"`
-	Origin        string `json:"origin"`
-	Collector     string `json:"collector"`
+	Justification string     `json:"justification"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+	KnownSince    *time.Time `json:"knownSince,omitempty"`
 }
 
 // CertifyBadSpec allows filtering the list of CertifyBad to return.
 // Note: Package, Source or artifact must be specified but not at the same time
 // For package - a PackageName or PackageVersion must be specified (name or name, version, qualifiers and subpath)
 // For source - a SourceName must be specified (name, tag or commit)
+//
+// knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+// select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+// so that consecutive ranges don't overlap. Timestamps are compared in UTC.
 type CertifyBadSpec struct {
-	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
-	Justification *string                      `json:"justification,omitempty"`
-	Origin        *string                      `json:"origin,omitempty"`
-	Collector     *string                      `json:"collector,omitempty"`
+	Subject          *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
+	Justification    *string                      `json:"justification,omitempty"`
+	Origin           *string                      `json:"origin,omitempty"`
+	Collector        *string                      `json:"collector,omitempty"`
+	KnownSince       *time.Time                   `json:"knownSince,omitempty"`
+	KnownSinceAfter  *time.Time                   `json:"knownSinceAfter,omitempty"`
+	KnownSinceBefore *time.Time                   `json:"knownSinceBefore,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+}
+
+// CertifyGood is an attestation represents when a package, source or artifact is considered good
+//
+// subject - union type that can be either a package, source or artifact object type
+// justification (property) - string value representing why the subject is considered good
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+// knownSince (property) - timestamp since when the subject is considered good, the zero time for the
+// certifications ingested without one
+//
+// Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
+type CertifyGood struct {
+	Subject       PackageSourceOrArtifact `json:"subject"`
+	Justification string                  `json:"justification"`
+	Origin        string                  `json:"origin"`
+	Collector     string                  `json:"collector"`
+	KnownSince    time.Time               `json:"knownSince"`
+}
+
+func (CertifyGood) IsConflictEvidence() {}
+
+func (CertifyGood) IsNodes() {}
+
+// CertifyGoodInputSpec is the same as CertifyGood but for mutation input.
+//
+// All fields are required, except knownSince which defaults to the zero time.
+type CertifyGoodInputSpec struct {
+	Justification string     `json:"justification"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+	KnownSince    *time.Time `json:"knownSince,omitempty"`
+}
+
+// CertifyGoodSpec allows filtering the list of CertifyGood to return.
+// Note: Package, Source or artifact must be specified but not at the same time
+// For package - a PackageName or PackageVersion must be specified (name or name, version, qualifiers and subpath)
+// For source - a SourceName must be specified (name, tag or commit)
+//
+// knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+// select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+// so that consecutive ranges don't overlap. Timestamps are compared in UTC.
+type CertifyGoodSpec struct {
+	Subject          *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
+	Justification    *string                      `json:"justification,omitempty"`
+	Origin           *string                      `json:"origin,omitempty"`
+	Collector        *string                      `json:"collector,omitempty"`
+	KnownSince       *time.Time                   `json:"knownSince,omitempty"`
+	KnownSinceAfter  *time.Time                   `json:"knownSinceAfter,omitempty"`
+	KnownSinceBefore *time.Time                   `json:"knownSinceBefore,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // CertifyPkg is an attestation that represents when a package objects are similar
@@ -209,6 +294,8 @@ type CertifyPkgSpec struct {
 	Justification *string    `json:"justification,omitempty"`
 	Origin        *string    `json:"origin,omitempty"`
 	Collector     *string    `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // CertifyScorecard is an attestation which represents the scorecard of a
@@ -234,25 +321,91 @@ type CertifyScorecardSpec struct {
 	ScorecardCommit  *string               `json:"scorecardCommit,omitempty"`
 	Origin           *string               `json:"origin,omitempty"`
 	Collector        *string               `json:"collector,omitempty"`
+	// match any of these origins, in addition to origin
+	Origins []string `json:"origins,omitempty"`
+	// match any of these collectors, in addition to collector
+	Collectors []string `json:"collectors,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+	// order the results by aggregateScore, applied after filtering
+	OrderByScore *SortDirection `json:"orderByScore,omitempty"`
+}
+
+// CertifySigned is an attestation that represents a signature found on an artifact.
+//
+// artifact (subject) - the artifact that is signed
+// signer (property) - the identity of the signer (key name, certificate subject or email)
+// signatureType (property) - the kind of signature (cosign, notation, gpg)
+// status (property) - whether the signature was verified
+// verifiedAt (property) - the time the signature was last checked
+// trustRoot (property) - the key or root certificate the signature was checked against, empty if none applied
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+type CertifySigned struct {
+	ID            string          `json:"id"`
+	Artifact      *Artifact       `json:"artifact"`
+	Signer        string          `json:"signer"`
+	SignatureType SignatureType   `json:"signatureType"`
+	Status        SignatureStatus `json:"status"`
+	VerifiedAt    time.Time       `json:"verifiedAt"`
+	TrustRoot     string          `json:"trustRoot"`
+	Origin        string          `json:"origin"`
+	Collector     string          `json:"collector"`
+}
+
+func (CertifySigned) IsNodes() {}
+
+// CertifySignedInputSpec is the same as CertifySigned but for mutation input.
+//
+// All fields are required.
+type CertifySignedInputSpec struct {
+	Signer        string          `json:"signer"`
+	SignatureType SignatureType   `json:"signatureType"`
+	Status        SignatureStatus `json:"status"`
+	VerifiedAt    time.Time       `json:"verifiedAt"`
+	TrustRoot     string          `json:"trustRoot"`
+	Origin        string          `json:"origin"`
+	Collector     string          `json:"collector"`
+}
+
+// CertifySignedSpec allows filtering the list of CertifySigned to return.
+type CertifySignedSpec struct {
+	ID            *string          `json:"id,omitempty"`
+	Artifact      *ArtifactSpec    `json:"artifact,omitempty"`
+	Signer        *string          `json:"signer,omitempty"`
+	SignatureType *SignatureType   `json:"signatureType,omitempty"`
+	Status        *SignatureStatus `json:"status,omitempty"`
+	VerifiedAt    *time.Time       `json:"verifiedAt,omitempty"`
+	TrustRoot     *string          `json:"trustRoot,omitempty"`
+	Origin        *string          `json:"origin,omitempty"`
+	Collector     *string          `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (CVE or GHSA)
 //
 // subject - union type that represents a package or artifact
 // vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// status (property) - status of the subject with regard to the vulnerability
+// statement (property) - action to take when the subject is affected, empty if not given
+// justification (property) - justification for VEX, only set when the subject is not affected
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type CertifyVEXStatement struct {
 	Subject       PackageOrArtifact `json:"subject"`
 	Vulnerability CveOrGhsa         `json:"vulnerability"`
+	Status        VexStatus         `json:"status"`
+	Statement     string            `json:"statement"`
 	Justification string            `json:"justification"`
 	KnownSince    time.Time         `json:"knownSince"`
 	Origin        string            `json:"origin"`
 	Collector     string            `json:"collector"`
 }
 
+func (CertifyVEXStatement) IsConflictEvidence() {}
+
 func (CertifyVEXStatement) IsNodes() {}
 
 // CertifyVEXStatementSpec allows filtering the list of CertifyVEXStatement to return.
@@ -264,6 +417,8 @@ type CertifyVEXStatementSpec struct {
 	KnownSince    *time.Time             `json:"knownSince,omitempty"`
 	Origin        *string                `json:"origin,omitempty"`
 	Collector     *string                `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // CertifyVuln is an attestation that represents when a package has a vulnerability
@@ -271,29 +426,118 @@ type CertifyVuln struct {
 	ID string `json:"id"`
 	// package (subject) - the package object type that represents the package
 	Package *Package `json:"package"`
-	// vulnerability (object) - union type that consists of osv, cve or ghsa
+	// vulnerability (object) - union type that consists of osv, cve, ghsa or noVuln
 	Vulnerability OsvCveOrGhsa `json:"vulnerability"`
 	// metadata (property) - contains all the vulnerability metadata
 	Metadata *VulnerabilityMetaData `json:"metadata"`
+	// successors - the SupersededBy chain of the package, only set if includeSuccessors is set in the spec
+	Successors []*SupersededBy `json:"successors,omitempty"`
+	// effectiveSeverity - the scanner score after applying the SeverityOverride precedence, null if neither the scanner nor an override scored the vulnerability
+	EffectiveSeverity *EffectiveSeverity `json:"effectiveSeverity,omitempty"`
 }
 
+func (CertifyVuln) IsConflictEvidence() {}
+
 func (CertifyVuln) IsNodes() {}
 
+// CertifyVulnConnection is a page of CertifyVulnList results.
+//
+// totalCount - the number of CertifyVuln matching the filter, across all pages
+// endCursor - pass as `after` to get the next page
+// hasNextPage - true if there are more results after this page
+type CertifyVulnConnection struct {
+	CertifyVulns []*CertifyVuln `json:"certifyVulns"`
+	TotalCount   int            `json:"totalCount"`
+	EndCursor    *string        `json:"endCursor,omitempty"`
+	HasNextPage  bool           `json:"hasNextPage"`
+}
+
 // CertifyVulnSpec allows filtering the list of CertifyVuln to return.
 //
 // Specifying just the package allows to query for all vulnerabilities associated with the package.
-// Only OSV, CVE or GHSA can be specified at once
+// Only OSV, CVE, GHSA or noVuln can be specified at once
+//
+// timeScanned matches the exact timestamp. timeScannedAfter and
+// timeScannedBefore select a range, both bounds being inclusive. Timestamps are
+// compared in UTC.
 type CertifyVulnSpec struct {
-	ID             *string           `json:"id,omitempty"`
-	Package        *PkgSpec          `json:"package,omitempty"`
-	Vulnerability  *OsvCveOrGhsaSpec `json:"vulnerability,omitempty"`
-	TimeScanned    *time.Time        `json:"timeScanned,omitempty"`
-	DbURI          *string           `json:"dbUri,omitempty"`
-	DbVersion      *string           `json:"dbVersion,omitempty"`
-	ScannerURI     *string           `json:"scannerUri,omitempty"`
-	ScannerVersion *string           `json:"scannerVersion,omitempty"`
-	Origin         *string           `json:"origin,omitempty"`
-	Collector      *string           `json:"collector,omitempty"`
+	ID                *string           `json:"id,omitempty"`
+	Package           *PkgSpec          `json:"package,omitempty"`
+	Vulnerability     *OsvCveOrGhsaSpec `json:"vulnerability,omitempty"`
+	TimeScanned       *time.Time        `json:"timeScanned,omitempty"`
+	TimeScannedAfter  *time.Time        `json:"timeScannedAfter,omitempty"`
+	TimeScannedBefore *time.Time        `json:"timeScannedBefore,omitempty"`
+	DbURI             *string           `json:"dbUri,omitempty"`
+	DbVersion         *string           `json:"dbVersion,omitempty"`
+	ScannerURI        *string           `json:"scannerUri,omitempty"`
+	ScannerVersion    *string           `json:"scannerVersion,omitempty"`
+	Origin            *string           `json:"origin,omitempty"`
+	Collector         *string           `json:"collector,omitempty"`
+	// match any of these origins, in addition to origin
+	Origins []string `json:"origins,omitempty"`
+	// match any of these collectors, in addition to collector
+	Collectors []string `json:"collectors,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+	// annotate the results with the SupersededBy chain of the package
+	IncludeSuccessors *bool `json:"includeSuccessors,omitempty"`
+	// order the results by timeScanned, applied after filtering
+	OrderByTimeScanned *SortDirection `json:"orderByTimeScanned,omitempty"`
+}
+
+// Change is a node that was ingested, as returned by changes.
+//
+// cursor - pass as `after` to get the changes following this one
+// type - the kind of node
+// node - the node, in its current state
+type Change struct {
+	Cursor string   `json:"cursor"`
+	Type   NodeType `json:"type"`
+	Node   Nodes    `json:"node"`
+}
+
+// ChangeConnection is a page of changes results.
+//
+// endCursor - pass as `after` to get the next page. It is always set, even if
+// the page is empty, so that consumers can keep polling from it.
+// hasNextPage - true if there are more changes after this page
+type ChangeConnection struct {
+	Changes     []*Change `json:"changes"`
+	EndCursor   string    `json:"endCursor"`
+	HasNextPage bool      `json:"hasNextPage"`
+}
+
+// CollectorDiff is the three-way partition of the findings of two collectors.
+//
+// onlyA - findings only reported by collectorA
+// onlyB - findings only reported by collectorB
+// both - findings reported by both collectors, sampled from the evidence of collectorA
+type CollectorDiff struct {
+	OnlyA *CollectorDiffPartition `json:"onlyA"`
+	OnlyB *CollectorDiffPartition `json:"onlyB"`
+	Both  *CollectorDiffPartition `json:"both"`
+}
+
+// CollectorDiffPartition is one part of a collectorDiff result.
+//
+// count - the number of distinct findings in the partition
+// sample - up to sampleSize findings of the partition, in ingestion order
+type CollectorDiffPartition struct {
+	Count  int     `json:"count"`
+	Sample []Nodes `json:"sample"`
+}
+
+// Conflict is a pair of evidence that contradict each other.
+//
+// pattern - the kind of contradiction
+// first - the evidence that was ingested first
+// second - the evidence whose ingestion raised the conflict
+// detectedAt - when the conflict was detected
+type Conflict struct {
+	Pattern    ConflictPattern  `json:"pattern"`
+	First      ConflictEvidence `json:"first"`
+	Second     ConflictEvidence `json:"second"`
+	DetectedAt time.Time        `json:"detectedAt"`
 }
 
 // CveOrGhsaInput allows using CveOrGhsa union as
@@ -312,6 +556,35 @@ type CveOrGhsaSpec struct {
 	Ghsa *GHSASpec `json:"ghsa,omitempty"`
 }
 
+// DependencyTree is the transitive closure of the dependencies of a package,
+// returned by dependencies.
+//
+// packages - the dependent packages reached, as package names, in breadth-first order
+// dependencies - the IsDependency followed, including those between packages reached already
+// occurrences - the IsOccurrence of the versions of the packages reached
+// truncated - true if maxDepth stopped the walk before all dependencies were reached
+type DependencyTree struct {
+	Packages     []*Package      `json:"packages"`
+	Dependencies []*IsDependency `json:"dependencies"`
+	Occurrences  []*IsOccurrence `json:"occurrences"`
+	Truncated    bool            `json:"truncated"`
+}
+
+// EffectiveSeverity is the severity of a vulnerability after applying the
+// override precedence rules.
+//
+// score (property) - the effective score
+// bucket (property) - the qualitative rating of the effective score
+// source (property) - whether the score came from an override or the scanner
+// override (object) - the override that was applied, if any
+type EffectiveSeverity struct {
+	Vulnerability OsvCveOrGhsa      `json:"vulnerability"`
+	Score         float64           `json:"score"`
+	Bucket        SeverityBucket    `json:"bucket"`
+	Source        SeveritySource    `json:"source"`
+	Override      *SeverityOverride `json:"override,omitempty"`
+}
+
 // GHSA represents GitHub security advisories.
 //
 // We create a separate node to allow retrieving all GHSAs.
@@ -328,7 +601,8 @@ func (Ghsa) IsNodes() {}
 
 // GHSAId is the actual ID that is given to a specific vulnerability on GitHub
 //
-// The `id` field is mandatory and canonicalized to be lowercase.
+// The `id` field is mandatory, must be of the form GHSA-xxxx-xxxx-xxxx and is
+// canonicalized to be lowercase.
 //
 // This node can be referred to by other parts of GUAC.
 type GHSAId struct {
@@ -355,24 +629,32 @@ type GHSASpec struct {
 // uri (property) - identifier string for the SBOM
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// documentHash (property) - content hash of the original document in the blob store, if it was stored
+// fileMode (property) - how the file level entries of the SBOM were ingested (full, checksums-only or skip), if the parser supports choosing
 //
 // Note: Only package object or source object can be defined. Not both.
 type HasSbom struct {
-	Subject   PackageOrSource `json:"subject"`
-	URI       string          `json:"uri"`
-	Origin    string          `json:"origin"`
-	Collector string          `json:"collector"`
+	Subject      PackageOrSource `json:"subject"`
+	URI          string          `json:"uri"`
+	Origin       string          `json:"origin"`
+	Collector    string          `json:"collector"`
+	DocumentHash *string         `json:"documentHash,omitempty"`
+	FileMode     *string         `json:"fileMode,omitempty"`
 }
 
 func (HasSbom) IsNodes() {}
 
 // HasSBOMInputSpec is the same as HasSBOM but for mutation input.
 //
-// All fields are required.
+// All fields are required, except documentHash which is only set when the
+// original document was stored, and fileMode which is only set by parsers that
+// can leave out the file level entries.
 type HasSBOMInputSpec struct {
-	URI       string `json:"uri"`
-	Origin    string `json:"origin"`
-	Collector string `json:"collector"`
+	URI          string  `json:"uri"`
+	Origin       string  `json:"origin"`
+	Collector    string  `json:"collector"`
+	DocumentHash *string `json:"documentHash,omitempty"`
+	FileMode     *string `json:"fileMode,omitempty"`
 }
 
 // HashEqualSpec allows filtering the list of HasSBOM to return.
@@ -384,6 +666,10 @@ type HasSBOMSpec struct {
 	URI       *string              `json:"uri,omitempty"`
 	Origin    *string              `json:"origin,omitempty"`
 	Collector *string              `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch  *StringMatchMode `json:"stringMatch,omitempty"`
+	DocumentHash *string          `json:"documentHash,omitempty"`
+	FileMode     *string          `json:"fileMode,omitempty"`
 }
 
 // HasSLSA records that a subject node has a SLSA attestation.
@@ -410,6 +696,9 @@ type HasSLSASpec struct {
 	FinishedOn  *time.Time           `json:"finishedOn,omitempty"`
 	Origin      *string              `json:"origin,omitempty"`
 	Collector   *string              `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch  *StringMatchMode `json:"stringMatch,omitempty"`
+	DocumentHash *string          `json:"documentHash,omitempty"`
 }
 
 // HasSourceAt is an attestation represents that a package object has a source object since a timestamp
@@ -430,8 +719,22 @@ type HasSourceAt struct {
 	Collector     string    `json:"collector"`
 }
 
+func (HasSourceAt) IsConflictEvidence() {}
+
 func (HasSourceAt) IsNodes() {}
 
+// HasSourceAtConnection is a page of HasSourceAtList results.
+//
+// totalCount - the number of HasSourceAt matching the filter, across all pages
+// endCursor - pass as `after` to get the next page
+// hasNextPage - true if there are more results after this page
+type HasSourceAtConnection struct {
+	HasSourceAts []*HasSourceAt `json:"hasSourceAts"`
+	TotalCount   int            `json:"totalCount"`
+	EndCursor    *string        `json:"endCursor,omitempty"`
+	HasNextPage  bool           `json:"hasNextPage"`
+}
+
 // HasSourceAtInputSpec is the same as HasSourceAt but for mutation input.
 //
 // All fields are required.
@@ -443,14 +746,35 @@ type HasSourceAtInputSpec struct {
 }
 
 // HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+//
+// knownSince matches the exact timestamp. knownSinceAfter and knownSinceBefore
+// select a range: knownSinceAfter is inclusive and knownSinceBefore exclusive,
+// so that consecutive ranges don't overlap. Timestamps are compared in UTC.
+//
+// latest keeps, among the HasSourceAt matching the other filters, only the one
+// with the latest knownSince for each package. A HasSourceAt attached to a
+// package name and one attached to a version of it are for different packages.
+// On a tie on knownSince, the one with the highest ID, ingested last, is kept.
 type HasSourceAtSpec struct {
-	ID            *string     `json:"id,omitempty"`
-	Package       *PkgSpec    `json:"package,omitempty"`
-	Source        *SourceSpec `json:"source,omitempty"`
-	KnownSince    *time.Time  `json:"knownSince,omitempty"`
-	Justification *string     `json:"justification,omitempty"`
-	Origin        *string     `json:"origin,omitempty"`
-	Collector     *string     `json:"collector,omitempty"`
+	ID               *string     `json:"id,omitempty"`
+	Package          *PkgSpec    `json:"package,omitempty"`
+	Source           *SourceSpec `json:"source,omitempty"`
+	KnownSince       *time.Time  `json:"knownSince,omitempty"`
+	KnownSinceAfter  *time.Time  `json:"knownSinceAfter,omitempty"`
+	KnownSinceBefore *time.Time  `json:"knownSinceBefore,omitempty"`
+	Justification    *string     `json:"justification,omitempty"`
+	Origin           *string     `json:"origin,omitempty"`
+	Collector        *string     `json:"collector,omitempty"`
+	// match any of these origins, in addition to origin
+	Origins []string `json:"origins,omitempty"`
+	// match any of these collectors, in addition to collector
+	Collectors []string `json:"collectors,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+	// order the results by knownSince, applied after filtering
+	OrderByKnownSince *SortDirection `json:"orderByKnownSince,omitempty"`
+	// only return the latest HasSourceAt of every package
+	Latest *bool `json:"latest,omitempty"`
 }
 
 // HashEqual is an attestation that represents when two artifact hash are similar based on a justification.
@@ -487,6 +811,8 @@ type HashEqualSpec struct {
 	Justification *string         `json:"justification,omitempty"`
 	Origin        *string         `json:"origin,omitempty"`
 	Collector     *string         `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // IsDependency is an attestation that represents when a package is dependent on another package
@@ -497,18 +823,32 @@ type HashEqualSpec struct {
 // justification (property) - string value representing why the artifacts are the equal
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
+// successors - the SupersededBy chain of the dependent package, only set if includeSuccessors is set in the spec
 type IsDependency struct {
-	ID               string   `json:"id"`
-	Package          *Package `json:"package"`
-	DependentPackage *Package `json:"dependentPackage"`
-	VersionRange     string   `json:"versionRange"`
-	Justification    string   `json:"justification"`
-	Origin           string   `json:"origin"`
-	Collector        string   `json:"collector"`
+	ID               string          `json:"id"`
+	Package          *Package        `json:"package"`
+	DependentPackage *Package        `json:"dependentPackage"`
+	VersionRange     string          `json:"versionRange"`
+	Justification    string          `json:"justification"`
+	Origin           string          `json:"origin"`
+	Collector        string          `json:"collector"`
+	Successors       []*SupersededBy `json:"successors,omitempty"`
 }
 
 func (IsDependency) IsNodes() {}
 
+// IsDependencyConnection is a page of IsDependencyList results.
+//
+// totalCount - the number of IsDependency matching the filter, across all pages
+// endCursor - pass as `after` to get the next page
+// hasNextPage - true if there are more results after this page
+type IsDependencyConnection struct {
+	IsDependencies []*IsDependency `json:"isDependencies"`
+	TotalCount     int             `json:"totalCount"`
+	EndCursor      *string         `json:"endCursor,omitempty"`
+	HasNextPage    bool            `json:"hasNextPage"`
+}
+
 // IsDependencyInputSpec is the same as IsDependency but for mutation input.
 //
 // All fields are required.
@@ -523,14 +863,24 @@ type IsDependencyInputSpec struct {
 //
 // Note: the package object must be defined to return its dependent packages.
 // Dependent Packages must represent the packageName (cannot be the packageVersion)
+//
+// dependentVersion selects the IsDependency whose versionRange contains this
+// version of the dependent package, versionRange being a semver range in the npm
+// syntax, as ^1.2.0 or >=1.2.0 <2.0.0. Ranges and versions that are not semver,
+// as those of deb packages, only match when they are equal.
 type IsDependencySpec struct {
 	ID               *string      `json:"id,omitempty"`
 	Package          *PkgSpec     `json:"package,omitempty"`
 	DependentPackage *PkgNameSpec `json:"dependentPackage,omitempty"`
 	VersionRange     *string      `json:"versionRange,omitempty"`
+	DependentVersion *string      `json:"dependentVersion,omitempty"`
 	Justification    *string      `json:"justification,omitempty"`
 	Origin           *string      `json:"origin,omitempty"`
 	Collector        *string      `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+	// annotate the results with the SupersededBy chain of the dependent package
+	IncludeSuccessors *bool `json:"includeSuccessors,omitempty"`
 }
 
 // IsOccurrence is an attestation represents when either a package or source is represented by an artifact
@@ -574,6 +924,12 @@ type IsOccurrenceSpec struct {
 	Justification *string              `json:"justification,omitempty"`
 	Origin        *string              `json:"origin,omitempty"`
 	Collector     *string              `json:"collector,omitempty"`
+	// match any of these origins, in addition to origin
+	Origins []string `json:"origins,omitempty"`
+	// match any of these collectors, in addition to collector
+	Collectors []string `json:"collectors,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // IsVulnerability is an attestation that represents when an OSV ID represents a CVE or GHSA
@@ -612,6 +968,8 @@ type IsVulnerabilitySpec struct {
 	Justification *string        `json:"justification,omitempty"`
 	Origin        *string        `json:"origin,omitempty"`
 	Collector     *string        `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
 }
 
 // MatchFlags is used to input the PkgMatchType enum.
@@ -619,6 +977,30 @@ type MatchFlags struct {
 	Pkg PkgMatchType `json:"pkg"`
 }
 
+// NoVuln is a special vulnerability node to attest that no vulnerability was
+// found when scanning a package, to tell it apart from a package never scanned.
+type NoVuln struct {
+	ID string `json:"id"`
+}
+
+func (NoVuln) IsOsvCveOrGhsa() {}
+
+func (NoVuln) IsNodes() {}
+
+// NodeTypeStats counts the nodes of a type and the edges they make.
+//
+// Packages are counted by version, sources by name and vulnerabilities by ID, as
+// the changes query reports them. Edges link evidence to the nodes it is about,
+// so packages, sources, artifacts, builders and vulnerabilities have none.
+type NodeTypeStats struct {
+	// The node type counted
+	Type NodeType `json:"type"`
+	// Number of nodes of the type
+	Nodes int `json:"nodes"`
+	// Number of edges from the nodes of the type to the nodes they link
+	Edges int `json:"edges"`
+}
+
 // OSV represents an Open Source Vulnerability.
 //
 // We create a separate node to allow retrieving all OSVs.
@@ -657,20 +1039,30 @@ type OSVSpec struct {
 
 // OsvCveOrGhsaInput allows using OsvCveOrGhsa union as
 // input type to be used in mutations.
-// Exactly one of the value must be set to non-nil.
+// Exactly one of the value must be set to non-nil, noVuln counting as set only
+// when true.
+//
+// Setting noVuln to true certifies that scanning the package found no
+// vulnerability. It is only valid when ingesting a CertifyVuln.
 type OsvCveOrGhsaInput struct {
-	Osv  *OSVInputSpec  `json:"osv,omitempty"`
-	Cve  *CVEInputSpec  `json:"cve,omitempty"`
-	Ghsa *GHSAInputSpec `json:"ghsa,omitempty"`
+	Osv    *OSVInputSpec  `json:"osv,omitempty"`
+	Cve    *CVEInputSpec  `json:"cve,omitempty"`
+	Ghsa   *GHSAInputSpec `json:"ghsa,omitempty"`
+	NoVuln *bool          `json:"noVuln,omitempty"`
 }
 
 // OsvCveOrGhsaSpec allows using OsvCveOrGhsa union as
 // input type to be used in read queries.
 // Exactly one of the value must be set to non-nil.
+//
+// Setting noVuln to true only returns the results attesting that no
+// vulnerability was found, while setting it to false only returns the results
+// with an actual vulnerability.
 type OsvCveOrGhsaSpec struct {
-	Osv  *OSVSpec  `json:"osv,omitempty"`
-	Cve  *CVESpec  `json:"cve,omitempty"`
-	Ghsa *GHSASpec `json:"ghsa,omitempty"`
+	Osv    *OSVSpec  `json:"osv,omitempty"`
+	Cve    *CVESpec  `json:"cve,omitempty"`
+	Ghsa   *GHSASpec `json:"ghsa,omitempty"`
+	NoVuln *bool     `json:"noVuln,omitempty"`
 }
 
 // Package represents a package.
@@ -802,20 +1194,6 @@ type PackageQualifierSpec struct {
 	Value *string `json:"value,omitempty"`
 }
 
-// PackageSourceArtifactBuilderOsvCveOrGhsaFilter allows for all the software tree node types to be
-// specified for the subject or the end target in a path query.
-//
-// Exactly one of the value must be set to non-nil.
-type PackageSourceArtifactBuilderOsvCveOrGhsaFilter struct {
-	Package  *PkgSpec      `json:"package,omitempty"`
-	Source   *SourceSpec   `json:"source,omitempty"`
-	Artifact *ArtifactSpec `json:"artifact,omitempty"`
-	Builder  *BuilderSpec  `json:"builder,omitempty"`
-	Osv      *OSVSpec      `json:"osv,omitempty"`
-	Cve      *CVESpec      `json:"cve,omitempty"`
-	Ghsa     *GHSASpec     `json:"ghsa,omitempty"`
-}
-
 // PackageSourceOrArtifactInput allows using PackageSourceOrArtifact union as
 // input type to be used in mutations.
 //
@@ -906,6 +1284,51 @@ type PkgSpec struct {
 	Subpath                  *string                 `json:"subpath,omitempty"`
 }
 
+// RiskyPackage is a package version returned by riskyPackages along with the
+// evidence for each condition that was requested.
+//
+// package - the package version
+// vulnerabilities - the CertifyVuln above the requested severity
+// unprovenancedArtifacts - the artifacts of the package without provenance
+// hasSBOM - whether the package has a HasSBOM
+// scorecards - the scorecards below the requested score
+type RiskyPackage struct {
+	Package                *Package            `json:"package"`
+	Vulnerabilities        []*CertifyVuln      `json:"vulnerabilities"`
+	UnprovenancedArtifacts []*Artifact         `json:"unprovenancedArtifacts"`
+	HasSbom                bool                `json:"hasSBOM"`
+	Scorecards             []*CertifyScorecard `json:"scorecards"`
+}
+
+// RiskyPackageConditions are the conditions a package version must match to be
+// returned by riskyPackages. All conditions that are set must hold. At least one
+// condition must be set.
+//
+// hasVulnAboveSeverity - the package has a CertifyVuln whose effective severity
+// (the scanner score after applying SeverityOverride) is at or above the value.
+// Vulnerabilities without a known severity never match.
+// lacksProvenance - if true, none of the artifacts the package occurs as is the
+// subject of a HasSLSA
+// lacksSBOM - if true, the package has no HasSBOM
+// scorecardBelow - the package has a source (via HasSourceAt) with a
+// CertifyScorecard whose aggregate score is below the value
+type RiskyPackageConditions struct {
+	HasVulnAboveSeverity *float64 `json:"hasVulnAboveSeverity,omitempty"`
+	LacksProvenance      *bool    `json:"lacksProvenance,omitempty"`
+	LacksSbom            *bool    `json:"lacksSBOM,omitempty"`
+	ScorecardBelow       *float64 `json:"scorecardBelow,omitempty"`
+}
+
+// RiskyPackageConnection is a page of riskyPackages results.
+//
+// endCursor - pass as `after` to get the next page
+// hasNextPage - true if there are more results after this page
+type RiskyPackageConnection struct {
+	Packages    []*RiskyPackage `json:"packages"`
+	EndCursor   *string         `json:"endCursor,omitempty"`
+	HasNextPage bool            `json:"hasNextPage"`
+}
+
 // SLSA contains all of the fields present in a SLSA attestation.
 //
 // The materials and builders are objects of the HasSLSA predicate, everything
@@ -933,11 +1356,14 @@ type Slsa struct {
 	Origin string `json:"origin"`
 	// GUAC collector for the document
 	Collector string `json:"collector"`
+	// Content hash of the original document in the blob store, if it was stored
+	DocumentHash *string `json:"documentHash,omitempty"`
 }
 
 // SLSAInputSpec is the same as SLSA but for mutation input.
 //
-// All fields are required.
+// All fields are required, except documentHash which is only set when the
+// original document was stored.
 type SLSAInputSpec struct {
 	BuildType     string                    `json:"buildType"`
 	SlsaPredicate []*SLSAPredicateInputSpec `json:"slsaPredicate"`
@@ -946,6 +1372,7 @@ type SLSAInputSpec struct {
 	FinishedOn    time.Time                 `json:"finishedOn"`
 	Origin        string                    `json:"origin"`
 	Collector     string                    `json:"collector"`
+	DocumentHash  *string                   `json:"documentHash,omitempty"`
 }
 
 // SLSAPredicate are the values from the SLSA predicate in key-value pair form.
@@ -1063,6 +1490,75 @@ type ScorecardInputSpec struct {
 	Collector        string                     `json:"collector"`
 }
 
+// SeverityOverride is an assertion that re-scores a vulnerability, either globally
+// or for a specific package or artifact.
+//
+// vulnerability (object) - union type that consists of osv, cve or ghsa
+// subject (object) - optional package or artifact the override is scoped to (global if null)
+// score (property) - the new score for the vulnerability
+// scoreType (property) - the scoring system used for the score (e.g., CVSSv3)
+// reviewer (property) - who reviewed and set the new score
+// justification (property) - why the score was changed
+// createdAt (property) - timestamp of when the override was created
+// expiresAt (property) - optional timestamp after which the override no longer applies
+// retracted (property) - true if the override has been withdrawn
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+//
+// Scoped overrides take precedence over global overrides which take precedence
+// over the score reported by scanners. Expired or retracted overrides are ignored.
+type SeverityOverride struct {
+	ID            string            `json:"id"`
+	Vulnerability OsvCveOrGhsa      `json:"vulnerability"`
+	Subject       PackageOrArtifact `json:"subject,omitempty"`
+	Score         float64           `json:"score"`
+	ScoreType     string            `json:"scoreType"`
+	Reviewer      string            `json:"reviewer"`
+	Justification string            `json:"justification"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	ExpiresAt     *time.Time        `json:"expiresAt,omitempty"`
+	Retracted     bool              `json:"retracted"`
+	Origin        string            `json:"origin"`
+	Collector     string            `json:"collector"`
+}
+
+func (SeverityOverride) IsNodes() {}
+
+// SeverityOverrideInputSpec is the same as SeverityOverride but for mutation input.
+//
+// All fields except expiresAt are required.
+//
+// An override is identified by its vulnerability, subject, scoreType, reviewer and
+// createdAt. Ingesting an override with the same identity updates the existing
+// one, which is how an override gets retracted or has its expiry changed.
+type SeverityOverrideInputSpec struct {
+	Score         float64    `json:"score"`
+	ScoreType     string     `json:"scoreType"`
+	Reviewer      string     `json:"reviewer"`
+	Justification string     `json:"justification"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	Retracted     bool       `json:"retracted"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
+}
+
+// SeverityOverrideSpec allows filtering the list of SeverityOverride to return.
+//
+// Only one of OSV, CVE or GHSA and only one of package or artifact can be specified at once.
+type SeverityOverrideSpec struct {
+	ID            *string                `json:"id,omitempty"`
+	Vulnerability *OsvCveOrGhsaSpec      `json:"vulnerability,omitempty"`
+	Subject       *PackageOrArtifactSpec `json:"subject,omitempty"`
+	ScoreType     *string                `json:"scoreType,omitempty"`
+	Reviewer      *string                `json:"reviewer,omitempty"`
+	Retracted     *bool                  `json:"retracted,omitempty"`
+	Origin        *string                `json:"origin,omitempty"`
+	Collector     *string                `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+}
+
 // Source represents a source.
 //
 // This can be the version control system that is being used.
@@ -1142,14 +1638,86 @@ type SourceSpec struct {
 	Commit    *string `json:"commit,omitempty"`
 }
 
-// VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
+// StitchProposal proposes a HashEqual between an artifact and another digest of
+// the same container image, e.g. the manifest digest in an SBOM and the image ID
+// in a provenance document.
+//
+// Proposals are conservative: the supporting IsOccurrence and HasSLSA evidence
+// must reference the same image repository and tag in their origin, must have
+// been ingested within the time window of each other, and the repository and tag
+// must point to exactly one other digest in that window.
+type StitchProposal struct {
+	// The queried artifact
+	Artifact *Artifact `json:"artifact"`
+	// The digest proposed to be equal to the queried artifact
+	EqualArtifact *Artifact `json:"equalArtifact"`
+	// Image repository and tag shared by the origins of the evidence
+	ImageRef string `json:"imageRef"`
+	// Justification to use when ingesting the HashEqual (stitched-by-guac)
+	Justification string `json:"justification"`
+	// IDs of the IsOccurrence and HasSLSA nodes supporting the proposal
+	Evidence []string `json:"evidence"`
+}
+
+// SupersededBy is an attestation that represents that a package has been renamed or deprecated upstream in favor
+// of another package
+//
+// package (subject) - the package object type that represents the superseded package
+// successor (object) - the package object type that represents the package replacing it
+// reason (property) - string value representing why the package was superseded (e.g. the deprecation message)
+// since (property) - timestamp since when the package is superseded
+// origin (property) - where this attestation was generated from (based on which document)
+// collector (property) - the GUAC collector that collected the document that generated this attestation
+//
+// The package and successor are either both at the version level or both at the package name level.
+type SupersededBy struct {
+	ID        string    `json:"id"`
+	Package   *Package  `json:"package"`
+	Successor *Package  `json:"successor"`
+	Reason    string    `json:"reason"`
+	Since     time.Time `json:"since"`
+	Origin    string    `json:"origin"`
+	Collector string    `json:"collector"`
+}
+
+func (SupersededBy) IsNodes() {}
+
+// SupersededByInputSpec is the same as SupersededBy but for mutation input.
 //
 // All fields are required.
+type SupersededByInputSpec struct {
+	Reason    string    `json:"reason"`
+	Since     time.Time `json:"since"`
+	Origin    string    `json:"origin"`
+	Collector string    `json:"collector"`
+}
+
+// SupersededBySpec allows filtering the list of SupersededBy to return.
+type SupersededBySpec struct {
+	ID        *string    `json:"id,omitempty"`
+	Package   *PkgSpec   `json:"package,omitempty"`
+	Successor *PkgSpec   `json:"successor,omitempty"`
+	Reason    *string    `json:"reason,omitempty"`
+	Since     *time.Time `json:"since,omitempty"`
+	Origin    *string    `json:"origin,omitempty"`
+	Collector *string    `json:"collector,omitempty"`
+	// how justification, origin and collector are matched, EXACT if not set
+	StringMatch *StringMatchMode `json:"stringMatch,omitempty"`
+}
+
+// VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
+//
+// The justification is required if and only if the status is NOT_AFFECTED, and
+// the statement is required if the status is AFFECTED. knownSince can't be the
+// zero time.
 type VexStatementInputSpec struct {
-	Justification string    `json:"justification"`
-	KnownSince    time.Time `json:"knownSince"`
-	Origin        string    `json:"origin"`
-	Collector     string    `json:"collector"`
+	// NOT_AFFECTED if not set, the status of the VEX statements ingested before it existed
+	Status        *VexStatus `json:"status,omitempty"`
+	Statement     *string    `json:"statement,omitempty"`
+	Justification string     `json:"justification"`
+	KnownSince    time.Time  `json:"knownSince"`
+	Origin        string     `json:"origin"`
+	Collector     string     `json:"collector"`
 }
 
 type VulnerabilityMetaData struct {
@@ -1167,11 +1735,14 @@ type VulnerabilityMetaData struct {
 	Origin string `json:"origin"`
 	// collector (property) - the GUAC collector that collected the document that generated this attestation
 	Collector string `json:"collector"`
+	// scannerScore (property) - score the scanner reported for the vulnerability, if any
+	ScannerScore *float64 `json:"scannerScore,omitempty"`
 }
 
 // VulnerabilityInputSpec is the same as VulnerabilityMetaData but for mutation input.
 //
-// All fields are required.
+// All fields are required, except scannerScore which is only set by scanners
+// that score the vulnerabilities they find.
 type VulnerabilityMetaDataInput struct {
 	TimeScanned    time.Time `json:"timeScanned"`
 	DbURI          string    `json:"dbUri"`
@@ -1180,6 +1751,148 @@ type VulnerabilityMetaDataInput struct {
 	ScannerVersion string    `json:"scannerVersion"`
 	Origin         string    `json:"origin"`
 	Collector      string    `json:"collector"`
+	ScannerScore   *float64  `json:"scannerScore,omitempty"`
+}
+
+// ConflictPattern is a kind of contradiction between two pieces of evidence.
+//
+// CERTIFY_BAD_GOOD - a CertifyBad and a CertifyGood on the same subject whose
+// validity windows overlap. A certification holds from its knownSince until the
+// next certification of the subject from the same collector, good or bad, so a
+// collector changing its verdict is not a conflict, while certifications from
+// different collectors conflict unless one is superseded before the other holds
+// VEX_CERTIFY_VULN - a CertifyVEXStatement stating that a package is not affected
+// by a vulnerability and a CertifyVuln reporting that it is (VEX statements don't
+// carry a status yet, so all of them are taken as not affected)
+// HAS_SOURCE_AT_REPOSITORY - two HasSourceAt mapping the same package to
+// different repositories (source type, namespace and name, ignoring tags and
+// commits). A single conflict is returned per pair of repositories, between the
+// first HasSourceAt to each that is not retracted
+type ConflictPattern string
+
+const (
+	ConflictPatternCertifyBadGood        ConflictPattern = "CERTIFY_BAD_GOOD"
+	ConflictPatternVexCertifyVuln        ConflictPattern = "VEX_CERTIFY_VULN"
+	ConflictPatternHasSourceAtRepository ConflictPattern = "HAS_SOURCE_AT_REPOSITORY"
+)
+
+var AllConflictPattern = []ConflictPattern{
+	ConflictPatternCertifyBadGood,
+	ConflictPatternVexCertifyVuln,
+	ConflictPatternHasSourceAtRepository,
+}
+
+func (e ConflictPattern) IsValid() bool {
+	switch e {
+	case ConflictPatternCertifyBadGood, ConflictPatternVexCertifyVuln, ConflictPatternHasSourceAtRepository:
+		return true
+	}
+	return false
+}
+
+func (e ConflictPattern) String() string {
+	return string(e)
+}
+
+func (e *ConflictPattern) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConflictPattern(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConflictPattern", str)
+	}
+	return nil
+}
+
+func (e ConflictPattern) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// NodeType is the kind of a node returned by changes.
+type NodeType string
+
+const (
+	NodeTypePackage             NodeType = "PACKAGE"
+	NodeTypeSource              NodeType = "SOURCE"
+	NodeTypeArtifact            NodeType = "ARTIFACT"
+	NodeTypeBuilder             NodeType = "BUILDER"
+	NodeTypeOsv                 NodeType = "OSV"
+	NodeTypeCve                 NodeType = "CVE"
+	NodeTypeGhsa                NodeType = "GHSA"
+	NodeTypeCertifyBad          NodeType = "CERTIFY_BAD"
+	NodeTypeCertifyGood         NodeType = "CERTIFY_GOOD"
+	NodeTypeCertifyPkg          NodeType = "CERTIFY_PKG"
+	NodeTypeCertifyScorecard    NodeType = "CERTIFY_SCORECARD"
+	NodeTypeCertifySigned       NodeType = "CERTIFY_SIGNED"
+	NodeTypeCertifyVexStatement NodeType = "CERTIFY_VEX_STATEMENT"
+	NodeTypeCertifyVuln         NodeType = "CERTIFY_VULN"
+	NodeTypeHasSbom             NodeType = "HAS_SBOM"
+	NodeTypeHasSlsa             NodeType = "HAS_SLSA"
+	NodeTypeHasSourceAt         NodeType = "HAS_SOURCE_AT"
+	NodeTypeHashEqual           NodeType = "HASH_EQUAL"
+	NodeTypeIsDependency        NodeType = "IS_DEPENDENCY"
+	NodeTypeIsOccurrence        NodeType = "IS_OCCURRENCE"
+	NodeTypeIsVulnerability     NodeType = "IS_VULNERABILITY"
+	NodeTypeSeverityOverride    NodeType = "SEVERITY_OVERRIDE"
+	NodeTypeSupersededBy        NodeType = "SUPERSEDED_BY"
+)
+
+var AllNodeType = []NodeType{
+	NodeTypePackage,
+	NodeTypeSource,
+	NodeTypeArtifact,
+	NodeTypeBuilder,
+	NodeTypeOsv,
+	NodeTypeCve,
+	NodeTypeGhsa,
+	NodeTypeCertifyBad,
+	NodeTypeCertifyGood,
+	NodeTypeCertifyPkg,
+	NodeTypeCertifyScorecard,
+	NodeTypeCertifySigned,
+	NodeTypeCertifyVexStatement,
+	NodeTypeCertifyVuln,
+	NodeTypeHasSbom,
+	NodeTypeHasSlsa,
+	NodeTypeHasSourceAt,
+	NodeTypeHashEqual,
+	NodeTypeIsDependency,
+	NodeTypeIsOccurrence,
+	NodeTypeIsVulnerability,
+	NodeTypeSeverityOverride,
+	NodeTypeSupersededBy,
+}
+
+func (e NodeType) IsValid() bool {
+	switch e {
+	case NodeTypePackage, NodeTypeSource, NodeTypeArtifact, NodeTypeBuilder, NodeTypeOsv, NodeTypeCve, NodeTypeGhsa, NodeTypeCertifyBad, NodeTypeCertifyGood, NodeTypeCertifyPkg, NodeTypeCertifyScorecard, NodeTypeCertifySigned, NodeTypeCertifyVexStatement, NodeTypeCertifyVuln, NodeTypeHasSbom, NodeTypeHasSlsa, NodeTypeHasSourceAt, NodeTypeHashEqual, NodeTypeIsDependency, NodeTypeIsOccurrence, NodeTypeIsVulnerability, NodeTypeSeverityOverride, NodeTypeSupersededBy:
+		return true
+	}
+	return false
+}
+
+func (e NodeType) String() string {
+	return string(e)
+}
+
+func (e *NodeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NodeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NodeType", str)
+	}
+	return nil
+}
+
+func (e NodeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 // PkgMatchType is an enum to determine if the attestation should be done at the
@@ -1224,3 +1937,379 @@ func (e *PkgMatchType) UnmarshalGQL(v interface{}) error {
 func (e PkgMatchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// SeverityBucket is the qualitative rating of a score, following the CVSS v3
+// severity rating scale.
+type SeverityBucket string
+
+const (
+	SeverityBucketNone     SeverityBucket = "NONE"
+	SeverityBucketLow      SeverityBucket = "LOW"
+	SeverityBucketMedium   SeverityBucket = "MEDIUM"
+	SeverityBucketHigh     SeverityBucket = "HIGH"
+	SeverityBucketCritical SeverityBucket = "CRITICAL"
+)
+
+var AllSeverityBucket = []SeverityBucket{
+	SeverityBucketNone,
+	SeverityBucketLow,
+	SeverityBucketMedium,
+	SeverityBucketHigh,
+	SeverityBucketCritical,
+}
+
+func (e SeverityBucket) IsValid() bool {
+	switch e {
+	case SeverityBucketNone, SeverityBucketLow, SeverityBucketMedium, SeverityBucketHigh, SeverityBucketCritical:
+		return true
+	}
+	return false
+}
+
+func (e SeverityBucket) String() string {
+	return string(e)
+}
+
+func (e *SeverityBucket) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SeverityBucket(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SeverityBucket", str)
+	}
+	return nil
+}
+
+func (e SeverityBucket) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SeveritySource records where an effective severity score came from.
+type SeveritySource string
+
+const (
+	SeveritySourceScopedOverride SeveritySource = "SCOPED_OVERRIDE"
+	SeveritySourceGlobalOverride SeveritySource = "GLOBAL_OVERRIDE"
+	SeveritySourceScanner        SeveritySource = "SCANNER"
+)
+
+var AllSeveritySource = []SeveritySource{
+	SeveritySourceScopedOverride,
+	SeveritySourceGlobalOverride,
+	SeveritySourceScanner,
+}
+
+func (e SeveritySource) IsValid() bool {
+	switch e {
+	case SeveritySourceScopedOverride, SeveritySourceGlobalOverride, SeveritySourceScanner:
+		return true
+	}
+	return false
+}
+
+func (e SeveritySource) String() string {
+	return string(e)
+}
+
+func (e *SeveritySource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SeveritySource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SeveritySource", str)
+	}
+	return nil
+}
+
+func (e SeveritySource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SignatureStatus records the outcome of verifying a signature.
+//
+// VERIFIED means the signature was checked against the trust root. FAILED means
+// the signature was found but could not be verified.
+type SignatureStatus string
+
+const (
+	SignatureStatusVerified SignatureStatus = "VERIFIED"
+	SignatureStatusFailed   SignatureStatus = "FAILED"
+)
+
+var AllSignatureStatus = []SignatureStatus{
+	SignatureStatusVerified,
+	SignatureStatusFailed,
+}
+
+func (e SignatureStatus) IsValid() bool {
+	switch e {
+	case SignatureStatusVerified, SignatureStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e SignatureStatus) String() string {
+	return string(e)
+}
+
+func (e *SignatureStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SignatureStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SignatureStatus", str)
+	}
+	return nil
+}
+
+func (e SignatureStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SignatureType is the kind of signature found on an artifact.
+type SignatureType string
+
+const (
+	SignatureTypeCosign   SignatureType = "COSIGN"
+	SignatureTypeNotation SignatureType = "NOTATION"
+	SignatureTypeGpg      SignatureType = "GPG"
+)
+
+var AllSignatureType = []SignatureType{
+	SignatureTypeCosign,
+	SignatureTypeNotation,
+	SignatureTypeGpg,
+}
+
+func (e SignatureType) IsValid() bool {
+	switch e {
+	case SignatureTypeCosign, SignatureTypeNotation, SignatureTypeGpg:
+		return true
+	}
+	return false
+}
+
+func (e SignatureType) String() string {
+	return string(e)
+}
+
+func (e *SignatureType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SignatureType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SignatureType", str)
+	}
+	return nil
+}
+
+func (e SignatureType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// SortDirection orders the results of a query by one of their fields.
+//
+// Results with equal values are ordered by ID, so that the order is stable
+// across pages.
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// StringMatchMode is how the justification, origin and collector filters of a
+// query are compared with the values of the evidence.
+//
+// EXACT requires the values to be equal. PREFIX requires the value to start with
+// the filter. GLOB matches the value against the filter, where * matches any
+// sequence of characters, including none and /, and ? any single character; a
+// backslash makes the next character of the filter literal, as in \* for an
+// asterisk. All modes are case sensitive.
+type StringMatchMode string
+
+const (
+	StringMatchModeExact  StringMatchMode = "EXACT"
+	StringMatchModePrefix StringMatchMode = "PREFIX"
+	StringMatchModeGlob   StringMatchMode = "GLOB"
+)
+
+var AllStringMatchMode = []StringMatchMode{
+	StringMatchModeExact,
+	StringMatchModePrefix,
+	StringMatchModeGlob,
+}
+
+func (e StringMatchMode) IsValid() bool {
+	switch e {
+	case StringMatchModeExact, StringMatchModePrefix, StringMatchModeGlob:
+		return true
+	}
+	return false
+}
+
+func (e StringMatchMode) String() string {
+	return string(e)
+}
+
+func (e *StringMatchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StringMatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StringMatchMode", str)
+	}
+	return nil
+}
+
+func (e StringMatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Verb is the kind of evidence compared by collectorDiff.
+type Verb string
+
+const (
+	VerbCertifyVuln  Verb = "CERTIFY_VULN"
+	VerbIsDependency Verb = "IS_DEPENDENCY"
+	VerbHasSourceAt  Verb = "HAS_SOURCE_AT"
+	VerbIsOccurrence Verb = "IS_OCCURRENCE"
+)
+
+var AllVerb = []Verb{
+	VerbCertifyVuln,
+	VerbIsDependency,
+	VerbHasSourceAt,
+	VerbIsOccurrence,
+}
+
+func (e Verb) IsValid() bool {
+	switch e {
+	case VerbCertifyVuln, VerbIsDependency, VerbHasSourceAt, VerbIsOccurrence:
+		return true
+	}
+	return false
+}
+
+func (e Verb) String() string {
+	return string(e)
+}
+
+func (e *Verb) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Verb(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Verb", str)
+	}
+	return nil
+}
+
+func (e Verb) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VexStatus is the status of a package or artifact with regard to a
+// vulnerability, as stated by a VEX.
+//
+// NOT_AFFECTED - the vulnerability can't be exploited, for the reason given in the justification
+// AFFECTED - the vulnerability can be exploited, the statement gives the action to take
+// FIXED - the vulnerability was fixed
+// UNDER_INVESTIGATION - it is not known yet whether the vulnerability can be exploited
+type VexStatus string
+
+const (
+	VexStatusNotAffected        VexStatus = "NOT_AFFECTED"
+	VexStatusAffected           VexStatus = "AFFECTED"
+	VexStatusFixed              VexStatus = "FIXED"
+	VexStatusUnderInvestigation VexStatus = "UNDER_INVESTIGATION"
+)
+
+var AllVexStatus = []VexStatus{
+	VexStatusNotAffected,
+	VexStatusAffected,
+	VexStatusFixed,
+	VexStatusUnderInvestigation,
+}
+
+func (e VexStatus) IsValid() bool {
+	switch e {
+	case VexStatusNotAffected, VexStatusAffected, VexStatusFixed, VexStatusUnderInvestigation:
+		return true
+	}
+	return false
+}
+
+func (e VexStatus) String() string {
+	return string(e)
+}
+
+func (e *VexStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VexStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VexStatus", str)
+	}
+	return nil
+}
+
+func (e VexStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
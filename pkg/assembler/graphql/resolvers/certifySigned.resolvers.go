@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestCertifySigned is the resolver for the ingestCertifySigned field.
+func (r *mutationResolver) IngestCertifySigned(ctx context.Context, artifact model.ArtifactInputSpec, certifySigned model.CertifySignedInputSpec) (*model.CertifySigned, error) {
+	return r.Backend.IngestCertifySigned(ctx, artifact, certifySigned)
+}
+
+// CertifySigned is the resolver for the CertifySigned field.
+func (r *queryResolver) CertifySigned(ctx context.Context, certifySignedSpec *model.CertifySignedSpec) ([]*model.CertifySigned, error) {
+	return r.Backend.CertifySigned(ctx, certifySignedSpec)
+}
@@ -19,3 +19,13 @@ func (r *mutationResolver) IngestVulnerability(ctx context.Context, pkg model.Pk
 func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
 	return r.Backend.CertifyVuln(ctx, certifyVulnSpec)
 }
+
+// CertifyVulnList is the resolver for the CertifyVulnList field.
+func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, first *int, after *string) (*model.CertifyVulnConnection, error) {
+	return r.Backend.CertifyVulnList(ctx, certifyVulnSpec, first, after)
+}
+
+// CertifyVulnCount is the resolver for the CertifyVulnCount field.
+func (r *queryResolver) CertifyVulnCount(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) (int, error) {
+	return r.Backend.CertifyVulnCount(ctx, certifyVulnSpec)
+}
@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// RetractEvidence is the resolver for the retractEvidence field.
+func (r *mutationResolver) RetractEvidence(ctx context.Context, origin string) (int, error) {
+	return r.Backend.RetractEvidence(ctx, origin)
+}
+
+// Conflicts is the resolver for the conflicts field.
+func (r *queryResolver) Conflicts(ctx context.Context, patterns []model.ConflictPattern) ([]*model.Conflict, error) {
+	return r.Backend.Conflicts(ctx, patterns)
+}
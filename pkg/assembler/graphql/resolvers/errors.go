@@ -0,0 +1,45 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter is an error presenter, to be installed with
+// SetErrorPresenter, that reports the kind of the errors of the backend in the
+// backends.ErrorCodeExtension error extension, so that clients can tell a
+// missing node from an invalid input or a failure of the backend:
+//
+//	"errors": [{
+//	  "message": "ID does not match existing node",
+//	  "path": ["HasSourceAt"],
+//	  "extensions": {"code": "NOT_FOUND"}
+//	}]
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if code := backends.ErrorCode(err); code != "" {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Extensions[backends.ErrorCodeExtension] = code
+	}
+	return gqlErr
+}
@@ -0,0 +1,81 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestErrorPresenter(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	r := &resolvers.Resolver{Backend: b}
+
+	tests := []struct {
+		Name    string
+		Spec    *model.HasSourceAtSpec
+		ExpKind error
+		ExpCode string
+	}{
+		{
+			Name:    "Unknown ID",
+			Spec:    &model.HasSourceAtSpec{ID: ptrfrom.String("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")},
+			ExpKind: backends.ErrNotFound,
+			ExpCode: backends.CodeNotFound,
+		},
+		{
+			Name:    "Malformed ID",
+			Spec:    &model.HasSourceAtSpec{ID: ptrfrom.String("tensorflow")},
+			ExpKind: backends.ErrInvalidInput,
+			ExpCode: backends.CodeInvalidInput,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := r.Query().HasSourceAt(ctx, test.Spec)
+			if !errors.Is(err, test.ExpKind) {
+				t.Fatalf("expected error of kind %v from the resolver, got: %v", test.ExpKind, err)
+			}
+			gqlErr := resolvers.ErrorPresenter(ctx, err)
+			if !errors.Is(gqlErr, test.ExpKind) {
+				t.Errorf("expected presented error of kind %v, got: %v", test.ExpKind, gqlErr)
+			}
+			if code := gqlErr.Extensions[backends.ErrorCodeExtension]; code != test.ExpCode {
+				t.Errorf("expected error code %v, got: %v", test.ExpCode, code)
+			}
+			if gqlErr.Message != err.Error() {
+				t.Errorf("expected error message %q, got: %q", err.Error(), gqlErr.Message)
+			}
+		})
+	}
+
+	// errors not raised by the backend have no code
+	gqlErr := resolvers.ErrorPresenter(ctx, errors.New("unexpected"))
+	if code, ok := gqlErr.Extensions[backends.ErrorCodeExtension]; ok {
+		t.Errorf("expected no error code, got: %v", code)
+	}
+}
@@ -15,7 +15,22 @@ func (r *mutationResolver) IngestHasSourceAt(ctx context.Context, pkg model.PkgI
 	return r.Backend.IngestHasSourceAt(ctx, pkg, pkgMatchType, source, hasSourceAt)
 }
 
+// IngestHasSourceAts is the resolver for the ingestHasSourceAts field.
+func (r *mutationResolver) IngestHasSourceAts(ctx context.Context, pkgs []*model.PkgInputSpec, pkgMatchType model.MatchFlags, sources []*model.SourceInputSpec, hasSourceAts []*model.HasSourceAtInputSpec) ([]*model.HasSourceAt, error) {
+	return r.Backend.IngestHasSourceAts(ctx, pkgs, pkgMatchType, sources, hasSourceAts)
+}
+
 // HasSourceAt is the resolver for the HasSourceAt field.
 func (r *queryResolver) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
 	return r.Backend.HasSourceAt(ctx, hasSourceAtSpec)
 }
+
+// HasSourceAtList is the resolver for the HasSourceAtList field.
+func (r *queryResolver) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, first *int, after *string) (*model.HasSourceAtConnection, error) {
+	return r.Backend.HasSourceAtList(ctx, hasSourceAtSpec, first, after)
+}
+
+// HasSourceAtCount is the resolver for the HasSourceAtCount field.
+func (r *queryResolver) HasSourceAtCount(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) (int, error) {
+	return r.Backend.HasSourceAtCount(ctx, hasSourceAtSpec)
+}
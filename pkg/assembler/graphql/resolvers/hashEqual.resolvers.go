@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestHashEqual(ctx context.Context, artifact model.A
 func (r *queryResolver) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
 	return r.Backend.HashEqual(ctx, hashEqualSpec)
 }
+
+// EqualArtifacts is the resolver for the equalArtifacts field.
+func (r *queryResolver) EqualArtifacts(ctx context.Context, artifact model.ArtifactSpec) ([]*model.Artifact, error) {
+	return r.Backend.EqualArtifacts(ctx, artifact)
+}
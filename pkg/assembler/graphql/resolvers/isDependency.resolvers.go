@@ -19,3 +19,18 @@ func (r *mutationResolver) IngestDependency(ctx context.Context, pkg model.PkgIn
 func (r *queryResolver) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
 	return r.Backend.IsDependency(ctx, isDependencySpec)
 }
+
+// IsDependencyList is the resolver for the IsDependencyList field.
+func (r *queryResolver) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, first *int, after *string) (*model.IsDependencyConnection, error) {
+	return r.Backend.IsDependencyList(ctx, isDependencySpec, first, after)
+}
+
+// IsDependencyCount is the resolver for the IsDependencyCount field.
+func (r *queryResolver) IsDependencyCount(ctx context.Context, isDependencySpec *model.IsDependencySpec) (int, error) {
+	return r.Backend.IsDependencyCount(ctx, isDependencySpec)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *queryResolver) Dependencies(ctx context.Context, pkg model.PkgSpec, maxDepth int) (*model.DependencyTree, error) {
+	return r.Backend.Dependencies(ctx, pkg, maxDepth)
+}
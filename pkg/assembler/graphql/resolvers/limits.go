@@ -0,0 +1,47 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+)
+
+// ResultLimits is a response middleware, to be installed with
+// AroundResponses, that reports the queries truncated by the backend's
+// default result cap in the helper.ResultLimitsExtension response extension:
+//
+//	"extensions": {
+//	  "resultLimits": {
+//	    "CertifyVuln": {"returned": 1000, "totalAvailable": 80000, "truncated": true}
+//	  }
+//	}
+func ResultLimits(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	ctx, limits := helper.WithResultLimits(ctx)
+	resp := next(ctx)
+	if resp == nil {
+		return nil
+	}
+	if truncated := limits.Truncated(); truncated != nil {
+		if resp.Extensions == nil {
+			resp.Extensions = map[string]interface{}{}
+		}
+		resp.Extensions[helper.ResultLimitsExtension] = truncated
+	}
+	return resp
+}
@@ -0,0 +1,14 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+)
+
+// PurgeNamespace is the resolver for the purgeNamespace field.
+func (r *mutationResolver) PurgeNamespace(ctx context.Context) (bool, error) {
+	return r.Backend.PurgeNamespace(ctx)
+}
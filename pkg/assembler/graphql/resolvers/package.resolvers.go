@@ -15,6 +15,11 @@ func (r *mutationResolver) IngestPackage(ctx context.Context, pkg model.PkgInput
 	return r.Backend.IngestPackage(ctx, pkg)
 }
 
+// IngestPackages is the resolver for the ingestPackages field.
+func (r *mutationResolver) IngestPackages(ctx context.Context, pkgs []*model.PkgInputSpec) ([]*model.Package, error) {
+	return r.Backend.IngestPackages(ctx, pkgs)
+}
+
 // Packages is the resolver for the packages field.
 func (r *queryResolver) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
 	return r.Backend.Packages(ctx, pkgSpec)
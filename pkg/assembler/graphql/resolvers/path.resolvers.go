@@ -6,12 +6,26 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 // Path is the resolver for the path field.
-func (r *queryResolver) Path(ctx context.Context, subject model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, target model.PackageSourceArtifactBuilderOsvCveOrGhsaFilter, maxPathLength int) ([]model.Nodes, error) {
-	panic(fmt.Errorf("not implemented: Path - path"))
+func (r *queryResolver) Path(ctx context.Context, subject string, target string, maxPathLength int) ([]model.Nodes, error) {
+	return r.Backend.Path(ctx, subject, target, maxPathLength)
+}
+
+// Neighbors is the resolver for the neighbors field.
+func (r *queryResolver) Neighbors(ctx context.Context, node string) ([]model.Nodes, error) {
+	return r.Backend.Neighbors(ctx, node)
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, node string) (model.Nodes, error) {
+	return r.Backend.Node(ctx, node)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, nodes []string) ([]model.Nodes, error) {
+	return r.Backend.Nodes(ctx, nodes)
 }
@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestSeverityOverride is the resolver for the ingestSeverityOverride field.
+func (r *mutationResolver) IngestSeverityOverride(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, severityOverride model.SeverityOverrideInputSpec) (*model.SeverityOverride, error) {
+	return r.Backend.IngestSeverityOverride(ctx, vulnerability, subject, severityOverride)
+}
+
+// SeverityOverride is the resolver for the SeverityOverride field.
+func (r *queryResolver) SeverityOverride(ctx context.Context, severityOverrideSpec *model.SeverityOverrideSpec) ([]*model.SeverityOverride, error) {
+	return r.Backend.SeverityOverride(ctx, severityOverrideSpec)
+}
+
+// EffectiveSeverity is the resolver for the effectiveSeverity field.
+func (r *queryResolver) EffectiveSeverity(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, subject *model.PackageOrArtifactInput, scannerScore float64) (*model.EffectiveSeverity, error) {
+	return r.Backend.EffectiveSeverity(ctx, vulnerability, subject, scannerScore)
+}
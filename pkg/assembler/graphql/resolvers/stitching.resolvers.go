@@ -0,0 +1,16 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// StitchingProposals is the resolver for the stitchingProposals field.
+func (r *queryResolver) StitchingProposals(ctx context.Context, artifact model.ArtifactSpec, windowSeconds *int) ([]*model.StitchProposal, error) {
+	return r.Backend.StitchingProposals(ctx, artifact, windowSeconds)
+}
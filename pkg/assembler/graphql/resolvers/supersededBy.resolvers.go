@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.27
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestSupersededBy is the resolver for the ingestSupersededBy field.
+func (r *mutationResolver) IngestSupersededBy(ctx context.Context, pkg model.PkgInputSpec, successor model.PkgInputSpec, pkgMatchType model.MatchFlags, supersededBy model.SupersededByInputSpec) (*model.SupersededBy, error) {
+	return r.Backend.IngestSupersededBy(ctx, pkg, successor, pkgMatchType, supersededBy)
+}
+
+// SupersededBy is the resolver for the SupersededBy field.
+func (r *queryResolver) SupersededBy(ctx context.Context, supersededBySpec *model.SupersededBySpec) ([]*model.SupersededBy, error) {
+	return r.Backend.SupersededBy(ctx, supersededBySpec)
+}
+
+// Successors is the resolver for the successors field.
+func (r *queryResolver) Successors(ctx context.Context, pkg model.PkgSpec) ([]*model.SupersededBy, error) {
+	return r.Backend.Successors(ctx, pkg)
+}
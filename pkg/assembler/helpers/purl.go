@@ -17,7 +17,9 @@ package helpers
 
 import (
 	"fmt"
+	"net/url"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
@@ -36,6 +38,233 @@ func PurlToPkg(purlUri string) (*model.PkgInputSpec, error) {
 	return purlConvert(p)
 }
 
+// PurlToPkgInput converts a purl URI string into a graphql package node,
+// following the purl spec: the type is lowercased, the components are
+// percent-decoded, the namespace and name are normalized as required by their
+// type (see normalizePurl), empty qualifiers are dropped and the qualifiers
+// are sorted by key. Like PurlToPkg, it uses the GUAC conventions of an empty namespace,
+// version and subpath for the components missing from the purl, and of the
+// repository_url qualifier of OCI purls as the namespace. PkgInputToPurl
+// converts the package back into the same canonical purl.
+func PurlToPkgInput(purlUri string) (*model.PkgInputSpec, error) {
+	p, err := parsePurl(purlUri)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse purl %s: %v", purlUri, err)
+	}
+	pkg, err := purlConvert(p)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pkg.Qualifiers, func(i, j int) bool {
+		return pkg.Qualifiers[i].Key < pkg.Qualifiers[j].Key
+	})
+	return pkg, nil
+}
+
+// PkgInputToPurl converts a graphql package node into its canonical purl URI
+// string, the inverse of PurlToPkgInput. The namespace of docker packages is
+// kept as the purl namespace, as it can't be told apart from a repository_url
+// qualifier folded in it.
+func PkgInputToPurl(pkg *model.PkgInputSpec) string {
+	typ := strings.ToLower(pkg.Type)
+	namespace, name := normalizePurl(typ, deref(pkg.Namespace), pkg.Name)
+	qualifiers := map[string]string{}
+	for _, q := range pkg.Qualifiers {
+		qualifiers[strings.ToLower(q.Key)] = q.Value
+	}
+	if typ == purl.TypeOCI && namespace != "" {
+		qualifiers["repository_url"] = namespace + "/" + name
+		namespace = ""
+	}
+
+	var b strings.Builder
+	b.WriteString("pkg:" + typ + "/")
+	if segments := purlSegments(namespace); len(segments) > 0 {
+		b.WriteString(strings.Join(segments, "/") + "/")
+	}
+	b.WriteString(purlEscape(name, ""))
+	if version := deref(pkg.Version); version != "" {
+		b.WriteString("@" + purlEscape(version, ""))
+	}
+	keys := make([]string, 0, len(qualifiers))
+	for k, v := range qualifiers {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+		b.WriteString(k + "=" + purlEscape(qualifiers[k], ":/"))
+	}
+	if segments := purlSegments(deref(pkg.Subpath)); len(segments) > 0 {
+		b.WriteString("#" + strings.Join(segments, "/"))
+	}
+	return b.String()
+}
+
+// parsePurl splits a purl into its components, as described in
+// https://github.com/package-url/purl-spec/blob/master/PURL-SPECIFICATION.rst#how-to-parse-a-purl-string-in-its-components
+func parsePurl(purlUri string) (purl.PackageURL, error) {
+	var p purl.PackageURL
+	remainder, subpath, _ := cutLast(purlUri, "#")
+	segments, err := purlUnescapeSegments(subpath)
+	if err != nil {
+		return p, err
+	}
+	p.Subpath = strings.Join(segments, "/")
+
+	remainder, rawQualifiers, _ := cutLast(remainder, "?")
+	qualifiers := map[string]string{}
+	if rawQualifiers != "" {
+		for _, pair := range strings.Split(rawQualifiers, "&") {
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok || key == "" {
+				return p, fmt.Errorf("malformed qualifier %q", pair)
+			}
+			key = strings.ToLower(key)
+			if _, ok := qualifiers[key]; ok {
+				return p, fmt.Errorf("duplicate qualifier %q", key)
+			}
+			if value, err = url.PathUnescape(value); err != nil {
+				return p, err
+			}
+			if value != "" {
+				qualifiers[key] = value
+			}
+		}
+	}
+	keys := make([]string, 0, len(qualifiers))
+	for k := range qualifiers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p.Qualifiers = append(p.Qualifiers, purl.Qualifier{Key: k, Value: qualifiers[k]})
+	}
+
+	scheme, remainder, ok := strings.Cut(remainder, ":")
+	if !ok || !strings.EqualFold(scheme, "pkg") {
+		return p, fmt.Errorf("purl must start with pkg:")
+	}
+	remainder = strings.Trim(remainder, "/")
+	typ, remainder, ok := strings.Cut(remainder, "/")
+	if !ok || !validPurlType(typ) {
+		return p, fmt.Errorf("invalid type %q", typ)
+	}
+	p.Type = strings.ToLower(typ)
+
+	remainder, version, ok := cutLast(remainder, "@")
+	if ok {
+		if p.Version, err = url.PathUnescape(version); err != nil {
+			return p, err
+		}
+	}
+	segments, err = purlUnescapeSegments(remainder)
+	if err != nil {
+		return p, err
+	}
+	if len(segments) == 0 {
+		return p, fmt.Errorf("purl has no name")
+	}
+	p.Namespace, p.Name = normalizePurl(p.Type, strings.Join(segments[:len(segments)-1], "/"), segments[len(segments)-1])
+	return p, nil
+}
+
+// normalizePurl applies the rules of the known purl types to the namespace
+// and the name, as the packageurl-go parser used by PurlToPkg does: they are
+// case insensitive for some types, and pypi names also treat _ as -.
+//
+// Ref: https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst
+func normalizePurl(typ, namespace, name string) (string, string) {
+	switch typ {
+	case purl.TypeBitbucket, purl.TypeDebian, purl.TypeGithub, purl.TypeGolang, purl.TypeNPM:
+		return strings.ToLower(namespace), strings.ToLower(name)
+	case purl.TypeRPM:
+		return strings.ToLower(namespace), name
+	case purl.TypePyPi:
+		return namespace, strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	}
+	return namespace, name
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+func validPurlType(typ string) bool {
+	if typ == "" || (typ[0] >= '0' && typ[0] <= '9') {
+		return false
+	}
+	for _, r := range typ {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '+' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// purlUnescapeSegments percent-decodes the segments of a namespace or a
+// subpath, dropping the empty, "." and ".." segments.
+func purlUnescapeSegments(s string) ([]string, error) {
+	var segments []string
+	for _, segment := range strings.Split(s, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			continue
+		}
+		segment, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// purlSegments percent-encodes the segments of a namespace or a subpath,
+// dropping the empty, "." and ".." segments.
+func purlSegments(s string) []string {
+	var segments []string
+	for _, segment := range strings.Split(s, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			continue
+		}
+		segments = append(segments, purlEscape(segment, ""))
+	}
+	return segments
+}
+
+// purlEscape percent-encodes all the characters of s but the unreserved ones
+// and the ones in keep.
+func purlEscape(s string, keep string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '.' || c == '_' || c == '~' || strings.IndexByte(keep, c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func purlConvert(p purl.PackageURL) (*model.PkgInputSpec, error) {
 	switch p.Type {
 
@@ -75,7 +304,7 @@ func purlConvert(p purl.PackageURL) (*model.PkgInputSpec, error) {
 		}
 
 		delete(qs, "repository_url")
-		ns = strings.TrimRight(ns, "/"+p.Name)
+		ns = strings.TrimSuffix(ns, "/"+p.Name)
 		r := pkg(p.Type, ns, p.Name, p.Version, p.Subpath, qs)
 		return r, nil
 	case purl.TypeDocker:
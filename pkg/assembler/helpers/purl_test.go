@@ -17,6 +17,7 @@ package helpers
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -207,6 +208,229 @@ func TestPurlConvert(t *testing.T) {
 	}
 }
 
+func TestPurlRoundTrip(t *testing.T) {
+	testCases := []struct {
+		purlUri   string
+		expected  *model.PkgInputSpec
+		canonical string
+	}{
+		{
+			purlUri:   "pkg:maven/org.apache.commons/io",
+			expected:  pkg("maven", "org.apache.commons", "io", "", "", map[string]string{}),
+			canonical: "pkg:maven/org.apache.commons/io",
+		}, {
+			// slashes after the scheme are not significant
+			purlUri:   "pkg:///maven/org.apache.commons/io",
+			expected:  pkg("maven", "org.apache.commons", "io", "", "", map[string]string{}),
+			canonical: "pkg:maven/org.apache.commons/io",
+		}, {
+			// maven classifier qualifiers are sorted
+			purlUri: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?type=zip&classifier=dist",
+			expected: pkg("maven", "org.apache.xmlgraphics", "batik-anim", "1.9.1", "", map[string]string{
+				"type":       "zip",
+				"classifier": "dist",
+			}),
+			canonical: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=dist&type=zip",
+		}, {
+			purlUri: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=sources&repositoryurl=repo.spring.io/release",
+			expected: pkg("maven", "org.apache.xmlgraphics", "batik-anim", "1.9.1", "", map[string]string{
+				"classifier":    "sources",
+				"repositoryurl": "repo.spring.io/release",
+			}),
+			canonical: "pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=sources&repositoryurl=repo.spring.io/release",
+		}, {
+			// empty qualifiers are dropped
+			purlUri: "pkg:maven/net.sf.jacob-project/jacob@1.14.3?classifier=&type=dll",
+			expected: pkg("maven", "net.sf.jacob-project", "jacob", "1.14.3", "", map[string]string{
+				"type": "dll",
+			}),
+			canonical: "pkg:maven/net.sf.jacob-project/jacob@1.14.3?type=dll",
+		}, {
+			purlUri:   "pkg:npm/foobar@12.3.1",
+			expected:  pkg("npm", "", "foobar", "12.3.1", "", map[string]string{}),
+			canonical: "pkg:npm/foobar@12.3.1",
+		}, {
+			// the type is lowercased
+			purlUri:   "pkg:NPM/foobar@12.3.1",
+			expected:  pkg("npm", "", "foobar", "12.3.1", "", map[string]string{}),
+			canonical: "pkg:npm/foobar@12.3.1",
+		}, {
+			purlUri:   "pkg:npm/%40angular/animation@12.3.1",
+			expected:  pkg("npm", "@angular", "animation", "12.3.1", "", map[string]string{}),
+			canonical: "pkg:npm/%40angular/animation@12.3.1",
+		}, {
+			purlUri:   "pkg:pypi/django@1.11.1",
+			expected:  pkg("pypi", "", "django", "1.11.1", "", map[string]string{}),
+			canonical: "pkg:pypi/django@1.11.1",
+		}, {
+			// pypi names are case insensitive and _ is the same as -
+			purlUri:   "pkg:pypi/Django_Rest@3.14.0",
+			expected:  pkg("pypi", "", "django-rest", "3.14.0", "", map[string]string{}),
+			canonical: "pkg:pypi/django-rest@3.14.0",
+		}, {
+			// npm namespaces and names are case insensitive
+			purlUri:   "pkg:npm/%40Angular/Animation@12.3.1",
+			expected:  pkg("npm", "@angular", "animation", "12.3.1", "", map[string]string{}),
+			canonical: "pkg:npm/%40angular/animation@12.3.1",
+		}, {
+			purlUri:   "pkg:github/Package-URL/Purl-Spec@244fd47e07d10",
+			expected:  pkg("github", "package-url", "purl-spec", "244fd47e07d10", "", map[string]string{}),
+			canonical: "pkg:github/package-url/purl-spec@244fd47e07d10",
+		}, {
+			// but maven names are not
+			purlUri:   "pkg:maven/org.Apache.Commons/IO_Utils",
+			expected:  pkg("maven", "org.Apache.Commons", "IO_Utils", "", "", map[string]string{}),
+			canonical: "pkg:maven/org.Apache.Commons/IO_Utils",
+		}, {
+			purlUri:   "pkg:golang/github.com/gorilla/context@234fd47e07d1004f0aed9c#api",
+			expected:  pkg("golang", "github.com/gorilla", "context", "234fd47e07d1004f0aed9c", "api", map[string]string{}),
+			canonical: "pkg:golang/github.com/gorilla/context@234fd47e07d1004f0aed9c#api",
+		}, {
+			// slashes around the subpath are not significant
+			purlUri:   "pkg:golang/google.golang.org/genproto#/googleapis/api/annotations/",
+			expected:  pkg("golang", "google.golang.org", "genproto", "", "googleapis/api/annotations", map[string]string{}),
+			canonical: "pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+		}, {
+			purlUri: "pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=docker.io/library/debian&arch=amd64&tag=latest",
+			expected: pkg("oci", "docker.io/library", "debian", "sha256:244fd47e07d10", "", map[string]string{
+				"arch": "amd64",
+				"tag":  "latest",
+			}),
+			canonical: "pkg:oci/debian@sha256%3A244fd47e07d10?arch=amd64&repository_url=docker.io/library/debian&tag=latest",
+		}, {
+			// the repository_url is not trimmed beyond the name
+			purlUri:   "pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=quay.io/bin/debian",
+			expected:  pkg("oci", "quay.io/bin", "debian", "sha256:244fd47e07d10", "", map[string]string{}),
+			canonical: "pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=quay.io/bin/debian",
+		}, {
+			purlUri: "pkg:oci/hello-wasm@sha256%3A244fd47e07d10?tag=v1",
+			expected: pkg("oci", "", "hello-wasm", "sha256:244fd47e07d10", "", map[string]string{
+				"tag": "v1",
+			}),
+			canonical: "pkg:oci/hello-wasm@sha256%3A244fd47e07d10?tag=v1",
+		}, {
+			purlUri: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+			expected: pkg("deb", "debian", "curl", "7.50.3-1", "", map[string]string{
+				"arch":   "i386",
+				"distro": "jessie",
+			}),
+			canonical: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie",
+		}, {
+			// the version is percent-decoded
+			purlUri: "pkg:deb/debian/libssl1.1@1.1.1n-0%2Bdeb11u3?arch=amd64",
+			expected: pkg("deb", "debian", "libssl1.1", "1.1.1n-0+deb11u3", "", map[string]string{
+				"arch": "amd64",
+			}),
+			canonical: "pkg:deb/debian/libssl1.1@1.1.1n-0%2Bdeb11u3?arch=amd64",
+		}, {
+			// qualifier keys are lowercased
+			purlUri: "pkg:rpm/fedora/curl@7.50.3-1.fc25?Arch=i386&Distro=fedora-25",
+			expected: pkg("rpm", "fedora", "curl", "7.50.3-1.fc25", "", map[string]string{
+				"arch":   "i386",
+				"distro": "fedora-25",
+			}),
+			canonical: "pkg:rpm/fedora/curl@7.50.3-1.fc25?arch=i386&distro=fedora-25",
+		}, {
+			// rpm vendors are case insensitive, names are not
+			purlUri:   "pkg:rpm/Fedora/PyYAML@6.0-1.fc38",
+			expected:  pkg("rpm", "fedora", "PyYAML", "6.0-1.fc38", "", map[string]string{}),
+			canonical: "pkg:rpm/fedora/PyYAML@6.0-1.fc38",
+		}, {
+			purlUri: "pkg:rpm/centerim@4.22.10-1.el6?arch=i686&epoch=1&distro=fedora-25",
+			expected: pkg("rpm", "", "centerim", "4.22.10-1.el6", "", map[string]string{
+				"arch":   "i686",
+				"epoch":  "1",
+				"distro": "fedora-25",
+			}),
+			canonical: "pkg:rpm/centerim@4.22.10-1.el6?arch=i686&distro=fedora-25&epoch=1",
+		}, {
+			purlUri: "pkg:generic/bitwarderl?vcs_url=git%2Bhttps://git.fsfe.org/dxtr/bitwarderl%40cc55108da32",
+			expected: pkg("generic", "", "bitwarderl", "", "", map[string]string{
+				"vcs_url": "git+https://git.fsfe.org/dxtr/bitwarderl@cc55108da32",
+			}),
+			canonical: "pkg:generic/bitwarderl?vcs_url=git%2Bhttps://git.fsfe.org/dxtr/bitwarderl%40cc55108da32",
+		}, {
+			// the name is percent-encoded
+			purlUri: "pkg:swid/Acme/example.com/Enterprise+Server@1.0.0?tag_id=75b8c285-fa7b-485b-b199-4745e3004d0d",
+			expected: pkg("swid", "Acme/example.com", "Enterprise+Server", "1.0.0", "", map[string]string{
+				"tag_id": "75b8c285-fa7b-485b-b199-4745e3004d0d",
+			}),
+			canonical: "pkg:swid/Acme/example.com/Enterprise%2BServer@1.0.0?tag_id=75b8c285-fa7b-485b-b199-4745e3004d0d",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("processing %v", tt.purlUri), func(t *testing.T) {
+			got, err := PurlToPkgInput(tt.purlUri)
+			if err != nil {
+				t.Fatalf("unable to parse purl %v: %v", tt.purlUri, err)
+			}
+			if diff := cmp.Diff(tt.expected, got, cmpOpts...); diff != "" {
+				t.Errorf("model Package mismatch (-want +got):\n%s", diff)
+			}
+			if !sort.SliceIsSorted(got.Qualifiers, func(i, j int) bool { return got.Qualifiers[i].Key < got.Qualifiers[j].Key }) {
+				t.Errorf("qualifiers are not sorted: %v", got.Qualifiers)
+			}
+			if purl := PkgInputToPurl(got); purl != tt.canonical {
+				t.Errorf("expected purl %v, got %v", tt.canonical, purl)
+			}
+			again, err := PurlToPkgInput(tt.canonical)
+			if err != nil {
+				t.Fatalf("unable to parse purl %v: %v", tt.canonical, err)
+			}
+			if diff := cmp.Diff(got, again); diff != "" {
+				t.Errorf("model Package mismatch after round trip (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPkgInputToPurlNormalizes(t *testing.T) {
+	testCases := []struct {
+		pkg      *model.PkgInputSpec
+		expected string
+	}{
+		{
+			pkg:      pkg("pypi", "", "Django_Rest", "3.14.0", "", map[string]string{}),
+			expected: "pkg:pypi/django-rest@3.14.0",
+		}, {
+			pkg:      pkg("npm", "@Angular", "Core", "", "", map[string]string{}),
+			expected: "pkg:npm/%40angular/core",
+		}, {
+			pkg:      pkg("maven", "org.Apache", "IO_Utils", "", "", map[string]string{}),
+			expected: "pkg:maven/org.Apache/IO_Utils",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.expected, func(t *testing.T) {
+			if purl := PkgInputToPurl(tt.pkg); purl != tt.expected {
+				t.Errorf("expected purl %v, got %v", tt.expected, purl)
+			}
+		})
+	}
+}
+
+func TestPurlToPkgInputErrors(t *testing.T) {
+	testCases := []string{
+		"npm/foobar@12.3.1",
+		"pkg:npm",
+		"pkg:3d/foobar@12.3.1",
+		"pkg:npm/@12.3.1",
+		"pkg:npm/foobar@12.3.1?arch=x86&arch=amd64",
+		"pkg:npm/foobar@12.3.1?arch",
+		"pkg:npm/foo%zzbar@12.3.1",
+		"pkg:unknown/foobar@12.3.1",
+	}
+
+	for _, purlUri := range testCases {
+		t.Run(fmt.Sprintf("processing %v", purlUri), func(t *testing.T) {
+			if got, err := PurlToPkgInput(purlUri); err == nil {
+				t.Errorf("expected an error, got %+v", got)
+			}
+		})
+	}
+}
+
 func TestGuacPkgPurl(t *testing.T) {
 	testCases := []struct {
 		pkgName    string
@@ -34,49 +34,123 @@ func IsVcs(s string) bool {
 	return err == nil
 }
 
+// VcsToSrc converts a VCS uri in the SPDX format above into a graphql source
+// node. See VcsToSrcInput for the conversion.
 func VcsToSrc(vcsUri string) (*model.SourceInputSpec, error) {
 	u, err := url.Parse(vcsUri)
 	if err != nil {
 		return nil, err
 	}
 
-	m := &model.SourceInputSpec{}
-
 	// Should be <vcs_tool>+<transport>
 	schemeSp := strings.Split(u.Scheme, "+")
 	if len(schemeSp) != 2 {
 		return nil, fmt.Errorf("scheme should be in format <vcs_tool>+<transport>, got %s", u.Scheme)
 	}
-	m.Type = schemeSp[0]
 
-	m.Namespace = u.Host
+	return VcsToSrcInput(vcsUri)
+}
+
+// VcsToSrcInput converts the uri of a repository into a graphql source node,
+// so that all the ingestors put the same repository in the same source trie.
+// Besides the SPDX format above, it accepts uris with just a transport, such
+// as https://github.com/org/repo.git or git://host/path#commit, in which case
+// the type is git. The host and the path up to the repository are the
+// namespace, and the name of the repository is stripped of its .git suffix.
+// The revision, after @ or in the fragment, is a commit if it is a 40
+// characters hex string and a tag otherwise. As a source has either a tag or
+// a commit, setting both is an error.
+func VcsToSrcInput(vcsUri string) (*model.SourceInputSpec, error) {
+	u, err := url.Parse(vcsUri)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &model.SourceInputSpec{}
+	tool, transport, found := strings.Cut(u.Scheme, "+")
+	switch {
+	case found && tool != "" && transport != "":
+		m.Type = tool
+	case !found && vcsTransports[u.Scheme]:
+		m.Type = "git"
+	default:
+		return nil, fmt.Errorf("scheme should be in format <vcs_tool>+<transport> or <transport>, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("uri has no host")
+	}
 
-	idx := strings.LastIndex(u.Path, "/")
-	if idx > 0 {
-		m.Name = u.Path[idx+1:]
-		m.Namespace += u.Path[:idx]
-	} else {
-		m.Name = strings.TrimPrefix(u.Path, "/")
+	path := strings.Trim(u.Path, "/")
+	var revisions []string
+	if name, revision, found := strings.Cut(path, "@"); found {
+		if strings.Contains(revision, "@") {
+			return nil, fmt.Errorf("uri contains more than 1 @")
+		}
+		path = name
+		revisions = append(revisions, revision)
+	}
+	if u.Fragment != "" {
+		revisions = append(revisions, u.Fragment)
 	}
 
-	sp := strings.Split(m.Name, "@")
-	if len(sp) > 2 {
-		return nil, fmt.Errorf("uri contains more than 1 @")
+	m.Namespace = u.Host
+	if idx := strings.LastIndex(path, "/"); idx >= 0 {
+		m.Namespace += "/" + path[:idx]
+		path = path[idx+1:]
+	}
+	m.Name = strings.TrimSuffix(path, ".git")
+	if m.Name == "" {
+		return nil, fmt.Errorf("uri has no repository name")
 	}
 
-	m.Name = sp[0]
-	if len(sp) == 2 {
-		tag := sp[1]
-		if isCommit(tag) {
-			m.Commit = &tag
+	for _, revision := range revisions {
+		revision := revision
+		if revision == "" {
+			return nil, fmt.Errorf("uri has an empty revision")
+		}
+		if isCommit(revision) {
+			if m.Commit != nil {
+				return nil, fmt.Errorf("uri has more than one commit")
+			}
+			m.Commit = &revision
 		} else {
-			m.Tag = &tag
+			if m.Tag != nil {
+				return nil, fmt.Errorf("uri has more than one tag")
+			}
+			m.Tag = &revision
 		}
 	}
+	if m.Tag != nil && m.Commit != nil {
+		return nil, fmt.Errorf("uri has both tag %s and commit %s, a source can only have one", *m.Tag, *m.Commit)
+	}
 
 	return m, nil
 }
 
+// SrcInputToVcs converts a graphql source node into a VCS uri in the SPDX
+// format above, the inverse of VcsToSrcInput. The transport is https.
+func SrcInputToVcs(src *model.SourceInputSpec) (string, error) {
+	if src.Tag != nil && src.Commit != nil {
+		return "", fmt.Errorf("source has both tag %s and commit %s, it can only have one", *src.Tag, *src.Commit)
+	}
+	uri := fmt.Sprintf("%s+https://%s/%s", src.Type, src.Namespace, src.Name)
+	if src.Tag != nil {
+		uri += "@" + *src.Tag
+	}
+	if src.Commit != nil {
+		uri += "@" + *src.Commit
+	}
+	return uri, nil
+}
+
+// vcsTransports are the schemes of the repository uris without a vcs tool.
+var vcsTransports = map[string]bool{
+	"git":   true,
+	"http":  true,
+	"https": true,
+	"ssh":   true,
+}
+
 func isCommit(s string) bool {
 	// for now assume commit is sha1 string
 	if len(s) != 160/4 {
@@ -88,6 +88,85 @@ func TestVcsUriToSrc(t *testing.T) {
 	}
 }
 
+func TestVcsToSrcInput(t *testing.T) {
+	testCases := []struct {
+		uri      string
+		wantErr  bool
+		expected *model.SourceInputSpec
+	}{
+		{
+			uri:      "https://github.com/kubernetes/kubernetes.git",
+			expected: src("git", "github.com/kubernetes", "kubernetes", nil, nil),
+		},
+		{
+			uri:      "git://github.com/kubernetes/kubernetes#3985f0a87ba4277b561e0cac9fba4f594eb8228a",
+			expected: src("git", "github.com/kubernetes", "kubernetes", strP("3985f0a87ba4277b561e0cac9fba4f594eb8228a"), nil),
+		},
+		{
+			uri:      "git+https://github.com/kubernetes/kubernetes@v1.26.3",
+			expected: src("git", "github.com/kubernetes", "kubernetes", nil, strP("v1.26.3")),
+		},
+		{
+			uri:      "hg+ssh://hg.example.com/projects/repo.git@tip",
+			expected: src("hg", "hg.example.com/projects", "repo", nil, strP("tip")),
+		},
+		{
+			uri:     "git+https://github.com/kubernetes/kubernetes@v1.26.3#3985f0a87ba4277b561e0cac9fba4f594eb8228a",
+			wantErr: true,
+		},
+		{
+			uri:     "git+https://github.com/kubernetes/kubernetes@v1.26.3#main",
+			wantErr: true,
+		},
+		{
+			uri:     "git+https:///kubernetes/kubernetes",
+			wantErr: true,
+		},
+		{
+			uri:     "ftp://github.com/kubernetes/kubernetes",
+			wantErr: true,
+		},
+		{
+			uri:     "https://github.com/",
+			wantErr: true,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("parsing %s", tt.uri), func(t *testing.T) {
+			got, err := VcsToSrcInput(tt.uri)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("want err: %v, got err=%v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("model SourceInputSpec mismatch (-want +got):\n%s", diff)
+			}
+
+			// converting back and forth must give the same source
+			uri, err := SrcInputToVcs(got)
+			if err != nil {
+				t.Fatalf("unexpected error converting back: %v", err)
+			}
+			again, err := VcsToSrcInput(uri)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %s: %v", uri, err)
+			}
+			if diff := cmp.Diff(got, again); diff != "" {
+				t.Errorf("round trip through %s mismatch (-want +got):\n%s", uri, diff)
+			}
+		})
+	}
+}
+
+func TestSrcInputToVcsBothTagAndCommit(t *testing.T) {
+	_, err := SrcInputToVcs(src("git", "github.com/kubernetes", "kubernetes", strP("3985f0a87ba4277b561e0cac9fba4f594eb8228a"), strP("main")))
+	if err == nil {
+		t.Errorf("expected error for source with both tag and commit")
+	}
+}
+
 func src(typ, namespace, name string, commit, tag *string) *model.SourceInputSpec {
 	return &model.SourceInputSpec{
 		Type:      typ,
@@ -0,0 +1,211 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blobstore keeps the original documents ingested by GUAC so that the
+// evidence nodes built from them can be traced back to the exact bytes.
+// Documents are stored once per content hash and the store can be capped in
+// size, evicting the least recently used documents first.
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned when no document is stored under a hash.
+var ErrNotFound = errors.New("document not found in blob store")
+
+var hashRegex = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// Store persists original documents keyed by their content hash.
+type Store interface {
+	// Put stores the document and returns its content hash. Storing a
+	// document that is already present only marks it as recently used.
+	Put(ctx context.Context, data []byte, mediaType string) (string, error)
+	// Get returns the document stored under hash, or ErrNotFound. The
+	// caller must close the returned blob.
+	Get(ctx context.Context, hash string) (*Blob, error)
+}
+
+// Blob is a stored document being read back.
+type Blob struct {
+	io.ReadCloser
+	Hash      string
+	MediaType string
+	Size      int64
+}
+
+// Hash returns the content hash that documents are stored under, in the
+// algorithm:encoded form used for artifacts.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// ValidateHash checks that hash is a content hash as returned by Hash.
+func ValidateHash(hash string) error {
+	if !hashRegex.MatchString(hash) {
+		return fmt.Errorf("invalid document hash %q", hash)
+	}
+	return nil
+}
+
+// Option configures a Store.
+type Option func(*store)
+
+// WithMaxBytes caps the total size of the stored documents. When a new
+// document would go over the cap, the least recently used documents are
+// evicted until it fits. A cap of zero, the default, means no limit.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(s *store) {
+		s.lru.maxBytes = maxBytes
+	}
+}
+
+// object is a document as stored by a backend.
+type object struct {
+	key     string
+	size    int64
+	modTime time.Time
+}
+
+// backend is where a store keeps the documents. Keys are derived from the
+// content hash and are safe to use as relative paths.
+type backend interface {
+	write(ctx context.Context, key string, data []byte, mediaType string) error
+	read(ctx context.Context, key string) (io.ReadCloser, string, int64, error)
+	// touch marks the document as used, so that the order survives restarts.
+	touch(ctx context.Context, key string) error
+	remove(ctx context.Context, key string) error
+	list(ctx context.Context) ([]object, error)
+}
+
+// store implements Store on top of a backend, deduplicating by hash and
+// enforcing the size cap.
+type store struct {
+	mu      sync.Mutex
+	backend backend
+	lru     *lru
+}
+
+func newStore(ctx context.Context, b backend, opts ...Option) (*store, error) {
+	s := &store{
+		backend: b,
+		lru:     newLRU(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	objects, err := b.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list stored documents: %w", err)
+	}
+	// Seed the LRU from oldest to newest use.
+	sortByModTime(objects)
+	for _, o := range objects {
+		s.lru.add(o.key, o.size)
+	}
+	if err := s.evict(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *store) Put(ctx context.Context, data []byte, mediaType string) (string, error) {
+	hash := Hash(data)
+	key := hashToKey(hash)
+	size := int64(len(data))
+	if s.lru.maxBytes > 0 && size > s.lru.maxBytes {
+		return "", fmt.Errorf("document of %d bytes is larger than the blob store cap of %d bytes", size, s.lru.maxBytes)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lru.contains(key) {
+		s.lru.touch(key)
+		if err := s.backend.touch(ctx, key); err != nil {
+			return "", err
+		}
+		return hash, nil
+	}
+	if err := s.backend.write(ctx, key, data, mediaType); err != nil {
+		return "", fmt.Errorf("unable to store document %s: %w", hash, err)
+	}
+	s.lru.add(key, size)
+	if err := s.evict(ctx); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+func (s *store) Get(ctx context.Context, hash string) (*Blob, error) {
+	if err := ValidateHash(hash); err != nil {
+		return nil, err
+	}
+	key := hashToKey(hash)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.lru.contains(key) {
+		return nil, fmt.Errorf("%s: %w", hash, ErrNotFound)
+	}
+	r, mediaType, size, err := s.backend.read(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read document %s: %w", hash, err)
+	}
+	s.lru.touch(key)
+	if err := s.backend.touch(ctx, key); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &Blob{
+		ReadCloser: r,
+		Hash:       hash,
+		MediaType:  mediaType,
+		Size:       size,
+	}, nil
+}
+
+// evict removes the least recently used documents until the store is
+// within its cap. It must be called with the lock held.
+func (s *store) evict(ctx context.Context) error {
+	for _, key := range s.lru.overflow() {
+		if err := s.backend.remove(ctx, key); err != nil {
+			return fmt.Errorf("unable to evict document %s: %w", keyToHash(key), err)
+		}
+		s.lru.remove(key)
+	}
+	return nil
+}
+
+// hashToKey turns sha256:<hex> into sha256/<hex>.
+func hashToKey(hash string) string {
+	return strings.Replace(hash, ":", "/", 1)
+}
+
+func keyToHash(key string) string {
+	return strings.Replace(key, "/", ":", 1)
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func readAll(t *testing.T, s Store, hash string) (string, string) {
+	t.Helper()
+	b, err := s.Get(context.Background(), hash)
+	if err != nil {
+		t.Fatalf("Get(%s) error = %v", hash, err)
+	}
+	defer b.Close()
+	data, err := io.ReadAll(b)
+	if err != nil {
+		t.Fatalf("unable to read blob: %v", err)
+	}
+	if int64(len(data)) != b.Size {
+		t.Errorf("blob size = %d, read %d bytes", b.Size, len(data))
+	}
+	return string(data), b.MediaType
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewFileStore(ctx, dir)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	doc := []byte(`{"spdxVersion": "SPDX-2.3"}`)
+	hash, err := s.Put(ctx, doc, "application/spdx+json")
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if hash != Hash(doc) {
+		t.Errorf("Put() = %s, want %s", hash, Hash(doc))
+	}
+	again, err := s.Put(ctx, doc, "application/spdx+json")
+	if err != nil || again != hash {
+		t.Errorf("Put() of the same document = %s, %v, want %s", again, err, hash)
+	}
+
+	data, mediaType := readAll(t, s, hash)
+	if data != string(doc) || mediaType != "application/spdx+json" {
+		t.Errorf("Get() = %q (%s), want %q (application/spdx+json)", data, mediaType, doc)
+	}
+
+	if _, err := s.Get(ctx, Hash([]byte("missing"))); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of a missing document error = %v, want ErrNotFound", err)
+	}
+	if _, err := s.Get(ctx, "sha256:../../etc/passwd"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of an invalid hash error = %v, want invalid hash", err)
+	}
+
+	// Documents are picked up again when the store is reopened.
+	reopened, err := NewFileStore(ctx, dir)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	data, mediaType = readAll(t, reopened, hash)
+	if data != string(doc) || mediaType != "application/spdx+json" {
+		t.Errorf("Get() after reopening = %q (%s), want %q (application/spdx+json)", data, mediaType, doc)
+	}
+}
+
+func TestStoreEviction(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFileStore(ctx, t.TempDir(), WithMaxBytes(10))
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	put := func(data string) string {
+		t.Helper()
+		hash, err := s.Put(ctx, []byte(data), "text/plain")
+		if err != nil {
+			t.Fatalf("Put(%q) error = %v", data, err)
+		}
+		return hash
+	}
+
+	a := put("aaaa")
+	b := put("bbbb")
+	// Reading a makes b the least recently used document.
+	readAll(t, s, a)
+	c := put("cccc")
+
+	if _, err := s.Get(ctx, b); !errors.Is(err, ErrNotFound) {
+		t.Errorf("least recently used document was not evicted, error = %v", err)
+	}
+	for _, hash := range []string{a, c} {
+		readAll(t, s, hash)
+	}
+
+	// Putting a stored document again counts as a use.
+	put("aaaa")
+	put("dddd")
+	if _, err := s.Get(ctx, c); !errors.Is(err, ErrNotFound) {
+		t.Errorf("least recently used document was not evicted, error = %v", err)
+	}
+
+	if _, err := s.Put(ctx, []byte("larger than the cap"), "text/plain"); err == nil {
+		t.Errorf("Put() of a document larger than the cap succeeded")
+	}
+}
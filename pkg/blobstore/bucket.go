@@ -0,0 +1,92 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gocloud.dev/blob"
+	// register the s3:// bucket scheme
+	_ "gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
+)
+
+// bucketBackend keeps each document as an object in a cloud bucket, with
+// its media type as the object's content type.
+type bucketBackend struct {
+	bucket *blob.Bucket
+}
+
+// NewBucketStore returns a Store that keeps documents in the bucket at
+// bucketURL, such as s3://my-bucket?region=us-east-1. Documents already in
+// the bucket are picked up, in the order they were last written.
+func NewBucketStore(ctx context.Context, bucketURL string, opts ...Option) (Store, error) {
+	bucket, err := blob.OpenBucket(ctx, bucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open blob store bucket: %w", err)
+	}
+	return newStore(ctx, &bucketBackend{bucket: bucket}, opts...)
+}
+
+func (b *bucketBackend) write(ctx context.Context, key string, data []byte, mediaType string) error {
+	return b.bucket.WriteAll(ctx, key, data, &blob.WriterOptions{ContentType: mediaType})
+}
+
+func (b *bucketBackend) read(ctx context.Context, key string) (io.ReadCloser, string, int64, error) {
+	r, err := b.bucket.NewReader(ctx, key, nil)
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return nil, "", 0, ErrNotFound
+	}
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return r, r.ContentType(), r.Size(), nil
+}
+
+// touch is a no-op: objects cannot be touched without rewriting them, so
+// after a restart documents are evicted in the order they were written.
+func (b *bucketBackend) touch(ctx context.Context, key string) error {
+	return nil
+}
+
+func (b *bucketBackend) remove(ctx context.Context, key string) error {
+	err := b.bucket.Delete(ctx, key)
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return nil
+	}
+	return err
+}
+
+func (b *bucketBackend) list(ctx context.Context) ([]object, error) {
+	var objects []object
+	iter := b.bucket.List(nil)
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if obj.IsDir || ValidateHash(keyToHash(obj.Key)) != nil {
+			continue
+		}
+		objects = append(objects, object{key: obj.Key, size: obj.Size, modTime: obj.ModTime})
+	}
+}
@@ -0,0 +1,140 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// mediaTypeSuffix names the file next to each document holding its media type.
+const mediaTypeSuffix = ".mediatype"
+
+// fileBackend keeps each document in a file under dir, named by its hash.
+type fileBackend struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileStore returns a Store that keeps documents under dir, creating it
+// if needed. Documents already in dir are picked up, in the order they were
+// last used.
+func NewFileStore(ctx context.Context, dir string, opts ...Option) (Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create blob store directory: %w", err)
+	}
+	return newStore(ctx, &fileBackend{dir: dir, now: time.Now}, opts...)
+}
+
+func (b *fileBackend) path(key string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(key))
+}
+
+func (b *fileBackend) write(ctx context.Context, key string, data []byte, mediaType string) error {
+	p := b.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p+mediaTypeSuffix, []byte(mediaType), 0o644); err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a partially written document
+	// is never served.
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (b *fileBackend) read(ctx context.Context, key string) (io.ReadCloser, string, int64, error) {
+	p := b.path(key)
+	mediaType, err := os.ReadFile(p + mediaTypeSuffix)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, "", 0, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, "", 0, ErrNotFound
+	}
+	if err != nil {
+		return nil, "", 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, "", 0, err
+	}
+	return f, string(mediaType), info.Size(), nil
+}
+
+func (b *fileBackend) touch(ctx context.Context, key string) error {
+	now := b.now()
+	return os.Chtimes(b.path(key), now, now)
+}
+
+func (b *fileBackend) remove(ctx context.Context, key string) error {
+	p := b.path(key)
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Remove(p + mediaTypeSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (b *fileBackend) list(ctx context.Context) ([]object, error) {
+	var objects []object
+	err := filepath.WalkDir(b.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(p, mediaTypeSuffix) || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(b.dir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if ValidateHash(keyToHash(key)) != nil {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, object{key: key, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return objects, err
+}
@@ -0,0 +1,88 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Open returns the Store for location, which is either a directory (as a
+// plain path or a file:// URL) or a bucket URL such as s3://my-bucket.
+func Open(ctx context.Context, location string, opts ...Option) (Store, error) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" {
+		return NewFileStore(ctx, location, opts...)
+	}
+	if u.Scheme == "file" {
+		return NewFileStore(ctx, u.Path, opts...)
+	}
+	return NewBucketStore(ctx, location, opts...)
+}
+
+// Handler serves the stored documents over HTTP. The document hash is the
+// request path, so the handler is meant to be mounted with
+// http.StripPrefix, e.g. GET /blob/sha256:<hex>.
+func Handler(ctx context.Context, s Store) http.Handler {
+	logger := logging.FromContext(ctx)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		hash := strings.TrimPrefix(r.URL.Path, "/")
+		if err := ValidateHash(hash); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		b, err := s.Get(r.Context(), hash)
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, fmt.Sprintf("document %s not found", hash), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			logger.Errorf("unable to read document %s: %v", hash, err)
+			http.Error(w, "unable to read document", http.StatusInternalServerError)
+			return
+		}
+		defer b.Close()
+
+		mediaType := b.MediaType
+		if mediaType == "" {
+			mediaType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", mediaType)
+		w.Header().Set("Content-Length", strconv.FormatInt(b.Size, 10))
+		w.Header().Set("ETag", strconv.Quote(hash))
+		// the content of a hash never changes, but the server may only
+		// serve it to some callers, so shared caches must not keep it
+		w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, _ = io.Copy(w, b)
+	})
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/blobstore"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var sbom = []byte(`{
+  "spdxVersion": "SPDX-2.3",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "gcr.io/google-containers/alpine-latest",
+  "documentNamespace": "https://anchore.com/syft/image/alpine-latest"
+}`)
+
+func TestDocumentRoundTrip(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store, err := blobstore.NewFileStore(ctx, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	server := httptest.NewServer(http.StripPrefix("/blob", blobstore.Handler(ctx, store)))
+	defer server.Close()
+
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	pkg := model.PkgInputSpec{Type: "guac", Namespace: ptrfrom.String("oci/gcr.io/google-containers"), Name: "alpine-latest"}
+	if _, err := b.IngestPackage(ctx, pkg); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+
+	// The processor stores the original document and the parser records its
+	// hash on the evidence node.
+	hash, err := store.Put(ctx, sbom, "application/spdx+json")
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrSourceInput{Package: &pkg}, model.HasSBOMInputSpec{
+		URI:          "https://anchore.com/syft/image/alpine-latest",
+		Origin:       "file:///sbom.json",
+		Collector:    "FileCollector",
+		DocumentHash: &hash,
+	}); err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+
+	found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{URI: ptrfrom.String("https://anchore.com/syft/image/alpine-latest")})
+	if err != nil {
+		t.Fatalf("HasSBOM() error = %v", err)
+	}
+	if len(found) != 1 || found[0].DocumentHash == nil {
+		t.Fatalf("HasSBOM() = %+v, want one node with a document hash", found)
+	}
+
+	resp, err := http.Get(server.URL + "/blob/" + *found[0].DocumentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch document: %v", err)
+	}
+	defer resp.Body.Close()
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unable to read document: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /blob/%s = %s: %s", *found[0].DocumentHash, resp.Status, got)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/spdx+json" {
+		t.Errorf("Content-Type = %s, want application/spdx+json", ct)
+	}
+	if !bytes.Equal(got, sbom) {
+		t.Errorf("fetched document differs from the ingested one:\n%s", got)
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store, err := blobstore.NewFileStore(ctx, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	server := httptest.NewServer(http.StripPrefix("/blob", blobstore.Handler(ctx, store)))
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{name: "invalid hash", method: http.MethodGet, path: "/blob/sha256:1234", want: http.StatusBadRequest},
+		{name: "unknown document", method: http.MethodGet, path: "/blob/" + blobstore.Hash([]byte("unknown")), want: http.StatusNotFound},
+		{name: "wrong method", method: http.MethodPost, path: "/blob/" + blobstore.Hash(sbom), want: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, nil)
+			if err != nil {
+				t.Fatalf("unable to create request: %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("%s %s = %d, want %d", tt.method, tt.path, resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamespaceDocuments(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store, err := blobstore.NewFileStore(ctx, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	b, err := inmem.GetEmptyBackend(nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate testing backend: %v", err)
+	}
+	tokens := map[string][]string{"token-a": {"team-a"}, "token-b": {"team-b"}}
+	handler := helper.NamespaceHandler(helper.DocumentHandler(blobstore.Handler(ctx, store), b), tokens)
+	server := httptest.NewServer(http.StripPrefix("/blob", handler))
+	defer server.Close()
+
+	// Only team-a recorded evidence from the document
+	teamA := helper.WithNamespace(ctx, "team-a")
+	pkg := model.PkgInputSpec{Type: "guac", Namespace: ptrfrom.String("oci/gcr.io/google-containers"), Name: "alpine-latest"}
+	if _, err := b.IngestPackage(teamA, pkg); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	hash, err := store.Put(ctx, sbom, "application/spdx+json")
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, err := b.IngestHasSbom(teamA, model.PackageOrSourceInput{Package: &pkg}, model.HasSBOMInputSpec{
+		URI:          "https://anchore.com/syft/image/alpine-latest",
+		Origin:       "file:///sbom.json",
+		Collector:    "FileCollector",
+		DocumentHash: &hash,
+	}); err != nil {
+		t.Fatalf("Could not ingest HasSBOM: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{name: "namespace with the document", token: "token-a", want: http.StatusOK},
+		{name: "other namespace", token: "token-b", want: http.StatusNotFound},
+		{name: "unauthenticated", want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, server.URL+"/blob/"+hash, nil)
+			if err != nil {
+				t.Fatalf("unable to create request: %v", err)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("GET /blob/%s = %d, want %d", hash, resp.StatusCode, tt.want)
+			}
+			if cc := resp.Header.Get("Cache-Control"); tt.want == http.StatusOK && cc != "private, max-age=31536000, immutable" {
+				t.Errorf("Cache-Control = %s, want a private one", cc)
+			}
+		})
+	}
+}
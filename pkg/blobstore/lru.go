@@ -0,0 +1,94 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobstore
+
+import (
+	"container/list"
+	"sort"
+)
+
+// lru tracks the stored documents from most to least recently used along
+// with their total size.
+type lru struct {
+	maxBytes int64
+	size     int64
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type lruEntry struct {
+	key  string
+	size int64
+}
+
+func newLRU() *lru {
+	return &lru{
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (l *lru) contains(key string) bool {
+	_, ok := l.entries[key]
+	return ok
+}
+
+// add records a new document as the most recently used one.
+func (l *lru) add(key string, size int64) {
+	if l.contains(key) {
+		l.touch(key)
+		return
+	}
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, size: size})
+	l.size += size
+}
+
+func (l *lru) touch(key string) {
+	if e, ok := l.entries[key]; ok {
+		l.order.MoveToFront(e)
+	}
+}
+
+func (l *lru) remove(key string) {
+	if e, ok := l.entries[key]; ok {
+		l.size -= e.Value.(*lruEntry).size
+		l.order.Remove(e)
+		delete(l.entries, key)
+	}
+}
+
+// overflow returns the keys to evict, least recently used first, for the
+// total size to fit in maxBytes. The most recently used document is never
+// returned.
+func (l *lru) overflow() []string {
+	if l.maxBytes <= 0 {
+		return nil
+	}
+	var keys []string
+	size := l.size
+	for e := l.order.Back(); e != nil && e != l.order.Front() && size > l.maxBytes; e = e.Prev() {
+		entry := e.Value.(*lruEntry)
+		keys = append(keys, entry.key)
+		size -= entry.size
+	}
+	return keys
+}
+
+func sortByModTime(objects []object) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].modTime.Before(objects[j].modTime)
+	})
+}
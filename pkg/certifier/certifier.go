@@ -49,4 +49,5 @@ type CertifierType string
 const (
 	CertifierOSV       CertifierType = "OSV"
 	CertifierScorecard CertifierType = "scorecard"
+	CertifierDepsDev   CertifierType = "depsdev"
 )
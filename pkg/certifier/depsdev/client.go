@@ -0,0 +1,94 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package depsdev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type versionKey struct {
+	System  string `json:"system"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// version is the subset of the deps.dev GetVersion response used here.
+type version struct {
+	VersionKey       versionKey `json:"versionKey"`
+	IsDefault        bool       `json:"isDefault"`
+	IsDeprecated     bool       `json:"isDeprecated"`
+	DeprecatedReason string     `json:"deprecatedReason"`
+}
+
+// pkg is the subset of the deps.dev GetPackage response used here.
+type pkg struct {
+	Versions []version `json:"versions"`
+}
+
+func (p *pkg) defaultVersion() string {
+	for _, v := range p.Versions {
+		if v.IsDefault {
+			return v.VersionKey.Version
+		}
+	}
+	return ""
+}
+
+func (d *depsDevCertifier) getVersion(ctx context.Context, system, name, ver string) (*version, error) {
+	var v version
+	path := fmt.Sprintf("/systems/%s/packages/%s/versions/%s", system, url.PathEscape(name), url.PathEscape(ver))
+	if err := d.get(ctx, path, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (d *depsDevCertifier) getPackage(ctx context.Context, system, name string) (*pkg, error) {
+	var p pkg
+	path := fmt.Sprintf("/systems/%s/packages/%s", system, url.PathEscape(name))
+	if err := d.get(ctx, path, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (d *depsDevCertifier) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deps.dev request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", path, errNotFound)
+	default:
+		return fmt.Errorf("deps.dev request %s failed with status %s", path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("unable to decode deps.dev response for %s: %w", path, err)
+	}
+	return nil
+}
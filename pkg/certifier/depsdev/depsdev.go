@@ -0,0 +1,246 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package depsdev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/deprecation"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	URI        string = "deps.dev"
+	DefaultURL string = "https://api.deps.dev/v3alpha"
+)
+
+var ErrDepsDevComponentTypeMismatch error = fmt.Errorf("rootComponent type is not *root_package.PackageComponent")
+
+// errNotFound is returned when deps.dev does not know about a package or version.
+var errNotFound = errors.New("not found on deps.dev")
+
+// systems maps the purl types to the deps.dev package management systems
+// whose deprecations are understood.
+var systems = map[string]string{
+	purl.TypeNPM:    "npm",
+	purl.TypeGolang: "go",
+}
+
+const (
+	quote = "['\"`]"
+	// npmName matches a possibly scoped npm package name.
+	npmName = `(@?[a-z0-9][a-z0-9._\-]*(?:/[a-z0-9][a-z0-9._\-]*)?)`
+	// goModule matches a Go module path, whose first element has a dot.
+	goModule = `([a-z0-9][a-z0-9.\-]*\.[a-z]{2,}(?:/[a-zA-Z0-9._~\-]+)+)`
+)
+
+var (
+	// npmSuccessor finds the replacement package in npm deprecation messages
+	// such as "renamed to new-lib" or "please use `new-lib`". A bare name is
+	// only taken after phrases that cannot introduce ordinary prose.
+	npmSuccessor = regexp.MustCompile(`(?i)\b(?:(?:renamed|moved) to|replaced by|superseded by|in favou?r of)\s+` + quote + `?` + npmName + `(?:` + quote + `|[\s.,;)]|$)` +
+		`|\b(?:use|switch to|migrate to)\s+` + quote + npmName + quote)
+	// goSuccessor finds the replacement module path in Go deprecation comments
+	// and retraction rationales such as "Deprecated: use example.com/new".
+	goSuccessor = regexp.MustCompile(`(?i)\b(?:(?:renamed|moved) to|replaced by|superseded by|in favou?r of|use|switch to|migrate to)\s+` + quote + `?` + goModule)
+)
+
+// Option configures the deps.dev certifier.
+type Option func(*depsDevCertifier)
+
+// WithURL sets the base URL of the deps.dev API.
+func WithURL(u string) Option {
+	return func(d *depsDevCertifier) {
+		d.baseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to talk to deps.dev.
+func WithHTTPClient(c *http.Client) Option {
+	return func(d *depsDevCertifier) {
+		d.client = c
+	}
+}
+
+type depsDevCertifier struct {
+	baseURL string
+	client  *http.Client
+	now     func() time.Time
+}
+
+// NewDepsDevCertifier initializes the certifier that looks up npm and Go
+// packages on deps.dev and records the packages superseding deprecated
+// or retracted ones.
+func NewDepsDevCertifier(opts ...Option) certifier.Certifier {
+	d := &depsDevCertifier{
+		baseURL: DefaultURL,
+		client:  http.DefaultClient,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// CertifyComponent takes in the root component from the guac database and walks it and
+// its dependencies, emitting a deprecation document for every superseded package found
+func (d *depsDevCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	component, ok := rootComponent.(*root_package.PackageComponent)
+	if !ok {
+		return ErrDepsDevComponentTypeMismatch
+	}
+	return d.certifyHelper(ctx, component, docChannel, map[string]bool{})
+}
+
+// certifyHelper checks the package and then recurses into its dependencies.
+// The visited map is used to prevent infinite recursion.
+func (d *depsDevCertifier) certifyHelper(ctx context.Context, component *root_package.PackageComponent, docChannel chan<- *processor.Document,
+	visited map[string]bool) error {
+	if visited[component.Package.Purl] {
+		return nil
+	}
+	visited[component.Package.Purl] = true
+
+	deprecations, err := d.getDeprecations(ctx, component.Package.Purl)
+	if err != nil {
+		return err
+	}
+	for i := range deprecations {
+		doc, err := generateDocument(&deprecations[i])
+		if err != nil {
+			return err
+		}
+		docChannel <- doc
+	}
+
+	for _, depPack := range component.DepPackages {
+		if err := d.certifyHelper(ctx, depPack, docChannel, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDeprecations looks up a single package version. A deprecation message
+// naming another package supersedes the whole package. For Go, a retracted
+// version is superseded by the default version of the module.
+func (d *depsDevCertifier) getDeprecations(ctx context.Context, purlString string) ([]deprecation.Deprecation, error) {
+	p, err := purl.FromString(purlString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse purl %s: %w", purlString, err)
+	}
+	system, ok := systems[p.Type]
+	if !ok || p.Version == "" {
+		return nil, nil
+	}
+	name := p.Name
+	if p.Namespace != "" {
+		name = p.Namespace + "/" + p.Name
+	}
+
+	version, err := d.getVersion(ctx, system, name, p.Version)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !version.IsDeprecated {
+		return nil, nil
+	}
+
+	since := d.now().UTC()
+	var deprecations []deprecation.Deprecation
+	if successor := successorName(p.Type, version.DeprecatedReason); successor != "" && successor != name {
+		deprecations = append(deprecations, deprecation.Deprecation{
+			Package:   toPurl(p.Type, name, ""),
+			Successor: toPurl(p.Type, successor, ""),
+			Reason:    version.DeprecatedReason,
+			Since:     since,
+		})
+	}
+
+	if p.Type == purl.TypeGolang {
+		pkg, err := d.getPackage(ctx, system, name)
+		if err != nil && !errors.Is(err, errNotFound) {
+			return nil, err
+		}
+		if pkg != nil {
+			if def := pkg.defaultVersion(); def != "" && def != p.Version {
+				deprecations = append(deprecations, deprecation.Deprecation{
+					Package:   toPurl(p.Type, name, p.Version),
+					Successor: toPurl(p.Type, name, def),
+					Reason:    version.DeprecatedReason,
+					Since:     since,
+				})
+			}
+		}
+	}
+	return deprecations, nil
+}
+
+func successorName(purlType string, reason string) string {
+	re := npmSuccessor
+	if purlType == purl.TypeGolang {
+		re = goSuccessor
+	}
+	m := re.FindStringSubmatch(reason)
+	if m == nil {
+		return ""
+	}
+	for _, s := range m[1:] {
+		if s != "" {
+			return strings.TrimRight(s, ".")
+		}
+	}
+	return ""
+}
+
+// toPurl turns a deps.dev package name, which includes the namespace, back into a purl.
+func toPurl(purlType string, name string, version string) string {
+	namespace := ""
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+	return purl.NewPackageURL(purlType, namespace, name, version, nil, "").ToString()
+}
+
+func generateDocument(d *deprecation.Deprecation) (*processor.Document, error) {
+	payload, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return &processor.Document{
+		Blob:   payload,
+		Type:   processor.DocumentDeprecation,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: URI,
+			Source:    URI,
+		},
+	}, nil
+}
@@ -0,0 +1,187 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package depsdev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/deprecation"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var responses = map[string]string{
+	"/systems/npm/packages/old-lib/versions/1.0.0": `{
+		"versionKey": {"system": "NPM", "name": "old-lib", "version": "1.0.0"},
+		"isDeprecated": true,
+		"deprecatedReason": "This package has been renamed to new-lib."
+	}`,
+	"/systems/npm/packages/@scope%2Fhelper/versions/2.0.0": `{
+		"versionKey": {"system": "NPM", "name": "@scope/helper", "version": "2.0.0"},
+		"isDeprecated": true,
+		"deprecatedReason": "Please use it at your own risk"
+	}`,
+	"/systems/npm/packages/left-pad/versions/1.3.0": `{
+		"versionKey": {"system": "NPM", "name": "left-pad", "version": "1.3.0"},
+		"isDeprecated": false
+	}`,
+	"/systems/go/packages/github.com%2Fexample%2Fmod/versions/v1.2.0": `{
+		"versionKey": {"system": "GO", "name": "github.com/example/mod", "version": "v1.2.0"},
+		"isDeprecated": true,
+		"deprecatedReason": "retracted: contains a data race"
+	}`,
+	"/systems/go/packages/github.com%2Fexample%2Fmod": `{
+		"versions": [
+			{"versionKey": {"system": "GO", "name": "github.com/example/mod", "version": "v1.2.0"}},
+			{"versionKey": {"system": "GO", "name": "github.com/example/mod", "version": "v1.2.1"}, "isDefault": true}
+		]
+	}`,
+	"/systems/go/packages/github.com%2Fexample%2Fold/versions/v0.3.0": `{
+		"versionKey": {"system": "GO", "name": "github.com/example/old", "version": "v0.3.0"},
+		"isDeprecated": true,
+		"deprecatedReason": "Deprecated: moved to github.com/example/new."
+	}`,
+	"/systems/go/packages/github.com%2Fexample%2Fold": `{
+		"versions": [
+			{"versionKey": {"system": "GO", "name": "github.com/example/old", "version": "v0.3.0"}, "isDefault": true}
+		]
+	}`,
+}
+
+func TestDepsDevCertifier_CertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	component := func(purl string, deps ...*root_package.PackageComponent) *root_package.PackageComponent {
+		return &root_package.PackageComponent{
+			Package:     assembler.PackageNode{Purl: purl},
+			DepPackages: deps,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		rootComponent interface{}
+		want          []deprecation.Deprecation
+		wantErr       error
+	}{{
+		name: "npm rename, go retraction and go module move",
+		rootComponent: component("pkg:npm/old-lib@1.0.0",
+			component("pkg:golang/github.com/example/mod@v1.2.0"),
+			component("pkg:golang/github.com/example/old@v0.3.0",
+				component("pkg:npm/old-lib@1.0.0")),
+			component("pkg:npm/left-pad@1.3.0"),
+		),
+		want: []deprecation.Deprecation{{
+			Package:   "pkg:npm/old-lib",
+			Successor: "pkg:npm/new-lib",
+			Reason:    "This package has been renamed to new-lib.",
+			Since:     now,
+		}, {
+			Package:   "pkg:golang/github.com/example/mod@v1.2.0",
+			Successor: "pkg:golang/github.com/example/mod@v1.2.1",
+			Reason:    "retracted: contains a data race",
+			Since:     now,
+		}, {
+			Package:   "pkg:golang/github.com/example/old",
+			Successor: "pkg:golang/github.com/example/new",
+			Reason:    "Deprecated: moved to github.com/example/new.",
+			Since:     now,
+		}},
+	}, {
+		name:          "deprecation without a successor",
+		rootComponent: component("pkg:npm/%40scope/helper@2.0.0"),
+	}, {
+		name:          "unknown packages and unsupported types are skipped",
+		rootComponent: component("pkg:npm/unknown@1.0.0", component("pkg:pypi/numpy@1.24.0"), component("pkg:npm/old-lib")),
+	}, {
+		name:          "wrong component type",
+		rootComponent: assembler.PackageNode{Purl: "pkg:npm/old-lib@1.0.0"},
+		wantErr:       ErrDepsDevComponentTypeMismatch,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDepsDevCertifier(WithURL(server.URL), WithHTTPClient(server.Client())).(*depsDevCertifier)
+			d.now = func() time.Time { return now }
+
+			docChan := make(chan *processor.Document, 10)
+			err := d.CertifyComponent(ctx, tt.rootComponent, docChan)
+			if err != tt.wantErr {
+				t.Fatalf("CertifyComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			close(docChan)
+
+			var got []deprecation.Deprecation
+			for doc := range docChan {
+				if doc.Type != processor.DocumentDeprecation || doc.Format != processor.FormatJSON {
+					t.Errorf("unexpected document type %v and format %v", doc.Type, doc.Format)
+				}
+				if doc.SourceInformation.Collector != URI || doc.SourceInformation.Source != URI {
+					t.Errorf("unexpected source information %+v", doc.SourceInformation)
+				}
+				var dep deprecation.Deprecation
+				if err := json.Unmarshal(doc.Blob, &dep); err != nil {
+					t.Fatalf("unable to unmarshal document: %v", err)
+				}
+				got = append(got, dep)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSuccessorName(t *testing.T) {
+	tests := []struct {
+		purlType string
+		reason   string
+		want     string
+	}{
+		{"npm", "renamed to `@scope/new-lib`", "@scope/new-lib"},
+		{"npm", "This module is superseded by got, please migrate", "got"},
+		{"npm", "Please switch to 'undici'.", "undici"},
+		{"npm", "Please use it at your own risk", ""},
+		{"npm", "moved to https://example.com/new-lib", ""},
+		{"npm", "request has been deprecated, see https://github.com/request/request/issues/3142", ""},
+		{"golang", "Deprecated: use example.com/new/v2 instead", "example.com/new/v2"},
+		{"golang", "retracted because of a bug", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			if got := successorName(tt.purlType, tt.reason); got != tt.want {
+				t.Errorf("successorName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,87 +21,39 @@ import (
 	"fmt"
 
 	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
 const (
-	BufferChannelSize int = 1000
+	BufferChannelSize int = sdk.BufferChannelSize
 )
 
-type Collector interface {
-	// RetrieveArtifacts collects the documents from the collector. It emits each collected
-	// document through the channel to be collected and processed by the upstream processor.
-	// The function should block until all the artifacts are collected and return a nil error
-	// or return an error from the collector crashing. This function can keep running and check
-	// for new artifacts as they are being uploaded by polling on an interval or run once and
-	// grab all the artifacts and end.
-	RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error
-	// Type returns the collector type
-	Type() string
-}
+// Collector is the interface implemented by all collectors, see the sdk
+// package for helpers to write one.
+type Collector = sdk.Collector
 
 // Emitter processes a document
-type Emitter func(*processor.Document) error
+type Emitter = sdk.Emitter
 
 // ErrHandler processes an error and returns a boolean representing if
 // the error was able to be gracefully handled
-type ErrHandler func(error) bool
+type ErrHandler = sdk.ErrHandler
 
 var (
-	documentCollectors    = map[string]Collector{}
-	ErrCollectorOverwrite = fmt.Errorf("the document collector is being overwritten")
+	documentCollectors    = sdk.Registry{}
+	ErrCollectorOverwrite = sdk.ErrCollectorOverwrite
 )
 
 func RegisterDocumentCollector(c Collector, collectorType string) error {
-	if _, ok := documentCollectors[collectorType]; ok {
-		documentCollectors[collectorType] = c
-		return fmt.Errorf("%w: %s", ErrCollectorOverwrite, collectorType)
-	}
-	documentCollectors[collectorType] = c
-
-	return nil
+	return documentCollectors.Register(c, collectorType)
 }
 
 // Collect takes all the collectors and starts collecting artifacts
 // after Collect is called, no calls to RegisterDocumentCollector should happen.
 func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
-	// docChan to collect artifacts
-	docChan := make(chan *processor.Document, BufferChannelSize)
-	// errChan to receive error from collectors
-	errChan := make(chan error, len(documentCollectors))
-	// logger
-	logger := logging.FromContext(ctx)
-
-	for _, collector := range documentCollectors {
-		c := collector
-		go func() {
-			errChan <- c.RetrieveArtifacts(ctx, docChan)
-		}()
-	}
-
-	numCollectors := len(documentCollectors)
-	collectorsDone := 0
-	for collectorsDone < numCollectors {
-		select {
-		case d := <-docChan:
-			if err := emitter(d); err != nil {
-				logger.Errorf("emit error: %v", err)
-			}
-		case err := <-errChan:
-			if !handleErr(err) {
-				return err
-			}
-			collectorsDone += 1
-		}
-	}
-	for len(docChan) > 0 {
-		d := <-docChan
-		if err := emitter(d); err != nil {
-			logger.Errorf("emit error: %v", err)
-		}
-	}
-	return nil
+	return documentCollectors.Collect(ctx, emitter, handleErr)
 }
 
 // Publish is used by NATS JetStream to stream the documents and send them to the processor
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -90,35 +91,17 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 			},
 		}
 
-		docChannel <- doc
-
-		return nil
+		return sdk.Emit(ctx, docChannel, doc)
 	}
 
-	if f.poll {
-		for {
-			select {
-			// If the context has been canceled it contains an err which we can throw.
-			case <-ctx.Done():
-				return ctx.Err() // nolint:wrapcheck
-			default:
-				err := filepath.WalkDir(f.path, readFunc)
-				if err != nil {
-					return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
-				}
-				f.lastChecked = time.Now()
-				time.Sleep(f.interval)
-			}
-		}
-	} else {
+	return sdk.Poll(ctx, f.poll, f.interval, func(ctx context.Context) error {
 		err := filepath.WalkDir(f.path, readFunc)
 		if err != nil {
 			return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
 		}
 		f.lastChecked = time.Now()
-	}
-
-	return nil
+		return nil
+	})
 }
 
 // Type returns the collector type
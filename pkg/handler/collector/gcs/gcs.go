@@ -20,13 +20,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -48,17 +48,13 @@ const (
 )
 
 func getBucketPath() string {
-	if env := os.Getenv(bucketEnv); env != "" {
-		return env
-	}
-	return ""
+	bucket, _ := sdk.ResolveCredential(sdk.FromEnv(bucketEnv))
+	return bucket
 }
 
 func getCredsPath() string {
-	if env := os.Getenv(gcsCredsEnv); env != "" {
-		return env
-	}
-	return ""
+	creds, _ := sdk.ResolveCredential(sdk.FromEnv(gcsCredsEnv))
+	return creds
 }
 
 // NewGCSClient initializes the gcs and sets it for polling or one time run
@@ -67,7 +63,7 @@ func NewGCSClient(ctx context.Context, poll bool, interval time.Duration) (*gcs,
 	if getCredsPath() == "" {
 		return nil, errors.New("gcs bucket not specified")
 	}
-	client, err := storage.NewClient(ctx, option.WithCredentialsFile(os.Getenv(gcsCredsEnv)))
+	client, err := storage.NewClient(ctx, option.WithCredentialsFile(getCredsPath()))
 	if err != nil {
 		return nil, err
 	}
@@ -122,30 +118,14 @@ func (g *gcs) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processo
 		return errors.New("gcs not initialized")
 	}
 
-	gcsGetArtifacts := func() error {
+	return sdk.Poll(ctx, g.poll, g.interval, func(ctx context.Context) error {
 		err := g.getArtifacts(ctx, docChannel)
 		if err != nil {
 			return fmt.Errorf("failed to get artifacts from gcs: %w", err)
 		}
 		g.lastDownload = time.Now()
 		return nil
-	}
-
-	if g.poll {
-		for {
-			time.Sleep(g.interval)
-			err := gcsGetArtifacts()
-			if err != nil {
-				return err
-			}
-		}
-	} else {
-		err := gcsGetArtifacts()
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	})
 }
 
 func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
@@ -184,7 +164,9 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 					Source:    g.bucket + "/" + attrs.Name,
 				},
 			}
-			docChannel <- doc
+			if err := sdk.Emit(ctx, docChannel, doc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -24,6 +24,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"go.uber.org/zap"
@@ -67,27 +68,19 @@ func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll b
 func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	logger := logging.FromContext(ctx)
 
-	if g.poll {
-		for {
-			if ctx.Err() != nil {
-				return nil
-			}
-			err := g.createOrPull(ctx, logger, docChannel)
-			if err != nil {
-				return fmt.Errorf("error creating or pulling git repo: %w", err)
-			}
-			g.lastChecked = time.Now()
-			time.Sleep(g.interval)
-		}
-	} else {
+	err := sdk.Poll(ctx, g.poll, g.interval, func(ctx context.Context) error {
 		err := g.createOrPull(ctx, logger, docChannel)
 		if err != nil {
 			return fmt.Errorf("error creating or pulling git repo: %w", err)
 		}
 		g.lastChecked = time.Now()
+		return nil
+	})
+	// stopping to poll when the context is canceled is not an error
+	if sdk.IsShutdown(err) {
+		return nil
 	}
-
-	return nil
+	return err
 }
 
 func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.SugaredLogger, docChannel chan<- *processor.Document) error {
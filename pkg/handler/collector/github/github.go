@@ -25,6 +25,7 @@ import (
 	"github.com/guacsec/guac/internal/client"
 	"github.com/guacsec/guac/internal/client/githubclient"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -124,18 +125,12 @@ func (g *githubCollector) RetrieveArtifacts(ctx context.Context, docChannel chan
 	if err != nil {
 		return err
 	}
-	if g.poll {
+	return sdk.Poll(ctx, g.poll, g.interval, func(ctx context.Context) error {
 		for repo, tags := range g.repoToReleaseTags {
 			g.fetchAssets(ctx, repo.Owner, repo.Repo, tags, docChannel)
 		}
-		time.Sleep(g.interval)
-	} else {
-		for repo, tags := range g.repoToReleaseTags {
-			g.fetchAssets(ctx, repo.Owner, repo.Repo, tags, docChannel)
-		}
-	}
-
-	return nil
+		return nil
+	})
 }
 
 func (g *githubCollector) Type() string {
@@ -214,7 +209,10 @@ func (g *githubCollector) collectAssetsForRelease(ctx context.Context, release c
 					Source:    asset.URL,
 				},
 			}
-			docChannel <- doc
+			if err := sdk.Emit(ctx, docChannel, doc); err != nil {
+				logger.Warnf("unable to emit asset: %v", err)
+				return
+			}
 		}
 	}
 }
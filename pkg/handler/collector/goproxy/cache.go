@@ -0,0 +1,123 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goproxy
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Cache keeps the responses of the proxy that never change once published:
+// the .info and .mod files of a version and the hashes of its zip. Keys are
+// escaped proxy paths, such as github.com/!azure/go-autorest/@v/v1.0.0.mod.
+type Cache interface {
+	// Get returns the cached data for key, and false if there is none.
+	Get(key string) ([]byte, bool, error)
+	Put(key string, data []byte) error
+}
+
+type memoryCache struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+// NewMemoryCache returns a Cache that is lost when the process exits.
+func NewMemoryCache() Cache {
+	return &memoryCache{files: map[string][]byte{}}
+}
+
+func (m *memoryCache) Get(key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[key]
+	return data, ok, nil
+}
+
+func (m *memoryCache) Put(key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[key] = data
+	return nil
+}
+
+type dirCache struct {
+	dir string
+}
+
+// NewDirCache returns a Cache that keeps every key as a file under dir, in
+// the same layout as the proxy. The directory is created if needed.
+func NewDirCache(dir string) (Cache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create cache directory %s: %w", dir, err)
+	}
+	return &dirCache{dir: dir}, nil
+}
+
+func (d *dirCache) path(key string) (string, error) {
+	clean := path.Clean(key)
+	if path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("invalid cache key %q", key)
+	}
+	return filepath.Join(d.dir, filepath.FromSlash(clean)), nil
+}
+
+func (d *dirCache) Get(key string) ([]byte, bool, error) {
+	file, err := d.path(key)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to read cached %s: %w", key, err)
+	}
+	return data, true, nil
+}
+
+// Put writes the file under a temporary name first, so that a crash never
+// leaves a truncated file behind to be served from the cache.
+func (d *dirCache) Put(key string, data []byte) error {
+	file, err := d.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return fmt.Errorf("unable to cache %s: %w", key, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("unable to cache %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("unable to cache %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to cache %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return fmt.Errorf("unable to cache %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goproxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/gomodule"
+	"github.com/guacsec/guac/pkg/logging"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+const (
+	GoProxyCollector = "GoProxyCollector"
+	// DefaultProxy is the public Go module mirror run by Google
+	DefaultProxy = "https://proxy.golang.org"
+)
+
+// errNotFound is returned when the proxy does not have a module or version,
+// which it reports with a 404 or a 410.
+var errNotFound = errors.New("not found")
+
+// DiscoverFunc returns more module paths to collect, for example the Go
+// packages already in the graph. It is called on every polling pass.
+type DiscoverFunc func(ctx context.Context) ([]string, error)
+
+type goProxyCollector struct {
+	proxy     string
+	modules   []string
+	discover  DiscoverFunc
+	poll      bool
+	interval  time.Duration
+	client    *http.Client
+	limiter   sdk.RateLimiter
+	cache     Cache
+	collected map[string]bool
+}
+
+// Option configures optional behavior of the go proxy collector.
+type Option func(*goProxyCollector)
+
+// WithDiscovery adds the module paths returned by discover to the ones
+// collected.
+func WithDiscovery(discover DiscoverFunc) Option {
+	return func(g *goProxyCollector) {
+		g.discover = discover
+	}
+}
+
+// WithRateLimiter replaces the default limit of 10 requests per second to the
+// proxy. Responses served from the cache are not limited.
+func WithRateLimiter(limiter sdk.RateLimiter) Option {
+	return func(g *goProxyCollector) {
+		g.limiter = limiter
+	}
+}
+
+// WithCache replaces the default in memory cache of immutable responses, for
+// example with a directory cache shared across runs.
+func WithCache(cache Cache) Option {
+	return func(g *goProxyCollector) {
+		g.cache = cache
+	}
+}
+
+// WithHTTPClient replaces the client used to talk to the proxy.
+func WithHTTPClient(client *http.Client) Option {
+	return func(g *goProxyCollector) {
+		g.client = client
+	}
+}
+
+// NewGoProxyCollector initializes the go proxy collector to collect every
+// version of the given modules from proxy, which can be an http(s) or a file
+// URL, like GOPROXY. Each version is emitted once per collector, so polling
+// only emits the versions published since the previous pass.
+func NewGoProxyCollector(proxy string, modules []string, poll bool, interval time.Duration, opts ...Option) (*goProxyCollector, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %s: %w", proxy, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "file":
+	default:
+		return nil, fmt.Errorf("unsupported proxy URL %s, expected an http, https or file URL", proxy)
+	}
+	for _, path := range modules {
+		if err := module.CheckPath(path); err != nil {
+			return nil, err
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+	g := &goProxyCollector{
+		proxy:     strings.TrimSuffix(proxy, "/"),
+		modules:   modules,
+		poll:      poll,
+		interval:  interval,
+		client:    &http.Client{Transport: transport},
+		limiter:   sdk.NewRateLimiter(100*time.Millisecond, 10),
+		cache:     NewMemoryCache(),
+		collected: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
+
+// RetrieveArtifacts collects the modules based on polling or one time
+func (g *goProxyCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	return sdk.Poll(ctx, g.poll, g.interval, func(ctx context.Context) error {
+		modules, err := g.modulePaths(ctx)
+		if err != nil {
+			return err
+		}
+		for _, path := range modules {
+			err := g.collectModule(ctx, path, docChannel)
+			if errors.Is(err, errNotFound) {
+				logger.Warnf("module %s not found on %s: %v", path, g.proxy, err)
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("unable to collect module %s: %w", path, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Type returns the collector type
+func (g *goProxyCollector) Type() string {
+	return GoProxyCollector
+}
+
+// modulePaths returns the configured and discovered module paths, sorted and
+// without duplicates. Discovered paths that are not valid module paths, such
+// as packages inside a module, are skipped.
+func (g *goProxyCollector) modulePaths(ctx context.Context) ([]string, error) {
+	seen := map[string]bool{}
+	for _, path := range g.modules {
+		seen[path] = true
+	}
+	if g.discover != nil {
+		discovered, err := g.discover(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to discover modules: %w", err)
+		}
+		for _, path := range discovered {
+			if module.CheckPath(path) == nil {
+				seen[path] = true
+			}
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func (g *goProxyCollector) collectModule(ctx context.Context, path string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	escPath, err := module.EscapePath(path)
+	if err != nil {
+		return err
+	}
+
+	list, err := g.get(ctx, escPath+"/@v/list", false)
+	if err != nil {
+		return err
+	}
+	versions := strings.Fields(string(list))
+	if len(versions) == 0 {
+		// modules without tagged versions only have a pseudo-version
+		latest, err := g.get(ctx, escPath+"/@latest", false)
+		if err != nil {
+			return err
+		}
+		var info struct{ Version string }
+		if err := json.Unmarshal(latest, &info); err != nil {
+			return fmt.Errorf("unable to parse @latest: %w", err)
+		}
+		versions = []string{info.Version}
+	}
+
+	for _, version := range versions {
+		key := path + "@" + version
+		if g.collected[key] {
+			continue
+		}
+		m, err := g.fetchVersion(ctx, path, escPath, version)
+		if errors.Is(err, errNotFound) {
+			logger.Warnf("module %s listed but not served by %s: %v", key, g.proxy, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		blob, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentGoModule,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: GoProxyCollector,
+				Source:    fmt.Sprintf("%s/%s/@v/%s", g.proxy, path, version),
+			},
+		}
+		if err := sdk.Emit(ctx, docChannel, doc); err != nil {
+			return err
+		}
+		g.collected[key] = true
+	}
+	return nil
+}
+
+func (g *goProxyCollector) fetchVersion(ctx context.Context, path, escPath, version string) (*gomodule.Module, error) {
+	escVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	base := escPath + "/@v/" + escVersion
+
+	info, err := g.get(ctx, base+".info", true)
+	if err != nil {
+		return nil, err
+	}
+	var parsedInfo struct {
+		Version string
+		Time    time.Time
+	}
+	if err := json.Unmarshal(info, &parsedInfo); err != nil {
+		return nil, fmt.Errorf("unable to parse %s.info: %w", base, err)
+	}
+	mod, err := g.get(ctx, base+".mod", true)
+	if err != nil {
+		return nil, err
+	}
+	zipDigest, zipHash, err := g.zipHashes(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gomodule.Module{
+		Path:      path,
+		Version:   version,
+		Time:      parsedInfo.Time.UTC(),
+		GoMod:     string(mod),
+		ZipDigest: zipDigest,
+		ZipHash:   zipHash,
+		Proxy:     g.proxy,
+	}, nil
+}
+
+// zipHashes returns the sha256 digest and the go.sum hash of the module zip.
+// Only the hashes are cached, in a .ziphash entry like in the module cache of
+// the go command, as the zips themselves can be large.
+func (g *goProxyCollector) zipHashes(ctx context.Context, base string) (string, string, error) {
+	if cached, ok, err := g.cache.Get(base + ".ziphash"); err != nil {
+		return "", "", err
+	} else if ok {
+		if digest, hash, ok := strings.Cut(string(cached), "\n"); ok {
+			return digest, hash, nil
+		}
+	}
+
+	data, err := g.get(ctx, base+".zip", false)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256(data)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	hash, err := hashZip(data)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to hash %s.zip: %w", base, err)
+	}
+	if err := g.cache.Put(base+".ziphash", []byte(digest+"\n"+hash)); err != nil {
+		return "", "", err
+	}
+	return digest, hash, nil
+}
+
+// hashZip computes the go.sum hash of a module zip held in memory, like
+// dirhash.HashZip does for a zip file.
+func hashZip(data []byte) (string, error) {
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", err
+	}
+	files := make([]string, 0, len(z.File))
+	byName := map[string]*zip.File{}
+	for _, f := range z.File {
+		files = append(files, f.Name)
+		byName[f.Name] = f
+	}
+	return dirhash.Hash1(files, func(name string) (io.ReadCloser, error) {
+		f, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("file %s not found in zip", name)
+		}
+		return f.Open()
+	})
+}
+
+// get fetches a file from the proxy, from the cache first if the file is
+// immutable.
+func (g *goProxyCollector) get(ctx context.Context, file string, immutable bool) ([]byte, error) {
+	if immutable {
+		if data, ok, err := g.cache.Get(file); err != nil {
+			return nil, err
+		} else if ok {
+			return data, nil
+		}
+	}
+
+	if err := g.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.proxy+"/"+file, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s: %w", file, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusGone:
+		return nil, fmt.Errorf("%s: %w", file, errNotFound)
+	default:
+		return nil, fmt.Errorf("unable to fetch %s: %s", file, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s: %w", file, err)
+	}
+
+	if immutable {
+		if err := g.cache.Put(file, data); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,218 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goproxy
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/gomodule"
+)
+
+const greeterVersion = "v0.0.0-20230201000000-abcdef123456"
+
+// countingLimiter counts the requests made to the proxy
+type countingLimiter struct {
+	mu       sync.Mutex
+	requests int
+}
+
+func (c *countingLimiter) Wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests++
+	return nil
+}
+
+func testProxy(t *testing.T) string {
+	t.Helper()
+	dir, err := filepath.Abs("testdata/proxy")
+	if err != nil {
+		t.Fatalf("unable to find proxy fixture: %v", err)
+	}
+	return "file://" + filepath.ToSlash(dir)
+}
+
+func collect(t *testing.T, g *goProxyCollector) ([]*processor.Document, []gomodule.Module) {
+	t.Helper()
+	docChannel := make(chan *processor.Document, 10)
+	if err := g.RetrieveArtifacts(context.Background(), docChannel); err != nil {
+		t.Fatalf("goProxyCollector.RetrieveArtifacts() error = %v", err)
+	}
+	close(docChannel)
+	var docs []*processor.Document
+	var modules []gomodule.Module
+	for d := range docChannel {
+		var m gomodule.Module
+		if err := json.Unmarshal(d.Blob, &m); err != nil {
+			t.Fatalf("unable to unmarshal document: %v", err)
+		}
+		docs = append(docs, d)
+		modules = append(modules, m)
+	}
+	return docs, modules
+}
+
+func Test_goProxyCollector_RetrieveArtifacts(t *testing.T) {
+	proxy := testProxy(t)
+	hello100 := gomodule.Module{
+		Path:      "example.com/hello",
+		Version:   "v1.0.0",
+		Time:      time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC),
+		GoMod:     "module example.com/hello\n\ngo 1.19\n",
+		ZipDigest: "sha256:ff5c3d7ae764605f64363802fdd1f7f39c1043b5d9a73a3980f5ca3ee501e8d7",
+		ZipHash:   "h1:5uvltJqvuHAQZOZc33aiiQcZZzlnAPBqdbPe+F3fFs0=",
+		Proxy:     proxy,
+	}
+	hello110 := gomodule.Module{
+		Path:    "example.com/hello",
+		Version: "v1.1.0",
+		Time:    time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC),
+		GoMod: "module example.com/hello\n\ngo 1.19\n\nrequire (\n\tgithub.com/Example/greeter " + greeterVersion +
+			"\n\tgolang.org/x/text v0.8.0 // indirect\n)\n\nreplace github.com/Example/greeter => github.com/example/greeter-fork v0.1.1\n",
+		ZipDigest: "sha256:70cc31449eed7acb14d1757d8b99048f502751c8e30e5a489e59eb343d471c98",
+		ZipHash:   "h1:mwGaKdLmYhUMbzaKaE9u5VhXmJPGqGlS4V6JH8qwDGk=",
+		Proxy:     proxy,
+	}
+	greeter := gomodule.Module{
+		Path:      "github.com/Example/greeter",
+		Version:   greeterVersion,
+		Time:      time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		GoMod:     "module github.com/Example/greeter\n\ngo 1.19\n",
+		ZipDigest: "sha256:4f2948c8d6d5520ac417400b158c8033f4aa136bc5107738cca82f6f6595dd3b",
+		ZipHash:   "h1:lqA5RlO0fBl1OuzOQbiRZIXkwo1JG0uCfW9tL1L2W0s=",
+		Proxy:     proxy,
+	}
+
+	tests := []struct {
+		name     string
+		modules  []string
+		discover DiscoverFunc
+		want     []gomodule.Module
+	}{{
+		name:    "all versions of the modules",
+		modules: []string{"github.com/Example/greeter", "example.com/hello"},
+		want:    []gomodule.Module{hello100, hello110, greeter},
+	}, {
+		name:    "modules missing from the proxy are skipped",
+		modules: []string{"example.com/missing", "example.com/hello"},
+		want:    []gomodule.Module{hello100, hello110},
+	}, {
+		name:    "discovered modules",
+		modules: []string{"example.com/hello"},
+		discover: func(ctx context.Context) ([]string, error) {
+			return []string{"github.com/Example/greeter", "example.com/hello", "not a module"}, nil
+		},
+		want: []gomodule.Module{hello100, hello110, greeter},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGoProxyCollector(proxy, tt.modules, false, 0, WithDiscovery(tt.discover))
+			if err != nil {
+				t.Fatalf("NewGoProxyCollector() error = %v", err)
+			}
+			docs, got := collect(t, g)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("goProxyCollector.RetrieveArtifacts() = %+v, want %+v", got, tt.want)
+			}
+			for i, d := range docs {
+				if d.Type != processor.DocumentGoModule || d.Format != processor.FormatJSON {
+					t.Errorf("document %d has type %s and format %s", i, d.Type, d.Format)
+				}
+				wantSource := proxy + "/" + got[i].Path + "/@v/" + got[i].Version
+				if d.SourceInformation.Collector != GoProxyCollector || d.SourceInformation.Source != wantSource {
+					t.Errorf("document %d has source information %+v, want source %s", i, d.SourceInformation, wantSource)
+				}
+			}
+		})
+	}
+}
+
+func Test_goProxyCollector_Cache(t *testing.T) {
+	proxy := testProxy(t)
+	modules := []string{"example.com/hello", "example.com/missing", "github.com/Example/greeter"}
+	cache, err := NewDirCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDirCache() error = %v", err)
+	}
+
+	first := &countingLimiter{}
+	g, err := NewGoProxyCollector(proxy, modules, false, 0, WithCache(cache), WithRateLimiter(first))
+	if err != nil {
+		t.Fatalf("NewGoProxyCollector() error = %v", err)
+	}
+	if docs, _ := collect(t, g); len(docs) != 3 {
+		t.Errorf("expected 3 documents on the first run, got %d", len(docs))
+	}
+	// the lists, @latest and the missing v1.2.0 of example.com/hello are
+	// fetched on every run, the rest only once
+	if first.requests != 14 {
+		t.Errorf("expected 14 requests on the first run, got %d", first.requests)
+	}
+
+	second := &countingLimiter{}
+	g, err = NewGoProxyCollector(proxy, modules, false, 0, WithCache(cache), WithRateLimiter(second))
+	if err != nil {
+		t.Fatalf("NewGoProxyCollector() error = %v", err)
+	}
+	if docs, _ := collect(t, g); len(docs) != 3 {
+		t.Errorf("expected 3 documents from the cache, got %d", len(docs))
+	}
+	if second.requests != 5 {
+		t.Errorf("expected 5 requests with a warm cache, got %d", second.requests)
+	}
+
+	// versions already emitted by a collector are not emitted again
+	if docs, _ := collect(t, g); len(docs) != 0 {
+		t.Errorf("expected no documents on the next pass, got %d", len(docs))
+	}
+}
+
+func TestNewGoProxyCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxy   string
+		modules []string
+		wantErr bool
+	}{{
+		name:    "https proxy",
+		proxy:   DefaultProxy,
+		modules: []string{"github.com/spf13/cobra"},
+	}, {
+		name:    "unsupported scheme",
+		proxy:   "ftp://proxy.example.com",
+		wantErr: true,
+	}, {
+		name:    "invalid module path",
+		proxy:   DefaultProxy,
+		modules: []string{"cobra"},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGoProxyCollector(tt.proxy, tt.modules, false, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewGoProxyCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+module example.com/hello
+
+go 1.19
+
+require (
+	github.com/Example/greeter v0.0.0-20230201000000-abcdef123456
+	golang.org/x/text v0.8.0 // indirect
+)
+
+replace github.com/Example/greeter => github.com/example/greeter-fork v0.1.1
@@ -0,0 +1,3 @@
+module github.com/Example/greeter
+
+go 1.19
@@ -17,15 +17,20 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/handler/collector/oci/sigverify"
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/pkg/errors"
 	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types"
 	"github.com/regclient/regclient/types/manifest"
 	"github.com/regclient/regclient/types/ref"
 )
@@ -39,6 +44,29 @@ type ociCollector struct {
 	checkedDigest     map[string][]string
 	poll              bool
 	interval          time.Duration
+	trustRoots        *sigverify.TrustRoots
+	rcOpts            []regclient.Opt
+	now               func() time.Time
+}
+
+// Option configures optional behavior of the oci collector.
+type Option func(*ociCollector)
+
+// WithTrustRoots enables discovery of cosign and notation signatures on the
+// collected images. Found signatures are verified against the trust roots and
+// emitted as signature documents, including the ones that fail verification.
+func WithTrustRoots(trustRoots *sigverify.TrustRoots) Option {
+	return func(o *ociCollector) {
+		o.trustRoots = trustRoots
+	}
+}
+
+// WithRegClientOpts passes additional options to the registry client, for
+// example the configuration of registries that do not use TLS.
+func WithRegClientOpts(opts ...regclient.Opt) Option {
+	return func(o *ociCollector) {
+		o.rcOpts = append(o.rcOpts, opts...)
+	}
 }
 
 // NewOCICollector initializes the oci collector by passing in the repo and tag being collected.
@@ -46,13 +74,18 @@ type ociCollector struct {
 // repos in a given registry. For further details see issue #298
 //
 // Interval should be set to about 5 mins or more for production so that it doesn't clobber registries.
-func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration) *ociCollector {
-	return &ociCollector{
+func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSource, poll bool, interval time.Duration, opts ...Option) *ociCollector {
+	o := &ociCollector{
 		collectDataSource: collectDataSource,
 		checkedDigest:     map[string][]string{},
 		poll:              poll,
 		interval:          interval,
+		now:               time.Now,
+	}
+	for _, opt := range opts {
+		opt(o)
 	}
+	return o
 }
 
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
@@ -89,47 +122,32 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		return nil
 	}
 
-	if o.poll {
-		for {
-			err := populateRepoTags()
-			if err != nil {
-				return fmt.Errorf("unable to populate repotags: %w", err)
-			}
-
-			for repo, tags := range repoTags {
-				// when polling if tags are specified, it will never get any new tags
-				// that might be added after the fact. Defeating the point of the polling
-				if len(tags) > 0 {
-					return errors.New("image tag should not specified when using polling")
-				}
-				err = o.getTagsAndFetch(ctx, repo, tags, docChannel)
-				if err != nil {
-					return err
-				}
-			}
-			time.Sleep(o.interval)
-		}
-	} else {
+	return sdk.Poll(ctx, o.poll, o.interval, func(ctx context.Context) error {
 		err := populateRepoTags()
 		if err != nil {
 			return fmt.Errorf("unable to populate repotags: %w", err)
 		}
 
 		for repo, tags := range repoTags {
-			err := o.getTagsAndFetch(ctx, repo, tags, docChannel)
+			// when polling if tags are specified, it will never get any new tags
+			// that might be added after the fact. Defeating the point of the polling
+			if o.poll && len(tags) > 0 {
+				return errors.New("image tag should not specified when using polling")
+			}
+			err = o.getTagsAndFetch(ctx, repo, tags, docChannel)
 			if err != nil {
 				return err
 			}
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
 
 func (o *ociCollector) getTagsAndFetch(ctx context.Context, repo string, tags []string, docChannel chan<- *processor.Document) error {
 	rcOpts := []regclient.Opt{}
 	rcOpts = append(rcOpts, regclient.WithDockerCreds())
 	rcOpts = append(rcOpts, regclient.WithDockerCerts())
+	rcOpts = append(rcOpts, o.rcOpts...)
 
 	if len(tags) > 0 {
 		for _, tag := range tags {
@@ -260,7 +278,20 @@ func (o *ociCollector) fetchOCIArtifacts(ctx context.Context, repo string, rc *r
 						Source:    imageTag,
 					},
 				}
-				docChannel <- doc
+				if err := sdk.Emit(ctx, docChannel, doc); err != nil {
+					return err
+				}
+			}
+			o.checkedDigest[repo] = append(o.checkedDigest[repo], digestTag)
+		}
+	}
+
+	if !o.trustRoots.IsEmpty() {
+		digestTag := fmt.Sprintf("%v.sig", digestFormatted)
+		if !contains(o.checkedDigest[repo], digestTag) {
+			err = o.fetchSignatures(ctx, repo, rc, image, digest.String(), docChannel)
+			if err != nil {
+				return err
 			}
 			o.checkedDigest[repo] = append(o.checkedDigest[repo], digestTag)
 		}
@@ -269,6 +300,110 @@ func (o *ociCollector) fetchOCIArtifacts(ctx context.Context, repo string, rc *r
 	return nil
 }
 
+// fetchSignatures discovers the cosign signatures stored under the
+// `<algorithm>-<encoded>.sig` tag and the notation signatures attached as
+// referrers of the image with the given digest, verifies them and emits a
+// single signature document for the image. Signatures that fail verification
+// are recorded as failed rather than dropped.
+func (o *ociCollector) fetchSignatures(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, digest string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	verification := signature.Verification{
+		Artifact:   digest,
+		Signatures: []signature.Signature{},
+	}
+
+	// cosign signatures are stored as layers of the .sig tag, one per signature
+	sigTag := fmt.Sprintf("%v:%v.sig", repo, strings.Replace(digest, ":", "-", 1))
+	sigRef, err := ref.New(sigTag)
+	if err != nil {
+		return err
+	}
+	layers, err := getLayers(ctx, rc, sigRef)
+	if err != nil {
+		logger.Debugf("no cosign signatures found for %s: %v", sigTag, err)
+	}
+	for i, layer := range layers {
+		payload, err := getBlob(ctx, rc, sigRef, layer)
+		if err != nil {
+			return fmt.Errorf("failed pulling cosign signature layer %d: %w", i, err)
+		}
+		sig, err := o.trustRoots.VerifyCosign(digest, payload, layer.Annotations, o.now().UTC())
+		if err != nil {
+			logger.Warnf("cosign signature on %s@%s failed verification: %v", repo, digest, err)
+		}
+		verification.Signatures = append(verification.Signatures, sig)
+	}
+
+	// notation signatures are referrers of the image
+	image.Digest = digest
+	referrers, err := rc.ReferrerList(ctx, image)
+	if err != nil {
+		logger.Debugf("unable to list referrers for %s@%s: %v", repo, digest, err)
+	} else {
+		for _, desc := range referrers.Descriptors {
+			if desc.ArtifactType != sigverify.NotationArtifactType {
+				continue
+			}
+			sigRef := image
+			sigRef.Tag = ""
+			sigRef.Digest = desc.Digest.String()
+			layers, err := getLayers(ctx, rc, sigRef)
+			if err != nil {
+				return fmt.Errorf("failed retrieving notation signature %s: %w", desc.Digest, err)
+			}
+			for i, layer := range layers {
+				envelope, err := getBlob(ctx, rc, sigRef, layer)
+				if err != nil {
+					return fmt.Errorf("failed pulling notation signature layer %d: %w", i, err)
+				}
+				sig, err := o.trustRoots.VerifyNotation(digest, layer.MediaType, envelope, o.now().UTC())
+				if err != nil {
+					logger.Warnf("notation signature on %s@%s failed verification: %v", repo, digest, err)
+				}
+				verification.Signatures = append(verification.Signatures, sig)
+			}
+		}
+	}
+
+	if len(verification.Signatures) == 0 {
+		return nil
+	}
+	blob, err := json.Marshal(verification)
+	if err != nil {
+		return err
+	}
+	doc := &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentSignature,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: string(OCICollector),
+			Source:    fmt.Sprintf("%v@%v", repo, digest),
+		},
+	}
+	return sdk.Emit(ctx, docChannel, doc)
+}
+
+func getLayers(ctx context.Context, rc *regclient.RegClient, r ref.Ref) ([]types.Descriptor, error) {
+	m, err := rc.ManifestGet(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	mi, ok := m.(manifest.Imager)
+	if !ok {
+		return nil, fmt.Errorf("reference is not a known image media type")
+	}
+	return mi.GetLayers()
+}
+
+func getBlob(ctx context.Context, rc *regclient.RegClient, r ref.Ref, d types.Descriptor) ([]byte, error) {
+	blob, err := rc.BlobGet(ctx, r, d)
+	if err != nil {
+		return nil, err
+	}
+	return blob.RawBody()
+}
+
 func contains(elems []string, v string) bool {
 	for _, s := range elems {
 		if v == s {
@@ -17,6 +17,11 @@ package oci
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -26,8 +31,12 @@ import (
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/oci/sigverify"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
 	"github.com/pkg/errors"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/config"
 )
 
 func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
@@ -219,3 +228,197 @@ func toDataSource(ociValues []string) datasource.CollectSource {
 	}
 	return ds
 }
+
+// testRegistry serves a single image repository with manifests, blobs and
+// referrers from memory.
+type testRegistry struct {
+	repo      string
+	manifests map[string]testManifest
+	blobs     map[string][]byte
+	referrers map[string][]byte
+}
+
+type testManifest struct {
+	mediaType string
+	body      []byte
+}
+
+func (r *testRegistry) addManifest(reference string, mediaType string, body []byte) string {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+	r.manifests[reference] = testManifest{mediaType: mediaType, body: body}
+	r.manifests[digest] = testManifest{mediaType: mediaType, body: body}
+	return digest
+}
+
+func (r *testRegistry) addBlob(body []byte) string {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+	r.blobs[digest] = body
+	return digest
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/v2/" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	prefix := "/v2/" + r.repo + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	kind, reference, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, prefix), "/")
+	var body []byte
+	var mediaType string
+	switch kind {
+	case "manifests":
+		m, ok := r.manifests[reference]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, mediaType = m.body, m.mediaType
+	case "blobs":
+		b, ok := r.blobs[reference]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, mediaType = b, "application/octet-stream"
+	case "referrers":
+		b, ok := r.referrers[reference]
+		if !ok {
+			b = []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`)
+		}
+		body, mediaType = b, "application/vnd.oci.image.index.v1+json"
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+	w.Header().Set("Docker-Content-Digest", fmt.Sprintf("sha256:%x", sha256.Sum256(body)))
+	if req.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+// newSignedImageRegistry serves the signed image fixture as
+// guacsec/signed-image:v1 with its cosign and notation signatures.
+func newSignedImageRegistry(t *testing.T) *testRegistry {
+	t.Helper()
+	reg := &testRegistry{
+		repo:      "guacsec/signed-image",
+		manifests: map[string]testManifest{},
+		blobs:     map[string][]byte{},
+		referrers: map[string][]byte{},
+	}
+	reg.addBlob([]byte("{}"))
+	imageDigest := reg.addManifest("v1", "application/vnd.oci.image.manifest.v1+json", testdata.OCISignedImageManifest)
+
+	var annotations []map[string]string
+	if err := json.Unmarshal(testdata.OCICosignSignatures, &annotations); err != nil {
+		t.Fatalf("unable to parse cosign signatures: %v", err)
+	}
+	payloadDigest := reg.addBlob(testdata.OCICosignPayload)
+	var cosignLayers []string
+	for _, a := range annotations {
+		aj, _ := json.Marshal(a)
+		cosignLayers = append(cosignLayers, fmt.Sprintf(`{"mediaType":"application/vnd.dev.cosign.simplesigning.v1+json","digest":"%s","size":%d,"annotations":%s}`,
+			payloadDigest, len(testdata.OCICosignPayload), aj))
+	}
+	cosignManifest := fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[%s]}`,
+		strings.Join(cosignLayers, ","))
+	reg.addManifest(strings.Replace(imageDigest, ":", "-", 1)+".sig", "application/vnd.oci.image.manifest.v1+json", []byte(cosignManifest))
+
+	envelopeDigest := reg.addBlob(testdata.OCINotationSignature)
+	notationManifest := []byte(fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/vnd.cncf.notary.signature","config":{"mediaType":"application/vnd.oci.empty.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[{"mediaType":"application/jose+json","digest":"%s","size":%d}],"subject":{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"%s","size":%d}}`,
+		envelopeDigest, len(testdata.OCINotationSignature), imageDigest, len(testdata.OCISignedImageManifest)))
+	notationDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(notationManifest))
+	reg.manifests[notationDigest] = testManifest{mediaType: "application/vnd.oci.image.manifest.v1+json", body: notationManifest}
+	reg.referrers[imageDigest] = []byte(fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[{"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/vnd.cncf.notary.signature","digest":"%s","size":%d}]}`,
+		notationDigest, len(notationManifest)))
+	return reg
+}
+
+func Test_ociCollector_Signatures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	imageDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(testdata.OCISignedImageManifest))
+
+	trustRoots := sigverify.NewTrustRoots()
+	if err := trustRoots.AddCosignKey("release-key", testdata.CosignPublicKey); err != nil {
+		t.Fatalf("unable to add cosign key: %v", err)
+	}
+	if err := trustRoots.AddRoot("guac-test-root", testdata.SigningRootCA); err != nil {
+		t.Fatalf("unable to add root: %v", err)
+	}
+
+	server := httptest.NewServer(newSignedImageRegistry(t))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	repo := host + "/guacsec/signed-image"
+
+	g := NewOCICollector(ctx, toDataSource([]string{repo + ":v1"}), false, 0,
+		WithTrustRoots(trustRoots),
+		WithRegClientOpts(regclient.WithConfigHost(config.Host{Name: host, TLS: config.TLSDisabled})))
+	g.now = func() time.Time { return now }
+
+	docChan := make(chan *processor.Document, 10)
+	if err := g.RetrieveArtifacts(ctx, docChan); err != nil {
+		t.Fatalf("g.RetrieveArtifacts() error = %v", err)
+	}
+	close(docChan)
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+
+	wantVerification := signature.Verification{
+		Artifact: imageDigest,
+		Signatures: []signature.Signature{{
+			Signer:     "release-key",
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "release-key",
+		}, {
+			Signer:     "release-eng@example.com",
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "guac-test-root",
+		}, {
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		}, {
+			Signer:     "CN=release.example.com,O=Example,C=US",
+			Type:       signature.TypeNotation,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "guac-test-root",
+		}},
+	}
+	wantBlob, err := json.Marshal(wantVerification)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*processor.Document{{
+		Blob:   wantBlob,
+		Type:   processor.DocumentSignature,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: string(OCICollector),
+			Source:    repo + "@" + imageDigest,
+		},
+	}}
+	if len(docs) != len(want) {
+		t.Fatalf("g.RetrieveArtifacts() returned %d documents, want %d", len(docs), len(want))
+	}
+	for i := range docs {
+		if !dochelper.DocTreeEqual(dochelper.DocNode(docs[i]), dochelper.DocNode(want[i])) {
+			t.Errorf("g.RetrieveArtifacts() = %v, want %v", string(docs[i].Blob), string(want[i].Blob))
+		}
+	}
+}
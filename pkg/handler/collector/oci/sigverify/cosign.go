@@ -0,0 +1,151 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
+)
+
+const (
+	// CosignSignatureAnnotation holds the base64 encoded signature of a cosign
+	// signature layer.
+	CosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// CosignCertificateAnnotation holds the PEM encoded signing certificate of
+	// a keyless cosign signature.
+	CosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	// CosignChainAnnotation holds the PEM encoded intermediate certificates
+	// of a keyless cosign signature.
+	CosignChainAnnotation = "dev.sigstore.cosign/chain"
+)
+
+// cosignPayload is the simple signing payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// VerifyCosign verifies a cosign signature layer of the image with the given
+// digest. The payload is the layer blob and the annotations are the layer
+// annotations.
+//
+// Key-based signatures are verified against the trusted cosign keys, and
+// keyless signatures against the trusted roots. No transparency log is
+// consulted, so the certificate of a keyless signature is checked at the
+// start of its validity period.
+//
+// If the signature cannot be verified, a FAILED signature is returned
+// together with the reason.
+func (t *TrustRoots) VerifyCosign(digest string, payload []byte, annotations map[string]string, now time.Time) (signature.Signature, error) {
+	var signer string
+	var cert *x509.Certificate
+	var intermediates []*x509.Certificate
+	if certPEM, ok := annotations[CosignCertificateAnnotation]; ok {
+		certs, err := parseCertificates([]byte(certPEM))
+		if err != nil || len(certs) == 0 {
+			return failed(signature.TypeCosign, "", now, fmt.Errorf("invalid signing certificate: %v", err))
+		}
+		cert = certs[0]
+		signer = certificateIdentity(cert)
+		if chainPEM, ok := annotations[CosignChainAnnotation]; ok {
+			intermediates, err = parseCertificates([]byte(chainPEM))
+			if err != nil {
+				return failed(signature.TypeCosign, signer, now, fmt.Errorf("invalid certificate chain: %w", err))
+			}
+		}
+	}
+
+	encoded, ok := annotations[CosignSignatureAnnotation]
+	if !ok {
+		return failed(signature.TypeCosign, signer, now, errors.New("signature annotation not found"))
+	}
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return failed(signature.TypeCosign, signer, now, fmt.Errorf("unable to decode signature: %w", err))
+	}
+
+	var p cosignPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return failed(signature.TypeCosign, signer, now, fmt.Errorf("unable to parse signature payload: %w", err))
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return failed(signature.TypeCosign, signer, now, fmt.Errorf("signature payload is for %s, not %s", p.Critical.Image.DockerManifestDigest, digest))
+	}
+
+	if cert != nil {
+		root, err := t.verifyChain(cert, intermediates, cert.NotBefore)
+		if err != nil {
+			return failed(signature.TypeCosign, signer, now, err)
+		}
+		if err := verifySignature(cert.PublicKey, crypto.SHA256, false, false, payload, sig); err != nil {
+			return failed(signature.TypeCosign, signer, now, err)
+		}
+		return verified(signature.TypeCosign, signer, root, now), nil
+	}
+
+	if len(t.CosignKeys) == 0 {
+		return failed(signature.TypeCosign, "", now, errors.New("no trusted cosign keys"))
+	}
+	for name, key := range t.CosignKeys {
+		if verifySignature(key, crypto.SHA256, false, false, payload, sig) == nil {
+			return verified(signature.TypeCosign, name, name, now), nil
+		}
+	}
+	return failed(signature.TypeCosign, "", now, errors.New("signature does not match any trusted cosign key"))
+}
+
+func parseCertificates(pemBytes []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// certificateIdentity returns the identity a keyless signing certificate was
+// issued to.
+func certificateIdentity(cert *x509.Certificate) string {
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return cert.Subject.String()
+}
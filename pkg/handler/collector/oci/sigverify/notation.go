@@ -0,0 +1,143 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
+)
+
+const (
+	// NotationArtifactType is the artifact type of notation signature manifests.
+	NotationArtifactType = "application/vnd.cncf.notary.signature"
+	// NotationJWSMediaType is the media type of JWS signature envelopes.
+	NotationJWSMediaType = "application/jose+json"
+	// NotationCOSEMediaType is the media type of COSE signature envelopes.
+	NotationCOSEMediaType = "application/cose"
+)
+
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5C []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type jwsProtectedHeader struct {
+	Algorithm   string    `json:"alg"`
+	SigningTime time.Time `json:"io.cncf.notary.signingTime"`
+}
+
+type notationPayload struct {
+	TargetArtifact struct {
+		Digest string `json:"digest"`
+	} `json:"targetArtifact"`
+}
+
+type jwsAlgorithm struct {
+	hash crypto.Hash
+	pss  bool
+}
+
+var jwsAlgorithms = map[string]jwsAlgorithm{
+	"PS256": {hash: crypto.SHA256, pss: true},
+	"PS384": {hash: crypto.SHA384, pss: true},
+	"PS512": {hash: crypto.SHA512, pss: true},
+	"ES256": {hash: crypto.SHA256},
+	"ES384": {hash: crypto.SHA384},
+	"ES512": {hash: crypto.SHA512},
+}
+
+// VerifyNotation verifies a notation signature envelope of the image with the
+// given digest. The certificate chain in the envelope must lead to one of the
+// trusted roots at the signing time recorded in the envelope. Only JWS
+// envelopes are supported.
+//
+// If the signature cannot be verified, a FAILED signature is returned
+// together with the reason.
+func (t *TrustRoots) VerifyNotation(digest, mediaType string, envelope []byte, now time.Time) (signature.Signature, error) {
+	if mediaType != NotationJWSMediaType {
+		return failed(signature.TypeNotation, "", now, fmt.Errorf("unsupported signature envelope type %s", mediaType))
+	}
+	var env jwsEnvelope
+	if err := json.Unmarshal(envelope, &env); err != nil {
+		return failed(signature.TypeNotation, "", now, fmt.Errorf("unable to parse signature envelope: %w", err))
+	}
+	if len(env.Header.X5C) == 0 {
+		return failed(signature.TypeNotation, "", now, errors.New("signature envelope has no certificate chain"))
+	}
+	var certs []*x509.Certificate
+	for _, c := range env.Header.X5C {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return failed(signature.TypeNotation, "", now, fmt.Errorf("unable to decode certificate: %w", err))
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return failed(signature.TypeNotation, "", now, fmt.Errorf("unable to parse certificate: %w", err))
+		}
+		certs = append(certs, cert)
+	}
+	signer := certs[0].Subject.String()
+
+	protected, err := base64.RawURLEncoding.DecodeString(env.Protected)
+	if err != nil {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unable to decode protected header: %w", err))
+	}
+	var header jwsProtectedHeader
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unable to parse protected header: %w", err))
+	}
+	alg, ok := jwsAlgorithms[header.Algorithm]
+	if !ok {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unsupported signature algorithm %s", header.Algorithm))
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(env.Signature)
+	if err != nil {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unable to decode signature: %w", err))
+	}
+
+	root, err := t.verifyChain(certs[0], certs[1:], header.SigningTime)
+	if err != nil {
+		return failed(signature.TypeNotation, signer, now, err)
+	}
+	signingInput := []byte(env.Protected + "." + env.Payload)
+	if err := verifySignature(certs[0].PublicKey, alg.hash, alg.pss, true, signingInput, sig); err != nil {
+		return failed(signature.TypeNotation, signer, now, err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unable to decode payload: %w", err))
+	}
+	var p notationPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("unable to parse payload: %w", err))
+	}
+	if p.TargetArtifact.Digest != digest {
+		return failed(signature.TypeNotation, signer, now, fmt.Errorf("signature is for %s, not %s", p.TargetArtifact.Digest, digest))
+	}
+	return verified(signature.TypeNotation, signer, root, now), nil
+}
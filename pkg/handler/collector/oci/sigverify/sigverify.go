@@ -0,0 +1,192 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sigverify verifies cosign and notation signatures found on OCI
+// artifacts against a set of trusted keys and root certificates.
+package sigverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
+)
+
+// TrustRoots are the keys and certificates that signatures are verified
+// against. Each key and root is named, and the name is recorded as the trust
+// root of the signatures it verifies.
+type TrustRoots struct {
+	// CosignKeys are the public keys trusted for key-based cosign signatures.
+	CosignKeys map[string]crypto.PublicKey
+	// Roots are the root certificates trusted for keyless cosign and notation
+	// signatures.
+	Roots map[string]*x509.Certificate
+}
+
+// NewTrustRoots returns an empty set of trust roots.
+func NewTrustRoots() *TrustRoots {
+	return &TrustRoots{
+		CosignKeys: map[string]crypto.PublicKey{},
+		Roots:      map[string]*x509.Certificate{},
+	}
+}
+
+// AddCosignKey adds a PEM encoded public key trusted for cosign signatures.
+func (t *TrustRoots) AddCosignKey(name string, pemBytes []byte) error {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return fmt.Errorf("no PEM block found in cosign key %s", name)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to parse cosign key %s: %w", name, err)
+	}
+	t.CosignKeys[name] = key
+	return nil
+}
+
+// AddRoot adds a PEM encoded root certificate.
+func (t *TrustRoots) AddRoot(name string, pemBytes []byte) error {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return fmt.Errorf("no PEM block found in root certificate %s", name)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to parse root certificate %s: %w", name, err)
+	}
+	t.Roots[name] = cert
+	return nil
+}
+
+// IsEmpty returns true if there is nothing to verify signatures against.
+func (t *TrustRoots) IsEmpty() bool {
+	return t == nil || (len(t.CosignKeys) == 0 && len(t.Roots) == 0)
+}
+
+// verifyChain verifies that the leaf certificate chains up to one of the
+// roots at the given time and returns the name of that root.
+func (t *TrustRoots) verifyChain(leaf *x509.Certificate, intermediates []*x509.Certificate, at time.Time) (string, error) {
+	if len(t.Roots) == 0 {
+		return "", errors.New("no trusted root certificates")
+	}
+	roots := x509.NewCertPool()
+	for _, r := range t.Roots {
+		roots.AddCert(r)
+	}
+	inter := x509.NewCertPool()
+	for _, c := range intermediates {
+		inter.AddCert(c)
+	}
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: inter,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return "", fmt.Errorf("certificate chain verification failed: %w", err)
+	}
+	anchor := chains[0][len(chains[0])-1]
+	for name, r := range t.Roots {
+		if r.Equal(anchor) {
+			return name, nil
+		}
+	}
+	return "", errors.New("certificate chain does not end at a trusted root")
+}
+
+// failed returns a FAILED signature and the reason it failed.
+func failed(sigType signature.Type, signer string, now time.Time, err error) (signature.Signature, error) {
+	return signature.Signature{
+		Signer:     signer,
+		Type:       sigType,
+		Status:     signature.StatusFailed,
+		VerifiedAt: now,
+	}, err
+}
+
+func verified(sigType signature.Type, signer, trustRoot string, now time.Time) signature.Signature {
+	return signature.Signature{
+		Signer:     signer,
+		Type:       sigType,
+		Status:     signature.StatusVerified,
+		VerifiedAt: now,
+		TrustRoot:  trustRoot,
+	}
+}
+
+// verifySignature verifies sig over message with the public key. ECDSA
+// signatures are ASN.1 encoded unless rawECDSA is set, in which case they are
+// the concatenation of r and s as used by JWS.
+func verifySignature(pub crypto.PublicKey, hashFunc crypto.Hash, pss, rawECDSA bool, message, sig []byte) error {
+	var digest []byte
+	if hashFunc != 0 {
+		h := newHash(hashFunc)
+		h.Write(message)
+		digest = h.Sum(nil)
+	}
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !rawECDSA {
+			if !ecdsa.VerifyASN1(k, digest, sig) {
+				return errors.New("invalid ECDSA signature")
+			}
+			return nil
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if pss {
+			return rsa.VerifyPSS(k, hashFunc, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+		return rsa.VerifyPKCS1v15(k, hashFunc, digest, sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, message, sig) {
+			return errors.New("invalid ed25519 signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported public key type %T", pub)
+}
+
+func newHash(h crypto.Hash) hash.Hash {
+	switch h {
+	case crypto.SHA384:
+		return sha512.New384()
+	case crypto.SHA512:
+		return sha512.New()
+	}
+	return sha256.New()
+}
@@ -0,0 +1,217 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigverify
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
+)
+
+var now = time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+func testTrustRoots(t *testing.T) *TrustRoots {
+	t.Helper()
+	roots := NewTrustRoots()
+	if err := roots.AddCosignKey("release-key", testdata.CosignPublicKey); err != nil {
+		t.Fatalf("unable to add cosign key: %v", err)
+	}
+	if err := roots.AddRoot("guac-test-root", testdata.SigningRootCA); err != nil {
+		t.Fatalf("unable to add root: %v", err)
+	}
+	return roots
+}
+
+func signedImageDigest() string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(testdata.OCISignedImageManifest))
+}
+
+func cosignLayers(t *testing.T) []map[string]string {
+	t.Helper()
+	var layers []map[string]string
+	if err := json.Unmarshal(testdata.OCICosignSignatures, &layers); err != nil {
+		t.Fatalf("unable to parse cosign signatures: %v", err)
+	}
+	return layers
+}
+
+func TestVerifyCosign(t *testing.T) {
+	layers := cosignLayers(t)
+	tests := []struct {
+		name        string
+		roots       *TrustRoots
+		digest      string
+		annotations map[string]string
+		want        signature.Signature
+		wantErr     bool
+	}{{
+		name:        "trusted key",
+		roots:       testTrustRoots(t),
+		digest:      signedImageDigest(),
+		annotations: layers[0],
+		want: signature.Signature{
+			Signer:     "release-key",
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "release-key",
+		},
+	}, {
+		name:        "keyless",
+		roots:       testTrustRoots(t),
+		digest:      signedImageDigest(),
+		annotations: layers[1],
+		want: signature.Signature{
+			Signer:     "release-eng@example.com",
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "guac-test-root",
+		},
+	}, {
+		name:        "untrusted key",
+		roots:       testTrustRoots(t),
+		digest:      signedImageDigest(),
+		annotations: layers[2],
+		want: signature.Signature{
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}, {
+		name:        "keyless without trusted root",
+		roots:       NewTrustRoots(),
+		digest:      signedImageDigest(),
+		annotations: layers[1],
+		want: signature.Signature{
+			Signer:     "release-eng@example.com",
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}, {
+		name:        "signature for another image",
+		roots:       testTrustRoots(t),
+		digest:      "sha256:9e183c89765d92a440f44ac7059385c778cbadad0ee8fe3208360efb07c0ba09",
+		annotations: layers[0],
+		want: signature.Signature{
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}, {
+		name:        "missing signature",
+		roots:       testTrustRoots(t),
+		digest:      signedImageDigest(),
+		annotations: map[string]string{},
+		want: signature.Signature{
+			Type:       signature.TypeCosign,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.roots.VerifyCosign(tt.digest, testdata.OCICosignPayload, tt.annotations, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyCosign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("VerifyCosign() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyNotation(t *testing.T) {
+	signer := "CN=release.example.com,O=Example,C=US"
+	tests := []struct {
+		name      string
+		roots     *TrustRoots
+		digest    string
+		mediaType string
+		want      signature.Signature
+		wantErr   bool
+	}{{
+		name:      "trusted root",
+		roots:     testTrustRoots(t),
+		digest:    signedImageDigest(),
+		mediaType: NotationJWSMediaType,
+		want: signature.Signature{
+			Signer:     signer,
+			Type:       signature.TypeNotation,
+			Status:     signature.StatusVerified,
+			VerifiedAt: now,
+			TrustRoot:  "guac-test-root",
+		},
+	}, {
+		name:      "untrusted root",
+		roots:     NewTrustRoots(),
+		digest:    signedImageDigest(),
+		mediaType: NotationJWSMediaType,
+		want: signature.Signature{
+			Signer:     signer,
+			Type:       signature.TypeNotation,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}, {
+		name:      "signature for another image",
+		roots:     testTrustRoots(t),
+		digest:    "sha256:9e183c89765d92a440f44ac7059385c778cbadad0ee8fe3208360efb07c0ba09",
+		mediaType: NotationJWSMediaType,
+		want: signature.Signature{
+			Signer:     signer,
+			Type:       signature.TypeNotation,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}, {
+		name:      "unsupported envelope",
+		roots:     testTrustRoots(t),
+		digest:    signedImageDigest(),
+		mediaType: NotationCOSEMediaType,
+		want: signature.Signature{
+			Type:       signature.TypeNotation,
+			Status:     signature.StatusFailed,
+			VerifiedAt: now,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.roots.VerifyNotation(tt.digest, tt.mediaType, testdata.OCINotationSignature, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyNotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("VerifyNotation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// CheckpointStore remembers, per key, the time up to which a collector has
+// collected documents so that a restarted collector does not start over.
+// A key that has never been saved loads as the zero time.
+type CheckpointStore interface {
+	Load(ctx context.Context, key string) (time.Time, error)
+	Save(ctx context.Context, key string, checkpoint time.Time) error
+}
+
+type memoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]time.Time
+}
+
+// NewMemoryCheckpointStore returns a CheckpointStore that is lost when the
+// process exits.
+func NewMemoryCheckpointStore() CheckpointStore {
+	return &memoryCheckpointStore{checkpoints: map[string]time.Time{}}
+}
+
+func (m *memoryCheckpointStore) Load(ctx context.Context, key string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.checkpoints[key], nil
+}
+
+func (m *memoryCheckpointStore) Save(ctx context.Context, key string, checkpoint time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[key] = checkpoint
+	return nil
+}
+
+type fileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore returns a CheckpointStore that persists all keys as
+// a JSON object in the file at path. The file is created on the first save.
+func NewFileCheckpointStore(path string) CheckpointStore {
+	return &fileCheckpointStore{path: path}
+}
+
+func (f *fileCheckpointStore) read() (map[string]time.Time, error) {
+	checkpoints := map[string]time.Time{}
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read checkpoint file %s: %w", f.path, err)
+	}
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("unable to parse checkpoint file %s: %w", f.path, err)
+	}
+	return checkpoints, nil
+}
+
+func (f *fileCheckpointStore) Load(ctx context.Context, key string) (time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return checkpoints[key], nil
+}
+
+func (f *fileCheckpointStore) Save(ctx context.Context, key string, checkpoint time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	checkpoints, err := f.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = checkpoint
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return fmt.Errorf("unable to marshal checkpoints: %w", err)
+	}
+	if err := os.WriteFile(f.path, data, 0o600); err != nil {
+		return fmt.Errorf("unable to write checkpoint file %s: %w", f.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
+)
+
+func TestCheckpointStore(t *testing.T) {
+	tests := []struct {
+		name  string
+		store func(t *testing.T) sdk.CheckpointStore
+	}{{
+		name: "memory",
+		store: func(t *testing.T) sdk.CheckpointStore {
+			return sdk.NewMemoryCheckpointStore()
+		},
+	}, {
+		name: "file",
+		store: func(t *testing.T) sdk.CheckpointStore {
+			return sdk.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := tt.store(t)
+			got, err := s.Load(ctx, "missing")
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if !got.IsZero() {
+				t.Errorf("Load() = %v, want zero time", got)
+			}
+
+			want := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+			if err := s.Save(ctx, "a", want); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			if err := s.Save(ctx, "b", want.Add(time.Hour)); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			got, err = s.Load(ctx, "a")
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("Load() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFileCheckpointStore_persists(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	want := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := sdk.NewFileCheckpointStore(path).Save(ctx, "a", want); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := sdk.NewFileCheckpointStore(path).Load(ctx, "a")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("Load() = %v, want %v", got, want)
+	}
+
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sdk.NewFileCheckpointStore(path).Load(ctx, "a"); err == nil {
+		t.Errorf("Load() of corrupt file did not return an error")
+	}
+}
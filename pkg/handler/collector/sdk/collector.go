@@ -0,0 +1,158 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdk contains the building blocks needed to write a GUAC collector:
+// the Collector interface and document channel contract, a registry and
+// RunCollector harness that drive collectors, a polling loop, checkpoint
+// stores, rate limiting and credential resolution.
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	BufferChannelSize int = 1000
+)
+
+type Collector interface {
+	// RetrieveArtifacts collects the documents from the collector. It emits each collected
+	// document through the channel to be collected and processed by the upstream processor.
+	// The function should block until all the artifacts are collected and return a nil error
+	// or return an error from the collector crashing. This function can keep running and check
+	// for new artifacts as they are being uploaded by polling on an interval or run once and
+	// grab all the artifacts and end.
+	RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error
+	// Type returns the collector type
+	Type() string
+}
+
+// Emitter processes a document
+type Emitter func(*processor.Document) error
+
+// ErrHandler processes an error and returns a boolean representing if
+// the error was able to be gracefully handled
+type ErrHandler func(error) bool
+
+var (
+	ErrCollectorOverwrite = fmt.Errorf("the document collector is being overwritten")
+)
+
+// Emit sends the document on the channel, unless the context is canceled
+// first. Collectors should use Emit instead of writing to the channel directly
+// so that they do not block forever once the consumer has stopped.
+func Emit(ctx context.Context, docChannel chan<- *processor.Document, doc *processor.Document) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err() // nolint:wrapcheck
+	case docChannel <- doc:
+		return nil
+	}
+}
+
+// IsShutdown returns true if the error was caused by the context being
+// canceled or timing out, that is, the collector was asked to stop.
+func IsShutdown(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Registry holds collectors by type.
+type Registry map[string]Collector
+
+// Register adds the collector to the registry. If a collector of the same type
+// was already registered it is replaced and ErrCollectorOverwrite is returned.
+func (r Registry) Register(c Collector, collectorType string) error {
+	if _, ok := r[collectorType]; ok {
+		r[collectorType] = c
+		return fmt.Errorf("%w: %s", ErrCollectorOverwrite, collectorType)
+	}
+	r[collectorType] = c
+
+	return nil
+}
+
+// Collect runs all the registered collectors concurrently and passes every
+// document they emit to the emitter. It returns once all collectors are done,
+// or as soon as handleErr reports that an error could not be handled.
+func (r Registry) Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
+	// docChan to collect artifacts
+	docChan := make(chan *processor.Document, BufferChannelSize)
+	// errChan to receive error from collectors
+	errChan := make(chan error, len(r))
+	// logger
+	logger := logging.FromContext(ctx)
+
+	for _, collector := range r {
+		c := collector
+		go func() {
+			errChan <- c.RetrieveArtifacts(ctx, docChan)
+		}()
+	}
+
+	numCollectors := len(r)
+	collectorsDone := 0
+	for collectorsDone < numCollectors {
+		select {
+		case d := <-docChan:
+			if err := emitter(d); err != nil {
+				logger.Errorf("emit error: %v", err)
+			}
+		case err := <-errChan:
+			if !handleErr(err) {
+				return err
+			}
+			collectorsDone += 1
+		}
+	}
+	for len(docChan) > 0 {
+		d := <-docChan
+		if err := emitter(d); err != nil {
+			logger.Errorf("emit error: %v", err)
+		}
+	}
+	return nil
+}
+
+// RunCollector registers a single collector and runs it until it finishes
+// or the context is canceled. Stopping because of the context is treated as a
+// graceful shutdown and is not reported as an error. Any other error is
+// logged and returned.
+func RunCollector(ctx context.Context, c Collector, emitter Emitter) error {
+	logger := logging.FromContext(ctx)
+	registry := Registry{}
+	if err := registry.Register(c, c.Type()); err != nil {
+		return err
+	}
+
+	var collectErr error
+	handleErr := func(err error) bool {
+		if err == nil || IsShutdown(err) {
+			logger.Infof("collector %s ended gracefully", c.Type())
+			return true
+		}
+		logger.Errorf("collector %s ended with error: %v", c.Type(), err)
+		collectErr = err
+		return false
+	}
+	if err := registry.Collect(ctx, emitter, handleErr); err != nil {
+		return fmt.Errorf("collector %s failed: %w", c.Type(), err)
+	}
+	return collectErr
+}
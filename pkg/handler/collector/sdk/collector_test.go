@@ -0,0 +1,229 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const fakeStoreCollector = "FakeStoreCollector"
+
+// fakeObject and fakeStore stand in for a third party artifact store.
+type fakeObject struct {
+	name    string
+	updated time.Time
+	content []byte
+}
+
+type fakeStore struct {
+	mu      sync.Mutex
+	objects []fakeObject
+}
+
+func (s *fakeStore) put(o fakeObject) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects = append(s.objects, o)
+}
+
+func (s *fakeStore) list() []fakeObject {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeObject{}, s.objects...)
+}
+
+// newFakeStoreCollector is a complete example of a collector written with
+// the SDK: it only has to know how to list the store and turn objects into
+// documents, the SDK handles polling, checkpoints and rate limiting.
+func newFakeStoreCollector(store *fakeStore, opts ...sdk.Opt) *sdk.PollingCollector {
+	fetch := func(ctx context.Context, since time.Time, docChannel chan<- *processor.Document) error {
+		for _, o := range store.list() {
+			if !o.updated.After(since) {
+				continue
+			}
+			doc := &processor.Document{
+				Blob:   o.content,
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{
+					Collector: fakeStoreCollector,
+					Source:    "fake://" + o.name,
+				},
+			}
+			if err := sdk.Emit(ctx, docChannel, doc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return sdk.NewPollingCollector(fakeStoreCollector, fetch, opts...)
+}
+
+func sources(docs []*processor.Document) []string {
+	var s []string
+	for _, d := range docs {
+		s = append(s, d.SourceInformation.Source)
+	}
+	return s
+}
+
+func TestRunCollector_fakeStore(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store := &fakeStore{}
+	store.put(fakeObject{name: "a", updated: time.Now().Add(-time.Hour), content: []byte("a")})
+	store.put(fakeObject{name: "b", updated: time.Now().Add(-time.Hour), content: []byte("b")})
+
+	checkpoints := sdk.NewMemoryCheckpointStore()
+	var docs []*processor.Document
+	emit := func(d *processor.Document) error {
+		docs = append(docs, d)
+		return nil
+	}
+
+	if err := sdk.RunCollector(ctx, newFakeStoreCollector(store, sdk.WithCheckpointStore(checkpoints)), emit); err != nil {
+		t.Fatalf("RunCollector() error = %v", err)
+	}
+	if got, want := sources(docs), []string{"fake://a", "fake://b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RunCollector() = %v, want %v", got, want)
+	}
+
+	// A second run with the same checkpoint store only picks up new objects.
+	store.put(fakeObject{name: "c", updated: time.Now().Add(time.Hour), content: []byte("c")})
+	docs = nil
+	if err := sdk.RunCollector(ctx, newFakeStoreCollector(store, sdk.WithCheckpointStore(checkpoints)), emit); err != nil {
+		t.Fatalf("RunCollector() error = %v", err)
+	}
+	if got, want := sources(docs), []string{"fake://c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RunCollector() = %v, want %v", got, want)
+	}
+}
+
+func TestRunCollector_pollingShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	defer cancel()
+	store := &fakeStore{}
+	store.put(fakeObject{name: "a", updated: time.Now().Add(-time.Hour), content: []byte("a")})
+
+	var docs []*processor.Document
+	emit := func(d *processor.Document) error {
+		docs = append(docs, d)
+		// stop the collector once the first document has been received
+		cancel()
+		return nil
+	}
+	c := newFakeStoreCollector(store, sdk.WithPolling(time.Millisecond))
+	if err := sdk.RunCollector(ctx, c, emit); err != nil {
+		t.Fatalf("RunCollector() error = %v, want graceful shutdown", err)
+	}
+	if got, want := sources(docs), []string{"fake://a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RunCollector() = %v, want %v", got, want)
+	}
+}
+
+type errCollector struct {
+	err error
+}
+
+func (e *errCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	return e.err
+}
+
+func (e *errCollector) Type() string {
+	return "ErrCollector"
+}
+
+func TestRunCollector_error(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	want := errors.New("store unavailable")
+	err := sdk.RunCollector(ctx, &errCollector{err: want}, func(d *processor.Document) error { return nil })
+	if !errors.Is(err, want) {
+		t.Errorf("RunCollector() error = %v, want %v", err, want)
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := sdk.Registry{}
+	if err := r.Register(&errCollector{}, "ErrCollector"); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := r.Register(&errCollector{}, "ErrCollector"); !errors.Is(err, sdk.ErrCollectorOverwrite) {
+		t.Errorf("Register() error = %v, want %v", err, sdk.ErrCollectorOverwrite)
+	}
+	if len(r) != 1 {
+		t.Errorf("Registry has %d collectors, want 1", len(r))
+	}
+}
+
+func TestRegistry_Collect(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	storeA := &fakeStore{}
+	storeA.put(fakeObject{name: "a", updated: time.Now(), content: []byte("a")})
+	r := sdk.Registry{}
+	if err := r.Register(newFakeStoreCollector(storeA), fakeStoreCollector); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	failing := errors.New("failed")
+	if err := r.Register(&errCollector{err: failing}, "ErrCollector"); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	var handled []error
+	var docs []*processor.Document
+	err := r.Collect(ctx, func(d *processor.Document) error {
+		docs = append(docs, d)
+		return nil
+	}, func(err error) bool {
+		handled = append(handled, err)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if len(handled) != 2 {
+		t.Errorf("Collect() handled %d collector results, want 2", len(handled))
+	}
+	if got, want := sources(docs), []string{"fake://a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+
+	// an unhandled error stops the collection
+	err = r.Collect(ctx, func(d *processor.Document) error { return nil }, func(err error) bool {
+		return err == nil
+	})
+	if !errors.Is(err, failing) {
+		t.Errorf("Collect() error = %v, want %v", err, failing)
+	}
+}
+
+func TestEmit_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// unbuffered channel without a reader would block forever
+	docChannel := make(chan *processor.Document)
+	err := sdk.Emit(ctx, docChannel, &processor.Document{})
+	if !sdk.IsShutdown(err) {
+		t.Errorf("Emit() error = %v, want context canceled", err)
+	}
+}
@@ -0,0 +1,79 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrNoCredential = errors.New("no credential found")
+
+// CredentialSource looks up a credential. It returns false if the source does
+// not have the credential, so that the next source can be tried.
+type CredentialSource func() (string, bool, error)
+
+// FromEnv reads the credential from the named environment variable.
+func FromEnv(name string) CredentialSource {
+	return func() (string, bool, error) {
+		if env := os.Getenv(name); env != "" {
+			return env, true, nil
+		}
+		return "", false, nil
+	}
+}
+
+// FromFile reads the credential from the file at path, trimming surrounding
+// whitespace. A missing file is not an error.
+func FromFile(path string) CredentialSource {
+	return func() (string, bool, error) {
+		if path == "" {
+			return "", false, nil
+		}
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return "", false, nil
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("unable to read credential file %s: %w", path, err)
+		}
+		return strings.TrimSpace(string(data)), true, nil
+	}
+}
+
+// FromValue returns the value if it is set, e.g. from a command line flag.
+func FromValue(value string) CredentialSource {
+	return func() (string, bool, error) {
+		return value, value != "", nil
+	}
+}
+
+// ResolveCredential returns the credential from the first source that has it.
+// If no source has it ErrNoCredential is returned.
+func ResolveCredential(sources ...CredentialSource) (string, error) {
+	for _, source := range sources {
+		value, ok, err := source()
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return value, nil
+		}
+	}
+	return "", ErrNoCredential
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
+)
+
+func TestResolveCredential(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SDK_TEST_TOKEN", "from-env")
+
+	tests := []struct {
+		name    string
+		sources []sdk.CredentialSource
+		want    string
+		wantErr error
+	}{{
+		name:    "value first",
+		sources: []sdk.CredentialSource{sdk.FromValue("from-flag"), sdk.FromEnv("SDK_TEST_TOKEN")},
+		want:    "from-flag",
+	}, {
+		name:    "falls through empty value and unset env",
+		sources: []sdk.CredentialSource{sdk.FromValue(""), sdk.FromEnv("SDK_TEST_UNSET"), sdk.FromEnv("SDK_TEST_TOKEN")},
+		want:    "from-env",
+	}, {
+		name:    "file is trimmed",
+		sources: []sdk.CredentialSource{sdk.FromFile(filepath.Join(dir, "missing")), sdk.FromFile(tokenFile)},
+		want:    "from-file",
+	}, {
+		name:    "not found",
+		sources: []sdk.CredentialSource{sdk.FromEnv("SDK_TEST_UNSET"), sdk.FromFile("")},
+		wantErr: sdk.ErrNoCredential,
+	}, {
+		name:    "unreadable file",
+		sources: []sdk.CredentialSource{sdk.FromFile(dir)},
+		wantErr: errors.New("any"),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sdk.ResolveCredential(tt.sources...)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("ResolveCredential() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(tt.wantErr, sdk.ErrNoCredential) && !errors.Is(err, sdk.ErrNoCredential) {
+				t.Errorf("ResolveCredential() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveCredential() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Poll runs fn once, or if poll is set, repeatedly every interval until fn
+// returns an error or the context is canceled. On cancellation the context
+// error is returned so callers can tell a shutdown apart with IsShutdown.
+func Poll(ctx context.Context, poll bool, interval time.Duration, fn func(ctx context.Context) error) error {
+	for {
+		// If the context has been canceled it contains an err which we can throw.
+		if ctx.Err() != nil {
+			return ctx.Err() // nolint:wrapcheck
+		}
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		if !poll {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(interval):
+		}
+	}
+}
+
+// FetchFunc performs a single collection pass. It should emit every document
+// that changed after since, which is the zero time on the first run.
+type FetchFunc func(ctx context.Context, since time.Time, docChannel chan<- *processor.Document) error
+
+// PollingCollector is a Collector built from a FetchFunc. It takes care of
+// the polling loop, checkpointing the time of the last successful pass and
+// rate limiting the passes.
+type PollingCollector struct {
+	collectorType string
+	fetch         FetchFunc
+	poll          bool
+	interval      time.Duration
+	checkpoints   CheckpointStore
+	limiter       RateLimiter
+}
+
+type Opt func(*PollingCollector)
+
+// NewPollingCollector creates a collector of the given type. Without options
+// the collector runs once and keeps its checkpoint in memory.
+func NewPollingCollector(collectorType string, fetch FetchFunc, opts ...Opt) *PollingCollector {
+	p := &PollingCollector{
+		collectorType: collectorType,
+		fetch:         fetch,
+		checkpoints:   NewMemoryCheckpointStore(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(p *PollingCollector) {
+		p.poll = true
+		p.interval = interval
+	}
+}
+
+func WithCheckpointStore(store CheckpointStore) Opt {
+	return func(p *PollingCollector) {
+		p.checkpoints = store
+	}
+}
+
+func WithRateLimiter(limiter RateLimiter) Opt {
+	return func(p *PollingCollector) {
+		p.limiter = limiter
+	}
+}
+
+// RetrieveArtifacts runs the fetch function based on polling or one time
+func (p *PollingCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	return Poll(ctx, p.poll, p.interval, func(ctx context.Context) error {
+		if p.limiter != nil {
+			if err := p.limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		since, err := p.checkpoints.Load(ctx, p.collectorType)
+		if err != nil {
+			return fmt.Errorf("unable to load checkpoint: %w", err)
+		}
+		start := time.Now()
+		if err := p.fetch(ctx, since, docChannel); err != nil {
+			return fmt.Errorf("%s failed to fetch documents: %w", p.collectorType, err)
+		}
+		if err := p.checkpoints.Save(ctx, p.collectorType, start); err != nil {
+			return fmt.Errorf("unable to save checkpoint: %w", err)
+		}
+		return nil
+	})
+}
+
+// Type returns the collector type
+func (p *PollingCollector) Type() string {
+	return p.collectorType
+}
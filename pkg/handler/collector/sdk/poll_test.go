@@ -0,0 +1,116 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/collector/sdk"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestPoll(t *testing.T) {
+	failing := errors.New("failed")
+	tests := []struct {
+		name      string
+		poll      bool
+		failAfter int
+		timeout   time.Duration
+		wantRuns  int
+		wantErr   error
+	}{{
+		name:     "run once",
+		poll:     false,
+		wantRuns: 1,
+	}, {
+		name:      "poll until error",
+		poll:      true,
+		failAfter: 3,
+		timeout:   time.Second,
+		wantRuns:  3,
+		wantErr:   failing,
+	}, {
+		name:    "poll until canceled",
+		poll:    true,
+		timeout: 20 * time.Millisecond,
+		wantErr: context.DeadlineExceeded,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			runs := 0
+			err := sdk.Poll(ctx, tt.poll, time.Millisecond, func(ctx context.Context) error {
+				runs++
+				if tt.failAfter > 0 && runs == tt.failAfter {
+					return failing
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Poll() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantRuns > 0 && runs != tt.wantRuns {
+				t.Errorf("Poll() ran %d times, want %d", runs, tt.wantRuns)
+			}
+		})
+	}
+}
+
+func TestPollingCollector_rateLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	runs := 0
+	c := sdk.NewPollingCollector("Limited", func(ctx context.Context, since time.Time, docChannel chan<- *processor.Document) error {
+		runs++
+		return nil
+	}, sdk.WithPolling(0), sdk.WithRateLimiter(sdk.NewRateLimiter(time.Hour, 2)))
+
+	err := c.RetrieveArtifacts(ctx, make(chan *processor.Document))
+	if !sdk.IsShutdown(err) {
+		t.Errorf("RetrieveArtifacts() error = %v, want shutdown", err)
+	}
+	if runs != 2 {
+		t.Errorf("RetrieveArtifacts() fetched %d times, want 2", runs)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := sdk.NewRateLimiter(20*time.Millisecond, 1)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("3 requests with a burst of 1 took %v, want at least 40ms", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := l.Wait(canceled); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter blocks until the caller is allowed to make another request.
+type RateLimiter interface {
+	// Wait blocks until a request is allowed or the context is done.
+	Wait(ctx context.Context) error
+}
+
+type tokenBucket struct {
+	mu       sync.Mutex
+	every    time.Duration
+	burst    int
+	tokens   int
+	lastFill time.Time
+}
+
+// NewRateLimiter returns a token bucket RateLimiter that allows bursts of up
+// to burst requests and refills one token every interval.
+func NewRateLimiter(every time.Duration, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		every:    every,
+		burst:    burst,
+		tokens:   burst,
+		lastFill: time.Now(),
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns how long
+// to wait for the next one.
+func (t *tokenBucket) reserve(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.every > 0 {
+		refill := int(now.Sub(t.lastFill) / t.every)
+		if refill > 0 {
+			t.tokens += refill
+			t.lastFill = t.lastFill.Add(time.Duration(refill) * t.every)
+		}
+		if t.tokens >= t.burst {
+			t.tokens = t.burst
+			t.lastFill = now
+		}
+	} else {
+		t.tokens = t.burst
+	}
+	if t.tokens > 0 {
+		t.tokens--
+		return 0
+	}
+	return t.lastFill.Add(t.every).Sub(now)
+}
+
+func (t *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		wait := t.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Deprecation is the document emitted by certifiers that find a package
+// deprecated or renamed upstream in favor of another package.
+type Deprecation struct {
+	// Package is the purl of the superseded package. A purl without a version
+	// means every version of the package is superseded.
+	Package string `json:"package"`
+	// Successor is the purl of the package replacing it. It must carry a
+	// version if and only if Package does.
+	Successor string `json:"successor"`
+	// Reason is the upstream deprecation message or directive.
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+}
+
+// DeprecationProcessor processes deprecation documents.
+type DeprecationProcessor struct {
+}
+
+func (p *DeprecationProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentDeprecation {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDeprecation, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var dep Deprecation
+		if err := json.Unmarshal(d.Blob, &dep); err != nil {
+			return err
+		}
+		return dep.validate()
+	}
+
+	return fmt.Errorf("unable to support parsing of deprecation document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *DeprecationProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentDeprecation {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDeprecation, d.Type)
+	}
+
+	// Deprecation documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
+
+func (d *Deprecation) validate() error {
+	if !strings.HasPrefix(d.Package, "pkg:") {
+		return fmt.Errorf("package %q is not a purl", d.Package)
+	}
+	if !strings.HasPrefix(d.Successor, "pkg:") {
+		return fmt.Errorf("successor %q is not a purl", d.Successor)
+	}
+	if d.Package == d.Successor {
+		return fmt.Errorf("package %q cannot supersede itself", d.Package)
+	}
+	if hasVersion(d.Package) != hasVersion(d.Successor) {
+		return fmt.Errorf("package %q and successor %q must both be versioned or both unversioned", d.Package, d.Successor)
+	}
+	return nil
+}
+
+// hasVersion reports whether the purl has a version component. The version
+// is attached to the name, which is the last segment before the qualifiers
+// and subpath, so an '@' in a scoped npm namespace is not mistaken for it.
+func hasVersion(purl string) bool {
+	if i := strings.IndexAny(purl, "?#"); i >= 0 {
+		purl = purl[:i]
+	}
+	return strings.Contains(purl[strings.LastIndex(purl, "/")+1:], "@")
+}
@@ -0,0 +1,150 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var validDoc = []byte(`{
+  "package": "pkg:npm/request",
+  "successor": "pkg:npm/got",
+  "reason": "request has been deprecated, see https://github.com/request/request/issues/3142",
+  "since": "2020-02-11T00:00:00Z"
+}`)
+
+func TestDeprecationProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid deprecation document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: false,
+	}, {
+		name: "valid version level deprecation",
+		doc: processor.Document{
+			Blob:   []byte(`{"package": "pkg:golang/github.com/foo/bar@v1.2.0", "successor": "pkg:golang/github.com/foo/bar@v1.2.1"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: false,
+	}, {
+		name: "valid scoped npm deprecation",
+		doc: processor.Document{
+			Blob:   []byte(`{"package": "pkg:npm/@babel/polyfill", "successor": "pkg:npm/core-js"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: false,
+	}, {
+		name: "invalid document type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentScorecard,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid document format",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: true,
+	}, {
+		name: "package is not a purl",
+		doc: processor.Document{
+			Blob:   []byte(`{"package": "request", "successor": "pkg:npm/got"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: true,
+	}, {
+		name: "package supersedes itself",
+		doc: processor.Document{
+			Blob:   []byte(`{"package": "pkg:npm/got", "successor": "pkg:npm/got"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: true,
+	}, {
+		name: "mixed version levels",
+		doc: processor.Document{
+			Blob:   []byte(`{"package": "pkg:npm/%40babel/core@7.0.0", "successor": "pkg:npm/got"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := DeprecationProcessor{}
+			err := p.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("DeprecationProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestDeprecationProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "deprecation document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := DeprecationProcessor{}
+			actual, err := p.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("DeprecationProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("DeprecationProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Module is the document emitted by the Go module proxy collector for one
+// version of a module, with everything the proxy serves for it.
+type Module struct {
+	// Path is the module path, e.g. github.com/spf13/cobra
+	Path    string `json:"path"`
+	Version string `json:"version"`
+	// Time is the commit time of the version, from its .info file
+	Time time.Time `json:"time"`
+	// GoMod is the content of the go.mod file of the version
+	GoMod string `json:"goMod"`
+	// ZipDigest is the sha256 digest of the module zip, as sha256:<hex>
+	ZipDigest string `json:"zipDigest"`
+	// ZipHash is the hash of the module zip as recorded in go.sum (h1:...)
+	ZipHash string `json:"zipHash"`
+	// Proxy is the URL of the proxy the module was fetched from
+	Proxy string `json:"proxy"`
+}
+
+// GoModuleProcessor processes Go module documents.
+type GoModuleProcessor struct {
+}
+
+func (p *GoModuleProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentGoModule {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentGoModule, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var m Module
+		if err := json.Unmarshal(d.Blob, &m); err != nil {
+			return err
+		}
+		return m.validate()
+	}
+
+	return fmt.Errorf("unable to support parsing of go module document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *GoModuleProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentGoModule {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentGoModule, d.Type)
+	}
+
+	// Go module documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
+
+func (m *Module) validate() error {
+	if m.Path == "" {
+		return fmt.Errorf("missing module path")
+	}
+	if !strings.HasPrefix(m.Version, "v") {
+		return fmt.Errorf("version %q of module %s is not a semantic version", m.Version, m.Path)
+	}
+	if m.GoMod == "" {
+		return fmt.Errorf("missing go.mod of module %s@%s", m.Path, m.Version)
+	}
+	if !strings.HasPrefix(m.ZipDigest, "sha256:") {
+		return fmt.Errorf("zip digest %q of module %s@%s is not a sha256 digest", m.ZipDigest, m.Path, m.Version)
+	}
+	if b, err := hex.DecodeString(strings.TrimPrefix(m.ZipDigest, "sha256:")); err != nil || len(b) != 32 {
+		return fmt.Errorf("zip digest %q of module %s@%s is not a sha256 digest", m.ZipDigest, m.Path, m.Version)
+	}
+	if !strings.HasPrefix(m.ZipHash, "h1:") {
+		return fmt.Errorf("zip hash %q of module %s@%s is not a go.sum hash", m.ZipHash, m.Path, m.Version)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var validDoc = []byte(`{
+  "path": "example.com/hello",
+  "version": "v1.1.0",
+  "time": "2023-03-01T00:00:00Z",
+  "goMod": "module example.com/hello\n",
+  "zipDigest": "sha256:0b1a1ed6ee8a5b1a1d1dfa6c4b0e5e1a7d1f8e4b3d7c6e1b0a9f8e7d6c5b4a39",
+  "zipHash": "h1:uVZVdCaX8l+dG1fKzD5pG2BKwaJ0M1vG3/qVqOjrBSc=",
+  "proxy": "https://proxy.golang.org"
+}`)
+
+func TestGoModuleProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid go module document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: false,
+	}, {
+		name: "invalid document type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentDeprecation,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid document format",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: true,
+	}, {
+		name: "missing module path",
+		doc: processor.Document{
+			Blob:   []byte(`{"version": "v1.1.0", "goMod": "module example.com/hello\n", "zipDigest": "sha256:0b1a1ed6ee8a5b1a1d1dfa6c4b0e5e1a7d1f8e4b3d7c6e1b0a9f8e7d6c5b4a39", "zipHash": "h1:uVZVdCaX8l+dG1fKzD5pG2BKwaJ0M1vG3/qVqOjrBSc="}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: true,
+	}, {
+		name: "version is not semver",
+		doc: processor.Document{
+			Blob:   []byte(`{"path": "example.com/hello", "version": "1.1.0", "goMod": "module example.com/hello\n", "zipDigest": "sha256:0b1a1ed6ee8a5b1a1d1dfa6c4b0e5e1a7d1f8e4b3d7c6e1b0a9f8e7d6c5b4a39", "zipHash": "h1:uVZVdCaX8l+dG1fKzD5pG2BKwaJ0M1vG3/qVqOjrBSc="}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: true,
+	}, {
+		name: "zip digest is not sha256",
+		doc: processor.Document{
+			Blob:   []byte(`{"path": "example.com/hello", "version": "v1.1.0", "goMod": "module example.com/hello\n", "zipDigest": "sha1:2fd4e1c67a2d28fced849ee1bb76e7391b93eb12", "zipHash": "h1:uVZVdCaX8l+dG1fKzD5pG2BKwaJ0M1vG3/qVqOjrBSc="}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: true,
+	}, {
+		name: "missing zip hash",
+		doc: processor.Document{
+			Blob:   []byte(`{"path": "example.com/hello", "version": "v1.1.0", "goMod": "module example.com/hello\n", "zipDigest": "sha256:0b1a1ed6ee8a5b1a1d1dfa6c4b0e5e1a7d1f8e4b3d7c6e1b0a9f8e7d6c5b4a39"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := GoModuleProcessor{}
+			err := p.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("GoModuleProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestGoModuleProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "go module document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentGoModule,
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := GoModuleProcessor{}
+			actual, err := p.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("GoModuleProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("GoModuleProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -20,13 +20,17 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/blobstore"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/cyclonedx"
+	"github.com/guacsec/guac/pkg/handler/processor/deprecation"
 	"github.com/guacsec/guac/pkg/handler/processor/dsse"
+	"github.com/guacsec/guac/pkg/handler/processor/gomodule"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
 	"github.com/guacsec/guac/pkg/handler/processor/ite6"
 	"github.com/guacsec/guac/pkg/handler/processor/scorecard"
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
 	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/logging"
 	uuid "github.com/satori/go.uuid"
@@ -34,6 +38,8 @@ import (
 
 var (
 	documentProcessors = map[processor.DocumentType]processor.DocumentProcessor{}
+	documentStore      blobstore.Store
+	parserOptions      processor.ParserOptions
 )
 
 func init() {
@@ -44,6 +50,9 @@ func init() {
 	_ = RegisterDocumentProcessor(&spdx.SPDXProcessor{}, processor.DocumentSPDX)
 	_ = RegisterDocumentProcessor(&scorecard.ScorecardProcessor{}, processor.DocumentScorecard)
 	_ = RegisterDocumentProcessor(&cyclonedx.CycloneDXProcessor{}, processor.DocumentCycloneDX)
+	_ = RegisterDocumentProcessor(&signature.SignatureProcessor{}, processor.DocumentSignature)
+	_ = RegisterDocumentProcessor(&deprecation.DeprecationProcessor{}, processor.DocumentDeprecation)
+	_ = RegisterDocumentProcessor(&gomodule.GoModuleProcessor{}, processor.DocumentGoModule)
 }
 
 func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.DocumentType) error {
@@ -55,6 +64,18 @@ func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.Docume
 	return nil
 }
 
+// RegisterBlobStore sets the store that the original documents are kept in
+// once processed. Passing nil stops storing documents.
+func RegisterBlobStore(s blobstore.Store) {
+	documentStore = s
+}
+
+// RegisterParserOptions sets the options that the ingestor parses the
+// processed documents with.
+func RegisterParserOptions(opts processor.ParserOptions) {
+	parserOptions = opts
+}
+
 // Subscribe is used by NATS JetStream to stream the documents received from the collector
 // and process them them via Process
 func Subscribe(ctx context.Context, transportFunc func(processor.DocumentTree) error) error {
@@ -106,9 +127,64 @@ func Process(ctx context.Context, i *processor.Document) (processor.DocumentTree
 	if err != nil {
 		return nil, err
 	}
+	if documentStore != nil {
+		hash, err := documentStore.Put(ctx, i.Blob, mediaType(i))
+		if err != nil {
+			return nil, fmt.Errorf("unable to store document: %w", err)
+		}
+		setDocumentHash(node, hash)
+	}
+	setParserOptions(node, parserOptions)
 	return processor.DocumentTree(node), nil
 }
 
+// setDocumentHash records the hash of the original document on it and on
+// every document unpacked from it.
+func setDocumentHash(node *processor.DocumentNode, hash string) {
+	node.Document.SourceInformation.DocumentHash = hash
+	for _, c := range node.Children {
+		setDocumentHash(c, hash)
+	}
+}
+
+// setParserOptions records the options to parse the document with on it and
+// on every document unpacked from it.
+func setParserOptions(node *processor.DocumentNode, opts processor.ParserOptions) {
+	node.Document.ParserOptions = opts
+	for _, c := range node.Children {
+		setParserOptions(c, opts)
+	}
+}
+
+// mediaType returns the media type to serve the original document with.
+func mediaType(i *processor.Document) string {
+	switch i.Type {
+	case processor.DocumentDSSE:
+		return "application/vnd.dsse.envelope.v1+json"
+	case processor.DocumentITE6SLSA, processor.DocumentITE6Generic, processor.DocumentITE6Vul:
+		return "application/vnd.in-toto+json"
+	case processor.DocumentSPDX:
+		if i.Format == processor.FormatJSON {
+			return "application/spdx+json"
+		}
+		return "text/spdx"
+	case processor.DocumentCycloneDX:
+		if i.Format == processor.FormatXML {
+			return "application/vnd.cyclonedx+xml"
+		}
+		return "application/vnd.cyclonedx+json"
+	}
+	switch i.Format {
+	case processor.FormatJSON:
+		return "application/json"
+	case processor.FormatJSONLines:
+		return "application/jsonl"
+	case processor.FormatXML:
+		return "application/xml"
+	}
+	return "application/octet-stream"
+}
+
 func processHelper(ctx context.Context, doc *processor.Document) (*processor.DocumentNode, error) {
 	ds, err := processDocument(ctx, doc)
 	if err != nil {
@@ -16,9 +16,11 @@
 package process
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/guacsec/guac/internal/testing/dochelper"
 	nats_test "github.com/guacsec/guac/internal/testing/nats"
 	"github.com/guacsec/guac/internal/testing/simpledoc"
+	"github.com/guacsec/guac/pkg/blobstore"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
@@ -549,6 +552,79 @@ func Test_SimpleDocProcessTest(t *testing.T) {
 	}
 }
 
+func Test_ProcessBlobStore(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	doc := processor.Document{
+		Blob: []byte(`{
+			"issuer": "google.com",
+			"info": "this is a cool document",
+			"nested": [{
+				"issuer": "google.com",
+				"info": "this is a cooler nested doc 1"
+			}]
+		}`),
+		Type:              simpledoc.SimpleDocType,
+		Format:            processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{},
+	}
+	original := append([]byte{}, doc.Blob...)
+
+	err := RegisterDocumentProcessor(&simpledoc.SimpleDocProc{}, simpledoc.SimpleDocType)
+	if err != nil {
+		if !strings.Contains(err.Error(), "the document processor is being overwritten") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	err = guesser.RegisterDocumentTypeGuesser(&simpledoc.SimpleDocProc{}, "simple-doc-guesser")
+	if err != nil {
+		if !strings.Contains(err.Error(), "the document type guesser is being overwritten") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	store, err := blobstore.NewFileStore(ctx, t.TempDir())
+	if err != nil {
+		t.Fatalf("unable to create blob store: %v", err)
+	}
+	RegisterBlobStore(store)
+	defer RegisterBlobStore(nil)
+
+	docTree, err := Process(ctx, &doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash := blobstore.Hash(original)
+	var check func(n *processor.DocumentNode)
+	check = func(n *processor.DocumentNode) {
+		if n.Document.SourceInformation.DocumentHash != hash {
+			t.Errorf("document hash = %q, expected %q", n.Document.SourceInformation.DocumentHash, hash)
+		}
+		for _, c := range n.Children {
+			check(c)
+		}
+	}
+	check(docTree)
+	if len(docTree.Children) != 1 {
+		t.Errorf("expected the nested document to be unpacked, got %d children", len(docTree.Children))
+	}
+
+	b, err := store.Get(ctx, hash)
+	if err != nil {
+		t.Fatalf("unable to get stored document: %v", err)
+	}
+	defer b.Close()
+	stored, err := io.ReadAll(b)
+	if err != nil {
+		t.Fatalf("unable to read stored document: %v", err)
+	}
+	if !bytes.Equal(stored, original) {
+		t.Errorf("stored document does not match, got\n%s\nexpected\n%s", stored, original)
+	}
+	if b.MediaType != "application/json" {
+		t.Errorf("stored media type = %s, expected application/json", b.MediaType)
+	}
+}
+
 func Test_ProcessSubscribe(t *testing.T) {
 	natsTest := nats_test.NewNatsTestServer()
 	url, err := natsTest.EnableJetStreamForTest()
@@ -722,3 +798,55 @@ func testPublish(ctx context.Context, d *processor.Document) error {
 	}
 	return nil
 }
+
+func Test_ProcessParserOptions(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	doc := processor.Document{
+		Blob: []byte(`{
+			"issuer": "google.com",
+			"info": "this is a cool document",
+			"nested": [{
+				"issuer": "google.com",
+				"info": "this is a cooler nested doc 1"
+			}]
+		}`),
+		Type:              simpledoc.SimpleDocType,
+		Format:            processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{},
+	}
+
+	err := RegisterDocumentProcessor(&simpledoc.SimpleDocProc{}, simpledoc.SimpleDocType)
+	if err != nil {
+		if !strings.Contains(err.Error(), "the document processor is being overwritten") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	err = guesser.RegisterDocumentTypeGuesser(&simpledoc.SimpleDocProc{}, "simple-doc-guesser")
+	if err != nil {
+		if !strings.Contains(err.Error(), "the document type guesser is being overwritten") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	opts := processor.ParserOptions{SPDXFiles: processor.SPDXFilesSkip}
+	RegisterParserOptions(opts)
+	defer RegisterParserOptions(processor.ParserOptions{})
+
+	docTree, err := Process(ctx, &doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var check func(n *processor.DocumentNode)
+	check = func(n *processor.DocumentNode) {
+		if n.Document.ParserOptions != opts {
+			t.Errorf("parser options = %+v, expected %+v", n.Document.ParserOptions, opts)
+		}
+		for _, c := range n.Children {
+			check(c)
+		}
+	}
+	check(docTree)
+	if len(docTree.Children) != 1 {
+		t.Errorf("expected the nested document to be unpacked, got %d children", len(docTree.Children))
+	}
+}
@@ -15,6 +15,10 @@
 
 package processor
 
+import (
+	"fmt"
+)
+
 type DocumentProcessor interface {
 	// ValidateSchema validates the schema of the document
 	ValidateSchema(i *Document) error
@@ -35,6 +39,7 @@ type Document struct {
 	Type              DocumentType
 	Format            FormatType
 	SourceInformation SourceInformation
+	ParserOptions     ParserOptions
 }
 
 // DocumentTree describes the output of a document tree that resulted from
@@ -60,6 +65,9 @@ const (
 	DocumentJsonLines   DocumentType = "JSON_LINES"
 	DocumentScorecard   DocumentType = "SCORECARD"
 	DocumentCycloneDX   DocumentType = "CycloneDX"
+	DocumentSignature   DocumentType = "SIGNATURE"
+	DocumentDeprecation DocumentType = "DEPRECATION"
+	DocumentGoModule    DocumentType = "GO_MODULE"
 	DocumentUnknown     DocumentType = "UNKNOWN"
 )
 
@@ -80,4 +88,42 @@ type SourceInformation struct {
 	Collector string
 	// Source describes the source which the collector got this information
 	Source string
+	// DocumentHash is the hash under which the original document was kept in
+	// the blob store. It is empty if no blob store is configured.
+	DocumentHash string
+}
+
+// ParserOptions controls how the ingestor parses a document. They are set by
+// the processor, so that they travel with the document to the ingestor.
+type ParserOptions struct {
+	// SPDXFiles controls the ingestion of the files section of SPDX
+	// documents. Empty means SPDXFilesFull.
+	SPDXFiles SPDXFileMode
+}
+
+// SPDXFileMode describes how much of the files section of SPDX documents is
+// ingested. Documents with many files can add a large number of nodes to the
+// graph that are rarely queried.
+type SPDXFileMode string
+
+// SPDXFiles* is the enumerables of SPDXFileMode
+const (
+	// SPDXFilesFull ingests a package named after each file and checksum,
+	// and an artifact for each checksum.
+	SPDXFilesFull SPDXFileMode = "full"
+	// SPDXFilesChecksumsOnly ingests the artifacts, but the packages of the
+	// files are only named after their checksum, so files with the same
+	// content share them.
+	SPDXFilesChecksumsOnly SPDXFileMode = "checksums-only"
+	// SPDXFilesSkip drops the files section, keeping the package level data.
+	SPDXFilesSkip SPDXFileMode = "skip"
+)
+
+// ParseSPDXFileMode returns the SPDXFileMode named s.
+func ParseSPDXFileMode(s string) (SPDXFileMode, error) {
+	switch mode := SPDXFileMode(s); mode {
+	case SPDXFilesFull, SPDXFilesChecksumsOnly, SPDXFilesSkip:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid SPDX file mode %q, expected one of %s, %s or %s", s, SPDXFilesFull, SPDXFilesChecksumsOnly, SPDXFilesSkip)
 }
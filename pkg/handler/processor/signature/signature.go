@@ -0,0 +1,127 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Type is the kind of signature that was found.
+type Type string
+
+const (
+	TypeCosign   Type = "cosign"
+	TypeNotation Type = "notation"
+	TypeGPG      Type = "gpg"
+)
+
+// Status is the outcome of verifying a signature.
+type Status string
+
+const (
+	StatusVerified Status = "VERIFIED"
+	StatusFailed   Status = "FAILED"
+)
+
+// Verification is the document emitted by collectors that discover signatures
+// on an artifact and verify them. Signatures that could not be verified are
+// included with StatusFailed.
+type Verification struct {
+	// Artifact is the digest of the signed artifact, as algorithm:encoded
+	Artifact   string      `json:"artifact"`
+	Signatures []Signature `json:"signatures"`
+}
+
+// Signature is a single signature found on the artifact.
+type Signature struct {
+	// Signer is the identity of the signer: the name of the trusted key, or
+	// the email, URI or subject of the signing certificate. It is empty if the
+	// signer could not be determined.
+	Signer     string    `json:"signer"`
+	Type       Type      `json:"type"`
+	Status     Status    `json:"status"`
+	VerifiedAt time.Time `json:"verifiedAt"`
+	// TrustRoot is the name of the key or root certificate that the signature
+	// was verified against. It is empty for failed signatures.
+	TrustRoot string `json:"trustRoot,omitempty"`
+}
+
+// SignatureProcessor processes signature verification documents.
+type SignatureProcessor struct {
+}
+
+func (p *SignatureProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentSignature {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSignature, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var v Verification
+		if err := json.Unmarshal(d.Blob, &v); err != nil {
+			return err
+		}
+		return v.validate()
+	}
+
+	return fmt.Errorf("unable to support parsing of signature document format: %v", d.Format)
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *SignatureProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentSignature {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSignature, d.Type)
+	}
+
+	// Signature documents don't unpack into additional documents.
+	return []*processor.Document{}, nil
+}
+
+func (v *Verification) validate() error {
+	algorithm, encoded, ok := strings.Cut(v.Artifact, ":")
+	if !ok || algorithm == "" || encoded == "" {
+		return fmt.Errorf("artifact digest %q is not of the form algorithm:encoded", v.Artifact)
+	}
+	if len(v.Signatures) == 0 {
+		return fmt.Errorf("missing signatures")
+	}
+	for i, s := range v.Signatures {
+		switch s.Type {
+		case TypeCosign, TypeNotation, TypeGPG:
+		default:
+			return fmt.Errorf("signature %d has unknown type %q", i, s.Type)
+		}
+		switch s.Status {
+		case StatusVerified:
+			if s.TrustRoot == "" {
+				return fmt.Errorf("verified signature %d is missing the trust root", i)
+			}
+		case StatusFailed:
+		default:
+			return fmt.Errorf("signature %d has unknown status %q", i, s.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var validDoc = []byte(`{
+  "artifact": "sha256:b1a5d22bbbb5de8e8e4a4ab9bb3f7a2fa7d3b6d1ab2e8f1f4f3fb8e2aa2e6c4e",
+  "signatures": [
+    {"signer": "release-eng", "type": "cosign", "status": "VERIFIED", "verifiedAt": "2023-03-01T00:00:00Z", "trustRoot": "release-eng"},
+    {"signer": "", "type": "notation", "status": "FAILED", "verifiedAt": "2023-03-01T00:00:00Z"}
+  ]
+}`)
+
+func TestSignatureProcessor_ValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid signature document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: false,
+	}, {
+		name: "invalid document type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentScorecard,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid document format",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}, {
+		name: "artifact without algorithm",
+		doc: processor.Document{
+			Blob:   []byte(`{"artifact": "b1a5d22b", "signatures": [{"type": "cosign", "status": "FAILED"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}, {
+		name: "no signatures",
+		doc: processor.Document{
+			Blob:   []byte(`{"artifact": "sha256:b1a5d22b", "signatures": []}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}, {
+		name: "unknown signature type",
+		doc: processor.Document{
+			Blob:   []byte(`{"artifact": "sha256:b1a5d22b", "signatures": [{"type": "x509", "status": "FAILED"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}, {
+		name: "unknown signature status",
+		doc: processor.Document{
+			Blob:   []byte(`{"artifact": "sha256:b1a5d22b", "signatures": [{"type": "gpg", "status": "UNKNOWN"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}, {
+		name: "verified signature without trust root",
+		doc: processor.Document{
+			Blob:   []byte(`{"artifact": "sha256:b1a5d22b", "signatures": [{"signer": "a", "type": "gpg", "status": "VERIFIED"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := SignatureProcessor{}
+			err := p.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SignatureProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestSignatureProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "signature document",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSignature,
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   validDoc,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentUnknown,
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := SignatureProcessor{}
+			actual, err := p.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SignatureProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("SignatureProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
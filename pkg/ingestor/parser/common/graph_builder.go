@@ -81,6 +81,13 @@ func addMetadata(predicates *assembler.IngestPredicates, foundIdentities []Trust
 	for _, v := range predicates.HasSlsa {
 		v.HasSlsa.Collector = srcInfo.Collector
 		v.HasSlsa.Origin = srcInfo.Source
+		v.HasSlsa.DocumentHash = documentHash(srcInfo)
+	}
+
+	for _, v := range predicates.HasSBOM {
+		v.HasSBOM.Collector = srcInfo.Collector
+		v.HasSBOM.Origin = srcInfo.Source
+		v.HasSBOM.DocumentHash = documentHash(srcInfo)
 	}
 
 	for _, v := range predicates.CertifyVuln {
@@ -92,4 +99,29 @@ func addMetadata(predicates *assembler.IngestPredicates, foundIdentities []Trust
 		v.IsVuln.Collector = srcInfo.Collector
 		v.IsVuln.Origin = srcInfo.Source
 	}
+
+	for _, v := range predicates.CertifySigned {
+		v.CertifySigned.Collector = srcInfo.Collector
+		v.CertifySigned.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.SupersededBy {
+		v.SupersededBy.Collector = srcInfo.Collector
+		v.SupersededBy.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.HasSourceAt {
+		v.HasSourceAt.Collector = srcInfo.Collector
+		v.HasSourceAt.Origin = srcInfo.Source
+	}
+}
+
+// documentHash returns the blob store hash of the original document, or nil
+// if the document was not stored.
+func documentHash(srcInfo processor.SourceInformation) *string {
+	if srcInfo.DocumentHash == "" {
+		return nil
+	}
+	hash := srcInfo.DocumentHash
+	return &hash
 }
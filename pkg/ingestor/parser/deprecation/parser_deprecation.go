@@ -0,0 +1,104 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/deprecation"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+type deprecationParser struct {
+	supersededBy []assembler.SupersededByIngest
+}
+
+// NewDeprecationParser initializes the deprecationParser
+func NewDeprecationParser() common.DocumentParser {
+	return &deprecationParser{
+		supersededBy: []assembler.SupersededByIngest{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *deprecationParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentDeprecation {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentDeprecation, doc.Type)
+	}
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var d deprecation.Deprecation
+		if err := json.Unmarshal(doc.Blob, &d); err != nil {
+			return err
+		}
+		pred, err := getPredicate(&d)
+		if err != nil {
+			return fmt.Errorf("error parsing deprecation document: %w", err)
+		}
+		p.supersededBy = append(p.supersededBy, *pred)
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of deprecation document format: %v", doc.Format)
+}
+
+func (p *deprecationParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		SupersededBy: p.supersededBy,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *deprecationParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (p *deprecationParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return nil, fmt.Errorf("not yet implemented")
+}
+
+func getPredicate(d *deprecation.Deprecation) (*assembler.SupersededByIngest, error) {
+	pkg, err := helpers.PurlToPkg(d.Package)
+	if err != nil {
+		return nil, err
+	}
+	successor, err := helpers.PurlToPkg(d.Successor)
+	if err != nil {
+		return nil, err
+	}
+
+	// An unversioned purl deprecates the package name as a whole.
+	matchType := model.PkgMatchTypeAllVersions
+	if pkg.Version != nil && *pkg.Version != "" && successor.Version != nil && *successor.Version != "" {
+		matchType = model.PkgMatchTypeSpecificVersion
+	}
+
+	return &assembler.SupersededByIngest{
+		Pkg:          pkg,
+		Successor:    successor,
+		PkgMatchFlag: model.MatchFlags{Pkg: matchType},
+		SupersededBy: &model.SupersededByInputSpec{
+			Reason: d.Reason,
+			Since:  d.Since,
+		},
+	}, nil
+}
@@ -0,0 +1,249 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/gomodule"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+// repoHosts are the code hosts whose module paths are of the form
+// <host>/<owner>/<repo>[/<subdirectory>].
+var repoHosts = map[string]bool{
+	"github.com":    true,
+	"gitlab.com":    true,
+	"bitbucket.org": true,
+}
+
+// vanityPrefixes map well known vanity import paths to the repositories they
+// are served from. Other vanity paths need a ?go-get=1 lookup and are not
+// mapped to a source.
+var vanityPrefixes = map[string]string{
+	"golang.org/x/": "go.googlesource.com",
+}
+
+type goModuleParser struct {
+	isDependency []assembler.IsDependencyIngest
+	isOccurrence []assembler.IsOccurenceIngest
+	hasSourceAt  []assembler.HasSourceAtIngest
+}
+
+// NewGoModuleParser initializes the goModuleParser
+func NewGoModuleParser() common.DocumentParser {
+	return &goModuleParser{
+		isDependency: []assembler.IsDependencyIngest{},
+		isOccurrence: []assembler.IsOccurenceIngest{},
+		hasSourceAt:  []assembler.HasSourceAtIngest{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *goModuleParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentGoModule {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentGoModule, doc.Type)
+	}
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var m gomodule.Module
+		if err := json.Unmarshal(doc.Blob, &m); err != nil {
+			return err
+		}
+		if err := p.parseModule(ctx, &m); err != nil {
+			return fmt.Errorf("error parsing go module document: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of go module document format: %v", doc.Format)
+}
+
+func (p *goModuleParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		IsDependency: p.isDependency,
+		IsOccurence:  p.isOccurrence,
+		HasSourceAt:  p.hasSourceAt,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *goModuleParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (p *goModuleParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return nil, fmt.Errorf("not yet implemented")
+}
+
+func (p *goModuleParser) parseModule(ctx context.Context, m *gomodule.Module) error {
+	logger := logging.FromContext(ctx)
+
+	// The lax parser ignores replace directives and the strict one rejects
+	// directives it does not know, so only fall back to the lax one if needed.
+	f, err := modfile.Parse("go.mod", []byte(m.GoMod), nil)
+	if err != nil {
+		logger.Warnf("go.mod of %s@%s has unknown directives, ignoring its replace directives: %v", m.Path, m.Version, err)
+		f, err = modfile.ParseLax("go.mod", []byte(m.GoMod), nil)
+		if err != nil {
+			return fmt.Errorf("unable to parse go.mod of %s@%s: %w", m.Path, m.Version, err)
+		}
+	}
+
+	pkg, err := modulePkg(m.Path, m.Version)
+	if err != nil {
+		return err
+	}
+
+	algorithm, digest, _ := strings.Cut(m.ZipDigest, ":")
+	p.isOccurrence = append(p.isOccurrence, assembler.IsOccurenceIngest{
+		Pkg: pkg,
+		Artifact: &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(algorithm),
+			Digest:    strings.ToLower(digest),
+		},
+		IsOccurence: &model.IsOccurrenceInputSpec{
+			Justification: fmt.Sprintf("module zip served by %s, go.sum hash %s", m.Proxy, m.ZipHash),
+		},
+	})
+
+	if src := moduleSource(m.Path, m.Version); src != nil {
+		p.hasSourceAt = append(p.hasSourceAt, assembler.HasSourceAtIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			Src:          src,
+			HasSourceAt: &model.HasSourceAtInputSpec{
+				KnownSince:    m.Time,
+				Justification: "module path maps to the repository",
+			},
+		})
+	}
+
+	for _, r := range f.Require {
+		depPkg, err := modulePkg(r.Mod.Path, r.Mod.Version)
+		if err != nil {
+			return err
+		}
+		justification := "direct requirement in go.mod"
+		if r.Indirect {
+			justification = "indirect requirement in go.mod"
+		}
+		if replace := findReplace(f.Replace, r.Mod); replace != nil {
+			if replace.New.Version == "" {
+				justification += fmt.Sprintf(", replaced by local directory %s", replace.New.Path)
+			} else {
+				justification += fmt.Sprintf(", replaced by %s@%s", replace.New.Path, replace.New.Version)
+			}
+		}
+		p.isDependency = append(p.isDependency, assembler.IsDependencyIngest{
+			Pkg:    pkg,
+			DepPkg: depPkg,
+			IsDependency: &model.IsDependencyInputSpec{
+				VersionRange:  r.Mod.Version,
+				Justification: justification,
+			},
+		})
+	}
+	return nil
+}
+
+func modulePkg(path, version string) (*model.PkgInputSpec, error) {
+	return helpers.PurlToPkg(fmt.Sprintf("pkg:golang/%s@%s", path, version))
+}
+
+// findReplace returns the replace directive that applies to mod: one for its
+// exact version takes precedence over one for all versions of its path.
+func findReplace(replaces []*modfile.Replace, mod module.Version) *modfile.Replace {
+	var found *modfile.Replace
+	for _, r := range replaces {
+		if r.Old.Path != mod.Path {
+			continue
+		}
+		if r.Old.Version == mod.Version {
+			return r
+		}
+		if r.Old.Version == "" {
+			found = r
+		}
+	}
+	return found
+}
+
+// moduleSource maps a module version to the git repository it is developed
+// in, or returns nil if the module path is not on a known code host. Modules
+// in a subdirectory of the repository are tagged with the subdirectory as
+// prefix, and pseudo-versions point to a commit rather than a tag.
+func moduleSource(path, version string) *model.SourceInputSpec {
+	prefix, _, ok := module.SplitPathVersion(path)
+	if !ok {
+		return nil
+	}
+	elems := strings.Split(prefix, "/")
+	var namespace, name string
+	var subdir []string
+	if host := vanityHost(prefix); host != "" {
+		if len(elems) < 3 {
+			return nil
+		}
+		namespace, name, subdir = host, elems[2], elems[3:]
+	} else if repoHosts[elems[0]] {
+		if len(elems) < 3 {
+			return nil
+		}
+		namespace, name, subdir = elems[0]+"/"+elems[1], elems[2], elems[3:]
+	} else {
+		return nil
+	}
+
+	src := &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: namespace,
+		Name:      name,
+	}
+	if module.IsPseudoVersion(version) {
+		rev, err := module.PseudoVersionRev(version)
+		if err != nil {
+			return nil
+		}
+		src.Commit = &rev
+		return src
+	}
+	tag := strings.TrimSuffix(version, "+incompatible")
+	if len(subdir) > 0 {
+		tag = strings.Join(subdir, "/") + "/" + tag
+	}
+	src.Tag = &tag
+	return src
+}
+
+func vanityHost(path string) string {
+	for prefix, host := range vanityPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return host
+		}
+	}
+	return ""
+}
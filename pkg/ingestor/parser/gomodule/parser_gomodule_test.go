@@ -0,0 +1,217 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var helloModule = []byte(`{
+  "path": "github.com/example/hello/v2",
+  "version": "v2.1.0",
+  "time": "2023-02-10T00:00:00Z",
+  "goMod": "module github.com/example/hello/v2\n\ngo 1.19\n\nrequire (\n\tgithub.com/Example/greeter v0.0.0-20230201000000-abcdef123456\n\tgolang.org/x/text v0.8.0 // indirect\n)\n\nreplace github.com/Example/greeter => github.com/example/greeter-fork v0.1.1\n\nreplace golang.org/x/text v0.8.0 => ../text\n",
+  "zipDigest": "sha256:70CC31449EED7ACB14D1757D8B99048F502751C8E30E5A489E59EB343D471C98",
+  "zipHash": "h1:mwGaKdLmYhUMbzaKaE9u5VhXmJPGqGlS4V6JH8qwDGk=",
+  "proxy": "https://proxy.golang.org"
+}`)
+
+var goplsModule = []byte(`{
+  "path": "golang.org/x/tools/gopls",
+  "version": "v0.0.0-20230201000000-abcdef123456",
+  "time": "2023-02-01T00:00:00Z",
+  "goMod": "module golang.org/x/tools/gopls\n",
+  "zipDigest": "sha256:4f2948c8d6d5520ac417400b158c8033f4aa136bc5107738cca82f6f6595dd3b",
+  "zipHash": "h1:lqA5RlO0fBl1OuzOQbiRZIXkwo1JG0uCfW9tL1L2W0s=",
+  "proxy": "https://proxy.golang.org"
+}`)
+
+var quoteModule = []byte(`{
+  "path": "rsc.io/quote",
+  "version": "v1.5.2",
+  "time": "2018-02-14T15:44:20Z",
+  "goMod": "module \"rsc.io/quote\"\n\nrequire rsc.io/sampler v1.3.0\n",
+  "zipDigest": "sha256:ff5c3d7ae764605f64363802fdd1f7f39c1043b5d9a73a3980f5ca3ee501e8d7",
+  "zipHash": "h1:5uvltJqvuHAQZOZc33aiiQcZZzlnAPBqdbPe+F3fFs0=",
+  "proxy": "https://proxy.golang.org"
+}`)
+
+func golangPkg(namespace, name, version string) *model.PkgInputSpec {
+	subpath := ""
+	return &model.PkgInputSpec{
+		Type:      "golang",
+		Namespace: &namespace,
+		Name:      name,
+		Version:   &version,
+		Subpath:   &subpath,
+	}
+}
+
+func Test_goModuleParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	hello := golangPkg("github.com/example/hello", "v2", "v2.1.0")
+	gopls := golangPkg("golang.org/x/tools", "gopls", "v0.0.0-20230201000000-abcdef123456")
+	quote := golangPkg("rsc.io", "quote", "v1.5.2")
+	tag := "v2.1.0"
+	commit := "abcdef123456"
+	tests := []struct {
+		name           string
+		doc            *processor.Document
+		wantPredicates *assembler.IngestPredicates
+		wantErr        bool
+	}{{
+		name: "requirements with replace directives",
+		doc: &processor.Document{
+			Blob:   helloModule,
+			Type:   processor.DocumentGoModule,
+			Format: processor.FormatJSON,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			IsOccurence: []assembler.IsOccurenceIngest{{
+				Pkg: hello,
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "70cc31449eed7acb14d1757d8b99048f502751c8e30e5a489e59eb343d471c98",
+				},
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: "module zip served by https://proxy.golang.org, go.sum hash h1:mwGaKdLmYhUMbzaKaE9u5VhXmJPGqGlS4V6JH8qwDGk=",
+				},
+			}},
+			HasSourceAt: []assembler.HasSourceAtIngest{{
+				Pkg:          hello,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src: &model.SourceInputSpec{
+					Type:      "git",
+					Namespace: "github.com/example",
+					Name:      "hello",
+					Tag:       &tag,
+				},
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC),
+					Justification: "module path maps to the repository",
+				},
+			}},
+			IsDependency: []assembler.IsDependencyIngest{{
+				Pkg:    hello,
+				DepPkg: golangPkg("github.com/example", "greeter", "v0.0.0-20230201000000-abcdef123456"),
+				IsDependency: &model.IsDependencyInputSpec{
+					VersionRange:  "v0.0.0-20230201000000-abcdef123456",
+					Justification: "direct requirement in go.mod, replaced by github.com/example/greeter-fork@v0.1.1",
+				},
+			}, {
+				Pkg:    hello,
+				DepPkg: golangPkg("golang.org/x", "text", "v0.8.0"),
+				IsDependency: &model.IsDependencyInputSpec{
+					VersionRange:  "v0.8.0",
+					Justification: "indirect requirement in go.mod, replaced by local directory ../text",
+				},
+			}},
+		},
+	}, {
+		name: "pseudo-version of a module in a subdirectory",
+		doc: &processor.Document{
+			Blob:   goplsModule,
+			Type:   processor.DocumentGoModule,
+			Format: processor.FormatJSON,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			IsOccurence: []assembler.IsOccurenceIngest{{
+				Pkg: gopls,
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "4f2948c8d6d5520ac417400b158c8033f4aa136bc5107738cca82f6f6595dd3b",
+				},
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: "module zip served by https://proxy.golang.org, go.sum hash h1:lqA5RlO0fBl1OuzOQbiRZIXkwo1JG0uCfW9tL1L2W0s=",
+				},
+			}},
+			HasSourceAt: []assembler.HasSourceAtIngest{{
+				Pkg:          gopls,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src: &model.SourceInputSpec{
+					Type:      "git",
+					Namespace: "go.googlesource.com",
+					Name:      "tools",
+					Commit:    &commit,
+				},
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+					Justification: "module path maps to the repository",
+				},
+			}},
+		},
+	}, {
+		name: "vanity path without a known repository",
+		doc: &processor.Document{
+			Blob:   quoteModule,
+			Type:   processor.DocumentGoModule,
+			Format: processor.FormatJSON,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			IsOccurence: []assembler.IsOccurenceIngest{{
+				Pkg: quote,
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "ff5c3d7ae764605f64363802fdd1f7f39c1043b5d9a73a3980f5ca3ee501e8d7",
+				},
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: "module zip served by https://proxy.golang.org, go.sum hash h1:5uvltJqvuHAQZOZc33aiiQcZZzlnAPBqdbPe+F3fFs0=",
+				},
+			}},
+			IsDependency: []assembler.IsDependencyIngest{{
+				Pkg:    quote,
+				DepPkg: golangPkg("rsc.io", "sampler", "v1.3.0"),
+				IsDependency: &model.IsDependencyInputSpec{
+					VersionRange:  "v1.3.0",
+					Justification: "direct requirement in go.mod",
+				},
+			}},
+		},
+	}, {
+		name: "invalid go.mod",
+		doc: &processor.Document{
+			Blob:   []byte(`{"path": "example.com/hello", "version": "v1.0.0", "goMod": "require (\n"}`),
+			Type:   processor.DocumentGoModule,
+			Format: processor.FormatJSON,
+		},
+		wantPredicates: &assembler.IngestPredicates{},
+		wantErr:        true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewGoModuleParser()
+			if err := s.Parse(ctx, tt.doc); (err != nil) != tt.wantErr {
+				t.Errorf("gomodule.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			preds := s.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("gomodule.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
@@ -25,8 +25,11 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/ingestor/parser/cyclonedx"
+	"github.com/guacsec/guac/pkg/ingestor/parser/deprecation"
 	"github.com/guacsec/guac/pkg/ingestor/parser/dsse"
+	"github.com/guacsec/guac/pkg/ingestor/parser/gomodule"
 	"github.com/guacsec/guac/pkg/ingestor/parser/scorecard"
+	"github.com/guacsec/guac/pkg/ingestor/parser/signature"
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/vuln"
@@ -41,6 +44,9 @@ func init() {
 	_ = RegisterDocumentParser(spdx.NewSpdxParser, processor.DocumentSPDX)
 	_ = RegisterDocumentParser(cyclonedx.NewCycloneDXParser, processor.DocumentCycloneDX)
 	_ = RegisterDocumentParser(scorecard.NewScorecardParser, processor.DocumentScorecard)
+	_ = RegisterDocumentParser(signature.NewSignatureParser, processor.DocumentSignature)
+	_ = RegisterDocumentParser(deprecation.NewDeprecationParser, processor.DocumentDeprecation)
+	_ = RegisterDocumentParser(gomodule.NewGoModuleParser, processor.DocumentGoModule)
 }
 
 var (
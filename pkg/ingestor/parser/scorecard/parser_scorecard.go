@@ -19,11 +19,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	sc "github.com/ossf/scorecard/v4/pkg"
@@ -89,22 +89,13 @@ func (p *scorecardParser) GetIdentifiers(ctx context.Context) (*common.Identifie
 }
 
 func getPredicates(s *sc.JSONScorecardResultV2) (*model.ScorecardInputSpec, *model.SourceInputSpec, error) {
-	var ns, name string
-	idx := strings.LastIndex(s.Repo.Name, "/")
-	if idx < 0 {
-		name = s.Repo.Name
-	}
-
-	ns = s.Repo.Name[:idx]
-	name = s.Repo.Name[idx+1:]
-
-	srcInput := model.SourceInputSpec{
-		// assuming scorecards is only git
-		Type:      "git",
-		Namespace: ns,
-		Name:      name,
-		Commit:    &s.Repo.Commit,
+	// assuming scorecards is only git, the repository name is its location
+	// without scheme, e.g. github.com/kubernetes/kubernetes
+	srcInput, err := helpers.VcsToSrcInput("git+https://" + s.Repo.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse scorecard repository %s: %w", s.Repo.Name, err)
 	}
+	srcInput.Commit = &s.Repo.Commit
 
 	var checks []model.ScorecardCheckInputSpec
 	for _, c := range s.Checks {
@@ -114,11 +105,7 @@ func getPredicates(s *sc.JSONScorecardResultV2) (*model.ScorecardInputSpec, *mod
 		})
 	}
 
-	var (
-		timeScanned time.Time
-		err         error
-	)
-	timeScanned, err = time.Parse(time.RFC3339, s.Date)
+	timeScanned, err := time.Parse(time.RFC3339, s.Date)
 	if err != nil {
 		// at the moment, scorecard doesn't use RFC3339 and a custom format
 		// heuristic to check this and convert to RFC3339.
@@ -137,5 +124,5 @@ func getPredicates(s *sc.JSONScorecardResultV2) (*model.ScorecardInputSpec, *mod
 		ScorecardVersion: s.Scorecard.Version,
 		ScorecardCommit:  s.Scorecard.Commit,
 	}
-	return &scInput, &srcInput, nil
+	return &scInput, srcInput, nil
 }
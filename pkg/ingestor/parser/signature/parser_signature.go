@@ -0,0 +1,122 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/signature"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+var signatureTypes = map[signature.Type]model.SignatureType{
+	signature.TypeCosign:   model.SignatureTypeCosign,
+	signature.TypeNotation: model.SignatureTypeNotation,
+	signature.TypeGPG:      model.SignatureTypeGpg,
+}
+
+var signatureStatuses = map[signature.Status]model.SignatureStatus{
+	signature.StatusVerified: model.SignatureStatusVerified,
+	signature.StatusFailed:   model.SignatureStatusFailed,
+}
+
+type signatureParser struct {
+	certifySigned []assembler.CertifySignedIngest
+}
+
+// NewSignatureParser initializes the signatureParser
+func NewSignatureParser() common.DocumentParser {
+	return &signatureParser{
+		certifySigned: []assembler.CertifySignedIngest{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (p *signatureParser) Parse(ctx context.Context, doc *processor.Document) error {
+	if doc.Type != processor.DocumentSignature {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSignature, doc.Type)
+	}
+
+	switch doc.Format {
+	case processor.FormatJSON:
+		var v signature.Verification
+		if err := json.Unmarshal(doc.Blob, &v); err != nil {
+			return err
+		}
+		preds, err := getPredicates(&v)
+		if err != nil {
+			return fmt.Errorf("error parsing signature document: %w", err)
+		}
+		p.certifySigned = append(p.certifySigned, preds...)
+		return nil
+	}
+	return fmt.Errorf("unable to support parsing of signature document format: %v", doc.Format)
+}
+
+func (p *signatureParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		CertifySigned: p.certifySigned,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (p *signatureParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (p *signatureParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return nil, fmt.Errorf("not yet implemented")
+}
+
+func getPredicates(v *signature.Verification) ([]assembler.CertifySignedIngest, error) {
+	algorithm, digest, ok := strings.Cut(v.Artifact, ":")
+	if !ok {
+		return nil, fmt.Errorf("artifact digest %q is not of the form algorithm:encoded", v.Artifact)
+	}
+	artifact := &model.ArtifactInputSpec{
+		Algorithm: strings.ToLower(algorithm),
+		Digest:    strings.ToLower(digest),
+	}
+
+	var preds []assembler.CertifySignedIngest
+	for _, s := range v.Signatures {
+		sigType, ok := signatureTypes[s.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown signature type %q", s.Type)
+		}
+		status, ok := signatureStatuses[s.Status]
+		if !ok {
+			return nil, fmt.Errorf("unknown signature status %q", s.Status)
+		}
+		preds = append(preds, assembler.CertifySignedIngest{
+			Artifact: artifact,
+			CertifySigned: &model.CertifySignedInputSpec{
+				Signer:        s.Signer,
+				SignatureType: sigType,
+				Status:        status,
+				VerifiedAt:    s.VerifiedAt,
+				TrustRoot:     s.TrustRoot,
+			},
+		})
+	}
+	return preds, nil
+}
@@ -34,27 +34,46 @@ import (
 )
 
 type spdxParser struct {
-	// TODO: Add hasSBOMInputSpec when its created
 	doc              *processor.Document
 	packagePackages  map[string][]model.PkgInputSpec
 	packageArtifacts map[string][]model.ArtifactInputSpec
 	filePackages     map[string][]model.PkgInputSpec
 	fileArtifacts    map[string][]model.ArtifactInputSpec
+	// files dropped because of the file mode
+	skippedFiles map[string]bool
+	fileMode     processor.SPDXFileMode
+	stats        spdxStats
 
 	spdxDoc *v2_2.Document
 }
 
+// spdxStats summarizes what was ingested from an SPDX document, so that it is
+// clear what a file mode other than full left out.
+type spdxStats struct {
+	packages     int
+	files        int
+	skippedFiles int
+	fileMode     processor.SPDXFileMode
+	// relationships dropped because they reference a skipped file
+	skippedRelationships int
+}
+
 func NewSpdxParser() common.DocumentParser {
 	return &spdxParser{
 		packagePackages:  map[string][]model.PkgInputSpec{},
 		packageArtifacts: map[string][]model.ArtifactInputSpec{},
 		filePackages:     map[string][]model.PkgInputSpec{},
 		fileArtifacts:    map[string][]model.ArtifactInputSpec{},
+		skippedFiles:     map[string]bool{},
 	}
 }
 
 func (s *spdxParser) Parse(ctx context.Context, doc *processor.Document) error {
 	s.doc = doc
+	s.fileMode = doc.ParserOptions.SPDXFiles
+	if s.fileMode == "" {
+		s.fileMode = processor.SPDXFilesFull
+	}
 	spdxDoc, err := parseSpdxBlob(doc.Blob)
 	if err != nil {
 		return fmt.Errorf("failed to parse SPDX document: %w", err)
@@ -130,11 +149,21 @@ func (s *spdxParser) getPackages() error {
 
 func (s *spdxParser) getFiles() error {
 	for _, file := range s.spdxDoc.Files {
+		if s.fileMode == processor.SPDXFilesSkip {
+			s.skippedFiles[string(file.FileSPDXIdentifier)] = true
+			continue
+		}
+
+		// without the file name, files with the same content share their package
+		fileName := &file.FileName
+		if s.fileMode == processor.SPDXFilesChecksumsOnly {
+			fileName = nil
+		}
 
 		// if checksums exists create an artifact for each of them
 		for _, checksum := range file.Checksums {
 			// for each file create a package for each of them so they can be referenced as a dependency
-			purl := asmhelpers.GuacFilePurl(strings.ToLower(string(checksum.Algorithm)), checksum.Value, &file.FileName)
+			purl := asmhelpers.GuacFilePurl(strings.ToLower(string(checksum.Algorithm)), checksum.Value, fileName)
 			pkg, err := asmhelpers.PurlToPkg(purl)
 			if err != nil {
 				return err
@@ -187,6 +216,21 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 	// adding top level package edge manually for all depends on package
 	if toplevel != nil {
 		preds.IsDependency = append(preds.IsDependency, createTopLevelIsDeps(toplevel[0], s.packagePackages, s.filePackages, "top-level package GUAC heuristic connecting to each file/package")...)
+		// record the file mode so that consumers know if files are missing
+		fileMode := string(s.fileMode)
+		preds.HasSBOM = append(preds.HasSBOM, assembler.HasSBOMIngest{
+			Pkg: &toplevel[0],
+			HasSBOM: &model.HasSBOMInputSpec{
+				Uri:      s.spdxDoc.DocumentNamespace,
+				FileMode: &fileMode,
+			},
+		})
+	}
+	s.stats = spdxStats{
+		packages:     len(s.spdxDoc.Packages),
+		files:        len(s.spdxDoc.Files) - len(s.skippedFiles),
+		skippedFiles: len(s.skippedFiles),
+		fileMode:     s.fileMode,
 	}
 	for _, rel := range s.spdxDoc.Relationships {
 
@@ -197,6 +241,12 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 			continue
 		}
 
+		// the edges of skipped files are dropped with them
+		if s.skippedFiles[string(rel.RefA.ElementRefID)] || s.skippedFiles[string(rel.RefB.ElementRefID)] {
+			s.stats.skippedRelationships++
+			continue
+		}
+
 		foundPackNodes := s.getPackageElement(string(rel.RefA.ElementRefID))
 		foundFileNodes := s.getFileElement(string(rel.RefA.ElementRefID))
 		relatedPackNodes := s.getPackageElement(string(rel.RefB.ElementRefID))
@@ -255,6 +305,8 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 		}
 	}
 
+	logger.Infof("parsed SPDX document %s with file mode %s: %d packages, %d files, %d files and %d relationships skipped",
+		s.spdxDoc.DocumentNamespace, s.stats.fileMode, s.stats.packages, s.stats.files, s.stats.skippedFiles, s.stats.skippedRelationships)
 	return preds
 }
 
@@ -17,11 +17,13 @@ package spdx
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -66,3 +68,115 @@ func Test_spdxParser(t *testing.T) {
 		})
 	}
 }
+
+func Test_spdxParserFileModes(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	type counts struct {
+		Packages             int
+		FilePackages         int
+		Artifacts            int
+		IsDependency         int
+		IsOccurrence         int
+		SkippedRelationships int
+	}
+	tests := []struct {
+		name     string
+		fileMode processor.SPDXFileMode
+		want     counts
+	}{{
+		name:     "full",
+		fileMode: processor.SPDXFilesFull,
+		want: counts{
+			Packages:     8,
+			FilePackages: 4,
+			Artifacts:    4,
+			IsDependency: 11,
+			IsOccurrence: 4,
+		},
+	}, {
+		name:     "default is full",
+		fileMode: "",
+		want: counts{
+			Packages:     8,
+			FilePackages: 4,
+			Artifacts:    4,
+			IsDependency: 11,
+			IsOccurrence: 4,
+		},
+	}, {
+		name:     "checksums only",
+		fileMode: processor.SPDXFilesChecksumsOnly,
+		want: counts{
+			Packages:     8,
+			FilePackages: 4,
+			Artifacts:    4,
+			IsDependency: 11,
+			IsOccurrence: 4,
+		},
+	}, {
+		name:     "skip",
+		fileMode: processor.SPDXFilesSkip,
+		want: counts{
+			Packages:             4,
+			IsDependency:         4,
+			SkippedRelationships: 3,
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpdxParser()
+			err := s.Parse(ctx, &processor.Document{
+				Blob:          testdata.SpdxExampleAlpine,
+				Format:        processor.FormatJSON,
+				Type:          processor.DocumentSPDX,
+				ParserOptions: processor.ParserOptions{SPDXFiles: tt.fileMode},
+			})
+			if err != nil {
+				t.Fatalf("spdxParser.Parse() error = %v", err)
+			}
+			preds := s.GetPredicates(ctx)
+
+			packages := map[string]bool{}
+			filePackages := map[string]bool{}
+			artifacts := map[string]bool{}
+			addPackage := func(pkg *model.PkgInputSpec) {
+				key, _ := json.Marshal(pkg)
+				packages[string(key)] = true
+				if pkg.Namespace != nil && *pkg.Namespace == "files" {
+					filePackages[string(key)] = true
+					if tt.fileMode == processor.SPDXFilesChecksumsOnly && pkg.Subpath != nil && *pkg.Subpath != "" {
+						t.Errorf("file package %s is named after the file", key)
+					}
+				}
+			}
+			for _, dep := range preds.IsDependency {
+				addPackage(dep.Pkg)
+				addPackage(dep.DepPkg)
+			}
+			for _, occ := range preds.IsOccurence {
+				addPackage(occ.Pkg)
+				key, _ := json.Marshal(occ.Artifact)
+				artifacts[string(key)] = true
+			}
+			got := counts{
+				Packages:             len(packages),
+				FilePackages:         len(filePackages),
+				Artifacts:            len(artifacts),
+				IsDependency:         len(preds.IsDependency),
+				IsOccurrence:         len(preds.IsOccurence),
+				SkippedRelationships: s.(*spdxParser).stats.skippedRelationships,
+			}
+			if d := cmp.Diff(tt.want, got); len(d) != 0 {
+				t.Errorf("spdx.GetPredicate node counts mismatch (-want +got): %s", d)
+			}
+
+			wantMode := tt.fileMode
+			if wantMode == "" {
+				wantMode = processor.SPDXFilesFull
+			}
+			if len(preds.HasSBOM) != 1 || preds.HasSBOM[0].HasSBOM.FileMode == nil || *preds.HasSBOM[0].HasSBOM.FileMode != string(wantMode) {
+				t.Errorf("HasSBOM does not record file mode %s: %+v", wantMode, preds.HasSBOM)
+			}
+		})
+	}
+}